@@ -0,0 +1,350 @@
+package tfe
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// AccessReportPrincipalType represents the type of principal holding access.
+type AccessReportPrincipalType string
+
+// List all available access report principal types.
+const (
+	AccessReportPrincipalUser AccessReportPrincipalType = "user"
+	AccessReportPrincipalTeam AccessReportPrincipalType = "team"
+)
+
+// AccessReportResourceType represents the type of resource access is held on.
+type AccessReportResourceType string
+
+// List all available access report resource types.
+const (
+	AccessReportResourceOrganization AccessReportResourceType = "organization"
+	AccessReportResourceTeam         AccessReportResourceType = "team"
+	AccessReportResourceWorkspace    AccessReportResourceType = "workspace"
+)
+
+// List the permissions reported besides the organization access permissions
+// and workspace access types of teams.
+const (
+	AccessReportPermissionMember   = "member"
+	AccessReportPermissionAPIToken = "api-token"
+)
+
+// accessReportHeader is the header of the CSV export of an access report.
+var accessReportHeader = []string{
+	"principal_type", "principal", "principal_id", "team",
+	"resource_type", "resource", "resource_id", "permission", "detail",
+	"created_at", "last_used_at",
+}
+
+// AccessReport represents the access granted within an organization, as a
+// flat list of normalized entries suited for access reviews.
+type AccessReport struct {
+	Organization string               `json:"organization"`
+	GeneratedAt  time.Time            `json:"generated_at"`
+	Entries      []*AccessReportEntry `json:"entries"`
+}
+
+// AccessReportEntry represents a single permission a principal holds on a
+// resource.
+type AccessReportEntry struct {
+	PrincipalType AccessReportPrincipalType `json:"principal_type"`
+
+	// The email of a user, or the name of a team.
+	Principal string `json:"principal"`
+
+	// The organization membership ID of a user, or the ID of a team.
+	PrincipalID string `json:"principal_id"`
+
+	// The name of the team the permission is granted through, if any.
+	Team string `json:"team,omitempty"`
+
+	ResourceType AccessReportResourceType `json:"resource_type"`
+	Resource     string                   `json:"resource"`
+	ResourceID   string                   `json:"resource_id"`
+
+	// The permission, e.g. "member", "api-token", an organization access
+	// permission such as "manage-workspaces", or a workspace access type.
+	Permission string `json:"permission"`
+
+	// Additional details of the permission, e.g. the status of an
+	// organization membership or the permissions of custom workspace access.
+	Detail string `json:"detail,omitempty"`
+
+	// When a team token was created and last used.
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+}
+
+// GenerateAccessReport enumerates the organization memberships, teams, team
+// members, team tokens and team workspace access of an organization into an
+// access report. Reading team tokens requires an owners token.
+func GenerateAccessReport(ctx context.Context, client *Client, organization string) (*AccessReport, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+
+	report := &AccessReport{
+		Organization: organization,
+		GeneratedAt:  client.clock.Now(),
+	}
+
+	teams, err := listAllTeams(ctx, client, organization)
+	if err != nil {
+		return nil, err
+	}
+	teamNames := make(map[string]string, len(teams))
+	for _, t := range teams {
+		teamNames[t.ID] = t.Name
+	}
+
+	memberships, err := listAllOrganizationMemberships(ctx, client, organization)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range memberships {
+		report.add(&AccessReportEntry{
+			PrincipalType: AccessReportPrincipalUser,
+			Principal:     m.Email,
+			PrincipalID:   m.ID,
+			ResourceType:  AccessReportResourceOrganization,
+			Resource:      organization,
+			ResourceID:    organization,
+			Permission:    AccessReportPermissionMember,
+			Detail:        string(m.Status),
+		})
+		for _, t := range m.Teams {
+			report.add(&AccessReportEntry{
+				PrincipalType: AccessReportPrincipalUser,
+				Principal:     m.Email,
+				PrincipalID:   m.ID,
+				Team:          teamNames[t.ID],
+				ResourceType:  AccessReportResourceTeam,
+				Resource:      teamNames[t.ID],
+				ResourceID:    t.ID,
+				Permission:    AccessReportPermissionMember,
+			})
+		}
+	}
+
+	for _, t := range teams {
+		for _, permission := range t.OrganizationAccess.permissions() {
+			report.add(&AccessReportEntry{
+				PrincipalType: AccessReportPrincipalTeam,
+				Principal:     t.Name,
+				PrincipalID:   t.ID,
+				Team:          t.Name,
+				ResourceType:  AccessReportResourceOrganization,
+				Resource:      organization,
+				ResourceID:    organization,
+				Permission:    permission,
+			})
+		}
+
+		token, err := client.TeamTokens.Read(ctx, t.ID)
+		if err == ErrResourceNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		report.add(&AccessReportEntry{
+			PrincipalType: AccessReportPrincipalTeam,
+			Principal:     t.Name,
+			PrincipalID:   t.ID,
+			Team:          t.Name,
+			ResourceType:  AccessReportResourceTeam,
+			Resource:      t.Name,
+			ResourceID:    t.ID,
+			Permission:    AccessReportPermissionAPIToken,
+			Detail:        token.Description,
+			CreatedAt:     token.CreatedAt,
+			LastUsedAt:    token.LastUsedAt,
+		})
+	}
+
+	workspaces, err := listAllWorkspaces(ctx, client, organization, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range workspaces {
+		accesses, err := listAllTeamAccesses(ctx, client, w.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, ta := range accesses {
+			if ta.Team == nil {
+				continue
+			}
+			report.add(&AccessReportEntry{
+				PrincipalType: AccessReportPrincipalTeam,
+				Principal:     teamNames[ta.Team.ID],
+				PrincipalID:   ta.Team.ID,
+				Team:          teamNames[ta.Team.ID],
+				ResourceType:  AccessReportResourceWorkspace,
+				Resource:      w.Name,
+				ResourceID:    w.ID,
+				Permission:    string(ta.Access),
+				Detail:        ta.customPermissions(),
+			})
+		}
+	}
+
+	return report, nil
+}
+
+// WriteJSON writes the access report as indented JSON.
+func (r *AccessReport) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteCSV writes the entries of the access report as CSV, with a header
+// row. Times are formatted as RFC 3339, and left empty when unknown.
+func (r *AccessReport) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(accessReportHeader); err != nil {
+		return err
+	}
+
+	for _, e := range r.Entries {
+		err := cw.Write([]string{
+			string(e.PrincipalType), e.Principal, e.PrincipalID, e.Team,
+			string(e.ResourceType), e.Resource, e.ResourceID, e.Permission, e.Detail,
+			formatAccessReportTime(e.CreatedAt), formatAccessReportTime(e.LastUsedAt),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func (r *AccessReport) add(e *AccessReportEntry) {
+	r.Entries = append(r.Entries, e)
+}
+
+// permissions returns the names of the organization access permissions which
+// are granted, in alphabetical order.
+func (a *OrganizationAccess) permissions() []string {
+	if a == nil {
+		return nil
+	}
+
+	granted := map[string]bool{
+		"manage-modules":          a.ManageModules,
+		"manage-policies":         a.ManagePolicies,
+		"manage-policy-overrides": a.ManagePolicyOverrides,
+		"manage-providers":        a.ManageProviders,
+		"manage-run-tasks":        a.ManageRunTasks,
+		"manage-vcs-settings":     a.ManageVCSSettings,
+		"manage-workspaces":       a.ManageWorkspaces,
+	}
+
+	var permissions []string
+	for permission, ok := range granted {
+		if ok {
+			permissions = append(permissions, permission)
+		}
+	}
+	sort.Strings(permissions)
+
+	return permissions
+}
+
+// customPermissions describes the individual permissions of custom workspace
+// access, and is empty for the other access types.
+func (ta *TeamAccess) customPermissions() string {
+	if ta.Access != AccessCustom {
+		return ""
+	}
+
+	return strings.Join([]string{
+		fmt.Sprintf("runs=%s", ta.Runs),
+		fmt.Sprintf("variables=%s", ta.Variables),
+		fmt.Sprintf("state-versions=%s", ta.StateVersions),
+		fmt.Sprintf("sentinel-mocks=%s", ta.SentinelMocks),
+		fmt.Sprintf("workspace-locking=%t", ta.WorkspaceLocking),
+		fmt.Sprintf("run-tasks=%t", ta.RunTasks),
+	}, " ")
+}
+
+func formatAccessReportTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// listAllTeams lists the teams within an organization, following the
+// pagination until all pages are read.
+func listAllTeams(ctx context.Context, client *Client, organization string) ([]*Team, error) {
+	opts := TeamListOptions{}
+
+	var teams []*Team
+	for {
+		tl, err := client.Teams.List(ctx, organization, &opts)
+		if err != nil {
+			return nil, err
+		}
+		teams = append(teams, tl.Items...)
+
+		if tl.Pagination == nil || tl.NextPage == 0 {
+			return teams, nil
+		}
+		opts.PageNumber = tl.NextPage
+	}
+}
+
+// listAllOrganizationMemberships lists the organization memberships within
+// an organization including their teams, following the pagination until all
+// pages are read.
+func listAllOrganizationMemberships(ctx context.Context, client *Client, organization string) ([]*OrganizationMembership, error) {
+	opts := OrganizationMembershipListOptions{
+		Include: []OrgMembershipIncludeOpt{OrgMembershipTeam},
+	}
+
+	var memberships []*OrganizationMembership
+	for {
+		ml, err := client.OrganizationMemberships.List(ctx, organization, &opts)
+		if err != nil {
+			return nil, err
+		}
+		memberships = append(memberships, ml.Items...)
+
+		if ml.Pagination == nil || ml.NextPage == 0 {
+			return memberships, nil
+		}
+		opts.PageNumber = ml.NextPage
+	}
+}
+
+// listAllTeamAccesses lists the team accesses of a workspace, following the
+// pagination until all pages are read.
+func listAllTeamAccesses(ctx context.Context, client *Client, workspaceID string) ([]*TeamAccess, error) {
+	opts := TeamAccessListOptions{WorkspaceID: workspaceID}
+
+	var accesses []*TeamAccess
+	for {
+		tal, err := client.TeamAccess.List(ctx, &opts)
+		if err != nil {
+			return nil, err
+		}
+		accesses = append(accesses, tal.Items...)
+
+		if tal.Pagination == nil || tal.NextPage == 0 {
+			return accesses, nil
+		}
+		opts.PageNumber = tal.NextPage
+	}
+}
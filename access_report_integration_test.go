@@ -0,0 +1,108 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateAccessReport(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/organizations/acme/teams":
+			fmt.Fprint(w, `{"data":[`+
+				`{"id":"team-owners","type":"teams","attributes":{"name":"owners","organization-access":{"manage-workspaces":true,"manage-policies":true}}},`+
+				`{"id":"team-dev","type":"teams","attributes":{"name":"dev","organization-access":{}}}]}`)
+		case "/api/v2/organizations/acme/organization-memberships":
+			assert.Equal(t, "teams", r.URL.Query().Get("include"))
+			fmt.Fprint(w, `{"data":[{"id":"ou-1","type":"organization-memberships","attributes":{"email":"jane@example.com","status":"active"},`+
+				`"relationships":{"teams":{"data":[{"id":"team-dev","type":"teams"}]}}}]}`)
+		case "/api/v2/teams/team-owners/authentication-token":
+			fmt.Fprint(w, `{"data":{"id":"at-1","type":"authentication-tokens","attributes":{"description":"ci","created-at":"2023-01-01T12:00:00Z","last-used-at":"2023-02-01T12:00:00Z"}}}`)
+		case "/api/v2/organizations/acme/workspaces":
+			fmt.Fprint(w, `{"data":[{"id":"ws-1","type":"workspaces","attributes":{"name":"app"}}]}`)
+		case "/api/v2/team-workspaces":
+			assert.Equal(t, "ws-1", r.URL.Query().Get("filter[workspace][id]"))
+			fmt.Fprint(w, `{"data":[{"id":"tws-1","type":"team-workspaces","attributes":{"access":"custom","runs":"apply","variables":"read","state-versions":"read","sentinel-mocks":"none","workspace-locking":true},`+
+				`"relationships":{"team":{"data":{"id":"team-dev","type":"teams"}}}}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Clock:      NewFakeClock(now),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	report, err := GenerateAccessReport(ctx, client, "acme")
+	require.NoError(t, err)
+	assert.Equal(t, "acme", report.Organization)
+	assert.Equal(t, now, report.GeneratedAt)
+
+	var permissions []string
+	for _, e := range report.Entries {
+		permissions = append(permissions, fmt.Sprintf("%s %s %s:%s %s", e.PrincipalType, e.Principal, e.ResourceType, e.Resource, e.Permission))
+	}
+	assert.Equal(t, []string{
+		"user jane@example.com organization:acme member",
+		"user jane@example.com team:dev member",
+		"team owners organization:acme manage-policies",
+		"team owners organization:acme manage-workspaces",
+		"team owners team:owners api-token",
+		"team dev workspace:app custom",
+	}, permissions)
+
+	token := report.Entries[4]
+	assert.Equal(t, "ci", token.Detail)
+	assert.Equal(t, time.Date(2023, 2, 1, 12, 0, 0, 0, time.UTC), token.LastUsedAt)
+	assert.Equal(t, "runs=apply variables=read state-versions=read sentinel-mocks=none workspace-locking=true run-tasks=false", report.Entries[5].Detail)
+
+	t.Run("when exporting as CSV", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, report.WriteCSV(&buf))
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.Len(t, lines, 7)
+		assert.Equal(t, "principal_type,principal,principal_id,team,resource_type,resource,resource_id,permission,detail,created_at,last_used_at", lines[0])
+		assert.Equal(t, "user,jane@example.com,ou-1,,organization,acme,acme,member,active,,", lines[1])
+		assert.Equal(t, "team,owners,team-owners,owners,team,owners,team-owners,api-token,ci,2023-01-01T12:00:00Z,2023-02-01T12:00:00Z", lines[5])
+	})
+
+	t.Run("when exporting as JSON", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, report.WriteJSON(&buf))
+
+		decoded := &AccessReport{}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), decoded))
+		assert.Equal(t, report, decoded)
+	})
+
+	t.Run("with an invalid organization", func(t *testing.T) {
+		_, err := GenerateAccessReport(ctx, client, badIdentifier)
+		assert.Equal(t, ErrInvalidOrg, err)
+	})
+}
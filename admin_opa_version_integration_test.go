@@ -0,0 +1,142 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminOPAVersions_CreateDelete(t *testing.T) {
+	skipIfCloud(t)
+
+	client := testClient(t)
+	ctx := context.Background()
+
+	t.Run("with valid options", func(t *testing.T) {
+		opts := AdminOPAVersionCreateOptions{
+			Version:          String("1.1.100"),
+			URL:              String("https://www.hashicorp.com"),
+			Sha:              String(genSha(t, "secret", "data")),
+			Deprecated:       Bool(true),
+			DeprecatedReason: String("Test Reason"),
+			Official:         Bool(false),
+			Enabled:          Bool(false),
+			Beta:             Bool(false),
+		}
+		ov, err := client.Admin.OPAVersions.Create(ctx, opts)
+		require.NoError(t, err)
+
+		defer func() {
+			deleteErr := client.Admin.OPAVersions.Delete(ctx, ov.ID)
+			require.NoError(t, deleteErr)
+		}()
+
+		assert.Equal(t, *opts.Version, ov.Version)
+		assert.Equal(t, *opts.URL, ov.URL)
+		assert.Equal(t, *opts.Sha, ov.Sha)
+		assert.Equal(t, *opts.Official, ov.Official)
+		assert.Equal(t, *opts.Deprecated, ov.Deprecated)
+		assert.Equal(t, *opts.DeprecatedReason, *ov.DeprecatedReason)
+		assert.Equal(t, *opts.Enabled, ov.Enabled)
+		assert.Equal(t, *opts.Beta, ov.Beta)
+	})
+
+	t.Run("with only required options", func(t *testing.T) {
+		opts := AdminOPAVersionCreateOptions{
+			Version: String("1.1.100"),
+			URL:     String("https://www.hashicorp.com"),
+			Sha:     String(genSha(t, "secret", "data")),
+		}
+		ov, err := client.Admin.OPAVersions.Create(ctx, opts)
+		require.NoError(t, err)
+
+		defer func() {
+			deleteErr := client.Admin.OPAVersions.Delete(ctx, ov.ID)
+			require.NoError(t, deleteErr)
+		}()
+
+		assert.Equal(t, *opts.Version, ov.Version)
+		assert.Equal(t, *opts.URL, ov.URL)
+		assert.Equal(t, *opts.Sha, ov.Sha)
+		assert.Equal(t, false, ov.Official)
+		assert.Equal(t, false, ov.Deprecated)
+		assert.Nil(t, ov.DeprecatedReason)
+		assert.Equal(t, true, ov.Enabled)
+		assert.Equal(t, false, ov.Beta)
+	})
+
+	t.Run("with empty options", func(t *testing.T) {
+		_, err := client.Admin.OPAVersions.Create(ctx, AdminOPAVersionCreateOptions{})
+		require.Equal(t, err, ErrRequiredOPAVerCreateOps)
+	})
+}
+
+func TestAdminOPAVersions_ReadUpdate(t *testing.T) {
+	skipIfCloud(t)
+
+	client := testClient(t)
+	ctx := context.Background()
+
+	t.Run("reads and updates", func(t *testing.T) {
+		opts := AdminOPAVersionCreateOptions{
+			Version:          String("1.1.100"),
+			URL:              String("https://www.hashicorp.com"),
+			Sha:              String(genSha(t, "secret", "data")),
+			Official:         Bool(false),
+			Deprecated:       Bool(true),
+			DeprecatedReason: String("Test Reason"),
+			Enabled:          Bool(false),
+			Beta:             Bool(false),
+		}
+		ov, err := client.Admin.OPAVersions.Create(ctx, opts)
+		require.NoError(t, err)
+		id := ov.ID
+
+		defer func() {
+			deleteErr := client.Admin.OPAVersions.Delete(ctx, id)
+			require.NoError(t, deleteErr)
+		}()
+
+		ov, err = client.Admin.OPAVersions.Read(ctx, id)
+		require.NoError(t, err)
+
+		assert.Equal(t, *opts.Version, ov.Version)
+		assert.Equal(t, *opts.URL, ov.URL)
+		assert.Equal(t, *opts.Sha, ov.Sha)
+		assert.Equal(t, *opts.Official, ov.Official)
+		assert.Equal(t, *opts.Deprecated, ov.Deprecated)
+		assert.Equal(t, *opts.DeprecatedReason, *ov.DeprecatedReason)
+		assert.Equal(t, *opts.Enabled, ov.Enabled)
+		assert.Equal(t, *opts.Beta, ov.Beta)
+
+		updateVersion := "1.1.200"
+		updateURL := "https://app.terraform.io/"
+		updateOpts := AdminOPAVersionUpdateOptions{
+			Version:    String(updateVersion),
+			URL:        String(updateURL),
+			Deprecated: Bool(false),
+		}
+
+		ov, err = client.Admin.OPAVersions.Update(ctx, id, updateOpts)
+		require.NoError(t, err)
+
+		assert.Equal(t, updateVersion, ov.Version)
+		assert.Equal(t, updateURL, ov.URL)
+		assert.Equal(t, *opts.Sha, ov.Sha)
+		assert.Equal(t, *opts.Official, ov.Official)
+		assert.Equal(t, *updateOpts.Deprecated, ov.Deprecated)
+		assert.Equal(t, *opts.Enabled, ov.Enabled)
+		assert.Equal(t, *opts.Beta, ov.Beta)
+	})
+
+	t.Run("with non-existent OPA version", func(t *testing.T) {
+		randomID := "random-id"
+		_, err := client.Admin.OPAVersions.Read(ctx, randomID)
+		require.Error(t, err)
+	})
+}
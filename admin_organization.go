@@ -111,7 +111,7 @@ func (s *adminOrganizations) List(ctx context.Context, options *AdminOrganizatio
 	}
 
 	orgl := &AdminOrganizationList{}
-	err = s.client.do(ctx, req, orgl)
+	err = s.client.do(ctx, "adminOrganizations.List", req, orgl)
 	if err != nil {
 		return nil, err
 	}
@@ -133,7 +133,7 @@ func (s *adminOrganizations) ListModuleConsumers(ctx context.Context, organizati
 	}
 
 	orgl := &AdminOrganizationList{}
-	err = s.client.do(ctx, req, orgl)
+	err = s.client.do(ctx, "adminOrganizations.ListModuleConsumers", req, orgl)
 	if err != nil {
 		return nil, err
 	}
@@ -154,7 +154,7 @@ func (s *adminOrganizations) Read(ctx context.Context, organization string) (*Ad
 	}
 
 	org := &AdminOrganization{}
-	err = s.client.do(ctx, req, org)
+	err = s.client.do(ctx, "adminOrganizations.Read", req, org)
 	if err != nil {
 		return nil, err
 	}
@@ -175,7 +175,7 @@ func (s *adminOrganizations) Update(ctx context.Context, organization string, op
 	}
 
 	org := &AdminOrganization{}
-	err = s.client.do(ctx, req, org)
+	err = s.client.do(ctx, "adminOrganizations.Update", req, org)
 	if err != nil {
 		return nil, err
 	}
@@ -204,7 +204,7 @@ func (s *adminOrganizations) UpdateModuleConsumers(ctx context.Context, organiza
 		return err
 	}
 
-	err = s.client.do(ctx, req, nil)
+	err = s.client.do(ctx, "adminOrganizations.UpdateModuleConsumers", req, nil)
 	if err != nil {
 		return err
 	}
@@ -224,7 +224,7 @@ func (s *adminOrganizations) Delete(ctx context.Context, organization string) er
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "adminOrganizations.Delete", req, nil)
 }
 
 func (o *AdminOrganizationListOptions) valid() error {
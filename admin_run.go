@@ -1,11 +1,15 @@
 package tfe
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"strings"
 	"time"
+
+	"github.com/hashicorp/jsonapi"
 )
 
 // Compile-time proof of interface implementation.
@@ -22,6 +26,10 @@ type AdminRuns interface {
 
 	// Force-cancel a run by its ID.
 	ForceCancel(ctx context.Context, runID string, options AdminRunForceCancelOptions) error
+
+	// CountByStatus reads the number of runs in each status across the
+	// installation.
+	CountByStatus(ctx context.Context) (*AdminRunStatusCounts, error)
 }
 
 // AdminRun represents AdminRuns interface.
@@ -33,8 +41,13 @@ type AdminRun struct {
 	StatusTimestamps *RunStatusTimestamps `jsonapi:"attr,status-timestamps"`
 
 	// Relations
-	Workspace    *AdminWorkspace    `jsonapi:"relation,workspace"`
-	Organization *AdminOrganization `jsonapi:"relation,workspace.organization"`
+	Workspace *AdminWorkspace `jsonapi:"relation,workspace"`
+
+	// The organization of the workspace, including its owners, when the
+	// runs are listed with the "workspace.organization" or
+	// "workspace.organization.owners" include. It is decoded separately, as
+	// it is a relation of the workspace.
+	Organization *AdminOrganization
 }
 
 // AdminRunsList represents a list of runs.
@@ -43,6 +56,28 @@ type AdminRunsList struct {
 	Items []*AdminRun
 }
 
+// AdminRunStatusCounts represents the number of runs in each status across a
+// Terraform Enterprise installation, as reported by the admin runs list. The
+// configured run concurrency of an installation is not exposed by the API.
+type AdminRunStatusCounts struct {
+	// The total number of runs.
+	Total int
+
+	// The number of runs per status. Statuses without runs may be missing.
+	Counts map[RunStatus]int
+}
+
+// Queued returns the number of runs waiting to be queued or waiting for
+// capacity to plan or apply.
+func (c *AdminRunStatusCounts) Queued() int {
+	return c.Counts[RunPending] + c.Counts[RunPlanQueued] + c.Counts[RunApplyQueued]
+}
+
+// Active returns the number of runs currently planning or applying.
+func (c *AdminRunStatusCounts) Active() int {
+	return c.Counts[RunPlanning] + c.Counts[RunApplying]
+}
+
 // AdminRunIncludeOpt represents the available options for include query params.
 // https://www.terraform.io/cloud-docs/api-docs/admin/runs#available-related-resources
 type AdminRunIncludeOpt string
@@ -84,7 +119,7 @@ func (s *adminRuns) List(ctx context.Context, options *AdminRunsListOptions) (*A
 	}
 
 	rl := &AdminRunsList{}
-	err = s.client.do(ctx, req, rl)
+	err = s.client.do(ctx, "adminRuns.List", req, rl)
 	if err != nil {
 		return nil, err
 	}
@@ -112,7 +147,45 @@ func (s *adminRuns) ForceCancel(ctx context.Context, runID string, options Admin
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "adminRuns.ForceCancel", req, nil)
+}
+
+// CountByStatus reads the number of runs in each status across the
+// installation. The counts are part of the metadata of the admin runs list, so
+// only a single run is requested.
+func (s *adminRuns) CountByStatus(ctx context.Context) (*AdminRunStatusCounts, error) {
+	req, err := s.client.newRequest("GET", "admin/runs", &AdminRunsListOptions{
+		ListOptions: ListOptions{PageSize: 1},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewBuffer(nil)
+	if err := s.client.do(ctx, "adminRuns.CountByStatus", req, body); err != nil {
+		return nil, err
+	}
+
+	var raw struct {
+		Meta struct {
+			StatusCounts map[string]int `json:"status-counts"`
+		} `json:"meta"`
+	}
+	if err := json.Unmarshal(body.Bytes(), &raw); err != nil {
+		return nil, err
+	}
+
+	c := &AdminRunStatusCounts{Counts: make(map[RunStatus]int)}
+	for status, count := range raw.Meta.StatusCounts {
+		if status == "total" {
+			c.Total = count
+			continue
+		}
+		// The counts may be keyed with dashes instead of underscores.
+		c.Counts[RunStatus(strings.ReplaceAll(status, "-", "_"))] += count
+	}
+
+	return c, nil
 }
 
 func (o *AdminRunsListOptions) valid() error {
@@ -132,40 +205,7 @@ func (o *AdminRunsListOptions) valid() error {
 }
 
 func validateAdminRunFilterParams(runStatus string) error {
-	// For the platform, an invalid filter value is a semantically understood query that returns an empty set, no error, no warning. But for go-tfe, an invalid value is good enough reason to error prior to a network call to the platform:
-	if validString(&runStatus) {
-		sanitizedRunstatus := strings.TrimSpace(runStatus)
-		runStatuses := strings.Split(sanitizedRunstatus, ",")
-		// iterate over our statuses, and ensure it is valid.
-		for _, status := range runStatuses {
-			switch status {
-			case string(RunApplied),
-				string(RunApplyQueued),
-				string(RunApplying),
-				string(RunCanceled),
-				string(RunConfirmed),
-				string(RunCostEstimate),
-				string(RunCostEstimating),
-				string(RunDiscarded),
-				string(RunErrored),
-				string(RunPending),
-				string(RunPlanQueued),
-				string(RunPlanned),
-				string(RunPlannedAndFinished),
-				string(RunPlanning),
-				string(RunPolicyChecked),
-				string(RunPolicyChecking),
-				string(RunPolicyOverride),
-				string(RunPolicySoftFailed),
-				"":
-				// do nothing
-			default:
-				return fmt.Errorf(`invalid value "%s" for run status`, status)
-			}
-		}
-	}
-
-	return nil
+	return validateRunFilterParam("status", runStatus, runStatuses)
 }
 
 func validateAdminRunIncludeParams(params []AdminRunIncludeOpt) error {
@@ -180,3 +220,49 @@ func validateAdminRunIncludeParams(params []AdminRunIncludeOpt) error {
 
 	return nil
 }
+
+// unmarshalNode decodes the organization of the workspace of the run,
+// including the owners of the organization.
+func (r *AdminRun) unmarshalNode(node *jsonapi.Node, included []*jsonapi.Node) error {
+	workspace := relatedNode(node, "workspace", included)
+	if workspace == nil {
+		return nil
+	}
+	org := relatedNode(workspace, "organization", included)
+	if org == nil {
+		return nil
+	}
+
+	// The owners are resolved from the included resources.
+	payload, err := json.Marshal(&jsonapi.OnePayload{Data: org, Included: included})
+	if err != nil {
+		return err
+	}
+	r.Organization = &AdminOrganization{}
+	return jsonapi.UnmarshalPayload(bytes.NewReader(payload), r.Organization)
+}
+
+// relatedNode returns the included resource a to-one relation of the node
+// refers to, or nil when the relation or the resource was not included.
+func relatedNode(node *jsonapi.Node, relation string, included []*jsonapi.Node) *jsonapi.Node {
+	raw, ok := node.Relationships[relation]
+	if !ok {
+		return nil
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil
+	}
+	rel := &jsonapi.RelationshipOneNode{}
+	if err := json.Unmarshal(data, rel); err != nil || rel.Data == nil {
+		return nil
+	}
+
+	for _, n := range included {
+		if n.Type == rel.Data.Type && n.ID == rel.Data.ID {
+			return n
+		}
+	}
+	return nil
+}
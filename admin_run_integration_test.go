@@ -8,6 +8,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -92,6 +94,18 @@ func TestAdminRuns_List(t *testing.T) {
 		assert.NotEmpty(t, rl.Items[0].Workspace.Organization.Name)
 	})
 
+	t.Run("with workspace.organization.owners included", func(t *testing.T) {
+		rl, err := client.Admin.Runs.List(ctx, &AdminRunsListOptions{
+			Include: []AdminRunIncludeOpt{AdminRunWorkspaceOrgOwners},
+		})
+		require.NoError(t, err)
+
+		require.NotEmpty(t, rl.Items)
+		require.NotNil(t, rl.Items[0].Organization)
+		assert.Equal(t, rl.Items[0].Workspace.Organization.Name, rl.Items[0].Organization.Name)
+		assert.NotEmpty(t, rl.Items[0].Organization.Owners)
+	})
+
 	t.Run("with invalid Include option", func(t *testing.T) {
 		_, err := client.Admin.Runs.List(ctx, &AdminRunsListOptions{
 			Include: []AdminRunIncludeOpt{"workpsace"},
@@ -222,6 +236,38 @@ func TestAdminRuns_ForceCancel(t *testing.T) {
 	})
 }
 
+func TestAdminRuns_CountByStatus(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/admin/runs":
+			assert.Equal(t, "1", r.URL.Query().Get("page[size]"))
+			fmt.Fprint(w, `{"data":[],"meta":{"status-counts":{"pending":2,"plan-queued":1,"planning":3,"applying":1,"applied":7,"total":14},"pagination":{"current-page":1,"total-count":14}}}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	c, err := client.Admin.Runs.CountByStatus(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 14, c.Total)
+	assert.Equal(t, 7, c.Counts[RunApplied])
+	assert.Equal(t, 3, c.Queued())
+	assert.Equal(t, 4, c.Active())
+}
+
 func TestAdminRuns_AdminRunsListOptions_valid(t *testing.T) {
 	skipIfCloud(t)
 
@@ -323,3 +369,51 @@ func adminRunItemsContainsID(items []*AdminRun, id string) bool {
 
 	return hasID
 }
+
+func TestAdminRuns_ListIncludeOrganizationOwners(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/admin/runs":
+			assert.Equal(t, "workspace.organization.owners", r.URL.Query().Get("include"))
+			fmt.Fprint(w, `{"data":[`+
+				`{"id":"run-1","type":"runs","attributes":{"status":"applied"},"relationships":{"workspace":{"data":{"id":"ws-1","type":"workspaces"}}}},`+
+				`{"id":"run-2","type":"runs","attributes":{"status":"planned"},"relationships":{"workspace":{"data":null}}}],`+
+				`"included":[`+
+				`{"id":"ws-1","type":"workspaces","attributes":{"name":"app"},"relationships":{"organization":{"data":{"id":"acme","type":"organizations"}}}},`+
+				`{"id":"acme","type":"organizations","attributes":{"notification-email":"ops@example.com"},"relationships":{"owners":{"data":[{"id":"user-1","type":"users"}]}}},`+
+				`{"id":"user-1","type":"users","attributes":{"username":"jane","email":"jane@example.com"}}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	rl, err := client.Admin.Runs.List(context.Background(), &AdminRunsListOptions{
+		Include: []AdminRunIncludeOpt{AdminRunWorkspaceOrgOwners},
+	})
+	require.NoError(t, err)
+	require.Len(t, rl.Items, 2)
+
+	run := rl.Items[0]
+	assert.Equal(t, "app", run.Workspace.Name)
+	assert.Equal(t, "acme", run.Workspace.Organization.Name)
+	require.NotNil(t, run.Organization)
+	assert.Equal(t, "acme", run.Organization.Name)
+	assert.Equal(t, "ops@example.com", run.Organization.NotificationEmail)
+	require.Len(t, run.Organization.Owners, 1)
+	assert.Equal(t, "jane", run.Organization.Owners[0].Username)
+	assert.Equal(t, "jane@example.com", run.Organization.Owners[0].Email)
+
+	assert.Nil(t, rl.Items[1].Organization)
+}
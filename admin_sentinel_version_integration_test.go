@@ -0,0 +1,142 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminSentinelVersions_CreateDelete(t *testing.T) {
+	skipIfCloud(t)
+
+	client := testClient(t)
+	ctx := context.Background()
+
+	t.Run("with valid options", func(t *testing.T) {
+		opts := AdminSentinelVersionCreateOptions{
+			Version:          String("1.1.100"),
+			URL:              String("https://www.hashicorp.com"),
+			Sha:              String(genSha(t, "secret", "data")),
+			Deprecated:       Bool(true),
+			DeprecatedReason: String("Test Reason"),
+			Official:         Bool(false),
+			Enabled:          Bool(false),
+			Beta:             Bool(false),
+		}
+		sv, err := client.Admin.SentinelVersions.Create(ctx, opts)
+		require.NoError(t, err)
+
+		defer func() {
+			deleteErr := client.Admin.SentinelVersions.Delete(ctx, sv.ID)
+			require.NoError(t, deleteErr)
+		}()
+
+		assert.Equal(t, *opts.Version, sv.Version)
+		assert.Equal(t, *opts.URL, sv.URL)
+		assert.Equal(t, *opts.Sha, sv.Sha)
+		assert.Equal(t, *opts.Official, sv.Official)
+		assert.Equal(t, *opts.Deprecated, sv.Deprecated)
+		assert.Equal(t, *opts.DeprecatedReason, *sv.DeprecatedReason)
+		assert.Equal(t, *opts.Enabled, sv.Enabled)
+		assert.Equal(t, *opts.Beta, sv.Beta)
+	})
+
+	t.Run("with only required options", func(t *testing.T) {
+		opts := AdminSentinelVersionCreateOptions{
+			Version: String("1.1.100"),
+			URL:     String("https://www.hashicorp.com"),
+			Sha:     String(genSha(t, "secret", "data")),
+		}
+		sv, err := client.Admin.SentinelVersions.Create(ctx, opts)
+		require.NoError(t, err)
+
+		defer func() {
+			deleteErr := client.Admin.SentinelVersions.Delete(ctx, sv.ID)
+			require.NoError(t, deleteErr)
+		}()
+
+		assert.Equal(t, *opts.Version, sv.Version)
+		assert.Equal(t, *opts.URL, sv.URL)
+		assert.Equal(t, *opts.Sha, sv.Sha)
+		assert.Equal(t, false, sv.Official)
+		assert.Equal(t, false, sv.Deprecated)
+		assert.Nil(t, sv.DeprecatedReason)
+		assert.Equal(t, true, sv.Enabled)
+		assert.Equal(t, false, sv.Beta)
+	})
+
+	t.Run("with empty options", func(t *testing.T) {
+		_, err := client.Admin.SentinelVersions.Create(ctx, AdminSentinelVersionCreateOptions{})
+		require.Equal(t, err, ErrRequiredSentinelVerCreateOps)
+	})
+}
+
+func TestAdminSentinelVersions_ReadUpdate(t *testing.T) {
+	skipIfCloud(t)
+
+	client := testClient(t)
+	ctx := context.Background()
+
+	t.Run("reads and updates", func(t *testing.T) {
+		opts := AdminSentinelVersionCreateOptions{
+			Version:          String("1.1.100"),
+			URL:              String("https://www.hashicorp.com"),
+			Sha:              String(genSha(t, "secret", "data")),
+			Official:         Bool(false),
+			Deprecated:       Bool(true),
+			DeprecatedReason: String("Test Reason"),
+			Enabled:          Bool(false),
+			Beta:             Bool(false),
+		}
+		sv, err := client.Admin.SentinelVersions.Create(ctx, opts)
+		require.NoError(t, err)
+		id := sv.ID
+
+		defer func() {
+			deleteErr := client.Admin.SentinelVersions.Delete(ctx, id)
+			require.NoError(t, deleteErr)
+		}()
+
+		sv, err = client.Admin.SentinelVersions.Read(ctx, id)
+		require.NoError(t, err)
+
+		assert.Equal(t, *opts.Version, sv.Version)
+		assert.Equal(t, *opts.URL, sv.URL)
+		assert.Equal(t, *opts.Sha, sv.Sha)
+		assert.Equal(t, *opts.Official, sv.Official)
+		assert.Equal(t, *opts.Deprecated, sv.Deprecated)
+		assert.Equal(t, *opts.DeprecatedReason, *sv.DeprecatedReason)
+		assert.Equal(t, *opts.Enabled, sv.Enabled)
+		assert.Equal(t, *opts.Beta, sv.Beta)
+
+		updateVersion := "1.1.200"
+		updateURL := "https://app.terraform.io/"
+		updateOpts := AdminSentinelVersionUpdateOptions{
+			Version:    String(updateVersion),
+			URL:        String(updateURL),
+			Deprecated: Bool(false),
+		}
+
+		sv, err = client.Admin.SentinelVersions.Update(ctx, id, updateOpts)
+		require.NoError(t, err)
+
+		assert.Equal(t, updateVersion, sv.Version)
+		assert.Equal(t, updateURL, sv.URL)
+		assert.Equal(t, *opts.Sha, sv.Sha)
+		assert.Equal(t, *opts.Official, sv.Official)
+		assert.Equal(t, *updateOpts.Deprecated, sv.Deprecated)
+		assert.Equal(t, *opts.Enabled, sv.Enabled)
+		assert.Equal(t, *opts.Beta, sv.Beta)
+	})
+
+	t.Run("with non-existent Sentinel version", func(t *testing.T) {
+		randomID := "random-id"
+		_, err := client.Admin.SentinelVersions.Read(ctx, randomID)
+		require.Error(t, err)
+	})
+}
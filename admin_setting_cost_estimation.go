@@ -60,7 +60,7 @@ func (a *adminCostEstimationSettings) Read(ctx context.Context) (*AdminCostEstim
 	}
 
 	ace := &AdminCostEstimationSetting{}
-	err = a.client.do(ctx, req, ace)
+	err = a.client.do(ctx, "adminCostEstimationSettings.Read", req, ace)
 	if err != nil {
 		return nil, err
 	}
@@ -76,7 +76,7 @@ func (a *adminCostEstimationSettings) Update(ctx context.Context, options AdminC
 	}
 
 	ace := &AdminCostEstimationSetting{}
-	err = a.client.do(ctx, req, ace)
+	err = a.client.do(ctx, "adminCostEstimationSettings.Update", req, ace)
 	if err != nil {
 		return nil, err
 	}
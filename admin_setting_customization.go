@@ -39,7 +39,7 @@ func (a *adminCustomizationSettings) Read(ctx context.Context) (*AdminCustomizat
 	}
 
 	cs := &AdminCustomizationSetting{}
-	err = a.client.do(ctx, req, cs)
+	err = a.client.do(ctx, "adminCustomizationSettings.Read", req, cs)
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +66,7 @@ func (a *adminCustomizationSettings) Update(ctx context.Context, options AdminCu
 	}
 
 	cs := &AdminCustomizationSetting{}
-	err = a.client.do(ctx, req, cs)
+	err = a.client.do(ctx, "adminCustomizationSettings.Update", req, cs)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,177 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// AdminSettingsBaseline holds the expected admin settings of a Terraform
+// Enterprise installation. Only the non-nil settings are compared.
+type AdminSettingsBaseline struct {
+	// Optional: The expected general settings.
+	General *AdminGeneralSettingsBaseline
+
+	// Optional: The expected SAML settings.
+	SAML *AdminSAMLSettingsBaseline
+
+	// Optional: The expected SMTP settings.
+	SMTP *AdminSMTPSettingsBaseline
+
+	// Optional: Attributes excluded from the comparison, given as the
+	// section and attribute name, e.g. "saml.idp-cert".
+	Ignore []string
+}
+
+// AdminGeneralSettingsBaseline holds the expected general settings. Only the
+// non-nil attributes are compared.
+type AdminGeneralSettingsBaseline struct {
+	LimitUserOrganizationCreation    *bool   `jsonapi:"attr,limit-user-organization-creation"`
+	APIRateLimitingEnabled           *bool   `jsonapi:"attr,api-rate-limiting-enabled"`
+	APIRateLimit                     *int    `jsonapi:"attr,api-rate-limit"`
+	SendPassingStatusesEnabled       *bool   `jsonapi:"attr,send-passing-statuses-for-untriggered-speculative-plans"`
+	AllowSpeculativePlansOnPR        *bool   `jsonapi:"attr,allow-speculative-plans-on-pull-requests-from-forks"`
+	RequireTwoFactorForAdmin         *bool   `jsonapi:"attr,require-two-factor-for-admins"`
+	FairRunQueuingEnabled            *bool   `jsonapi:"attr,fair-run-queuing-enabled"`
+	LimitOrgsPerUser                 *bool   `jsonapi:"attr,limit-organizations-per-user"`
+	DefaultOrgsPerUserCeiling        *int    `jsonapi:"attr,default-organizations-per-user-ceiling"`
+	LimitWorkspacesPerOrg            *bool   `jsonapi:"attr,limit-workspaces-per-organization"`
+	DefaultWorkspacesPerOrgCeiling   *int    `jsonapi:"attr,default-workspaces-per-organization-ceiling"`
+	TerraformBuildWorkerApplyTimeout *string `jsonapi:"attr,terraform-build-worker-apply-timeout"`
+	TerraformBuildWorkerPlanTimeout  *string `jsonapi:"attr,terraform-build-worker-plan-timeout"`
+	DefaultRemoteStateAccess         *bool   `jsonapi:"attr,default-remote-state-access"`
+}
+
+// AdminSAMLSettingsBaseline holds the expected SAML settings. Only the non-nil
+// attributes are compared.
+type AdminSAMLSettingsBaseline struct {
+	Enabled                   *bool   `jsonapi:"attr,enabled"`
+	Debug                     *bool   `jsonapi:"attr,debug"`
+	IDPCert                   *string `jsonapi:"attr,idp-cert"`
+	SLOEndpointURL            *string `jsonapi:"attr,slo-endpoint-url"`
+	SSOEndpointURL            *string `jsonapi:"attr,sso-endpoint-url"`
+	AttrUsername              *string `jsonapi:"attr,attr-username"`
+	AttrGroups                *string `jsonapi:"attr,attr-groups"`
+	AttrSiteAdmin             *string `jsonapi:"attr,attr-site-admin"`
+	SiteAdminRole             *string `jsonapi:"attr,site-admin-role"`
+	SSOAPITokenSessionTimeout *int    `jsonapi:"attr,sso-api-token-session-timeout"`
+	TeamManagementEnabled     *bool   `jsonapi:"attr,team-management-enabled"`
+	Certificate               *string `jsonapi:"attr,certificate"`
+	AuthnRequestsSigned       *bool   `jsonapi:"attr,authn-requests-signed"`
+	WantAssertionsSigned      *bool   `jsonapi:"attr,want-assertions-signed"`
+}
+
+// AdminSMTPSettingsBaseline holds the expected SMTP settings. Only the non-nil
+// attributes are compared.
+type AdminSMTPSettingsBaseline struct {
+	Enabled  *bool         `jsonapi:"attr,enabled"`
+	Host     *string       `jsonapi:"attr,host"`
+	Port     *int          `jsonapi:"attr,port"`
+	Sender   *string       `jsonapi:"attr,sender"`
+	Auth     *SMTPAuthType `jsonapi:"attr,auth"`
+	Username *string       `jsonapi:"attr,username"`
+}
+
+// AdminSettingDrift describes a single admin setting which does not match the
+// baseline.
+type AdminSettingDrift struct {
+	// The section of the setting: "general", "saml" or "smtp".
+	Section string
+
+	// The API attribute name of the setting, e.g. "api-rate-limit".
+	Attribute string
+
+	Current  interface{}
+	Baseline interface{}
+}
+
+// CheckDrift compares the current admin settings against the baseline and
+// reports all settings which differ. Only the sections and attributes set in
+// the baseline are read and compared.
+func (s *AdminSettings) CheckDrift(ctx context.Context, baseline AdminSettingsBaseline) ([]*AdminSettingDrift, error) {
+	ignore := make(map[string]bool, len(baseline.Ignore))
+	for _, attr := range baseline.Ignore {
+		ignore[attr] = true
+	}
+
+	var drift []*AdminSettingDrift
+
+	if baseline.General != nil {
+		general, err := s.General.Read(ctx)
+		if err != nil {
+			return nil, err
+		}
+		drift = append(drift, adminSettingDrift("general", general, baseline.General, ignore)...)
+	}
+
+	if baseline.SAML != nil {
+		saml, err := s.SAML.Read(ctx)
+		if err != nil {
+			return nil, err
+		}
+		drift = append(drift, adminSettingDrift("saml", saml, baseline.SAML, ignore)...)
+	}
+
+	if baseline.SMTP != nil {
+		smtp, err := s.SMTP.Read(ctx)
+		if err != nil {
+			return nil, err
+		}
+		drift = append(drift, adminSettingDrift("smtp", smtp, baseline.SMTP, ignore)...)
+	}
+
+	return drift, nil
+}
+
+// adminSettingDrift compares the non-nil attributes of a baseline against the
+// attributes of the current setting with the same API name.
+func adminSettingDrift(section string, current, baseline interface{}, ignore map[string]bool) []*AdminSettingDrift {
+	var drift []*AdminSettingDrift
+
+	cv := reflect.Indirect(reflect.ValueOf(current))
+	attrs := make(map[string]reflect.Value, cv.NumField())
+	for i := 0; i < cv.NumField(); i++ {
+		if attr, ok := jsonapiAttr(cv.Type().Field(i)); ok {
+			attrs[attr] = cv.Field(i)
+		}
+	}
+
+	bv := reflect.Indirect(reflect.ValueOf(baseline))
+	for i := 0; i < bv.NumField(); i++ {
+		attr, ok := jsonapiAttr(bv.Type().Field(i))
+		if !ok || bv.Field(i).IsNil() || ignore[section+"."+attr] {
+			continue
+		}
+		field, ok := attrs[attr]
+		if !ok {
+			continue
+		}
+
+		c, b := field.Interface(), bv.Field(i).Elem().Interface()
+		if !reflect.DeepEqual(c, b) {
+			drift = append(drift, &AdminSettingDrift{
+				Section:   section,
+				Attribute: attr,
+				Current:   c,
+				Baseline:  b,
+			})
+		}
+	}
+
+	return drift
+}
+
+// jsonapiAttr returns the API attribute name of a struct field.
+func jsonapiAttr(field reflect.StructField) (string, bool) {
+	tag := strings.Split(field.Tag.Get("jsonapi"), ",")
+	if len(tag) < 2 || tag[0] != "attr" {
+		return "", false
+	}
+	return tag[1], true
+}
+
+// String returns a human readable description of the drift.
+func (d *AdminSettingDrift) String() string {
+	return fmt.Sprintf("%s.%s is %v, expected %v", d.Section, d.Attribute, d.Current, d.Baseline)
+}
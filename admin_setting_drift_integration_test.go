@@ -0,0 +1,102 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminSettingDrift(t *testing.T) {
+	current := &AdminSMTPSetting{
+		ID:      "smtp",
+		Enabled: true,
+		Host:    "smtp.example.com",
+		Port:    25,
+		Auth:    SMTPAuthNone,
+	}
+
+	t.Run("without drift", func(t *testing.T) {
+		drift := adminSettingDrift("smtp", current, &AdminSMTPSettingsBaseline{
+			Enabled: Bool(true),
+			Host:    String("smtp.example.com"),
+			Port:    Int(25),
+			Auth:    SMTPAuthValue(SMTPAuthNone),
+		}, nil)
+		assert.Empty(t, drift)
+	})
+
+	t.Run("with unset attributes", func(t *testing.T) {
+		drift := adminSettingDrift("smtp", current, &AdminSMTPSettingsBaseline{
+			Port: Int(25),
+		}, nil)
+		assert.Empty(t, drift)
+	})
+
+	t.Run("with attributes set to the zero value", func(t *testing.T) {
+		drift := adminSettingDrift("smtp", current, &AdminSMTPSettingsBaseline{
+			Enabled: Bool(false),
+		}, nil)
+		require.Len(t, drift, 1)
+		assert.Equal(t, "enabled", drift[0].Attribute)
+		assert.Equal(t, true, drift[0].Current)
+		assert.Equal(t, false, drift[0].Baseline)
+	})
+
+	t.Run("with drift", func(t *testing.T) {
+		drift := adminSettingDrift("smtp", current, &AdminSMTPSettingsBaseline{
+			Host: String("mail.example.com"),
+			Port: Int(587),
+		}, nil)
+		require.Len(t, drift, 2)
+		assert.Equal(t, "host", drift[0].Attribute)
+		assert.Equal(t, "port", drift[1].Attribute)
+		assert.Equal(t, 25, drift[1].Current)
+		assert.Equal(t, 587, drift[1].Baseline)
+		assert.Equal(t, "smtp.port is 25, expected 587", drift[1].String())
+	})
+
+	t.Run("with ignored attributes", func(t *testing.T) {
+		drift := adminSettingDrift("smtp", current, &AdminSMTPSettingsBaseline{
+			Port: Int(587),
+		}, map[string]bool{"smtp.port": true})
+		assert.Empty(t, drift)
+	})
+}
+
+func TestAdminSettingsCheckDrift(t *testing.T) {
+	skipIfCloud(t)
+
+	client := testClient(t)
+	ctx := context.Background()
+
+	general, err := client.Admin.Settings.General.Read(ctx)
+	require.NoError(t, err)
+
+	t.Run("with the current settings as baseline", func(t *testing.T) {
+		drift, err := client.Admin.Settings.CheckDrift(ctx, AdminSettingsBaseline{
+			General: &AdminGeneralSettingsBaseline{
+				APIRateLimitingEnabled: Bool(general.APIRateLimitingEnabled),
+				APIRateLimit:           Int(general.APIRateLimit),
+			},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, drift)
+	})
+
+	t.Run("with a drifted baseline", func(t *testing.T) {
+		drift, err := client.Admin.Settings.CheckDrift(ctx, AdminSettingsBaseline{
+			General: &AdminGeneralSettingsBaseline{
+				APIRateLimit: Int(general.APIRateLimit + 1),
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, drift, 1)
+		assert.Equal(t, "general", drift[0].Section)
+		assert.Equal(t, "api-rate-limit", drift[0].Attribute)
+	})
+}
@@ -60,7 +60,7 @@ func (a *adminGeneralSettings) Read(ctx context.Context) (*AdminGeneralSetting,
 	}
 
 	ags := &AdminGeneralSetting{}
-	err = a.client.do(ctx, req, ags)
+	err = a.client.do(ctx, "adminGeneralSettings.Read", req, ags)
 	if err != nil {
 		return nil, err
 	}
@@ -76,7 +76,7 @@ func (a *adminGeneralSettings) Update(ctx context.Context, options AdminGeneralS
 	}
 
 	ags := &AdminGeneralSetting{}
-	err = a.client.do(ctx, req, ags)
+	err = a.client.do(ctx, "adminGeneralSettings.Update", req, ags)
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +56,7 @@ func (a *adminSAMLSettings) Read(ctx context.Context) (*AdminSAMLSetting, error)
 	}
 
 	saml := &AdminSAMLSetting{}
-	err = a.client.do(ctx, req, saml)
+	err = a.client.do(ctx, "adminSAMLSettings.Read", req, saml)
 	if err != nil {
 		return nil, err
 	}
@@ -78,17 +78,29 @@ type AdminSAMLSettingsUpdateOptions struct {
 	AttrSiteAdmin             *string `jsonapi:"attr,attr-site-admin,omitempty"`
 	SiteAdminRole             *string `jsonapi:"attr,site-admin-role,omitempty"`
 	SSOAPITokenSessionTimeout *int    `jsonapi:"attr,sso-api-token-session-timeout,omitempty"`
+	TeamManagementEnabled     *bool   `jsonapi:"attr,team-management-enabled,omitempty"`
+
+	// The certificate and private key used to sign SAML requests and decrypt
+	// assertions, which must be set together.
+	Certificate          *string `jsonapi:"attr,certificate,omitempty"`
+	PrivateKey           *string `jsonapi:"attr,private-key,omitempty"`
+	AuthnRequestsSigned  *bool   `jsonapi:"attr,authn-requests-signed,omitempty"`
+	WantAssertionsSigned *bool   `jsonapi:"attr,want-assertions-signed,omitempty"`
 }
 
 // Update updates the SAML settings.
 func (a *adminSAMLSettings) Update(ctx context.Context, options AdminSAMLSettingsUpdateOptions) (*AdminSAMLSetting, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
 	req, err := a.client.newRequest("PATCH", "admin/saml-settings", &options)
 	if err != nil {
 		return nil, err
 	}
 
 	saml := &AdminSAMLSetting{}
-	err = a.client.do(ctx, req, saml)
+	err = a.client.do(ctx, "adminSAMLSettings.Update", req, saml)
 	if err != nil {
 		return nil, err
 	}
@@ -105,10 +117,18 @@ func (a *adminSAMLSettings) RevokeIdpCert(ctx context.Context) (*AdminSAMLSettin
 	}
 
 	saml := &AdminSAMLSetting{}
-	err = a.client.do(ctx, req, saml)
+	err = a.client.do(ctx, "adminSAMLSettings.RevokeIdpCert", req, saml)
 	if err != nil {
 		return nil, err
 	}
 
 	return saml, nil
 }
+
+func (o AdminSAMLSettingsUpdateOptions) valid() error {
+	if (o.Certificate == nil) != (o.PrivateKey == nil) {
+		return ErrRequiredSAMLCertificateAndKey
+	}
+
+	return nil
+}
@@ -58,6 +58,25 @@ func TestAdminSettings_SAML_Update(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, enabled, samlSettings.Enabled)
 	assert.Equal(t, debug, samlSettings.Debug)
+
+	t.Run("with team management and signing options", func(t *testing.T) {
+		samlSettings, err := client.Admin.Settings.SAML.Update(ctx, AdminSAMLSettingsUpdateOptions{
+			TeamManagementEnabled: Bool(true),
+			AuthnRequestsSigned:   Bool(false),
+			WantAssertionsSigned:  Bool(false),
+		})
+		require.NoError(t, err)
+		assert.True(t, samlSettings.TeamManagementEnabled)
+		assert.False(t, samlSettings.AuthnRequestsSigned)
+		assert.False(t, samlSettings.WantAssertionsSigned)
+	})
+
+	t.Run("with a certificate but no private key", func(t *testing.T) {
+		_, err := client.Admin.Settings.SAML.Update(ctx, AdminSAMLSettingsUpdateOptions{
+			Certificate: String("-----BEGIN CERTIFICATE-----"),
+		})
+		assert.Equal(t, ErrRequiredSAMLCertificateAndKey, err)
+	})
 }
 
 func TestAdminSettings_SAML_RevokeIdpCert(t *testing.T) {
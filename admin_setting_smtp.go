@@ -14,6 +14,9 @@ type SMTPSettings interface {
 
 	// Update updates SMTP settings.
 	Update(ctx context.Context, options AdminSMTPSettingsUpdateOptions) (*AdminSMTPSetting, error)
+
+	// SendTestEmail sends a test email using the current SMTP settings.
+	SendTestEmail(ctx context.Context, options AdminSMTPSettingsTestOptions) error
 }
 
 type adminSMTPSettings struct {
@@ -49,7 +52,7 @@ func (a *adminSMTPSettings) Read(ctx context.Context) (*AdminSMTPSetting, error)
 	}
 
 	smtp := &AdminSMTPSetting{}
-	err = a.client.do(ctx, req, smtp)
+	err = a.client.do(ctx, "adminSMTPSettings.Read", req, smtp)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +86,7 @@ func (a *adminSMTPSettings) Update(ctx context.Context, options AdminSMTPSetting
 	}
 
 	smtp := &AdminSMTPSetting{}
-	err = a.client.do(ctx, req, smtp)
+	err = a.client.do(ctx, "adminSMTPSettings.Update", req, smtp)
 	if err != nil {
 		return nil, err
 	}
@@ -91,6 +94,35 @@ func (a *adminSMTPSettings) Update(ctx context.Context, options AdminSMTPSetting
 	return smtp, nil
 }
 
+// AdminSMTPSettingsTestOptions represents the address to send a test email to.
+type AdminSMTPSettingsTestOptions struct {
+	TestEmailAddress *string `jsonapi:"attr,test-email-address"` // Required
+}
+
+// SendTestEmail sends a test email using the current SMTP settings. The API
+// sends it when updating the settings with a test email address, and rejects
+// the update when the email can not be sent.
+func (a *adminSMTPSettings) SendTestEmail(ctx context.Context, options AdminSMTPSettingsTestOptions) error {
+	if err := options.valid(); err != nil {
+		return err
+	}
+
+	req, err := a.client.newRequest("PATCH", "admin/smtp-settings", &options)
+	if err != nil {
+		return err
+	}
+
+	return a.client.do(ctx, "adminSMTPSettings.SendTestEmail", req, nil)
+}
+
+func (o AdminSMTPSettingsTestOptions) valid() error {
+	if !validString(o.TestEmailAddress) {
+		return ErrRequiredTestEmailAddress
+	}
+
+	return nil
+}
+
 func (o AdminSMTPSettingsUpdateOptions) valid() error {
 	if validString((*string)(o.Auth)) {
 		if err := validateAdminSettingSMTPAuth(*o.Auth); err != nil {
@@ -66,3 +66,15 @@ func TestAdminSettings_SMTP_Update(t *testing.T) {
 		assert.Equal(t, err, ErrInvalidSMTPAuth)
 	})
 }
+
+func TestAdminSettings_SMTP_SendTestEmail(t *testing.T) {
+	skipIfCloud(t)
+
+	client := testClient(t)
+	ctx := context.Background()
+
+	t.Run("without a test email address", func(t *testing.T) {
+		err := client.Admin.Settings.SMTP.SendTestEmail(ctx, AdminSMTPSettingsTestOptions{})
+		assert.Equal(t, ErrRequiredTestEmailAddress, err)
+	})
+}
@@ -40,7 +40,7 @@ func (a *adminTwilioSettings) Read(ctx context.Context) (*AdminTwilioSetting, er
 	}
 
 	twilio := &AdminTwilioSetting{}
-	err = a.client.do(ctx, req, twilio)
+	err = a.client.do(ctx, "adminTwilioSettings.Read", req, twilio)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +72,7 @@ func (a *adminTwilioSettings) Update(ctx context.Context, options AdminTwilioSet
 	}
 
 	twilio := &AdminTwilioSetting{}
-	err = a.client.do(ctx, req, twilio)
+	err = a.client.do(ctx, "adminTwilioSettings.Update", req, twilio)
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +90,7 @@ func (a *adminTwilioSettings) Verify(ctx context.Context, options AdminTwilioSet
 		return err
 	}
 
-	return a.client.do(ctx, req, nil)
+	return a.client.do(ctx, "adminTwilioSettings.Verify", req, nil)
 }
 
 func (o AdminTwilioSettingsVerifyOptions) valid() error {
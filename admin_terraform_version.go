@@ -92,6 +92,16 @@ type AdminTerraformVersionUpdateOptions struct {
 	Beta             *bool   `jsonapi:"attr,beta,omitempty"`
 }
 
+// AdminTerraformVersionsDeprecateOptions represents the options for
+// deprecating terraform versions in bulk.
+type AdminTerraformVersionsDeprecateOptions struct {
+	// Required: The exact versions to deprecate, like "0.12.31".
+	Versions []string
+
+	// Optional: The reason shown to the users of the deprecated versions.
+	Reason *string
+}
+
 // AdminTerraformVersionsList represents a list of terraform versions.
 type AdminTerraformVersionsList struct {
 	*Pagination
@@ -106,7 +116,7 @@ func (a *adminTerraformVersions) List(ctx context.Context, options *AdminTerrafo
 	}
 
 	tvl := &AdminTerraformVersionsList{}
-	err = a.client.do(ctx, req, tvl)
+	err = a.client.do(ctx, "adminTerraformVersions.List", req, tvl)
 	if err != nil {
 		return nil, err
 	}
@@ -127,7 +137,7 @@ func (a *adminTerraformVersions) Read(ctx context.Context, id string) (*AdminTer
 	}
 
 	tfv := &AdminTerraformVersion{}
-	err = a.client.do(ctx, req, tfv)
+	err = a.client.do(ctx, "adminTerraformVersions.Read", req, tfv)
 	if err != nil {
 		return nil, err
 	}
@@ -146,7 +156,7 @@ func (a *adminTerraformVersions) Create(ctx context.Context, options AdminTerraf
 	}
 
 	tfv := &AdminTerraformVersion{}
-	err = a.client.do(ctx, req, tfv)
+	err = a.client.do(ctx, "adminTerraformVersions.Create", req, tfv)
 	if err != nil {
 		return nil, err
 	}
@@ -167,7 +177,7 @@ func (a *adminTerraformVersions) Update(ctx context.Context, id string, options
 	}
 
 	tfv := &AdminTerraformVersion{}
-	err = a.client.do(ctx, req, tfv)
+	err = a.client.do(ctx, "adminTerraformVersions.Update", req, tfv)
 	if err != nil {
 		return nil, err
 	}
@@ -187,7 +197,51 @@ func (a *adminTerraformVersions) Delete(ctx context.Context, id string) error {
 		return err
 	}
 
-	return a.client.do(ctx, req, nil)
+	return a.client.do(ctx, "adminTerraformVersions.Delete", req, nil)
+}
+
+// DeprecateAdminTerraformVersions deprecates the given terraform versions in
+// bulk, returning the deprecated versions with their usage, so the remaining
+// users of the versions can be audited. Versions which are already deprecated
+// are updated with the reason as well.
+func DeprecateAdminTerraformVersions(ctx context.Context, client *Client, options AdminTerraformVersionsDeprecateOptions) ([]*AdminTerraformVersion, error) {
+	if len(options.Versions) == 0 {
+		return nil, ErrRequiredVersion
+	}
+
+	// Resolve all versions first, so no version is deprecated when one of
+	// them does not exist.
+	ids := make([]string, 0, len(options.Versions))
+	for _, version := range options.Versions {
+		if !validString(&version) {
+			return nil, ErrRequiredVersion
+		}
+
+		tvl, err := client.Admin.TerraformVersions.List(ctx, &AdminTerraformVersionsListOptions{
+			Filter: version,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(tvl.Items) == 0 {
+			return nil, fmt.Errorf("%w: terraform version %s", ErrResourceNotFound, version)
+		}
+		ids = append(ids, tvl.Items[0].ID)
+	}
+
+	deprecated := make([]*AdminTerraformVersion, 0, len(ids))
+	for _, id := range ids {
+		tfv, err := client.Admin.TerraformVersions.Update(ctx, id, AdminTerraformVersionUpdateOptions{
+			Deprecated:       Bool(true),
+			DeprecatedReason: options.Reason,
+		})
+		if err != nil {
+			return deprecated, err
+		}
+		deprecated = append(deprecated, tfv)
+	}
+
+	return deprecated, nil
 }
 
 func (o AdminTerraformVersionCreateOptions) valid() error {
@@ -5,6 +5,10 @@ package tfe
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
@@ -224,3 +228,77 @@ func TestAdminTerraformVersions_ReadUpdate(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestDeprecateAdminTerraformVersions(t *testing.T) {
+	var updated []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		switch {
+		case r.URL.Path == "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == "GET" && r.URL.Path == "/api/v2/admin/terraform-versions":
+			switch v := r.URL.Query().Get("filter[version]"); v {
+			case "0.12.31", "0.13.7":
+				fmt.Fprintf(w, `{"data": [{"id": "tool-%s", "type": "terraform-versions", "attributes": {"version": %q, "usage": 3}}]}`, v, v)
+			default:
+				fmt.Fprint(w, `{"data": []}`)
+			}
+		case r.Method == "PATCH" && strings.HasPrefix(r.URL.Path, "/api/v2/admin/terraform-versions/"):
+			id := strings.TrimPrefix(r.URL.Path, "/api/v2/admin/terraform-versions/")
+			updated = append(updated, id)
+
+			var body struct {
+				Data struct {
+					Attributes map[string]interface{} `json:"attributes"`
+				} `json:"data"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, true, body.Data.Attributes["deprecated"])
+			assert.Equal(t, "End of life", body.Data.Attributes["deprecated-reason"])
+
+			fmt.Fprintf(w, `{"data": {"id": %q, "type": "terraform-versions", "attributes": {"deprecated": true, "deprecated-reason": "End of life", "usage": 3}}}`, id)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("with existing versions", func(t *testing.T) {
+		updated = nil
+		tfvs, err := DeprecateAdminTerraformVersions(ctx, client, AdminTerraformVersionsDeprecateOptions{
+			Versions: []string{"0.12.31", "0.13.7"},
+			Reason:   String("End of life"),
+		})
+		require.NoError(t, err)
+		require.Len(t, tfvs, 2)
+		assert.True(t, tfvs[0].Deprecated)
+		assert.Equal(t, "End of life", *tfvs[0].DeprecatedReason)
+		assert.Equal(t, 3, tfvs[0].Usage)
+		assert.Equal(t, []string{"tool-0.12.31", "tool-0.13.7"}, updated)
+	})
+
+	t.Run("with a non-existent version", func(t *testing.T) {
+		updated = nil
+		_, err := DeprecateAdminTerraformVersions(ctx, client, AdminTerraformVersionsDeprecateOptions{
+			Versions: []string{"0.12.31", "0.1.0"},
+		})
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+		assert.Empty(t, updated)
+	})
+
+	t.Run("without versions", func(t *testing.T) {
+		_, err := DeprecateAdminTerraformVersions(ctx, client, AdminTerraformVersionsDeprecateOptions{})
+		assert.Equal(t, ErrRequiredVersion, err)
+	})
+}
@@ -36,6 +36,12 @@ type AdminUsers interface {
 	// Disable2FA disables a user's two-factor authentication in the situation
 	// where they have lost access to their device and recovery codes.
 	Disable2FA(ctx context.Context, userID string) (*AdminUser, error)
+
+	// Impersonate starts an impersonation session of a user by its ID.
+	Impersonate(ctx context.Context, userID string, options AdminUserImpersonateOptions) error
+
+	// Unimpersonate ends the current impersonation session.
+	Unimpersonate(ctx context.Context) error
 }
 
 // adminUsers implements the AdminUsers interface.
@@ -102,7 +108,7 @@ func (a *adminUsers) List(ctx context.Context, options *AdminUserListOptions) (*
 	}
 
 	aul := &AdminUserList{}
-	err = a.client.do(ctx, req, aul)
+	err = a.client.do(ctx, "adminUsers.List", req, aul)
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +128,7 @@ func (a *adminUsers) Delete(ctx context.Context, userID string) error {
 		return err
 	}
 
-	return a.client.do(ctx, req, nil)
+	return a.client.do(ctx, "adminUsers.Delete", req, nil)
 }
 
 // Suspend a user by its ID.
@@ -138,7 +144,7 @@ func (a *adminUsers) Suspend(ctx context.Context, userID string) (*AdminUser, er
 	}
 
 	au := &AdminUser{}
-	err = a.client.do(ctx, req, au)
+	err = a.client.do(ctx, "adminUsers.Suspend", req, au)
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +165,7 @@ func (a *adminUsers) Unsuspend(ctx context.Context, userID string) (*AdminUser,
 	}
 
 	au := &AdminUser{}
-	err = a.client.do(ctx, req, au)
+	err = a.client.do(ctx, "adminUsers.Unsuspend", req, au)
 	if err != nil {
 		return nil, err
 	}
@@ -180,7 +186,7 @@ func (a *adminUsers) GrantAdmin(ctx context.Context, userID string) (*AdminUser,
 	}
 
 	au := &AdminUser{}
-	err = a.client.do(ctx, req, au)
+	err = a.client.do(ctx, "adminUsers.GrantAdmin", req, au)
 	if err != nil {
 		return nil, err
 	}
@@ -201,7 +207,7 @@ func (a *adminUsers) RevokeAdmin(ctx context.Context, userID string) (*AdminUser
 	}
 
 	au := &AdminUser{}
-	err = a.client.do(ctx, req, au)
+	err = a.client.do(ctx, "adminUsers.RevokeAdmin", req, au)
 	if err != nil {
 		return nil, err
 	}
@@ -223,7 +229,7 @@ func (a *adminUsers) Disable2FA(ctx context.Context, userID string) (*AdminUser,
 	}
 
 	au := &AdminUser{}
-	err = a.client.do(ctx, req, au)
+	err = a.client.do(ctx, "adminUsers.Disable2FA", req, au)
 	if err != nil {
 		return nil, err
 	}
@@ -231,6 +237,52 @@ func (a *adminUsers) Disable2FA(ctx context.Context, userID string) (*AdminUser,
 	return au, nil
 }
 
+// AdminUserImpersonateOptions represents the options for impersonating a
+// user.
+type AdminUserImpersonateOptions struct {
+	// Required: The reason for impersonating the user, which is recorded in
+	// the audit log.
+	Reason *string `json:"reason"`
+}
+
+// Impersonate starts an impersonation session of a user by its ID. The
+// session applies to the session of the admin performing the request, and
+// ends with Unimpersonate.
+func (a *adminUsers) Impersonate(ctx context.Context, userID string, options AdminUserImpersonateOptions) error {
+	if !validStringID(&userID) {
+		return ErrInvalidUserValue
+	}
+	if err := options.valid(); err != nil {
+		return err
+	}
+
+	u := fmt.Sprintf("admin/users/%s/actions/impersonate", url.QueryEscape(userID))
+	req, err := a.client.newRequest("POST", u, &options)
+	if err != nil {
+		return err
+	}
+
+	return a.client.do(ctx, "adminUsers.Impersonate", req, nil)
+}
+
+// Unimpersonate ends the current impersonation session.
+func (a *adminUsers) Unimpersonate(ctx context.Context) error {
+	req, err := a.client.newRequest("POST", "admin/users/actions/unimpersonate", nil)
+	if err != nil {
+		return err
+	}
+
+	return a.client.do(ctx, "adminUsers.Unimpersonate", req, nil)
+}
+
+func (o AdminUserImpersonateOptions) valid() error {
+	if !validString(o.Reason) {
+		return ErrRequiredReason
+	}
+
+	return nil
+}
+
 func (o *AdminUserListOptions) valid() error {
 	if o == nil {
 		return nil // nothing to validate
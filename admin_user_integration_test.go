@@ -5,6 +5,9 @@ package tfe
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -162,6 +165,59 @@ func TestAdminUsers_Disable2FA(t *testing.T) {
 	require.NotNil(t, user)
 }
 
+func TestAdminUsers_Impersonate(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/admin/users/user-1/actions/impersonate":
+			assert.Equal(t, "POST", r.Method)
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "Investigating support ticket", body["reason"])
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/admin/users/actions/unimpersonate":
+			assert.Equal(t, "POST", r.Method)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("with a reason", func(t *testing.T) {
+		err := client.Admin.Users.Impersonate(ctx, "user-1", AdminUserImpersonateOptions{
+			Reason: String("Investigating support ticket"),
+		})
+		require.NoError(t, err)
+
+		err = client.Admin.Users.Unimpersonate(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("without a reason", func(t *testing.T) {
+		err := client.Admin.Users.Impersonate(ctx, "user-1", AdminUserImpersonateOptions{})
+		assert.Equal(t, ErrRequiredReason, err)
+	})
+
+	t.Run("with an invalid user ID", func(t *testing.T) {
+		err := client.Admin.Users.Impersonate(ctx, badIdentifier, AdminUserImpersonateOptions{
+			Reason: String("Investigating support ticket"),
+		})
+		assert.Equal(t, ErrInvalidUserValue, err)
+	})
+}
+
 func includesEmail(email string, userList []*AdminUser) bool {
 	for _, user := range userList {
 		if user.Email == email {
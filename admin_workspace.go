@@ -4,11 +4,16 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"time"
 )
 
 // Compile-time proof of interface implementation.
 var _ AdminWorkspaces = (*adminWorkspaces)(nil)
 
+// forceDeletePollInterval is the interval ForceDelete polls the active runs
+// of a workspace at.
+const forceDeletePollInterval = time.Second
+
 // AdminWorkspaces describes all the admin workspace related methods that the Terraform Enterprise API supports.
 // Note that admin settings are only available in Terraform Enterprise.
 //
@@ -20,8 +25,15 @@ type AdminWorkspaces interface {
 	// Read a workspace by its ID.
 	Read(ctx context.Context, workspaceID string) (*AdminWorkspace, error)
 
-	// Delete a workspace by its ID.
+	// Delete a workspace by its ID. It fails with an error wrapping
+	// ErrWorkspaceManagesResources when the workspace still manages
+	// resources.
 	Delete(ctx context.Context, workspaceID string) error
+
+	// ForceDelete force-cancels the active runs of a workspace, waits for
+	// them to complete, and deletes the workspace by its ID. It returns the
+	// runs which were force-canceled.
+	ForceDelete(ctx context.Context, workspaceID string, options AdminWorkspaceForceDeleteOptions) ([]*Run, error)
 }
 
 // adminWorkspaces implements AdminWorkspaces interface.
@@ -63,11 +75,24 @@ type AdminWorkspaceListOptions struct {
 	// A query string (partial workspace name) used to filter the results.
 	// https://www.terraform.io/docs/cloud/api/admin/workspaces.html#query-parameters
 	Query string `url:"q,omitempty"`
+	// Optional: The attribute to sort the results by, like "name" or
+	// "current-run.created-at". Prefix the attribute with a hyphen to sort
+	// in descending order.
+	// https://www.terraform.io/docs/cloud/api/admin/workspaces.html#query-parameters
+	Sort string `url:"sort,omitempty"`
 	// Optional: A list of relations to include. See available resources
 	// https://www.terraform.io/docs/cloud/api/admin/workspaces.html#available-related-resources
 	Include []AdminWorkspaceIncludeOpt `url:"include,omitempty"`
 }
 
+// AdminWorkspaceForceDeleteOptions represents the options for force-deleting
+// a workspace.
+type AdminWorkspaceForceDeleteOptions struct {
+	// Optional: A comment explaining the reason for force-canceling the
+	// active runs of the workspace.
+	Comment *string
+}
+
 // AdminWorkspaceList represents a list of workspaces.
 type AdminWorkspaceList struct {
 	*Pagination
@@ -87,7 +112,7 @@ func (s *adminWorkspaces) List(ctx context.Context, options *AdminWorkspaceListO
 	}
 
 	awl := &AdminWorkspaceList{}
-	err = s.client.do(ctx, req, awl)
+	err = s.client.do(ctx, "adminWorkspaces.List", req, awl)
 	if err != nil {
 		return nil, err
 	}
@@ -108,7 +133,7 @@ func (s *adminWorkspaces) Read(ctx context.Context, workspaceID string) (*AdminW
 	}
 
 	aw := &AdminWorkspace{}
-	err = s.client.do(ctx, req, aw)
+	err = s.client.do(ctx, "adminWorkspaces.Read", req, aw)
 	if err != nil {
 		return nil, err
 	}
@@ -116,7 +141,8 @@ func (s *adminWorkspaces) Read(ctx context.Context, workspaceID string) (*AdminW
 	return aw, nil
 }
 
-// Delete a workspace by its ID.
+// Delete a workspace by its ID. Deleting a workspace which still manages
+// resources fails with an error wrapping ErrWorkspaceManagesResources.
 func (s *adminWorkspaces) Delete(ctx context.Context, workspaceID string) error {
 	if !validStringID(&workspaceID) {
 		return ErrInvalidWorkspaceValue
@@ -128,7 +154,74 @@ func (s *adminWorkspaces) Delete(ctx context.Context, workspaceID string) error
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "adminWorkspaces.Delete", req, nil)
+}
+
+// ForceDelete force-cancels the active runs of a workspace, waits for them to
+// complete, and deletes the workspace by its ID. This removes orphaned
+// workspaces whose runs are stuck, which can not be deleted otherwise. Runs
+// queued while waiting, such as by a VCS push or a run trigger, are
+// force-canceled as well. Waiting for the runs is bounded by the context.
+func (s *adminWorkspaces) ForceDelete(ctx context.Context, workspaceID string, options AdminWorkspaceForceDeleteOptions) ([]*Run, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceValue
+	}
+
+	var canceled []*Run
+	seen := make(map[string]bool)
+	for {
+		active, err := s.listActiveRuns(ctx, workspaceID)
+		if err != nil {
+			return canceled, err
+		}
+		if len(active) == 0 {
+			break
+		}
+
+		for _, r := range active {
+			if seen[r.ID] {
+				continue
+			}
+			seen[r.ID] = true
+			canceled = append(canceled, r)
+
+			err := s.client.Admin.Runs.ForceCancel(ctx, r.ID, AdminRunForceCancelOptions{
+				Comment: options.Comment,
+			})
+			// Runs may have completed since they were listed.
+			if err != nil && err != ErrResourceNotFound {
+				return canceled, err
+			}
+		}
+
+		// Wait until the canceled runs completed and released the workspace.
+		select {
+		case <-ctx.Done():
+			return canceled, ctx.Err()
+		case <-s.client.clock.After(forceDeletePollInterval):
+		}
+	}
+
+	return canceled, s.Delete(ctx, workspaceID)
+}
+
+// listActiveRuns lists the runs of a workspace which are still in progress.
+func (s *adminWorkspaces) listActiveRuns(ctx context.Context, workspaceID string) ([]*Run, error) {
+	opts := &RunListOptions{StatusGroup: RunStatusGroupNonFinal}
+
+	var runs []*Run
+	for {
+		rl, err := s.client.Runs.List(ctx, workspaceID, opts)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, rl.Items...)
+
+		if rl.Pagination == nil || rl.NextPage == 0 {
+			return runs, nil
+		}
+		opts.PageNumber = rl.NextPage
+	}
 }
 
 func (o *AdminWorkspaceListOptions) valid() error {
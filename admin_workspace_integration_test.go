@@ -8,7 +8,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -86,6 +89,18 @@ func TestAdminWorkspaces_List(t *testing.T) {
 		assert.Equal(t, 0, wl.TotalCount)
 	})
 
+	t.Run("when sorting by name", func(t *testing.T) {
+		wl, err := client.Admin.Workspaces.List(ctx, &AdminWorkspaceListOptions{
+			Sort: "-name",
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, wl.Items)
+
+		for i := 1; i < len(wl.Items); i++ {
+			assert.GreaterOrEqual(t, wl.Items[i-1].Name, wl.Items[i].Name)
+		}
+	})
+
 	t.Run("with organization included", func(t *testing.T) {
 		wl, err := client.Admin.Workspaces.List(ctx, &AdminWorkspaceListOptions{
 			Include: []AdminWorkspaceIncludeOpt{AdminWorkspaceOrg},
@@ -197,6 +212,48 @@ func TestAdminWorkspaces_Delete(t *testing.T) {
 	})
 }
 
+func TestAdminWorkspaces_DeleteManagingResources(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		switch {
+		case r.URL.Path == "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == "DELETE" && r.URL.Path == "/api/v2/admin/workspaces/ws-managing":
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"errors": [{"status": "409", "title": "conflict", "detail": "Workspace has 12 resources under management and cannot be deleted"}]}`)
+		case r.Method == "DELETE" && r.URL.Path == "/api/v2/admin/workspaces/ws-locked":
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"errors": [{"status": "409", "title": "conflict", "detail": "Workspace is locked"}]}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("when the workspace manages resources", func(t *testing.T) {
+		err := client.Admin.Workspaces.Delete(ctx, "ws-managing")
+		assert.ErrorIs(t, err, ErrWorkspaceManagesResources)
+		assert.Contains(t, err.Error(), "12 resources under management")
+	})
+
+	t.Run("with another conflict", func(t *testing.T) {
+		err := client.Admin.Workspaces.Delete(ctx, "ws-locked")
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrWorkspaceManagesResources)
+		assert.Contains(t, err.Error(), "Workspace is locked")
+	})
+}
+
 func adminWorkspaceItemsContainsID(items []*AdminWorkspace, id string) bool {
 	hasID := false
 	for _, item := range items {
@@ -209,6 +266,74 @@ func adminWorkspaceItemsContainsID(items []*AdminWorkspace, id string) bool {
 	return hasID
 }
 
+func TestAdminWorkspaces_ForceDelete(t *testing.T) {
+	var requests []string
+	lists := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/workspaces/ws-1/runs":
+			assert.Equal(t, "non_final", r.URL.Query().Get("filter[status_group]"))
+			lists++
+			switch lists {
+			case 1:
+				fmt.Fprint(w, `{"data":[{"id":"run-1","type":"runs","attributes":{"status":"applying"}},{"id":"run-2","type":"runs","attributes":{"status":"pending"}}]}`)
+			case 2:
+				// A run was queued while waiting.
+				fmt.Fprint(w, `{"data":[{"id":"run-3","type":"runs","attributes":{"status":"pending"}},{"id":"run-1","type":"runs","attributes":{"status":"applying"}}]}`)
+			default:
+				fmt.Fprint(w, `{"data":[]}`)
+			}
+			return
+		case "POST /api/v2/admin/runs/run-2/actions/force-cancel":
+			w.WriteHeader(http.StatusNotFound)
+		}
+
+		requests = append(requests, r.Method+" "+r.URL.Path)
+	}))
+	defer ts.Close()
+
+	clock := NewFakeClock(time.Now())
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Clock:      clock,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("with active runs", func(t *testing.T) {
+		canceled, err := client.Admin.Workspaces.ForceDelete(ctx, "ws-1", AdminWorkspaceForceDeleteOptions{
+			Comment: String("orphaned"),
+		})
+		require.NoError(t, err)
+		require.Len(t, canceled, 3)
+		assert.Equal(t, "run-1", canceled[0].ID)
+		assert.Equal(t, "run-3", canceled[2].ID)
+
+		assert.Equal(t, []string{
+			"POST /api/v2/admin/runs/run-1/actions/force-cancel",
+			"POST /api/v2/admin/runs/run-2/actions/force-cancel",
+			"POST /api/v2/admin/runs/run-3/actions/force-cancel",
+			"DELETE /api/v2/admin/workspaces/ws-1",
+		}, requests)
+		assert.Equal(t, 3, lists)
+		assert.Equal(t, []time.Duration{forceDeletePollInterval, forceDeletePollInterval}, clock.Waits())
+	})
+
+	t.Run("with an invalid workspace ID", func(t *testing.T) {
+		_, err := client.Admin.Workspaces.ForceDelete(ctx, badIdentifier, AdminWorkspaceForceDeleteOptions{})
+		assert.Equal(t, ErrInvalidWorkspaceValue, err)
+	})
+}
+
 func TestAdminWorkspace_Unmarshal(t *testing.T) {
 	data := map[string]interface{}{
 		"data": map[string]interface{}{
@@ -100,7 +100,7 @@ func (s *agentPools) List(ctx context.Context, organization string, options *Age
 	}
 
 	poolList := &AgentPoolList{}
-	err = s.client.do(ctx, req, poolList)
+	err = s.client.do(ctx, "agentPools.List", req, poolList)
 	if err != nil {
 		return nil, err
 	}
@@ -125,7 +125,7 @@ func (s *agentPools) Create(ctx context.Context, organization string, options Ag
 	}
 
 	pool := &AgentPool{}
-	err = s.client.do(ctx, req, pool)
+	err = s.client.do(ctx, "agentPools.Create", req, pool)
 	if err != nil {
 		return nil, err
 	}
@@ -135,11 +135,16 @@ func (s *agentPools) Create(ctx context.Context, organization string, options Ag
 
 // Read a single agent pool by its ID
 func (s *agentPools) Read(ctx context.Context, agentpoolID string) (*AgentPool, error) {
-	return s.ReadWithOptions(ctx, agentpoolID, nil)
+	return s.readWithOptions(ctx, "agentPools.Read", agentpoolID, nil)
 }
 
 // Read a single agent pool by its ID with options.
 func (s *agentPools) ReadWithOptions(ctx context.Context, agentpoolID string, options *AgentPoolReadOptions) (*AgentPool, error) {
+	return s.readWithOptions(ctx, "agentPools.ReadWithOptions", agentpoolID, options)
+}
+
+// readWithOptions implements Read and ReadWithOptions, naming the API call op.
+func (s *agentPools) readWithOptions(ctx context.Context, op, agentpoolID string, options *AgentPoolReadOptions) (*AgentPool, error) {
 	if !validStringID(&agentpoolID) {
 		return nil, ErrInvalidAgentPoolID
 	}
@@ -154,7 +159,7 @@ func (s *agentPools) ReadWithOptions(ctx context.Context, agentpoolID string, op
 	}
 
 	pool := &AgentPool{}
-	err = s.client.do(ctx, req, pool)
+	err = s.client.do(ctx, op, req, pool)
 	if err != nil {
 		return nil, err
 	}
@@ -191,7 +196,7 @@ func (s *agentPools) Update(ctx context.Context, agentPoolID string, options Age
 	}
 
 	k := &AgentPool{}
-	err = s.client.do(ctx, req, k)
+	err = s.client.do(ctx, "agentPools.Update", req, k)
 	if err != nil {
 		return nil, err
 	}
@@ -211,7 +216,7 @@ func (s *agentPools) Delete(ctx context.Context, agentPoolID string) error {
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "agentPools.Delete", req, nil)
 }
 
 func (o AgentPoolCreateOptions) valid() error {
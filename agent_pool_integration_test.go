@@ -36,7 +36,7 @@ func TestAgentPoolsList(t *testing.T) {
 	t.Run("with Include option", func(t *testing.T) {
 		_, wTestCleanup := createWorkspaceWithVCS(t, client, orgTest, WorkspaceCreateOptions{
 			Name:          String("bar"),
-			ExecutionMode: String("agent"),
+			ExecutionMode: ExecutionMode(ExecutionModeAgent),
 			AgentPoolID:   String(agentPool.ID),
 		})
 		defer wTestCleanup()
@@ -150,7 +150,7 @@ func TestAgentPoolsRead(t *testing.T) {
 	t.Run("with Include option", func(t *testing.T) {
 		_, wTestCleanup := createWorkspaceWithVCS(t, client, orgTest, WorkspaceCreateOptions{
 			Name:          String("foo"),
-			ExecutionMode: String("agent"),
+			ExecutionMode: ExecutionMode(ExecutionModeAgent),
 			AgentPoolID:   String(pool.ID),
 		})
 		defer wTestCleanup()
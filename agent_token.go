@@ -74,7 +74,7 @@ func (s *agentTokens) List(ctx context.Context, agentPoolID string) (*AgentToken
 	}
 
 	tokenList := &AgentTokenList{}
-	err = s.client.do(ctx, req, tokenList)
+	err = s.client.do(ctx, "agentTokens.List", req, tokenList)
 	if err != nil {
 		return nil, err
 	}
@@ -99,7 +99,7 @@ func (s *agentTokens) Create(ctx context.Context, agentPoolID string, options Ag
 	}
 
 	at := &AgentToken{}
-	err = s.client.do(ctx, req, at)
+	err = s.client.do(ctx, "agentTokens.Create", req, at)
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +120,7 @@ func (s *agentTokens) Read(ctx context.Context, agentTokenID string) (*AgentToke
 	}
 
 	at := &AgentToken{}
-	err = s.client.do(ctx, req, at)
+	err = s.client.do(ctx, "agentTokens.Read", req, at)
 	if err != nil {
 		return nil, err
 	}
@@ -140,5 +140,5 @@ func (s *agentTokens) Delete(ctx context.Context, agentTokenID string) error {
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "agentTokens.Delete", req, nil)
 }
@@ -21,6 +21,19 @@ type Applies interface {
 
 	// Logs retrieves the logs of an apply.
 	Logs(ctx context.Context, applyID string) (io.Reader, error)
+
+	// LogURL reads only the log read URL of an apply, without the rest of
+	// the apply. The URL expires shortly, use LogURLExists to check whether it
+	// can still be used.
+	LogURL(ctx context.Context, applyID string) (string, error)
+
+	// LogURLExists reports whether the log can still be read from the given
+	// log read URL, using a HEAD request.
+	LogURLExists(ctx context.Context, logURL string) (bool, error)
+
+	// Summary waits for an apply to complete, and summarizes its resource
+	// changes from the machine-readable log of structured run output.
+	Summary(ctx context.Context, applyID string) (*ApplySummary, error)
 }
 
 // applies implements Applies interface.
@@ -53,16 +66,65 @@ type Apply struct {
 	ResourceDestructions int                    `jsonapi:"attr,resource-destructions"`
 	Status               ApplyStatus            `jsonapi:"attr,status"`
 	StatusTimestamps     *ApplyStatusTimestamps `jsonapi:"attr,status-timestamps"`
+
+	// Links
+	Links map[string]interface{} `jsonapi:"links,omitempty"`
 }
 
 // ApplyStatusTimestamps holds the timestamps for individual apply statuses.
+// The timestamps of the statuses an apply has not been in are zero.
 type ApplyStatusTimestamps struct {
 	CanceledAt      time.Time `jsonapi:"attr,canceled-at,rfc3339"`
 	ErroredAt       time.Time `jsonapi:"attr,errored-at,rfc3339"`
 	FinishedAt      time.Time `jsonapi:"attr,finished-at,rfc3339"`
 	ForceCanceledAt time.Time `jsonapi:"attr,force-canceled-at,rfc3339"`
+	MFAWaitingAt    time.Time `jsonapi:"attr,mfa-waiting-at,rfc3339"`
+	PendingAt       time.Time `jsonapi:"attr,pending-at,rfc3339"`
 	QueuedAt        time.Time `jsonapi:"attr,queued-at,rfc3339"`
 	StartedAt       time.Time `jsonapi:"attr,started-at,rfc3339"`
+	UnreachableAt   time.Time `jsonapi:"attr,unreachable-at,rfc3339"`
+}
+
+// At returns when the apply entered the given status, or the zero time when
+// it has not been in that status. The running status is entered when the
+// apply is started.
+func (t *ApplyStatusTimestamps) At(status ApplyStatus) time.Time {
+	switch status {
+	case ApplyCanceled:
+		return t.CanceledAt
+	case ApplyErrored:
+		return t.ErroredAt
+	case ApplyFinished:
+		return t.FinishedAt
+	case ApplyMFAWaiting:
+		return t.MFAWaitingAt
+	case ApplyPending:
+		return t.PendingAt
+	case ApplyQueued:
+		return t.QueuedAt
+	case ApplyRunning:
+		return t.StartedAt
+	case ApplyUnreachable:
+		return t.UnreachableAt
+	}
+	return time.Time{}
+}
+
+// Duration returns how long the apply ran, from when it was started until it
+// finished, errored or was canceled. It returns zero when the apply has not
+// completed.
+func (t *ApplyStatusTimestamps) Duration() time.Duration {
+	if t.StartedAt.IsZero() {
+		return 0
+	}
+
+	for _, end := range []time.Time{t.FinishedAt, t.ErroredAt, t.CanceledAt, t.ForceCanceledAt} {
+		if !end.IsZero() {
+			return end.Sub(t.StartedAt)
+		}
+	}
+
+	return 0
 }
 
 // Read an apply by its ID.
@@ -78,7 +140,7 @@ func (s *applies) Read(ctx context.Context, applyID string) (*Apply, error) {
 	}
 
 	a := &Apply{}
-	err = s.client.do(ctx, req, a)
+	err = s.client.do(ctx, "applies.Read", req, a)
 	if err != nil {
 		return nil, err
 	}
@@ -129,3 +191,35 @@ func (s *applies) Logs(ctx context.Context, applyID string) (io.Reader, error) {
 		logURL: u,
 	}, nil
 }
+
+// LogURL reads only the log read URL of an apply.
+func (s *applies) LogURL(ctx context.Context, applyID string) (string, error) {
+	if !validStringID(&applyID) {
+		return "", ErrInvalidApplyID
+	}
+
+	// Use a sparse fieldset to only retrieve the log read URL.
+	u := fmt.Sprintf("applies/%s?fields[applies]=log-read-url", url.QueryEscape(applyID))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return "", err
+	}
+
+	a := &Apply{}
+	err = s.client.do(ctx, "applies.LogURL", req, a)
+	if err != nil {
+		return "", err
+	}
+
+	if a.LogReadURL == "" {
+		return "", fmt.Errorf("apply %s does not have a log URL", applyID)
+	}
+
+	return a.LogReadURL, nil
+}
+
+// LogURLExists reports whether the log can still be read from the log read
+// URL.
+func (s *applies) LogURLExists(ctx context.Context, logURL string) (bool, error) {
+	return s.client.logURLExists(ctx, "applies.LogURLExists", logURL)
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/json"
 	"io/ioutil"
+	"strings"
 	"testing"
 	"time"
 
@@ -73,6 +74,29 @@ func TestAppliesLogs(t *testing.T) {
 	})
 }
 
+func TestAppliesLogURL(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	rTest, rTestCleanup := createAppliedRun(t, client, nil)
+	defer rTestCleanup()
+
+	t.Run("when the apply exists", func(t *testing.T) {
+		logURL, err := client.Applies.LogURL(ctx, rTest.Apply.ID)
+		require.NoError(t, err)
+		assert.NotEmpty(t, logURL)
+
+		exists, err := client.Applies.LogURLExists(ctx, logURL)
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("with invalid apply ID", func(t *testing.T) {
+		_, err := client.Applies.LogURL(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidApplyID, err)
+	})
+}
+
 func TestApplies_Unmarshal(t *testing.T) {
 	data := map[string]interface{}{
 		"data": map[string]interface{}{
@@ -85,10 +109,14 @@ func TestApplies_Unmarshal(t *testing.T) {
 				"resource-destructions": 1,
 				"status":                ApplyCanceled,
 				"status-timestamps": map[string]string{
-					"queued-at":  "2020-03-16T23:15:59+00:00",
-					"errored-at": "2019-03-16T23:23:59+00:00",
+					"queued-at":      "2020-03-16T23:15:59+00:00",
+					"errored-at":     "2019-03-16T23:23:59+00:00",
+					"unreachable-at": "2019-03-16T23:24:59+00:00",
 				},
 			},
+			"links": map[string]interface{}{
+				"self": "/api/v2/applies/apply-47MBvjwzBG8YKc2v",
+			},
 		},
 	}
 
@@ -112,4 +140,60 @@ func TestApplies_Unmarshal(t *testing.T) {
 	assert.Equal(t, apply.Status, ApplyCanceled)
 	assert.Equal(t, apply.StatusTimestamps.QueuedAt, queuedParsedTime)
 	assert.Equal(t, apply.StatusTimestamps.ErroredAt, erroredParsedTime)
+	assert.Equal(t, apply.StatusTimestamps.At(ApplyErrored), erroredParsedTime)
+	assert.Equal(t, apply.StatusTimestamps.At(ApplyUnreachable), erroredParsedTime.Add(time.Minute))
+	assert.True(t, apply.StatusTimestamps.At(ApplyFinished).IsZero())
+	assert.Equal(t, apply.Links["self"], "/api/v2/applies/apply-47MBvjwzBG8YKc2v")
+}
+
+func TestApplyStatusTimestampsDuration(t *testing.T) {
+	started := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("when the apply finished", func(t *testing.T) {
+		ts := &ApplyStatusTimestamps{StartedAt: started, FinishedAt: started.Add(90 * time.Second)}
+		assert.Equal(t, 90*time.Second, ts.Duration())
+	})
+
+	t.Run("when the apply errored", func(t *testing.T) {
+		ts := &ApplyStatusTimestamps{StartedAt: started, ErroredAt: started.Add(time.Minute)}
+		assert.Equal(t, time.Minute, ts.Duration())
+	})
+
+	t.Run("when the apply is still running", func(t *testing.T) {
+		ts := &ApplyStatusTimestamps{StartedAt: started}
+		assert.Zero(t, ts.Duration())
+	})
+}
+
+func TestApplySummaryParse(t *testing.T) {
+	t.Run("with structured run output", func(t *testing.T) {
+		logs := strings.Join([]string{
+			`{"@level":"info","@message":"Terraform 1.4.0","type":"version","terraform":"1.4.0"}`,
+			`{"type":"apply_complete","hook":{"resource":{"addr":"null_resource.foo","resource_type":"null_resource"},"action":"create","id_key":"id","id_value":"123","elapsed_seconds":2}}`,
+			`{"type":"apply_errored","hook":{"resource":{"addr":"null_resource.bar","resource_type":"null_resource"},"action":"delete","elapsed_seconds":0.5}}`,
+			`{"type":"change_summary","changes":{"add":1,"change":0,"import":0,"remove":1,"operation":"apply"}}`,
+		}, "\n")
+
+		summary, err := parseApplySummary(strings.NewReader(logs))
+		require.NoError(t, err)
+		assert.Equal(t, 1, summary.Add)
+		assert.Equal(t, 1, summary.Remove)
+		require.Len(t, summary.Resources, 2)
+
+		assert.Equal(t, &ApplyResourceResult{
+			Address:      "null_resource.foo",
+			ResourceType: "null_resource",
+			Action:       "create",
+			IDKey:        "id",
+			IDValue:      "123",
+			Elapsed:      2 * time.Second,
+		}, summary.Resources[0])
+		assert.True(t, summary.Resources[1].Errored)
+		assert.Equal(t, 500*time.Millisecond, summary.Resources[1].Elapsed)
+	})
+
+	t.Run("without structured run output", func(t *testing.T) {
+		_, err := parseApplySummary(strings.NewReader("Terraform v1.4.0\nApply complete!\n"))
+		assert.Equal(t, ErrStructuredRunOutputUnavailable, err)
+	})
 }
@@ -0,0 +1,129 @@
+package tfe
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+)
+
+// ApplySummary is the structured summary of an apply, computed from the
+// machine-readable log of runs with structured run output enabled.
+type ApplySummary struct {
+	// The number of resources added, changed, removed and imported, as
+	// reported by Terraform at the end of the apply.
+	Add    int
+	Change int
+	Remove int
+	Import int
+
+	// The results of the individual resource changes, in the order they
+	// completed or errored.
+	Resources []*ApplyResourceResult
+}
+
+// ApplyResourceResult is the result of applying the change of a single
+// resource instance.
+type ApplyResourceResult struct {
+	Address      string
+	ResourceType string
+	Action       string
+
+	// The attribute used as ID of the resource instance, and its value.
+	IDKey   string
+	IDValue string
+
+	Elapsed time.Duration
+	Errored bool
+}
+
+// applyLogMessage is a message of the machine-readable log of an apply.
+// https://developer.hashicorp.com/terraform/internals/machine-readable-ui
+type applyLogMessage struct {
+	Type    string `json:"type"`
+	Changes *struct {
+		Add       int    `json:"add"`
+		Change    int    `json:"change"`
+		Remove    int    `json:"remove"`
+		Import    int    `json:"import"`
+		Operation string `json:"operation"`
+	} `json:"changes"`
+	Hook *struct {
+		Resource struct {
+			Addr         string `json:"addr"`
+			ResourceType string `json:"resource_type"`
+		} `json:"resource"`
+		Action         string  `json:"action"`
+		IDKey          string  `json:"id_key"`
+		IDValue        string  `json:"id_value"`
+		ElapsedSeconds float64 `json:"elapsed_seconds"`
+	} `json:"hook"`
+}
+
+// Summary waits for the apply to complete, and summarizes its resource
+// changes from the machine-readable log. This is only supported for runs with
+// structured run output enabled, using Terraform 0.15.2 or newer.
+func (s *applies) Summary(ctx context.Context, applyID string) (*ApplySummary, error) {
+	logs, err := s.Logs(ctx, applyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseApplySummary(logs)
+}
+
+// parseApplySummary parses the machine-readable log of an apply. Lines which
+// are not JSON are ignored, but at least one JSON message is required.
+func parseApplySummary(r io.Reader) (*ApplySummary, error) {
+	summary := &ApplySummary{}
+	messages := 0
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "{") {
+			continue
+		}
+
+		var msg applyLogMessage
+		if err := json.Unmarshal([]byte(line), &msg); err != nil {
+			continue
+		}
+		messages++
+
+		switch msg.Type {
+		case "change_summary":
+			if msg.Changes != nil && msg.Changes.Operation != "plan" {
+				summary.Add = msg.Changes.Add
+				summary.Change = msg.Changes.Change
+				summary.Remove = msg.Changes.Remove
+				summary.Import = msg.Changes.Import
+			}
+		case "apply_complete", "apply_errored":
+			if msg.Hook == nil {
+				continue
+			}
+			summary.Resources = append(summary.Resources, &ApplyResourceResult{
+				Address:      msg.Hook.Resource.Addr,
+				ResourceType: msg.Hook.Resource.ResourceType,
+				Action:       msg.Hook.Action,
+				IDKey:        msg.Hook.IDKey,
+				IDValue:      msg.Hook.IDValue,
+				Elapsed:      time.Duration(msg.Hook.ElapsedSeconds * float64(time.Second)),
+				Errored:      msg.Type == "apply_errored",
+			})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if messages == 0 {
+		return nil, ErrStructuredRunOutputUnavailable
+	}
+
+	return summary, nil
+}
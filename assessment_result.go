@@ -0,0 +1,256 @@
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ AssessmentResults = (*assessmentResults)(nil)
+
+// AssessmentResults describes all the assessment result related methods that
+// the Terraform Enterprise API supports. Assessment results are the outcome
+// of the health assessments of a workspace, which detect drift between its
+// state and the real infrastructure.
+//
+// Health assessments are only supported by Terraform Cloud, and by Terraform
+// Enterprise releases which include them. When they are not supported, the
+// methods return ErrResourceNotFound.
+//
+// TFE API docs: https://developer.hashicorp.com/terraform/cloud-docs/api-docs/assessment-results
+type AssessmentResults interface {
+	// Create triggers an on-demand health assessment of a workspace. The
+	// workspace must have health assessments enabled.
+	Create(ctx context.Context, workspaceID string) (*AssessmentResult, error)
+
+	// CreateAndWait triggers an on-demand health assessment of a workspace,
+	// and waits until the assessment has completed.
+	CreateAndWait(ctx context.Context, workspaceID string) (*AssessmentResult, error)
+
+	// Read an assessment result by its ID.
+	Read(ctx context.Context, assessmentResultID string) (*AssessmentResult, error)
+
+	// ReadLatest reads the latest assessment result of a workspace.
+	ReadLatest(ctx context.Context, workspaceID string) (*AssessmentResult, error)
+
+	// JSONOutput reads the JSON plan of an assessment result, as produced by
+	// "terraform show -json".
+	JSONOutput(ctx context.Context, assessmentResultID string) ([]byte, error)
+
+	// JSONSchema reads the JSON provider schemas of an assessment result, as
+	// produced by "terraform providers schema -json".
+	JSONSchema(ctx context.Context, assessmentResultID string) ([]byte, error)
+
+	// DriftedResources reads the resources which drifted, from the JSON plan
+	// of an assessment result.
+	DriftedResources(ctx context.Context, assessmentResultID string) ([]*AssessmentDriftedResource, error)
+}
+
+// assessmentResults implements AssessmentResults.
+type assessmentResults struct {
+	client *Client
+}
+
+// AssessmentResultStatus represents the status of a health assessment.
+type AssessmentResultStatus string
+
+// List all available assessment result statuses.
+const (
+	AssessmentResultCanceled AssessmentResultStatus = "canceled"
+	AssessmentResultErrored  AssessmentResultStatus = "errored"
+	AssessmentResultFinished AssessmentResultStatus = "finished"
+	AssessmentResultPending  AssessmentResultStatus = "pending"
+	AssessmentResultQueued   AssessmentResultStatus = "queued"
+	AssessmentResultRunning  AssessmentResultStatus = "running"
+)
+
+// AssessmentResult represents the result of a health assessment of a
+// workspace.
+type AssessmentResult struct {
+	ID        string                 `jsonapi:"primary,assessment-results"`
+	CreatedAt time.Time              `jsonapi:"attr,created-at,iso8601"`
+	Drifted   bool                   `jsonapi:"attr,drifted"`
+	ErrorMsg  string                 `jsonapi:"attr,error-msg"`
+	Status    AssessmentResultStatus `jsonapi:"attr,status"`
+	Succeeded bool                   `jsonapi:"attr,succeeded"`
+
+	// Relations
+	Workspace *Workspace `jsonapi:"relation,workspace"`
+
+	// Links to the "json-output", "json-schema" and "log-output" of the
+	// assessment.
+	Links map[string]interface{} `jsonapi:"links,omitempty"`
+}
+
+// AssessmentDriftedResource represents a resource whose real infrastructure
+// drifted from the state, as listed in the JSON plan of an assessment.
+type AssessmentDriftedResource struct {
+	Address      string                   `json:"address"`
+	Mode         string                   `json:"mode"`
+	Type         string                   `json:"type"`
+	Name         string                   `json:"name"`
+	ProviderName string                   `json:"provider_name"`
+	Change       AssessmentResourceChange `json:"change"`
+}
+
+// AssessmentResourceChange describes how a drifted resource changed. The
+// values before and after the change are kept as raw JSON, as their shape
+// depends on the type of the resource.
+type AssessmentResourceChange struct {
+	Actions []string        `json:"actions"`
+	Before  json.RawMessage `json:"before"`
+	After   json.RawMessage `json:"after"`
+}
+
+// Create triggers an on-demand health assessment of a workspace.
+func (s *assessmentResults) Create(ctx context.Context, workspaceID string) (*AssessmentResult, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+
+	u := fmt.Sprintf("workspaces/%s/assessment-results", url.QueryEscape(workspaceID))
+	req, err := s.client.newRequest("POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ar := &AssessmentResult{}
+	err = s.client.do(ctx, "assessmentResults.Create", req, ar)
+	if err != nil {
+		return nil, err
+	}
+
+	return ar, nil
+}
+
+// CreateAndWait triggers an on-demand health assessment of a workspace, and
+// waits until the assessment has completed.
+func (s *assessmentResults) CreateAndWait(ctx context.Context, workspaceID string) (*AssessmentResult, error) {
+	ar, err := s.Create(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Assessments plan the whole workspace, so poll with an increasing
+	// interval.
+	for i := 0; !ar.completed(); i++ {
+		select {
+		case <-ctx.Done():
+			return ar, ctx.Err()
+		case <-s.client.clock.After(backoff(1000, 10000, i)):
+		}
+
+		ar, err = s.Read(ctx, ar.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ar, nil
+}
+
+// Read an assessment result by its ID.
+func (s *assessmentResults) Read(ctx context.Context, assessmentResultID string) (*AssessmentResult, error) {
+	if !validStringID(&assessmentResultID) {
+		return nil, ErrInvalidAssessmentResultID
+	}
+
+	u := fmt.Sprintf("assessment-results/%s", url.QueryEscape(assessmentResultID))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ar := &AssessmentResult{}
+	err = s.client.do(ctx, "assessmentResults.Read", req, ar)
+	if err != nil {
+		return nil, err
+	}
+
+	return ar, nil
+}
+
+// ReadLatest reads the latest assessment result of a workspace.
+func (s *assessmentResults) ReadLatest(ctx context.Context, workspaceID string) (*AssessmentResult, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+
+	u := fmt.Sprintf("workspaces/%s/current-assessment-result", url.QueryEscape(workspaceID))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ar := &AssessmentResult{}
+	err = s.client.do(ctx, "assessmentResults.ReadLatest", req, ar)
+	if err != nil {
+		return nil, err
+	}
+
+	return ar, nil
+}
+
+// JSONOutput reads the JSON plan of an assessment result.
+func (s *assessmentResults) JSONOutput(ctx context.Context, assessmentResultID string) ([]byte, error) {
+	return s.readOutput(ctx, "assessmentResults.JSONOutput", assessmentResultID, "json-output")
+}
+
+// JSONSchema reads the JSON provider schemas of an assessment result.
+func (s *assessmentResults) JSONSchema(ctx context.Context, assessmentResultID string) ([]byte, error) {
+	return s.readOutput(ctx, "assessmentResults.JSONSchema", assessmentResultID, "json-schema")
+}
+
+// DriftedResources reads the resources which drifted, from the JSON plan of
+// an assessment result.
+func (s *assessmentResults) DriftedResources(ctx context.Context, assessmentResultID string) ([]*AssessmentDriftedResource, error) {
+	output, err := s.JSONOutput(ctx, assessmentResultID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := struct {
+		ResourceDrift []*AssessmentDriftedResource `json:"resource_drift"`
+	}{}
+	if err := json.Unmarshal(output, &plan); err != nil {
+		return nil, err
+	}
+
+	return plan.ResourceDrift, nil
+}
+
+// readOutput reads an output of an assessment result, which the API
+// redirects to.
+func (s *assessmentResults) readOutput(ctx context.Context, op, assessmentResultID, output string) ([]byte, error) {
+	if !validStringID(&assessmentResultID) {
+		return nil, ErrInvalidAssessmentResultID
+	}
+
+	u := fmt.Sprintf("assessment-results/%s/%s", url.QueryEscape(assessmentResultID), output)
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err = s.client.do(ctx, op, req, &buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// completed reports whether the health assessment has completed.
+func (ar *AssessmentResult) completed() bool {
+	switch ar.Status {
+	case AssessmentResultCanceled, AssessmentResultErrored, AssessmentResultFinished:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,149 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssessmentResultsCreateAndWait(t *testing.T) {
+	reads := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/workspaces/ws-1/assessment-results":
+			require.Equal(t, http.MethodPost, r.Method)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"data":{"id":"asmtres-1","type":"assessment-results","attributes":{"status":"queued"}}}`)
+		case "/api/v2/assessment-results/asmtres-1":
+			reads++
+			status := "running"
+			if reads == 2 {
+				status = "finished"
+			}
+			fmt.Fprintf(w, `{"data":{"id":"asmtres-1","type":"assessment-results","attributes":{"status":"%s","drifted":true,"succeeded":true},`+
+				`"relationships":{"workspace":{"data":{"id":"ws-1","type":"workspaces"}}}}}`, status)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	clock := NewFakeClock(time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC))
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Clock:      clock,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("when the assessment completes", func(t *testing.T) {
+		ar, err := client.AssessmentResults.CreateAndWait(ctx, "ws-1")
+		require.NoError(t, err)
+		assert.Equal(t, "asmtres-1", ar.ID)
+		assert.Equal(t, AssessmentResultFinished, ar.Status)
+		assert.True(t, ar.Drifted)
+		assert.True(t, ar.Succeeded)
+		assert.Equal(t, "ws-1", ar.Workspace.ID)
+		assert.Equal(t, 2, reads)
+		assert.Len(t, clock.Waits(), 2)
+	})
+
+	t.Run("when assessments are not supported", func(t *testing.T) {
+		_, err := client.AssessmentResults.Create(ctx, "ws-unknown")
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("with invalid identifiers", func(t *testing.T) {
+		_, err := client.AssessmentResults.Create(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidWorkspaceID, err)
+
+		_, err = client.AssessmentResults.Read(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidAssessmentResultID, err)
+	})
+}
+
+func TestAssessmentResultsOutputs(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/workspaces/ws-1/current-assessment-result":
+			w.Header().Set("Content-Type", "application/vnd.api+json")
+			fmt.Fprint(w, `{"data":{"id":"asmtres-1","type":"assessment-results","attributes":{"status":"finished","drifted":true},`+
+				`"links":{"json-output":"/api/v2/assessment-results/asmtres-1/json-output","json-schema":"/api/v2/assessment-results/asmtres-1/json-schema"}}}`)
+		case "/api/v2/assessment-results/asmtres-1/json-output":
+			http.Redirect(w, r, "/archivist/json-output", http.StatusTemporaryRedirect)
+		case "/archivist/json-output":
+			fmt.Fprint(w, `{"format_version":"1.1","resource_drift":[{"address":"aws_instance.web","mode":"managed","type":"aws_instance","name":"web",`+
+				`"provider_name":"registry.terraform.io/hashicorp/aws","change":{"actions":["update"],"before":{"instance_type":"t3.micro"},"after":{"instance_type":"t3.large"}}}]}`)
+		case "/api/v2/assessment-results/asmtres-1/json-schema":
+			fmt.Fprint(w, `{"format_version":"1.0","provider_schemas":{}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("when reading the latest assessment result", func(t *testing.T) {
+		ar, err := client.AssessmentResults.ReadLatest(ctx, "ws-1")
+		require.NoError(t, err)
+		assert.Equal(t, "asmtres-1", ar.ID)
+		assert.True(t, ar.Drifted)
+		assert.Equal(t, "/api/v2/assessment-results/asmtres-1/json-output", ar.Links["json-output"])
+		assert.Equal(t, "/api/v2/assessment-results/asmtres-1/json-schema", ar.Links["json-schema"])
+	})
+
+	t.Run("when reading the drifted resources", func(t *testing.T) {
+		resources, err := client.AssessmentResults.DriftedResources(ctx, "asmtres-1")
+		require.NoError(t, err)
+		require.Len(t, resources, 1)
+		assert.Equal(t, "aws_instance.web", resources[0].Address)
+		assert.Equal(t, "registry.terraform.io/hashicorp/aws", resources[0].ProviderName)
+		assert.Equal(t, []string{"update"}, resources[0].Change.Actions)
+		assert.JSONEq(t, `{"instance_type":"t3.large"}`, string(resources[0].Change.After))
+	})
+
+	t.Run("when reading the JSON schema", func(t *testing.T) {
+		schema, err := client.AssessmentResults.JSONSchema(ctx, "asmtres-1")
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"format_version":"1.0","provider_schemas":{}}`, string(schema))
+	})
+
+	t.Run("when the workspace has no assessment result", func(t *testing.T) {
+		_, err := client.AssessmentResults.ReadLatest(ctx, "ws-unknown")
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("with invalid identifiers", func(t *testing.T) {
+		_, err := client.AssessmentResults.ReadLatest(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidWorkspaceID, err)
+
+		_, err = client.AssessmentResults.JSONOutput(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidAssessmentResultID, err)
+	})
+}
@@ -0,0 +1,123 @@
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ AuditTrails = (*auditTrails)(nil)
+
+// AuditTrails describes all the audit trail related methods that the
+// Terraform Cloud API supports. The audit trail of an organization can only
+// be read using an audit trails token of the organization, created with
+// OrganizationTokens.CreateWithOptions and the AuditTrailToken token type.
+//
+// Streaming audit logs to external destinations is configured when installing
+// Terraform Enterprise and is not exposed by the API, so log pipelines pull
+// the audit trail instead.
+//
+// TFE API docs: https://developer.hashicorp.com/terraform/cloud-docs/api-docs/audit-trails
+type AuditTrails interface {
+	// List the audit trail events of the organization of the token.
+	List(ctx context.Context, options *AuditTrailListOptions) (*AuditTrailList, error)
+}
+
+// auditTrails implements AuditTrails.
+type auditTrails struct {
+	client *Client
+}
+
+// AuditTrailList represents a list of audit trail events.
+type AuditTrailList struct {
+	*Pagination
+	Items []*AuditTrail
+}
+
+// AuditTrail represents an event of the audit trail of an organization.
+type AuditTrail struct {
+	ID        string             `json:"id"`
+	Version   string             `json:"version"`
+	Type      string             `json:"type"`
+	Timestamp time.Time          `json:"timestamp"`
+	Auth      AuditTrailAuth     `json:"auth"`
+	Request   AuditTrailRequest  `json:"request"`
+	Resource  AuditTrailResource `json:"resource"`
+}
+
+// AuditTrailAuth represents who performed the audited action.
+type AuditTrailAuth struct {
+	AccessorID     string  `json:"accessor_id"`
+	Description    string  `json:"description"`
+	Type           string  `json:"type"`
+	ImpersonatorID *string `json:"impersonator_id"`
+	OrganizationID string  `json:"organization_id"`
+}
+
+// AuditTrailRequest represents the request of the audited action.
+type AuditTrailRequest struct {
+	ID string `json:"id"`
+}
+
+// AuditTrailResource represents the resource the audited action was
+// performed on.
+type AuditTrailResource struct {
+	ID     string                 `json:"id"`
+	Type   string                 `json:"type"`
+	Action string                 `json:"action"`
+	Meta   map[string]interface{} `json:"meta"`
+}
+
+// AuditTrailListOptions represents the options for listing audit trail
+// events.
+type AuditTrailListOptions struct {
+	ListOptions
+
+	// Optional: Only list the events which occurred since the given time.
+	Since time.Time `url:"since,omitempty"`
+}
+
+// auditTrailListPayload represents the audit trail response, which is plain
+// JSON instead of JSON:API.
+type auditTrailListPayload struct {
+	Data       []*AuditTrail `json:"data"`
+	Pagination struct {
+		CurrentPage  int `json:"current_page"`
+		PreviousPage int `json:"prev_page"`
+		NextPage     int `json:"next_page"`
+		TotalPages   int `json:"total_pages"`
+		TotalCount   int `json:"total_count"`
+	} `json:"pagination"`
+}
+
+// List the audit trail events of the organization of the token.
+func (s *auditTrails) List(ctx context.Context, options *AuditTrailListOptions) (*AuditTrailList, error) {
+	req, err := s.client.newRequest("GET", "organization/audit-trail", options)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	err = s.client.do(ctx, "auditTrails.List", req, buf)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &auditTrailListPayload{}
+	if err := json.Unmarshal(buf.Bytes(), payload); err != nil {
+		return nil, err
+	}
+
+	return &AuditTrailList{
+		Pagination: &Pagination{
+			CurrentPage:  payload.Pagination.CurrentPage,
+			PreviousPage: payload.Pagination.PreviousPage,
+			NextPage:     payload.Pagination.NextPage,
+			TotalPages:   payload.Pagination.TotalPages,
+			TotalCount:   payload.Pagination.TotalCount,
+		},
+		Items: payload.Data,
+	}, nil
+}
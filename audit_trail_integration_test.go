@@ -0,0 +1,102 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditTrailsList(t *testing.T) {
+	since := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/organization/audit-trail":
+			assert.Equal(t, "GET", r.Method)
+			assert.Equal(t, "2023-01-01T00:00:00Z", r.URL.Query().Get("since"))
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{
+				"data": [{
+					"id": "ae66e491-db59-457c-8445-9c908ee726ae",
+					"version": "0",
+					"type": "Resource",
+					"timestamp": "2023-01-02T10:00:00.000Z",
+					"auth": {"accessor_id": "user-1", "description": "admin", "type": "Client", "impersonator_id": null, "organization_id": "org-1"},
+					"request": {"id": "4e5f9c67-25b5-4296-8dae-9ad8f8b2d98e"},
+					"resource": {"id": "ws-1", "type": "workspace", "action": "update", "meta": null}
+				}],
+				"pagination": {"current_page": 1, "prev_page": null, "next_page": 2, "total_pages": 2, "total_count": 2}
+			}`)
+		case "/api/v2/organizations/my-org/authentication-token":
+			assert.Equal(t, "audit-trails", r.URL.Query().Get("token"))
+			switch r.Method {
+			case "POST", "GET":
+				w.Header().Set("Content-Type", "application/vnd.api+json")
+				fmt.Fprint(w, `{"data": {"id": "at-1", "type": "authentication-tokens", "attributes": {"token": "secret"}}}`)
+			case "DELETE":
+				w.WriteHeader(http.StatusNoContent)
+			}
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	tokenType := AuditTrailToken
+
+	t.Run("with an audit trails token", func(t *testing.T) {
+		ot, err := client.OrganizationTokens.CreateWithOptions(ctx, "my-org", OrganizationTokenCreateOptions{
+			TokenType: &tokenType,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "secret", ot.Token)
+
+		ot, err = client.OrganizationTokens.ReadWithOptions(ctx, "my-org", OrganizationTokenReadOptions{
+			TokenType: &tokenType,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "at-1", ot.ID)
+
+		err = client.OrganizationTokens.DeleteWithOptions(ctx, "my-org", OrganizationTokenDeleteOptions{
+			TokenType: &tokenType,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("with a since option", func(t *testing.T) {
+		atl, err := client.AuditTrails.List(ctx, &AuditTrailListOptions{Since: since})
+		require.NoError(t, err)
+
+		require.Len(t, atl.Items, 1)
+		at := atl.Items[0]
+		assert.Equal(t, "ae66e491-db59-457c-8445-9c908ee726ae", at.ID)
+		assert.Equal(t, "user-1", at.Auth.AccessorID)
+		assert.Nil(t, at.Auth.ImpersonatorID)
+		assert.Equal(t, "ws-1", at.Resource.ID)
+		assert.Equal(t, "update", at.Resource.Action)
+		assert.True(t, at.Timestamp.After(since))
+
+		assert.Equal(t, 2, atl.NextPage)
+		assert.Equal(t, 2, atl.TotalCount)
+	})
+}
@@ -0,0 +1,140 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// capabilitiesCacheTTL is how long the capabilities probed for an
+// organization are cached for.
+const capabilitiesCacheTTL = time.Hour
+
+// Capabilities represents the optional features supported by the instance
+// the client is connected to, so tooling supporting several Terraform Cloud
+// and Enterprise versions can branch on them instead of on versions.
+type Capabilities struct {
+	// Whether workspaces can be organized in projects.
+	SupportsProjects bool
+
+	// Whether Open Policy Agent policies are supported. It is also true
+	// for instances without OPA support when the organization has no
+	// policies, as they can not be told apart.
+	SupportsOPA bool
+
+	// Whether stacks are supported.
+	SupportsStacks bool
+}
+
+// capabilityCache caches the capabilities probed for organizations.
+type capabilityCache struct {
+	mu      sync.Mutex
+	entries map[string]*capabilityCacheEntry
+}
+
+type capabilityCacheEntry struct {
+	capabilities *Capabilities
+	expiresAt    time.Time
+}
+
+// capabilityProbeOptions represents the query of a probe request, which
+// lists at most a single item.
+type capabilityProbeOptions struct {
+	ListOptions
+	Kind string `url:"filter[kind],omitempty"`
+}
+
+// capabilityProbePolicy represents the kind of a policy, which is only
+// returned by instances supporting other kinds of policies than Sentinel.
+type capabilityProbePolicy struct {
+	ID   string `jsonapi:"primary,policies"`
+	Kind string `jsonapi:"attr,kind"`
+}
+
+type capabilityProbePolicyList struct {
+	*Pagination
+	Items []*capabilityProbePolicy
+}
+
+// Capabilities probes which optional endpoints exist on the instance, using
+// cheap list requests scoped to the organization, as the API does not
+// distinguish unknown endpoints from unknown resources otherwise. Endpoints
+// the organization is not entitled to are reported as unsupported. The
+// capabilities are cached for an hour, and a copy is returned to every
+// caller.
+func (c *Client) Capabilities(ctx context.Context, organization string) (*Capabilities, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+
+	c.capabilities.mu.Lock()
+	entry, ok := c.capabilities.entries[organization]
+	c.capabilities.mu.Unlock()
+	if ok && c.clock.Now().Before(entry.expiresAt) {
+		caps := *entry.capabilities
+		return &caps, nil
+	}
+
+	// Make sure the organization exists, as the probes of an unknown
+	// organization would all report the endpoints as unsupported.
+	if _, err := c.Organizations.Read(ctx, organization); err != nil {
+		return nil, err
+	}
+
+	caps := &Capabilities{}
+	var err error
+	if caps.SupportsProjects, err = c.probe(ctx, organization, "projects", "", nil); err != nil {
+		return nil, err
+	}
+	if caps.SupportsStacks, err = c.probe(ctx, organization, "stacks", "", nil); err != nil {
+		return nil, err
+	}
+
+	// Instances without OPA support ignore the kind filter, and list the
+	// Sentinel policies without a kind instead.
+	policies := &capabilityProbePolicyList{}
+	if caps.SupportsOPA, err = c.probe(ctx, organization, "policies", "opa", policies); err != nil {
+		return nil, err
+	}
+	for _, p := range policies.Items {
+		if p.Kind != "opa" {
+			caps.SupportsOPA = false
+		}
+	}
+
+	c.capabilities.mu.Lock()
+	cached := *caps
+	c.capabilities.entries[organization] = &capabilityCacheEntry{
+		capabilities: &cached,
+		expiresAt:    c.clock.Now().Add(capabilitiesCacheTTL),
+	}
+	c.capabilities.mu.Unlock()
+
+	return caps, nil
+}
+
+// probe reports whether the organization scoped endpoint exists, decoding
+// the listed item into v when given.
+func (c *Client) probe(ctx context.Context, organization, path, kind string, v interface{}) (bool, error) {
+	u := fmt.Sprintf("organizations/%s/%s", url.QueryEscape(organization), path)
+	req, err := c.newRequest("GET", u, &capabilityProbeOptions{
+		ListOptions: ListOptions{PageSize: 1},
+		Kind:        kind,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	err = c.do(ctx, "capabilities.Probe", req, v)
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, ErrResourceNotFound):
+		return false, nil
+	default:
+		return false, err
+	}
+}
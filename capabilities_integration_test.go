@@ -0,0 +1,106 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Capabilities(t *testing.T) {
+	probes := 0
+	policies := `{"data":[{"id":"pol-1","type":"policies","attributes":{"kind":"opa"}}]}`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/organizations/acme", "/api/v2/organizations/legacy":
+			fmt.Fprint(w, `{"data":{"id":"acme","type":"organizations"}}`)
+		case "/api/v2/organizations/acme/projects":
+			probes++
+			assert.Equal(t, "1", r.URL.Query().Get("page[size]"))
+			fmt.Fprint(w, `{"data":[]}`)
+		case "/api/v2/organizations/acme/policies":
+			probes++
+			assert.Equal(t, "opa", r.URL.Query().Get("filter[kind]"))
+			fmt.Fprint(w, policies)
+		case "/api/v2/organizations/legacy/policies":
+			fmt.Fprint(w, `{"data":[{"id":"pol-1","type":"policies","attributes":{}}]}`)
+		default:
+			probes++
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"errors":[{"status":"404","title":"not found"}]}`)
+		}
+	}))
+	defer ts.Close()
+
+	clock := NewFakeClock(time.Now())
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Clock:      clock,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("with optional endpoints", func(t *testing.T) {
+		caps, err := client.Capabilities(ctx, "acme")
+		require.NoError(t, err)
+		assert.Equal(t, &Capabilities{
+			SupportsProjects: true,
+			SupportsOPA:      true,
+			SupportsStacks:   false,
+		}, caps)
+		assert.Equal(t, 3, probes)
+	})
+
+	t.Run("uses the cached capabilities", func(t *testing.T) {
+		caps, err := client.Capabilities(ctx, "acme")
+		require.NoError(t, err)
+		assert.Equal(t, 3, probes)
+
+		// Changing the returned capabilities does not change the cached
+		// ones.
+		caps.SupportsProjects = false
+		caps, err = client.Capabilities(ctx, "acme")
+		require.NoError(t, err)
+		assert.True(t, caps.SupportsProjects)
+		assert.Equal(t, 3, probes)
+	})
+
+	t.Run("probes again when the cache expired", func(t *testing.T) {
+		clock.After(capabilitiesCacheTTL)
+		_, err := client.Capabilities(ctx, "acme")
+		require.NoError(t, err)
+		assert.Equal(t, 6, probes)
+	})
+
+	t.Run("when the kind filter is ignored", func(t *testing.T) {
+		caps, err := client.Capabilities(ctx, "legacy")
+		require.NoError(t, err)
+		assert.False(t, caps.SupportsProjects)
+		assert.False(t, caps.SupportsOPA)
+	})
+
+	t.Run("when the organization does not exist", func(t *testing.T) {
+		_, err := client.Capabilities(ctx, "missing")
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("without a valid organization", func(t *testing.T) {
+		_, err := client.Capabilities(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidOrg, err)
+	})
+}
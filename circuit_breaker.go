@@ -0,0 +1,162 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultCircuitBreakerThreshold is the default number of consecutive
+	// failures which open the circuit.
+	defaultCircuitBreakerThreshold = 5
+
+	// defaultCircuitBreakerCoolDown is the default time the circuit stays
+	// open.
+	defaultCircuitBreakerCoolDown = 30 * time.Second
+)
+
+// CircuitBreakerOptions configures the circuit breaker of a client, which
+// stops sending requests after consecutive failures, to spare an API which is
+// already struggling from clients running in tight loops.
+//
+// A request fails when it returns a server error, a 5xx status code, or can
+// not reach the API. A request is counted once, after its retries. Once the
+// circuit is open, requests fail with a *CircuitOpenError until the cool-down
+// has passed. A single request is then let through, which closes the circuit
+// when it succeeds or opens it again when it fails.
+type CircuitBreakerOptions struct {
+	// The number of consecutive failed requests which open the circuit. Zero
+	// uses the default of 5.
+	Threshold int
+
+	// How long the circuit stays open. Zero uses the default of 30 seconds.
+	CoolDown time.Duration
+}
+
+// CircuitOpenError is returned when a request is not sent because the circuit
+// breaker is open. It wraps ErrCircuitOpen, so it matches it when compared
+// using errors.Is.
+type CircuitOpenError struct {
+	// How long until the circuit breaker lets a request through again. It is
+	// zero when another request is already probing the API.
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface.
+func (e *CircuitOpenError) Error() string {
+	if e.RetryAfter <= 0 {
+		return ErrCircuitOpen.Error()
+	}
+	return fmt.Sprintf("%s: retry after %s", ErrCircuitOpen, e.RetryAfter)
+}
+
+// Unwrap returns ErrCircuitOpen.
+func (e *CircuitOpenError) Unwrap() error {
+	return ErrCircuitOpen
+}
+
+// circuitBreaker counts the consecutive failed requests of a client. A nil
+// circuit breaker lets all requests through.
+type circuitBreaker struct {
+	threshold int
+	coolDown  time.Duration
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time
+	open     bool
+	probing  bool
+}
+
+// newCircuitBreaker creates a circuit breaker from its options, or returns
+// nil if they are nil.
+func newCircuitBreaker(options *CircuitBreakerOptions) *circuitBreaker {
+	if options == nil {
+		return nil
+	}
+
+	b := &circuitBreaker{
+		threshold: options.Threshold,
+		coolDown:  options.CoolDown,
+	}
+	if b.threshold <= 0 {
+		b.threshold = defaultCircuitBreakerThreshold
+	}
+	if b.coolDown <= 0 {
+		b.coolDown = defaultCircuitBreakerCoolDown
+	}
+	return b
+}
+
+// allow returns an error if a request may not be sent at the given time.
+// Once the cool-down has passed, it lets a single request through until its
+// outcome is recorded.
+func (b *circuitBreaker) allow(now time.Time) error {
+	if b == nil {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.open {
+		return nil
+	}
+	if wait := b.openedAt.Add(b.coolDown).Sub(now); wait > 0 {
+		return &CircuitOpenError{RetryAfter: wait}
+	}
+	if b.probing {
+		return &CircuitOpenError{}
+	}
+
+	b.probing = true
+	return nil
+}
+
+// record records the outcome of a request completed at the given time.
+// Requests canceled by their context are ignored.
+func (b *circuitBreaker) record(ctx context.Context, now time.Time, resp *http.Response, err error) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err != nil && ctx.Err() != nil {
+		// The request was abandoned, which says nothing about the API.
+		b.probing = false
+		return
+	}
+
+	switch failed := err != nil || resp.StatusCode >= 500; {
+	case !failed:
+		b.failures = 0
+		b.open = false
+	case b.probing:
+		b.openedAt = now
+	default:
+		b.failures++
+		if !b.open && b.failures >= b.threshold {
+			b.open = true
+			b.openedAt = now
+		}
+	}
+	b.probing = false
+}
+
+// abandon records that a request let through was not sent, such as when it
+// could not wait for the rate limiter, so another request may probe the API.
+func (b *circuitBreaker) abandon() {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
@@ -0,0 +1,117 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientCircuitBreaker(t *testing.T) {
+	var healthy, requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/organizations/acme":
+			atomic.AddInt32(&requests, 1)
+			if atomic.LoadInt32(&healthy) == 0 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.Write([]byte(`{"data":{"id":"acme","type":"organizations"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	clock := NewFakeClock(time.Now())
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Clock:      clock,
+		RetryMax:   -1,
+		CircuitBreaker: &CircuitBreakerOptions{
+			Threshold: 3,
+			CoolDown:  time.Minute,
+		},
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("when the failures reach the threshold", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			_, err := client.Organizations.Read(ctx, "acme")
+			require.Error(t, err)
+			assert.False(t, errors.Is(err, ErrCircuitOpen))
+		}
+
+		_, err := client.Organizations.Read(ctx, "acme")
+		var open *CircuitOpenError
+		require.True(t, errors.As(err, &open))
+		assert.True(t, errors.Is(err, ErrCircuitOpen))
+		assert.Equal(t, time.Minute, open.RetryAfter)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("when the probe fails after the cool-down", func(t *testing.T) {
+		clock.After(time.Minute)
+
+		_, err := client.Organizations.Read(ctx, "acme")
+		require.Error(t, err)
+		assert.False(t, errors.Is(err, ErrCircuitOpen))
+		assert.Equal(t, int32(4), atomic.LoadInt32(&requests))
+
+		_, err = client.Organizations.Read(ctx, "acme")
+		assert.True(t, errors.Is(err, ErrCircuitOpen))
+	})
+
+	t.Run("when the probe succeeds after the cool-down", func(t *testing.T) {
+		atomic.StoreInt32(&healthy, 1)
+		clock.After(time.Minute)
+
+		_, err := client.Organizations.Read(ctx, "acme")
+		require.NoError(t, err)
+
+		_, err = client.Organizations.Read(ctx, "acme")
+		require.NoError(t, err)
+		assert.Equal(t, int32(6), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("when client errors are returned", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			_, err := client.Workspaces.Read(ctx, "acme", "missing")
+			assert.Equal(t, ErrResourceNotFound, err)
+		}
+	})
+
+	t.Run("without a circuit breaker", func(t *testing.T) {
+		atomic.StoreInt32(&healthy, 0)
+		client, err := NewClient(&Config{
+			Address:    ts.URL,
+			Token:      "dummy-token",
+			HTTPClient: ts.Client(),
+			RetryMax:   -1,
+		})
+		require.NoError(t, err)
+
+		for i := 0; i < 10; i++ {
+			_, err := client.Organizations.Read(ctx, "acme")
+			assert.False(t, errors.Is(err, ErrCircuitOpen))
+		}
+	})
+}
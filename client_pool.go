@@ -0,0 +1,273 @@
+package tfe
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultClientPoolIdleTimeout is the time after which a client which
+	// was not requested is removed from a client pool.
+	DefaultClientPoolIdleTimeout = time.Hour
+
+	// clientPoolRateLimit is the default rate limit of the API in requests
+	// per second, which the default rate limiter of an address allows.
+	clientPoolRateLimit = 30
+)
+
+// ClientPoolOptions represents the options of a client pool.
+type ClientPoolOptions struct {
+	// Optional: The configuration of the clients, whose Address and Token
+	// are replaced by those each client is requested for.
+	Config *Config
+
+	// Optional: Returns the rate limiter shared by all clients of an
+	// address, which is called when the first client of an address is
+	// created. When nil, the Limiter of the configuration is shared by all
+	// clients, or each address gets a limiter allowing the default rate
+	// limit of the API.
+	NewLimiter func(address string) RateLimiter
+
+	// Optional: The time after which a client which was not requested is
+	// removed from the pool. Defaults to DefaultClientPoolIdleTimeout.
+	IdleTimeout time.Duration
+
+	// Optional: The maximum number of clients in the pool, beyond which the
+	// least recently requested client is removed. Defaults to no limit.
+	MaxClients int
+}
+
+// ClientPool holds the clients of many Terraform Enterprise instances and
+// tokens, for services which act on behalf of many users or organizations.
+// The clients are created when first requested, and share the connections of
+// a single HTTP client. Clients which are not requested for the idle timeout
+// are removed, together with the rate limiter of their address once it has no
+// clients left. A ClientPool is safe for concurrent use.
+type ClientPool struct {
+	config      Config
+	newLimiter  func(address string) RateLimiter
+	idleTimeout time.Duration
+	maxClients  int
+	clock       Clock
+
+	mu       sync.Mutex
+	clients  map[clientPoolKey]*clientPoolEntry
+	limiters map[string]RateLimiter
+}
+
+// clientPoolKey identifies the client of an address and token.
+type clientPoolKey struct {
+	address string
+	token   string
+}
+
+// clientPoolEntry holds a client which is created, or being created.
+type clientPoolEntry struct {
+	ready  chan struct{}
+	client *Client
+	err    error
+
+	// Whether creating the client was canceled by the context of the call
+	// creating it, which the waiting calls do not share.
+	canceled bool
+
+	// The last time the client was requested, guarded by the pool.
+	lastUsed time.Time
+}
+
+// NewClientPool creates a new pool of clients.
+func NewClientPool(options ClientPoolOptions) *ClientPool {
+	p := &ClientPool{
+		newLimiter:  options.NewLimiter,
+		idleTimeout: options.IdleTimeout,
+		maxClients:  options.MaxClients,
+		clients:     make(map[clientPoolKey]*clientPoolEntry),
+		limiters:    make(map[string]RateLimiter),
+	}
+	if options.Config != nil {
+		p.config = *options.Config
+	}
+	if p.config.HTTPClient == nil {
+		p.config.HTTPClient = cleanhttp.DefaultPooledClient()
+	}
+	if p.idleTimeout <= 0 {
+		p.idleTimeout = DefaultClientPoolIdleTimeout
+	}
+
+	p.clock = p.config.Clock
+	if p.clock == nil {
+		p.clock = realClock{}
+	}
+
+	return p
+}
+
+// Client returns the client of the given address and token, creating it if
+// it does not exist yet. Concurrent calls for the same address and token
+// wait for a single client to be created, which pings the API unless the
+// configuration sets NoPing. A client which fails to be created is not kept,
+// so a later call tries again. When the context of the call creating the
+// client is canceled, a waiting call creates the client instead.
+func (p *ClientPool) Client(ctx context.Context, address, token string) (*Client, error) {
+	key := clientPoolKey{address: address, token: token}
+
+	for {
+		p.mu.Lock()
+		now := p.clock.Now()
+		p.evictIdle(now)
+		entry, ok := p.clients[key]
+		if !ok {
+			entry = &clientPoolEntry{ready: make(chan struct{})}
+			p.clients[key] = entry
+		}
+		entry.lastUsed = now
+		p.evictLeastRecent()
+		p.mu.Unlock()
+
+		if !ok {
+			return p.create(ctx, key, entry)
+		}
+
+		select {
+		case <-entry.ready:
+			if !entry.canceled {
+				return entry.client, entry.err
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// create creates the client of an entry, and removes the entry when the
+// client fails to be created.
+func (p *ClientPool) create(ctx context.Context, key clientPoolKey, entry *clientPoolEntry) (*Client, error) {
+	config := p.config
+	config.Address = key.address
+	config.Token = key.token
+	config.Headers = p.config.Headers.Clone()
+	config.Limiter = p.limiter(key.address)
+
+	entry.client, entry.err = NewClientWithContext(ctx, &config)
+	if entry.err != nil {
+		entry.canceled = ctx.Err() != nil
+
+		p.mu.Lock()
+		if p.clients[key] == entry {
+			p.remove(key)
+		}
+		p.mu.Unlock()
+	}
+	close(entry.ready)
+
+	return entry.client, entry.err
+}
+
+// Remove removes the client of the given address and token from the pool,
+// such as when the token was revoked. It is a no-op if there is no such
+// client.
+func (p *ClientPool) Remove(address, token string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.remove(clientPoolKey{address: address, token: token})
+}
+
+// Len returns the number of clients in the pool.
+func (p *ClientPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.clients)
+}
+
+// CloseIdleConnections closes the idle connections of the HTTP client shared
+// by the clients of the pool.
+func (p *ClientPool) CloseIdleConnections() {
+	p.config.HTTPClient.CloseIdleConnections()
+}
+
+// evictIdle removes the clients which were not requested for the idle
+// timeout. Clients which are being created are never removed. It must be
+// called with the lock held.
+func (p *ClientPool) evictIdle(now time.Time) {
+	for key, entry := range p.clients {
+		if entry.created() && now.Sub(entry.lastUsed) > p.idleTimeout {
+			p.remove(key)
+		}
+	}
+}
+
+// evictLeastRecent removes the least recently requested clients beyond the
+// maximum number of clients. Clients which are being created are never
+// removed. It must be called with the lock held.
+func (p *ClientPool) evictLeastRecent() {
+	for p.maxClients > 0 && len(p.clients) > p.maxClients {
+		var oldest *clientPoolKey
+		for key, entry := range p.clients {
+			if !entry.created() {
+				continue
+			}
+			if oldest == nil || entry.lastUsed.Before(p.clients[*oldest].lastUsed) {
+				key := key
+				oldest = &key
+			}
+		}
+		if oldest == nil {
+			return
+		}
+		p.remove(*oldest)
+	}
+}
+
+// remove removes the client of a key, and the rate limiter of its address
+// when the address has no clients left. It must be called with the lock
+// held.
+func (p *ClientPool) remove(key clientPoolKey) {
+	delete(p.clients, key)
+
+	for other := range p.clients {
+		if other.address == key.address {
+			return
+		}
+	}
+	delete(p.limiters, key.address)
+}
+
+// limiter returns the rate limiter shared by the clients of an address.
+func (p *ClientPool) limiter(address string) RateLimiter {
+	if p.newLimiter == nil && p.config.Limiter != nil {
+		return p.config.Limiter
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	l, ok := p.limiters[address]
+	if !ok {
+		if p.newLimiter != nil {
+			l = p.newLimiter(address)
+		} else {
+			// Split the rate limit like the clients do, allowing a burst of
+			// 1/3 of the requests.
+			l = rate.NewLimiter(rate.Limit(clientPoolRateLimit*0.66), clientPoolRateLimit/3)
+		}
+		p.limiters[address] = l
+	}
+	return l
+}
+
+// created reports whether the client of the entry was created, or failed to
+// be created.
+func (e *clientPoolEntry) created() bool {
+	select {
+	case <-e.ready:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,208 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestClientPool(t *testing.T) {
+	var pings int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			atomic.AddInt32(&pings, 1)
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/organizations/acme":
+			w.Write([]byte(`{"data":{"id":"acme","type":"organizations"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	var limiters []string
+	limiter := &countingLimiter{}
+	pool := NewClientPool(ClientPoolOptions{
+		Config: &Config{HTTPClient: ts.Client()},
+		NewLimiter: func(address string) RateLimiter {
+			limiters = append(limiters, address)
+			return limiter
+		},
+	})
+	defer pool.CloseIdleConnections()
+
+	ctx := context.Background()
+
+	t.Run("when requesting the same client concurrently", func(t *testing.T) {
+		clients := make([]*Client, 10)
+		var wg sync.WaitGroup
+		for i := range clients {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				client, err := pool.Client(ctx, ts.URL, "token-1")
+				require.NoError(t, err)
+				clients[i] = client
+			}(i)
+		}
+		wg.Wait()
+
+		for _, client := range clients {
+			assert.Same(t, clients[0], client)
+		}
+		assert.Equal(t, int32(1), atomic.LoadInt32(&pings))
+		assert.Equal(t, 1, pool.Len())
+
+		_, err := clients[0].Organizations.Read(ctx, "acme")
+		require.NoError(t, err)
+		assert.Positive(t, limiter.waits)
+	})
+
+	t.Run("when requesting the client of another token", func(t *testing.T) {
+		client, err := pool.Client(ctx, ts.URL, "token-2")
+		require.NoError(t, err)
+
+		other, err := pool.Client(ctx, ts.URL, "token-1")
+		require.NoError(t, err)
+		assert.NotSame(t, other, client)
+		assert.Equal(t, 2, pool.Len())
+
+		// The rate limiter is shared by the clients of an address.
+		assert.Equal(t, []string{ts.URL}, limiters)
+	})
+
+	t.Run("when the client can not be created", func(t *testing.T) {
+		_, err := pool.Client(ctx, "http://[::1", "token-1")
+		assert.Error(t, err)
+		assert.Equal(t, 2, pool.Len())
+	})
+
+	t.Run("when removing a client", func(t *testing.T) {
+		before, err := pool.Client(ctx, ts.URL, "token-1")
+		require.NoError(t, err)
+
+		pool.Remove(ts.URL, "token-1")
+		assert.Equal(t, 1, pool.Len())
+
+		after, err := pool.Client(ctx, ts.URL, "token-1")
+		require.NoError(t, err)
+		assert.NotSame(t, before, after)
+	})
+}
+
+func TestClientPool_eviction(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	clock := NewFakeClock(time.Now())
+	pool := NewClientPool(ClientPoolOptions{
+		Config:      &Config{HTTPClient: ts.Client(), Clock: clock},
+		IdleTimeout: time.Minute,
+		MaxClients:  2,
+	})
+	defer pool.CloseIdleConnections()
+
+	ctx := context.Background()
+
+	t.Run("with the default rate limiter", func(t *testing.T) {
+		client1, err := pool.Client(ctx, ts.URL, "token-1")
+		require.NoError(t, err)
+		client2, err := pool.Client(ctx, ts.URL, "token-2")
+		require.NoError(t, err)
+
+		// The clients of an address share a limiter of the default rate limit.
+		require.NotNil(t, client1.rateLimiter)
+		assert.Same(t, client1.rateLimiter, client2.rateLimiter)
+		assert.Equal(t, rate.Limit(19.8), client1.rateLimiter.(*rate.Limiter).Limit())
+	})
+
+	t.Run("when exceeding the maximum number of clients", func(t *testing.T) {
+		clock.After(time.Second)
+		_, err := pool.Client(ctx, ts.URL, "token-1")
+		require.NoError(t, err)
+
+		clock.After(time.Second)
+		_, err = pool.Client(ctx, ts.URL, "token-3")
+		require.NoError(t, err)
+		assert.Equal(t, 2, pool.Len())
+
+		// The least recently requested client was removed.
+		pool.mu.Lock()
+		_, ok := pool.clients[clientPoolKey{address: ts.URL, token: "token-2"}]
+		pool.mu.Unlock()
+		assert.False(t, ok)
+	})
+
+	t.Run("when clients are idle", func(t *testing.T) {
+		before, err := pool.Client(ctx, ts.URL, "token-1")
+		require.NoError(t, err)
+
+		clock.After(2 * time.Minute)
+		after, err := pool.Client(ctx, ts.URL, "token-1")
+		require.NoError(t, err)
+		assert.NotSame(t, before, after)
+		assert.Equal(t, 1, pool.Len())
+
+		// The limiter of the address was removed with its last client.
+		assert.NotSame(t, before.rateLimiter, after.rateLimiter)
+	})
+}
+
+func TestClientPool_canceled(t *testing.T) {
+	var pings int32
+	pinging := make(chan struct{})
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&pings, 1) == 1 {
+			close(pinging)
+			<-release
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+	defer close(release)
+
+	pool := NewClientPool(ClientPoolOptions{
+		Config: &Config{HTTPClient: ts.Client()},
+	})
+	defer pool.CloseIdleConnections()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	created := make(chan error, 1)
+	go func() {
+		_, err := pool.Client(ctx, ts.URL, "token-1")
+		created <- err
+	}()
+	<-pinging
+
+	waited := make(chan error, 1)
+	go func() {
+		_, err := pool.Client(context.Background(), ts.URL, "token-1")
+		waited <- err
+	}()
+
+	// Canceling the call creating the client does not fail the waiting call,
+	// which creates the client itself.
+	cancel()
+	assert.True(t, errors.Is(<-created, context.Canceled))
+	assert.NoError(t, <-waited)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&pings))
+	assert.Equal(t, 1, pool.Len())
+}
@@ -0,0 +1,37 @@
+package tfe
+
+import (
+	"context"
+	"time"
+)
+
+// Clock provides the current time and timers to the client. It allows tests
+// to replace the passing of real time, so retries and polling complete
+// instantly and deterministically.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// After returns a channel which receives the current time once the
+	// given duration has passed.
+	After(d time.Duration) <-chan time.Time
+}
+
+// RateLimiter limits the rate of the requests made by the client. It is
+// satisfied by a *rate.Limiter.
+type RateLimiter interface {
+	// Wait blocks until a request may be made, or returns an error if the
+	// given context is canceled.
+	Wait(ctx context.Context) error
+}
+
+// realClock implements Clock using the real time.
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
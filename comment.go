@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"time"
 )
 
 // Compile-time proof of interface implementation.
@@ -18,6 +19,10 @@ type Comments interface {
 	// List all comments of the given run.
 	List(ctx context.Context, runID string) (*CommentList, error)
 
+	// ListWithOptions lists the comments of the given run using the
+	// pagination and include options.
+	ListWithOptions(ctx context.Context, runID string, options *CommentListOptions) (*CommentList, error)
+
 	// Read a comment by its ID.
 	Read(ctx context.Context, commentID string) (*Comment, error)
 
@@ -40,6 +45,39 @@ type CommentList struct {
 type Comment struct {
 	ID   string `jsonapi:"primary,comments"`
 	Body string `jsonapi:"attr,body"`
+
+	// Relations
+	RunEvent *RunTimelineEvent `jsonapi:"relation,run-event"`
+}
+
+// RunTimelineEvent represents an event in the timeline of a run, such as the
+// run being queued, applied or commented on.
+type RunTimelineEvent struct {
+	ID          string    `jsonapi:"primary,run-events"`
+	Action      string    `jsonapi:"attr,action"`
+	CreatedAt   time.Time `jsonapi:"attr,created-at,iso8601"`
+	Description string    `jsonapi:"attr,description"`
+
+	// Relations
+	Actor   *User    `jsonapi:"relation,actor"`
+	Comment *Comment `jsonapi:"relation,comment"`
+}
+
+// CommentIncludeOpt represents the available options for include query params.
+type CommentIncludeOpt string
+
+const (
+	CommentRunEvent CommentIncludeOpt = "run_event"
+)
+
+// CommentListOptions represents the options for listing comments. The API
+// does not support filtering comments, so they have to be filtered after
+// listing them, e.g. by the actor of their run event.
+type CommentListOptions struct {
+	ListOptions
+
+	// Optional: A list of relations to include.
+	Include []CommentIncludeOpt `url:"include,omitempty"`
 }
 
 type CommentCreateOptions struct {
@@ -55,18 +93,31 @@ type CommentCreateOptions struct {
 
 // List all comments of the given run.
 func (s *comments) List(ctx context.Context, runID string) (*CommentList, error) {
+	return s.listWithOptions(ctx, "comments.List", runID, nil)
+}
+
+// ListWithOptions lists the comments of the given run using the options.
+func (s *comments) ListWithOptions(ctx context.Context, runID string, options *CommentListOptions) (*CommentList, error) {
+	return s.listWithOptions(ctx, "comments.ListWithOptions", runID, options)
+}
+
+// listWithOptions implements List and ListWithOptions, naming the API call op.
+func (s *comments) listWithOptions(ctx context.Context, op, runID string, options *CommentListOptions) (*CommentList, error) {
 	if !validStringID(&runID) {
 		return nil, ErrInvalidRunID
 	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
 
 	u := fmt.Sprintf("runs/%s/comments", url.QueryEscape(runID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("GET", u, options)
 	if err != nil {
 		return nil, err
 	}
 
 	cl := &CommentList{}
-	err = s.client.do(ctx, req, cl)
+	err = s.client.do(ctx, op, req, cl)
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +142,7 @@ func (s *comments) Create(ctx context.Context, runID string, options CommentCrea
 	}
 
 	comm := &Comment{}
-	err = s.client.do(ctx, req, comm)
+	err = s.client.do(ctx, "comments.Create", req, comm)
 	if err != nil {
 		return nil, err
 	}
@@ -112,7 +163,7 @@ func (s *comments) Read(ctx context.Context, commentID string) (*Comment, error)
 	}
 
 	comm := &Comment{}
-	err = s.client.do(ctx, req, comm)
+	err = s.client.do(ctx, "comments.Read", req, comm)
 	if err != nil {
 		return nil, err
 	}
@@ -120,6 +171,23 @@ func (s *comments) Read(ctx context.Context, commentID string) (*Comment, error)
 	return comm, nil
 }
 
+func (o *CommentListOptions) valid() error {
+	if o == nil {
+		return nil // nothing to validate
+	}
+
+	for _, p := range o.Include {
+		switch p {
+		case CommentRunEvent:
+			// do nothing
+		default:
+			return ErrInvalidIncludeValue
+		}
+	}
+
+	return nil
+}
+
 func (o CommentCreateOptions) valid() error {
 	if !validString(&o.Body) {
 		return ErrInvalidCommentBody
@@ -62,6 +62,25 @@ func TestCommentsList(t *testing.T) {
 		assert.Equal(t, true, commentItemsContainsBody(commentsList.Items, commentBody1))
 		assert.Equal(t, true, commentItemsContainsBody(commentsList.Items, commentBody2))
 	})
+
+	t.Run("list comments with options", func(t *testing.T) {
+		commentsList, err := client.Comments.ListWithOptions(ctx, rTest.ID, &CommentListOptions{
+			ListOptions: ListOptions{PageSize: 1},
+			Include:     []CommentIncludeOpt{CommentRunEvent},
+		})
+		require.NoError(t, err)
+		require.Len(t, commentsList.Items, 1)
+		assert.Equal(t, 2, commentsList.TotalCount)
+		require.NotNil(t, commentsList.Items[0].RunEvent)
+		assert.NotEmpty(t, commentsList.Items[0].RunEvent.ID)
+	})
+
+	t.Run("with an invalid include option", func(t *testing.T) {
+		_, err := client.Comments.ListWithOptions(ctx, rTest.ID, &CommentListOptions{
+			Include: []CommentIncludeOpt{"workspace"},
+		})
+		assert.Equal(t, ErrInvalidIncludeValue, err)
+	})
 }
 
 func commentItemsContainsBody(items []*Comment, body string) bool {
@@ -3,14 +3,9 @@ package tfe
 import (
 	"bytes"
 	"context"
-	"errors"
 	"fmt"
-	"io/fs"
 	"net/url"
-	"os"
 	"time"
-
-	slug "github.com/hashicorp/go-slug"
 )
 
 // Compile-time proof of interface implementation.
@@ -35,17 +30,39 @@ type ConfigurationVersions interface {
 	// ReadWithOptions reads a configuration version by its ID using the options supplied
 	ReadWithOptions(ctx context.Context, cvID string, options *ConfigurationVersionReadOptions) (*ConfigurationVersion, error)
 
+	// ReadCurrentForWorkspace reads the current configuration version of a
+	// workspace, including its ingress attributes.
+	ReadCurrentForWorkspace(ctx context.Context, workspaceID string) (*ConfigurationVersion, error)
+
 	// Upload packages and uploads Terraform configuration files. It requires
 	// the upload URL from a configuration version and the full path to the
 	// configuration files on disk.
 	Upload(ctx context.Context, url string, path string) error
 
+	// UploadWithOptions packages and uploads Terraform configuration files
+	// like Upload, reporting the progress of the upload.
+	UploadWithOptions(ctx context.Context, url string, path string, options ConfigurationVersionUploadOptions) error
+
 	// Archive a configuration version. This can only be done on configuration versions that
 	// were created with the API or CLI, are in an uploaded state, and have no runs in progress.
 	Archive(ctx context.Context, cvID string) error
 
 	// Download a configuration version.  Only configuration versions in the uploaded state may be downloaded.
 	Download(ctx context.Context, cvID string) ([]byte, error)
+
+	// SoftDeleteBackingData soft deletes the configuration files of a
+	// configuration version, so they can be restored until they are
+	// permanently deleted. Only available in Terraform Enterprise.
+	SoftDeleteBackingData(ctx context.Context, cvID string) error
+
+	// RestoreBackingData restores the soft deleted configuration files of a
+	// configuration version. Only available in Terraform Enterprise.
+	RestoreBackingData(ctx context.Context, cvID string) error
+
+	// PermanentlyDeleteBackingData permanently deletes the soft deleted
+	// configuration files of a configuration version. Only available in
+	// Terraform Enterprise.
+	PermanentlyDeleteBackingData(ctx context.Context, cvID string) error
 }
 
 // configurationVersions implements ConfigurationVersions.
@@ -136,6 +153,13 @@ type ConfigurationVersionListOptions struct {
 	Include []ConfigVerIncludeOpt `url:"include,omitempty"`
 }
 
+// ConfigurationVersionUploadOptions represents the options for uploading
+// configuration files.
+type ConfigurationVersionUploadOptions struct {
+	// Optional: Called while uploading the packaged configuration files.
+	Progress SignedURLProgressFunc
+}
+
 // ConfigurationVersionCreateOptions represents the options for creating a
 // configuration version.
 type ConfigurationVersionCreateOptions struct {
@@ -194,7 +218,7 @@ func (s *configurationVersions) List(ctx context.Context, workspaceID string, op
 	}
 
 	cvl := &ConfigurationVersionList{}
-	err = s.client.do(ctx, req, cvl)
+	err = s.client.do(ctx, "configurationVersions.List", req, cvl)
 	if err != nil {
 		return nil, err
 	}
@@ -216,7 +240,7 @@ func (s *configurationVersions) Create(ctx context.Context, workspaceID string,
 	}
 
 	cv := &ConfigurationVersion{}
-	err = s.client.do(ctx, req, cv)
+	err = s.client.do(ctx, "configurationVersions.Create", req, cv)
 	if err != nil {
 		return nil, err
 	}
@@ -226,11 +250,16 @@ func (s *configurationVersions) Create(ctx context.Context, workspaceID string,
 
 // Read a configuration version by its ID.
 func (s *configurationVersions) Read(ctx context.Context, cvID string) (*ConfigurationVersion, error) {
-	return s.ReadWithOptions(ctx, cvID, nil)
+	return s.readWithOptions(ctx, "configurationVersions.Read", cvID, nil)
 }
 
 // Read a configuration version by its ID with the given options.
 func (s *configurationVersions) ReadWithOptions(ctx context.Context, cvID string, options *ConfigurationVersionReadOptions) (*ConfigurationVersion, error) {
+	return s.readWithOptions(ctx, "configurationVersions.ReadWithOptions", cvID, options)
+}
+
+// readWithOptions implements Read and ReadWithOptions, naming the API call op.
+func (s *configurationVersions) readWithOptions(ctx context.Context, op, cvID string, options *ConfigurationVersionReadOptions) (*ConfigurationVersion, error) {
 	if !validStringID(&cvID) {
 		return nil, ErrInvalidConfigVersionID
 	}
@@ -245,7 +274,7 @@ func (s *configurationVersions) ReadWithOptions(ctx context.Context, cvID string
 	}
 
 	cv := &ConfigurationVersion{}
-	err = s.client.do(ctx, req, cv)
+	err = s.client.do(ctx, op, req, cv)
 	if err != nil {
 		return nil, err
 	}
@@ -253,36 +282,53 @@ func (s *configurationVersions) ReadWithOptions(ctx context.Context, cvID string
 	return cv, nil
 }
 
-// Upload packages and uploads Terraform configuration files. It requires the
-// upload URL from a configuration version and the path to the configuration
-// files on disk.
-func (s *configurationVersions) Upload(ctx context.Context, u, path string) error {
-	file, err := os.Stat(path)
+// ReadCurrentForWorkspace reads the current configuration version of a
+// workspace, including its ingress attributes, which hold the commit the
+// configuration was sourced from. The ingress attributes are nil for
+// configuration versions which were not sourced from VCS.
+func (s *configurationVersions) ReadCurrentForWorkspace(ctx context.Context, workspaceID string) (*ConfigurationVersion, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
 
+	w, err := s.client.Workspaces.ReadByIDWithOptions(ctx, workspaceID, &WorkspaceReadOptions{
+		Include: []WSIncludeOpt{WSCurrentConfigVerIngress},
+	})
 	if err != nil {
-		if errors.Is(err, fs.ErrNotExist) {
-			return fmt.Errorf(`failed to find terraform configuration files under the path "%v": %w`, path, err)
-		}
-		return fmt.Errorf(`unable to upload terraform configuration files from the path "%v": %w`, path, err)
+		return nil, err
 	}
-
-	if !file.Mode().IsDir() {
-		return ErrMissingDirectory
+	if w.CurrentConfigurationVersion == nil {
+		return nil, fmt.Errorf("%w: workspace %s has no configuration version", ErrResourceNotFound, workspaceID)
 	}
 
-	body := bytes.NewBuffer(nil)
+	return w.CurrentConfigurationVersion, nil
+}
 
-	_, err = slug.Pack(path, body, true)
+// Upload packages and uploads Terraform configuration files. It requires the
+// upload URL from a configuration version and the path to the configuration
+// files on disk.
+func (s *configurationVersions) Upload(ctx context.Context, u, path string) error {
+	return s.UploadWithOptions(ctx, u, path, ConfigurationVersionUploadOptions{})
+}
+
+// UploadWithOptions packages and uploads Terraform configuration files,
+// reporting the progress of the upload. The files are packaged into a
+// temporary file, so large configurations are streamed instead of buffered
+// in memory.
+func (s *configurationVersions) UploadWithOptions(ctx context.Context, u, path string, options ConfigurationVersionUploadOptions) error {
+	body, err := packContents(path)
 	if err != nil {
 		return err
 	}
+	defer body.Close()
 
-	req, err := s.client.newRequest("PUT", u, body)
-	if err != nil {
+	if err := ctx.Err(); err != nil {
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.SignedURLs.Upload(ctx, u, body, &SignedURLUploadOptions{
+		Progress: options.Progress,
+	})
 }
 
 // Archive a configuration version. This can only be done on configuration versions that
@@ -300,7 +346,7 @@ func (s *configurationVersions) Archive(ctx context.Context, cvID string) error
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "configurationVersions.Archive", req, nil)
 }
 
 func (o *ConfigurationVersionReadOptions) valid() error {
@@ -353,10 +399,42 @@ func (s *configurationVersions) Download(ctx context.Context, cvID string) ([]by
 	}
 
 	var buf bytes.Buffer
-	err = s.client.do(ctx, req, &buf)
+	err = s.client.do(ctx, "configurationVersions.Download", req, &buf)
 	if err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
+
+// SoftDeleteBackingData soft deletes the configuration files of a
+// configuration version.
+func (s *configurationVersions) SoftDeleteBackingData(ctx context.Context, cvID string) error {
+	return s.manageBackingData(ctx, "configurationVersions.SoftDeleteBackingData", cvID, "soft_delete_backing_data")
+}
+
+// RestoreBackingData restores the soft deleted configuration files of a
+// configuration version.
+func (s *configurationVersions) RestoreBackingData(ctx context.Context, cvID string) error {
+	return s.manageBackingData(ctx, "configurationVersions.RestoreBackingData", cvID, "restore_backing_data")
+}
+
+// PermanentlyDeleteBackingData permanently deletes the soft deleted
+// configuration files of a configuration version.
+func (s *configurationVersions) PermanentlyDeleteBackingData(ctx context.Context, cvID string) error {
+	return s.manageBackingData(ctx, "configurationVersions.PermanentlyDeleteBackingData", cvID, "permanently_delete_backing_data")
+}
+
+func (s *configurationVersions) manageBackingData(ctx context.Context, op, cvID, action string) error {
+	if !validStringID(&cvID) {
+		return ErrInvalidConfigVersionID
+	}
+
+	u := fmt.Sprintf("configuration-versions/%s/actions/%s", url.QueryEscape(cvID), action)
+	req, err := s.client.newRequest("POST", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.client.do(ctx, op, req, nil)
+}
@@ -7,10 +7,15 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"github.com/hashicorp/go-slug"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-slug"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -186,6 +191,75 @@ func TestConfigurationVersionsReadWithOptions(t *testing.T) {
 	})
 }
 
+func TestConfigurationVersionsReadCurrentForWorkspace(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/workspaces/ws-vcs":
+			assert.Equal(t, "current_configuration_version.ingress_attributes", r.URL.Query().Get("include"))
+			_, _ = w.Write([]byte(`{
+				"data": {
+					"id": "ws-vcs",
+					"type": "workspaces",
+					"attributes": {"name": "network"},
+					"relationships": {
+						"current-configuration-version": {"data": {"id": "cv-1", "type": "configuration-versions"}}
+					}
+				},
+				"included": [{
+					"id": "cv-1",
+					"type": "configuration-versions",
+					"attributes": {"source": "github", "status": "uploaded"},
+					"relationships": {
+						"ingress-attributes": {"data": {"id": "ia-1", "type": "ingress-attributes"}}
+					}
+				}, {
+					"id": "ia-1",
+					"type": "ingress-attributes",
+					"attributes": {"branch": "main", "commit-sha": "abc123"}
+				}]
+			}`))
+		case "/api/v2/workspaces/ws-empty":
+			_, _ = w.Write([]byte(`{"data": {"id": "ws-empty", "type": "workspaces", "attributes": {"name": "empty"}}}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("with a configuration version sourced from VCS", func(t *testing.T) {
+		cv, err := client.ConfigurationVersions.ReadCurrentForWorkspace(ctx, "ws-vcs")
+		require.NoError(t, err)
+		assert.Equal(t, "cv-1", cv.ID)
+		assert.Equal(t, ConfigurationUploaded, cv.Status)
+		require.NotNil(t, cv.IngressAttributes)
+		assert.Equal(t, "main", cv.IngressAttributes.Branch)
+		assert.Equal(t, "abc123", cv.IngressAttributes.CommitSHA)
+	})
+
+	t.Run("without a configuration version", func(t *testing.T) {
+		_, err := client.ConfigurationVersions.ReadCurrentForWorkspace(ctx, "ws-empty")
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+	})
+
+	t.Run("with an invalid workspace ID", func(t *testing.T) {
+		_, err := client.ConfigurationVersions.ReadCurrentForWorkspace(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidWorkspaceID, err)
+	})
+}
+
 func TestConfigurationVersionsUpload(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -238,6 +312,75 @@ func TestConfigurationVersionsUpload(t *testing.T) {
 	})
 }
 
+func TestConfigurationVersionsUploadWithOptions(t *testing.T) {
+	var uploaded int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/upload":
+			n, err := io.Copy(io.Discard, r.Body)
+			require.NoError(t, err)
+			uploaded = n
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	t.Run("when reporting the progress", func(t *testing.T) {
+		var transferred, total int64
+		err := client.ConfigurationVersions.UploadWithOptions(context.Background(), ts.URL+"/upload", "test-fixtures/config-version", ConfigurationVersionUploadOptions{
+			Progress: func(n, size int64) {
+				transferred, total = n, size
+			},
+		})
+		require.NoError(t, err)
+		assert.NotZero(t, uploaded)
+		assert.Equal(t, uploaded, transferred)
+		assert.Equal(t, uploaded, total)
+	})
+
+	t.Run("when the context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		uploaded = 0
+		err := client.ConfigurationVersions.UploadWithOptions(ctx, ts.URL+"/upload", "test-fixtures/config-version", ConfigurationVersionUploadOptions{})
+		assert.Equal(t, context.Canceled, err)
+		assert.Zero(t, uploaded)
+	})
+
+	t.Run("without a valid path", func(t *testing.T) {
+		err := client.ConfigurationVersions.UploadWithOptions(context.Background(), ts.URL+"/upload", "nonexisting", ConfigurationVersionUploadOptions{})
+		assert.Error(t, err)
+	})
+}
+
+func TestPackContents(t *testing.T) {
+	body, err := packContents("test-fixtures/config-version")
+	require.NoError(t, err)
+
+	content, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	expected := bytes.NewBuffer(nil)
+	_, err = slug.Pack("test-fixtures/config-version", expected, true)
+	require.NoError(t, err)
+	assert.Equal(t, expected.Bytes(), content)
+
+	require.NoError(t, body.Close())
+	_, err = os.Stat(body.Name())
+	assert.True(t, os.IsNotExist(err))
+}
+
 func TestConfigurationVersionsArchive(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -340,6 +483,52 @@ func TestConfigurationVersionsDownload(t *testing.T) {
 	})
 }
 
+func TestConfigurationVersionsManageBackingData(t *testing.T) {
+	skipIfCloud(t)
+
+	client := testClient(t)
+	ctx := context.Background()
+
+	cv, cvCleanup := createUploadedConfigurationVersion(t, client, nil)
+	defer cvCleanup()
+
+	t.Run("when soft deleting and restoring the backing data", func(t *testing.T) {
+		err := client.ConfigurationVersions.SoftDeleteBackingData(ctx, cv.ID)
+		require.NoError(t, err)
+
+		_, err = client.ConfigurationVersions.Download(ctx, cv.ID)
+		assert.Equal(t, ErrResourceNotFound, err)
+
+		err = client.ConfigurationVersions.RestoreBackingData(ctx, cv.ID)
+		require.NoError(t, err)
+
+		_, err = client.ConfigurationVersions.Download(ctx, cv.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("when permanently deleting the backing data", func(t *testing.T) {
+		err := client.ConfigurationVersions.SoftDeleteBackingData(ctx, cv.ID)
+		require.NoError(t, err)
+
+		err = client.ConfigurationVersions.PermanentlyDeleteBackingData(ctx, cv.ID)
+		require.NoError(t, err)
+
+		err = client.ConfigurationVersions.RestoreBackingData(ctx, cv.ID)
+		assert.Error(t, err)
+	})
+
+	t.Run("with an invalid configuration version ID", func(t *testing.T) {
+		err := client.ConfigurationVersions.SoftDeleteBackingData(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidConfigVersionID, err)
+
+		err = client.ConfigurationVersions.RestoreBackingData(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidConfigVersionID, err)
+
+		err = client.ConfigurationVersions.PermanentlyDeleteBackingData(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidConfigVersionID, err)
+	})
+}
+
 func TestConfigurationVersions_Unmarshal(t *testing.T) {
 	data := map[string]interface{}{
 		"data": map[string]interface{}{
@@ -22,6 +22,10 @@ type CostEstimates interface {
 
 	// Logs retrieves the logs of a costEstimate.
 	Logs(ctx context.Context, costEstimateID string) (io.Reader, error)
+
+	// Resources reads the estimated costs of the resources of a
+	// costEstimate from its logs.
+	Resources(ctx context.Context, costEstimateID string) (*CostEstimateResources, error)
 }
 
 // costEstimates implements CostEstimates.
@@ -42,7 +46,8 @@ const (
 	CostEstimateSkippedDueToTargeting CostEstimateStatus = "skipped_due_to_targeting"
 )
 
-// CostEstimate represents a Terraform Enterprise costEstimate.
+// CostEstimate represents a Terraform Enterprise costEstimate. The monthly
+// costs are decimal strings, which are parsed into exact decimals by Amounts.
 type CostEstimate struct {
 	ID                      string                        `jsonapi:"primary,cost-estimates"`
 	DeltaMonthlyCost        string                        `jsonapi:"attr,delta-monthly-cost"`
@@ -79,7 +84,7 @@ func (s *costEstimates) Read(ctx context.Context, costEstimateID string) (*CostE
 	}
 
 	ce := &CostEstimate{}
-	err = s.client.do(ctx, req, ce)
+	err = s.client.do(ctx, "costEstimates.Read", req, ce)
 	if err != nil {
 		return nil, err
 	}
@@ -108,7 +113,7 @@ func (s *costEstimates) Logs(ctx context.Context, costEstimateID string) (io.Rea
 			select {
 			case <-ctx.Done():
 				return nil, ctx.Err()
-			case <-time.After(1000 * time.Millisecond):
+			case <-s.client.clock.After(1000 * time.Millisecond):
 				continue
 			}
 		}
@@ -120,7 +125,7 @@ func (s *costEstimates) Logs(ctx context.Context, costEstimateID string) (io.Rea
 		}
 
 		logs := bytes.NewBuffer(nil)
-		err = s.client.do(ctx, req, logs)
+		err = s.client.do(ctx, "costEstimates.Logs", req, logs)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,122 @@
+package tfe
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// CostEstimateCurrency is the currency the costs of cost estimates are
+// estimated in.
+const CostEstimateCurrency = "USD"
+
+// CostEstimateAmounts holds the monthly costs of a cost estimate as exact
+// decimals, so they can be compared and summed without the rounding errors
+// of floats.
+type CostEstimateAmounts struct {
+	Currency            string
+	PriorMonthlyCost    *big.Rat
+	ProposedMonthlyCost *big.Rat
+	DeltaMonthlyCost    *big.Rat
+}
+
+// CostEstimateThreshold represents the limits a cost estimate is gated on.
+// The limits are decimal strings, e.g. "100.50". Only the non-empty limits
+// are checked.
+type CostEstimateThreshold struct {
+	// Optional: The maximum increase of the monthly cost.
+	MaxDeltaMonthlyCost string
+
+	// Optional: The maximum increase of the monthly cost, in percent of the
+	// prior monthly cost. Any increase of a prior monthly cost of zero
+	// exceeds it.
+	MaxDeltaPercent string
+
+	// Optional: The maximum proposed monthly cost.
+	MaxProposedMonthlyCost string
+}
+
+// Amounts parses the monthly costs of a finished cost estimate.
+func (ce *CostEstimate) Amounts() (*CostEstimateAmounts, error) {
+	prior, err := parseCostEstimateAmount(ce.PriorMonthlyCost)
+	if err != nil {
+		return nil, err
+	}
+	proposed, err := parseCostEstimateAmount(ce.ProposedMonthlyCost)
+	if err != nil {
+		return nil, err
+	}
+	delta, err := parseCostEstimateAmount(ce.DeltaMonthlyCost)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CostEstimateAmounts{
+		Currency:            CostEstimateCurrency,
+		PriorMonthlyCost:    prior,
+		ProposedMonthlyCost: proposed,
+		DeltaMonthlyCost:    delta,
+	}, nil
+}
+
+// ExceedsThreshold reports whether the costs of a finished cost estimate
+// exceed any of the limits of the threshold.
+func (ce *CostEstimate) ExceedsThreshold(threshold CostEstimateThreshold) (bool, error) {
+	amounts, err := ce.Amounts()
+	if err != nil {
+		return false, err
+	}
+
+	return amounts.ExceedsThreshold(threshold)
+}
+
+// ExceedsThreshold reports whether the costs exceed any of the limits of the
+// threshold.
+func (a *CostEstimateAmounts) ExceedsThreshold(threshold CostEstimateThreshold) (bool, error) {
+	if threshold.MaxDeltaMonthlyCost != "" {
+		limit, err := parseCostEstimateAmount(threshold.MaxDeltaMonthlyCost)
+		if err != nil {
+			return false, err
+		}
+		if a.DeltaMonthlyCost.Cmp(limit) > 0 {
+			return true, nil
+		}
+	}
+
+	if threshold.MaxProposedMonthlyCost != "" {
+		limit, err := parseCostEstimateAmount(threshold.MaxProposedMonthlyCost)
+		if err != nil {
+			return false, err
+		}
+		if a.ProposedMonthlyCost.Cmp(limit) > 0 {
+			return true, nil
+		}
+	}
+
+	if threshold.MaxDeltaPercent != "" {
+		limit, err := parseCostEstimateAmount(threshold.MaxDeltaPercent)
+		if err != nil {
+			return false, err
+		}
+		if a.DeltaMonthlyCost.Sign() > 0 && a.PriorMonthlyCost.Sign() == 0 {
+			return true, nil
+		}
+		if a.PriorMonthlyCost.Sign() != 0 {
+			percent := new(big.Rat).Quo(a.DeltaMonthlyCost, a.PriorMonthlyCost)
+			percent.Mul(percent, big.NewRat(100, 1))
+			if percent.Cmp(limit) > 0 {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// parseCostEstimateAmount parses a decimal amount like "12.34".
+func parseCostEstimateAmount(s string) (*big.Rat, error) {
+	amount, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidCostEstimateAmount, s)
+	}
+	return amount, nil
+}
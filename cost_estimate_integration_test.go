@@ -7,6 +7,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -103,3 +109,127 @@ func TestCostEsimate_Unmarshal(t *testing.T) {
 	assert.Equal(t, ce.StatusTimestamps.QueuedAt, queuedParsedTime)
 	assert.Equal(t, ce.StatusTimestamps.ErroredAt, erroredParsedTime)
 }
+
+func TestCostEstimateAmounts(t *testing.T) {
+	ce := &CostEstimate{
+		PriorMonthlyCost:    "100.10",
+		ProposedMonthlyCost: "110.20",
+		DeltaMonthlyCost:    "10.10",
+	}
+
+	t.Run("when parsing the amounts", func(t *testing.T) {
+		amounts, err := ce.Amounts()
+		require.NoError(t, err)
+		assert.Equal(t, CostEstimateCurrency, amounts.Currency)
+		assert.Equal(t, big.NewRat(1001, 10), amounts.PriorMonthlyCost)
+		assert.Equal(t, big.NewRat(1102, 10), amounts.ProposedMonthlyCost)
+		assert.Equal(t, big.NewRat(101, 10), amounts.DeltaMonthlyCost)
+	})
+
+	t.Run("when comparing against a threshold", func(t *testing.T) {
+		for _, tc := range []struct {
+			threshold CostEstimateThreshold
+			exceeds   bool
+		}{
+			{CostEstimateThreshold{}, false},
+			{CostEstimateThreshold{MaxDeltaMonthlyCost: "10.10"}, false},
+			{CostEstimateThreshold{MaxDeltaMonthlyCost: "10.09"}, true},
+			{CostEstimateThreshold{MaxProposedMonthlyCost: "110"}, true},
+			{CostEstimateThreshold{MaxDeltaPercent: "10.1"}, false},
+			{CostEstimateThreshold{MaxDeltaPercent: "10"}, true},
+		} {
+			exceeds, err := ce.ExceedsThreshold(tc.threshold)
+			require.NoError(t, err)
+			assert.Equal(t, tc.exceeds, exceeds, "threshold %+v", tc.threshold)
+		}
+	})
+
+	t.Run("when the prior monthly cost is zero", func(t *testing.T) {
+		ce := &CostEstimate{PriorMonthlyCost: "0.0", ProposedMonthlyCost: "1.0", DeltaMonthlyCost: "1.0"}
+		exceeds, err := ce.ExceedsThreshold(CostEstimateThreshold{MaxDeltaPercent: "1000"})
+		require.NoError(t, err)
+		assert.True(t, exceeds)
+	})
+
+	t.Run("when the cost estimate is not finished", func(t *testing.T) {
+		_, err := (&CostEstimate{}).Amounts()
+		assert.True(t, errors.Is(err, ErrInvalidCostEstimateAmount))
+	})
+
+	t.Run("with an invalid threshold", func(t *testing.T) {
+		_, err := ce.ExceedsThreshold(CostEstimateThreshold{MaxDeltaMonthlyCost: "ten"})
+		assert.True(t, errors.Is(err, ErrInvalidCostEstimateAmount))
+	})
+}
+
+func TestCostEstimatesResources(t *testing.T) {
+	output := `{
+		"resources": {
+			"matched": [
+				{"address": "aws_instance.web", "name": "web", "type": "aws_instance",
+					"prior-monthly-cost": "0.0", "proposed-monthly-cost": "30.368", "delta-monthly-cost": "30.368"},
+				{"address": "aws_ebs_volume.data", "name": "data", "type": "aws_ebs_volume",
+					"prior-monthly-cost": 8, "proposed-monthly-cost": 4.5, "delta-monthly-cost": -3.5}
+			],
+			"unmatched": [
+				{"address": "aws_iam_role.web", "name": "web", "type": "aws_iam_role"}
+			]
+		}
+	}`
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/cost-estimates/ce-1":
+			fmt.Fprint(w, `{"data":{"id":"ce-1","type":"cost-estimates","attributes":{"status":"finished"}}}`)
+		case "/api/v2/cost-estimates/ce-1/output":
+			fmt.Fprint(w, output)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	t.Run("when reading the resources", func(t *testing.T) {
+		resources, err := client.CostEstimates.Resources(context.Background(), "ce-1")
+		require.NoError(t, err)
+		require.Len(t, resources.Matched, 2)
+		require.Len(t, resources.Unmatched, 1)
+
+		assert.Equal(t, &CostEstimateResource{
+			Address:             "aws_instance.web",
+			Name:                "web",
+			Type:                "aws_instance",
+			PriorMonthlyCost:    "0.0",
+			ProposedMonthlyCost: "30.368",
+			DeltaMonthlyCost:    "30.368",
+		}, resources.Matched[0])
+		assert.Equal(t, "-3.5", resources.Matched[1].DeltaMonthlyCost)
+		assert.Equal(t, "aws_iam_role.web", resources.Unmatched[0].Address)
+		assert.Empty(t, resources.Unmatched[0].DeltaMonthlyCost)
+
+		amounts, err := resources.Matched[1].Amounts()
+		require.NoError(t, err)
+		assert.Equal(t, big.NewRat(-7, 2), amounts.DeltaMonthlyCost)
+	})
+
+	t.Run("with an invalid output", func(t *testing.T) {
+		_, err := ParseCostEstimateResources(strings.NewReader("Resources: 1 of 2 estimated"))
+		assert.True(t, errors.Is(err, ErrInvalidCostEstimateOutput))
+	})
+
+	t.Run("without a valid cost estimate ID", func(t *testing.T) {
+		_, err := client.CostEstimates.Resources(context.Background(), badIdentifier)
+		assert.Equal(t, ErrInvalidCostEstimateID, err)
+	})
+}
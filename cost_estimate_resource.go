@@ -0,0 +1,128 @@
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// CostEstimateResources holds the resources of a cost estimate, as reported
+// by its JSON log output.
+type CostEstimateResources struct {
+	// The resources whose costs were estimated.
+	Matched []*CostEstimateResource
+
+	// The resources whose costs could not be estimated. Their costs are
+	// empty.
+	Unmatched []*CostEstimateResource
+}
+
+// CostEstimateResource represents the estimated costs of a single resource.
+// The monthly costs are decimal strings, like those of CostEstimate, which
+// are parsed into exact decimals by Amounts.
+type CostEstimateResource struct {
+	Address             string
+	Name                string
+	Type                string
+	PriorMonthlyCost    string
+	ProposedMonthlyCost string
+	DeltaMonthlyCost    string
+}
+
+// costEstimateOutput is the JSON log output of a cost estimate.
+type costEstimateOutput struct {
+	Resources struct {
+		Matched   []*costEstimateOutputResource `json:"matched"`
+		Unmatched []*costEstimateOutputResource `json:"unmatched"`
+	} `json:"resources"`
+}
+
+// costEstimateOutputResource is a resource in the JSON log output of a cost
+// estimate.
+type costEstimateOutputResource struct {
+	Address             string           `json:"address"`
+	Name                string           `json:"name"`
+	Type                string           `json:"type"`
+	PriorMonthlyCost    costEstimateCost `json:"prior-monthly-cost"`
+	ProposedMonthlyCost costEstimateCost `json:"proposed-monthly-cost"`
+	DeltaMonthlyCost    costEstimateCost `json:"delta-monthly-cost"`
+}
+
+// costEstimateCost is a cost in the JSON log output of a cost estimate, which
+// is either a decimal string or a number. Numbers are kept as written, to
+// not lose their precision.
+type costEstimateCost string
+
+func (c *costEstimateCost) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+	switch {
+	case bytes.Equal(b, []byte("null")):
+		*c = ""
+	case len(b) > 0 && b[0] == '"':
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		*c = costEstimateCost(s)
+	default:
+		var n json.Number
+		if err := json.Unmarshal(b, &n); err != nil {
+			return err
+		}
+		*c = costEstimateCost(n)
+	}
+	return nil
+}
+
+// Resources reads the estimated costs of the resources of a finished cost
+// estimate, by parsing its JSON log output.
+func (s *costEstimates) Resources(ctx context.Context, costEstimateID string) (*CostEstimateResources, error) {
+	logs, err := s.Logs(ctx, costEstimateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseCostEstimateResources(logs)
+}
+
+// ParseCostEstimateResources parses the estimated costs of the resources
+// from the JSON log output of a cost estimate, as returned by Logs.
+func ParseCostEstimateResources(r io.Reader) (*CostEstimateResources, error) {
+	output := &costEstimateOutput{}
+	if err := json.NewDecoder(r).Decode(output); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCostEstimateOutput, err)
+	}
+
+	return &CostEstimateResources{
+		Matched:   costEstimateResources(output.Resources.Matched),
+		Unmatched: costEstimateResources(output.Resources.Unmatched),
+	}, nil
+}
+
+// costEstimateResources converts the resources of the JSON log output.
+func costEstimateResources(resources []*costEstimateOutputResource) []*CostEstimateResource {
+	result := make([]*CostEstimateResource, 0, len(resources))
+	for _, r := range resources {
+		result = append(result, &CostEstimateResource{
+			Address:             r.Address,
+			Name:                r.Name,
+			Type:                r.Type,
+			PriorMonthlyCost:    string(r.PriorMonthlyCost),
+			ProposedMonthlyCost: string(r.ProposedMonthlyCost),
+			DeltaMonthlyCost:    string(r.DeltaMonthlyCost),
+		})
+	}
+	return result
+}
+
+// Amounts parses the monthly costs of a matched resource.
+func (r *CostEstimateResource) Amounts() (*CostEstimateAmounts, error) {
+	ce := &CostEstimate{
+		PriorMonthlyCost:    r.PriorMonthlyCost,
+		ProposedMonthlyCost: r.ProposedMonthlyCost,
+		DeltaMonthlyCost:    r.DeltaMonthlyCost,
+	}
+	return ce.Amounts()
+}
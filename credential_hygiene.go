@@ -0,0 +1,214 @@
+package tfe
+
+import (
+	"context"
+	"sort"
+)
+
+// CredentialKind represents the kind of an organization credential.
+type CredentialKind string
+
+// List all available credential kinds.
+const (
+	CredentialKindOAuthToken CredentialKind = "oauth-token"
+	CredentialKindSSHKey     CredentialKind = "ssh-key"
+)
+
+// CredentialCleanupOptions represents the options for cleaning up the stale
+// credentials of an organization.
+type CredentialCleanupOptions struct {
+	// Optional: Whether the stale credentials should be deleted. When false,
+	// they are only reported.
+	Delete bool
+}
+
+// StaleCredential describes an OAuth token or SSH key of an organization
+// which is not referenced by any workspace, policy set or registry module.
+type StaleCredential struct {
+	Kind CredentialKind
+	ID   string
+
+	// The name of the SSH key, or the VCS provider user of the OAuth token.
+	Name string
+
+	// Deleted is true when the credential was deleted.
+	Deleted bool
+
+	// Err holds the error returned while deleting the credential, if any.
+	Err error
+}
+
+// credentialReferences holds the IDs of the OAuth tokens and SSH keys which
+// are in use within an organization.
+type credentialReferences struct {
+	oAuthTokens map[string]bool
+	sshKeys     map[string]bool
+}
+
+// CleanupStaleCredentials lists the OAuth tokens and SSH keys of an
+// organization, and reports those which are not referenced by the VCS
+// repository of any workspace, policy set or registry module, or assigned to
+// any workspace. If options.Delete is set, the stale credentials are deleted.
+// Errors deleting a single credential are reported in its result and do not
+// abort the cleanup of the other credentials.
+func CleanupStaleCredentials(ctx context.Context, client *Client, organization string, options CredentialCleanupOptions) ([]*StaleCredential, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+
+	refs, err := listCredentialReferences(ctx, client, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := listAllOAuthTokens(ctx, client, organization)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := listAllSSHKeys(ctx, client, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	var stale []*StaleCredential
+	for _, t := range tokens {
+		if !refs.oAuthTokens[t.ID] {
+			stale = append(stale, &StaleCredential{
+				Kind: CredentialKindOAuthToken,
+				ID:   t.ID,
+				Name: t.ServiceProviderUser,
+			})
+		}
+	}
+	for _, k := range keys {
+		if !refs.sshKeys[k.ID] {
+			stale = append(stale, &StaleCredential{
+				Kind: CredentialKindSSHKey,
+				ID:   k.ID,
+				Name: k.Name,
+			})
+		}
+	}
+
+	sort.SliceStable(stale, func(i, j int) bool {
+		if stale[i].Kind != stale[j].Kind {
+			return stale[i].Kind < stale[j].Kind
+		}
+		return stale[i].ID < stale[j].ID
+	})
+
+	if !options.Delete {
+		return stale, nil
+	}
+
+	for _, c := range stale {
+		switch c.Kind {
+		case CredentialKindOAuthToken:
+			c.Err = client.OAuthTokens.Delete(ctx, c.ID)
+		case CredentialKindSSHKey:
+			c.Err = client.SSHKeys.Delete(ctx, c.ID)
+		}
+		c.Deleted = c.Err == nil
+	}
+
+	return stale, nil
+}
+
+// listCredentialReferences collects the OAuth tokens and SSH keys referenced
+// by the workspaces, policy sets and registry modules of an organization.
+func listCredentialReferences(ctx context.Context, client *Client, organization string) (*credentialReferences, error) {
+	refs := &credentialReferences{
+		oAuthTokens: make(map[string]bool),
+		sshKeys:     make(map[string]bool),
+	}
+	addVCSRepo := func(repo *VCSRepo) {
+		if repo != nil && repo.OAuthTokenID != "" {
+			refs.oAuthTokens[repo.OAuthTokenID] = true
+		}
+	}
+
+	workspaces, err := listAllWorkspaces(ctx, client, organization, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range workspaces {
+		addVCSRepo(w.VCSRepo)
+		if w.SSHKey != nil {
+			refs.sshKeys[w.SSHKey.ID] = true
+		}
+	}
+
+	psOpts := PolicySetListOptions{}
+	for {
+		psl, err := client.PolicySets.List(ctx, organization, &psOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, ps := range psl.Items {
+			addVCSRepo(ps.VCSRepo)
+		}
+
+		if psl.Pagination == nil || psl.NextPage == 0 {
+			break
+		}
+		psOpts.PageNumber = psl.NextPage
+	}
+
+	rmOpts := RegistryModuleListOptions{}
+	for {
+		rml, err := client.RegistryModules.List(ctx, organization, &rmOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, rm := range rml.Items {
+			addVCSRepo(rm.VCSRepo)
+		}
+
+		if rml.Pagination == nil || rml.NextPage == 0 {
+			break
+		}
+		rmOpts.PageNumber = rml.NextPage
+	}
+
+	return refs, nil
+}
+
+// listAllOAuthTokens lists the OAuth tokens of an organization, following
+// the pagination until all pages are read.
+func listAllOAuthTokens(ctx context.Context, client *Client, organization string) ([]*OAuthToken, error) {
+	opts := OAuthTokenListOptions{}
+
+	var tokens []*OAuthToken
+	for {
+		otl, err := client.OAuthTokens.List(ctx, organization, &opts)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, otl.Items...)
+
+		if otl.Pagination == nil || otl.NextPage == 0 {
+			return tokens, nil
+		}
+		opts.PageNumber = otl.NextPage
+	}
+}
+
+// listAllSSHKeys lists the SSH keys of an organization, following the
+// pagination until all pages are read.
+func listAllSSHKeys(ctx context.Context, client *Client, organization string) ([]*SSHKey, error) {
+	opts := SSHKeyListOptions{}
+
+	var keys []*SSHKey
+	for {
+		kl, err := client.SSHKeys.List(ctx, organization, &opts)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, kl.Items...)
+
+		if kl.Pagination == nil || kl.NextPage == 0 {
+			return keys, nil
+		}
+		opts.PageNumber = kl.NextPage
+	}
+}
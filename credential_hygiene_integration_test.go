@@ -0,0 +1,99 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCleanupStaleCredentials(t *testing.T) {
+	var mu sync.Mutex
+	var deleted []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "GET /api/v2/organizations/acme/workspaces":
+			fmt.Fprint(w, `{"data":[`+
+				`{"id":"ws-vcs","type":"workspaces","attributes":{"name":"vcs","vcs-repo":{"identifier":"acme/app","oauth-token-id":"ot-workspace"}}},`+
+				`{"id":"ws-ssh","type":"workspaces","attributes":{"name":"ssh"},"relationships":{"ssh-key":{"data":{"id":"sshkey-used","type":"ssh-keys"}}}}]}`)
+		case "GET /api/v2/organizations/acme/policy-sets":
+			fmt.Fprint(w, `{"data":[{"id":"polset-1","type":"policy-sets","attributes":{"name":"base","vcs-repo":{"identifier":"acme/policies","oauth-token-id":"ot-policy"}}}]}`)
+		case "GET /api/v2/organizations/acme/registry-modules":
+			fmt.Fprint(w, `{"data":[{"id":"mod-1","type":"registry-modules","attributes":{"name":"vpc","vcs-repo":{"identifier":"acme/terraform-aws-vpc","oauth-token-id":"ot-module"}}}]}`)
+		case "GET /api/v2/organizations/acme/oauth-tokens":
+			fmt.Fprint(w, `{"data":[`+
+				`{"id":"ot-workspace","type":"oauth-tokens"},`+
+				`{"id":"ot-policy","type":"oauth-tokens"},`+
+				`{"id":"ot-module","type":"oauth-tokens"},`+
+				`{"id":"ot-stale","type":"oauth-tokens","attributes":{"service-provider-user":"octocat"}}]}`)
+		case "GET /api/v2/organizations/acme/ssh-keys":
+			fmt.Fprint(w, `{"data":[`+
+				`{"id":"sshkey-used","type":"ssh-keys","attributes":{"name":"used"}},`+
+				`{"id":"sshkey-stale","type":"ssh-keys","attributes":{"name":"old deploy key"}}]}`)
+		case "DELETE /api/v2/oauth-tokens/ot-stale":
+			mu.Lock()
+			deleted = append(deleted, r.URL.Path)
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		case "DELETE /api/v2/ssh-keys/sshkey-stale":
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("when only reporting stale credentials", func(t *testing.T) {
+		stale, err := CleanupStaleCredentials(ctx, client, "acme", CredentialCleanupOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []*StaleCredential{
+			{Kind: CredentialKindOAuthToken, ID: "ot-stale", Name: "octocat"},
+			{Kind: CredentialKindSSHKey, ID: "sshkey-stale", Name: "old deploy key"},
+		}, stale)
+		assert.Empty(t, deleted)
+	})
+
+	t.Run("when deleting stale credentials", func(t *testing.T) {
+		stale, err := CleanupStaleCredentials(ctx, client, "acme", CredentialCleanupOptions{Delete: true})
+		require.NoError(t, err)
+		require.Len(t, stale, 2)
+
+		assert.True(t, stale[0].Deleted)
+		assert.NoError(t, stale[0].Err)
+
+		// A failed deletion does not abort the cleanup.
+		assert.False(t, stale[1].Deleted)
+		assert.Error(t, stale[1].Err)
+
+		sort.Strings(deleted)
+		assert.Equal(t, []string{"/api/v2/oauth-tokens/ot-stale"}, deleted)
+	})
+
+	t.Run("without a valid organization", func(t *testing.T) {
+		_, err := CleanupStaleCredentials(ctx, client, badIdentifier, CredentialCleanupOptions{})
+		assert.Equal(t, ErrInvalidOrg, err)
+	})
+}
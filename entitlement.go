@@ -0,0 +1,154 @@
+package tfe
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// entitlementCacheTTL is how long the entitlements of an organization are
+// cached for.
+const entitlementCacheTTL = 5 * time.Minute
+
+// ErrFeatureNotEntitled is returned instead of ErrResourceNotFound when a
+// request fails because the organization is not entitled to the feature. No
+// requests are made to find out: organization scoped requests are only
+// reported as such when the entitlements of the organization were read with
+// Organizations.ReadEntitlements shortly before. It matches
+// ErrResourceNotFound when compared using errors.Is.
+type ErrFeatureNotEntitled struct {
+	// The name of the organization.
+	Organization string
+
+	// The entitlement the organization is missing, e.g. "agents".
+	Feature string
+}
+
+// Error implements the error interface.
+func (e *ErrFeatureNotEntitled) Error() string {
+	return fmt.Sprintf("organization %q is not entitled to the %q feature", e.Organization, e.Feature)
+}
+
+// Is reports whether the error matches target, which is the case for
+// ErrResourceNotFound as this is what the API responded with.
+func (e *ErrFeatureNotEntitled) Is(target error) bool {
+	return target == ErrResourceNotFound
+}
+
+// entitledPaths maps organization scoped paths to the entitlement they
+// require.
+var entitledPaths = map[string]string{
+	"agent-pools":      "agents",
+	"oauth-clients":    "vcs-integrations",
+	"policies":         "sentinel",
+	"policy-sets":      "sentinel",
+	"registry-modules": "private-module-registry",
+	"tasks":            "run-tasks",
+	"teams":            "teams",
+}
+
+// entitlementCache caches the entitlements of organizations.
+type entitlementCache struct {
+	mu      sync.Mutex
+	entries map[string]*entitlementCacheEntry
+}
+
+type entitlementCacheEntry struct {
+	entitlements *Entitlements
+	expiresAt    time.Time
+}
+
+// notEntitled returns the feature a path requires, when the organization is
+// not entitled to it. It returns an empty feature when the path does not
+// require an entitlement, the organization is entitled to it, or the
+// entitlements of the organization are not cached.
+func (c *Client) notEntitled(path string) (organization, feature string) {
+	if c.entitlements == nil {
+		return "", ""
+	}
+
+	path = strings.TrimPrefix(path, c.baseURL.Path)
+	parts := strings.SplitN(path, "/", 4)
+	if len(parts) < 3 || parts[0] != "organizations" {
+		return "", ""
+	}
+
+	organization, feature = parts[1], entitledPaths[parts[2]]
+	if feature == "" {
+		return "", ""
+	}
+
+	entitlements, ok := c.entitlements.get(organization, c.clock.Now())
+	if !ok || entitlements.has(feature) {
+		return "", ""
+	}
+
+	return organization, feature
+}
+
+// get returns the cached entitlements of the organization, unless they are
+// not cached or expired.
+func (ec *entitlementCache) get(organization string, now time.Time) (*Entitlements, bool) {
+	ec.mu.Lock()
+	defer ec.mu.Unlock()
+
+	entry, ok := ec.entries[organization]
+	if !ok || !now.Before(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.entitlements, true
+}
+
+// put caches the entitlements of the organization.
+func (ec *entitlementCache) put(organization string, entitlements *Entitlements, now time.Time) {
+	if ec == nil {
+		return
+	}
+
+	ec.mu.Lock()
+	defer ec.mu.Unlock()
+
+	ec.entries[organization] = &entitlementCacheEntry{
+		entitlements: entitlements,
+		expiresAt:    now.Add(entitlementCacheTTL),
+	}
+}
+
+// entitlementError replaces ErrResourceNotFound by ErrFeatureNotEntitled when
+// the request failed because of a missing entitlement.
+func (c *Client) entitlementError(path string, err error) error {
+	if !errors.Is(err, ErrResourceNotFound) {
+		return err
+	}
+
+	organization, feature := c.notEntitled(path)
+	if feature == "" {
+		return err
+	}
+
+	return &ErrFeatureNotEntitled{Organization: organization, Feature: feature}
+}
+
+// has reports whether the entitlement with the given attribute name is
+// granted.
+func (e *Entitlements) has(feature string) bool {
+	switch feature {
+	case "agents":
+		return e.Agents
+	case "audit-logging":
+		return e.AuditLogging
+	case "private-module-registry":
+		return e.PrivateModuleRegistry
+	case "run-tasks":
+		return e.RunTasks
+	case "sentinel":
+		return e.Sentinel
+	case "teams":
+		return e.Teams
+	case "vcs-integrations":
+		return e.VCSIntegrations
+	}
+	return true
+}
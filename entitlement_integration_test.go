@@ -0,0 +1,93 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_entitlementErrors(t *testing.T) {
+	entitlementReads := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/organizations/acme/entitlement-set":
+			entitlementReads++
+			fmt.Fprint(w, `{"data":{"id":"org-acme","type":"entitlement-sets","attributes":{"agents":false,"teams":true}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"errors":[{"status":"404","title":"not found"}]}`)
+		}
+	}))
+	defer ts.Close()
+
+	clock := NewFakeClock(time.Now())
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Clock:      clock,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("without cached entitlements", func(t *testing.T) {
+		_, err := client.AgentPools.List(ctx, "acme", nil)
+		assert.Equal(t, ErrResourceNotFound, err)
+		assert.Equal(t, 0, entitlementReads)
+	})
+
+	_, err = client.Organizations.ReadEntitlements(ctx, "acme")
+	require.NoError(t, err)
+
+	t.Run("without the entitlement", func(t *testing.T) {
+		_, err := client.AgentPools.List(ctx, "acme", nil)
+
+		var notEntitled *ErrFeatureNotEntitled
+		require.True(t, errors.As(err, &notEntitled))
+		assert.Equal(t, "acme", notEntitled.Organization)
+		assert.Equal(t, "agents", notEntitled.Feature)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
+		assert.EqualError(t, err, `organization "acme" is not entitled to the "agents" feature`)
+		assert.Equal(t, 1, entitlementReads)
+	})
+
+	t.Run("with the entitlement", func(t *testing.T) {
+		_, err := client.Teams.List(ctx, "acme", nil)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("without an entitled path", func(t *testing.T) {
+		_, err := client.Workspaces.List(ctx, "acme", nil)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("with the audit trail", func(t *testing.T) {
+		// The audit trail is not scoped by the name of an organization, so
+		// its cached entitlements are unknown.
+		_, err := client.AuditTrails.List(ctx, nil)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("with expired entitlements", func(t *testing.T) {
+		clock.After(entitlementCacheTTL)
+
+		_, err := client.AgentPools.List(ctx, "acme", nil)
+		assert.Equal(t, ErrResourceNotFound, err)
+		assert.Equal(t, 1, entitlementReads)
+	})
+}
@@ -12,6 +12,20 @@ var (
 	// ErrResourceNotFound is returned when receiving a 404.
 	ErrResourceNotFound = errors.New("resource not found")
 
+	// ErrPaymentRequired is returned when receiving a 402, which means the
+	// feature is not included in the plan of the organization.
+	ErrPaymentRequired = errors.New("payment required: feature not available in the plan of the organization")
+
+	// ErrServiceUnavailableMaintenance is returned when receiving a 503
+	// because the API is down for maintenance. It is wrapped by a
+	// *MaintenanceError holding when to try again.
+	ErrServiceUnavailableMaintenance = errors.New("service unavailable: down for maintenance")
+
+	// ErrCircuitOpen is returned when a request is not sent because the
+	// circuit breaker of the client is open. It is wrapped by a
+	// *CircuitOpenError holding when to try again.
+	ErrCircuitOpen = errors.New("circuit breaker open: too many consecutive failed requests")
+
 	// ErrMissingDirectory is returned when the path does not have an existing directory.
 	ErrMissingDirectory = errors.New("path needs to be an existing directory")
 )
@@ -23,6 +37,24 @@ var (
 	ErrUnsupportedPrivateKey = errors.New("private Key can only be present with Azure DevOps Server service provider")
 
 	ErrUnsupportedRunTriggerType = errors.New(`"RunTriggerType" must be "inbound" when requesting "include" query params`)
+
+	ErrUnsupportedAgentExecutionMode = errors.New(`"agent" execution mode can not be enforced across workspaces`)
+
+	ErrUnsupportedNotificationPayloadVersion = errors.New("unsupported notification payload version")
+
+	ErrUnsupportedEmailRecipients = errors.New("email users and addresses can only be set for email notification destinations")
+
+	ErrUnsupportedBothTriggerPatternsAndPrefixes = errors.New(`"TriggerPatterns" and "TriggerPrefixes" cannot be populated at the same time`)
+
+	ErrUnsupportedBothTagsRegexAndTriggerPatterns = errors.New(`"TagsRegex" and "TriggerPatterns" cannot be populated at the same time`)
+
+	ErrUnsupportedBothTagsRegexAndTriggerPrefixes = errors.New(`"TagsRegex" and "TriggerPrefixes" cannot be populated at the same time`)
+
+	ErrUnsupportedBothTagsRegexAndFileTriggersEnabled = errors.New(`"TagsRegex" cannot be populated when "FileTriggersEnabled" is true`)
+
+	ErrUnsupportedFIPSTransport = errors.New("FIPS requires the transport of the HTTP client to be an *http.Transport")
+
+	ErrUnsupportedWebURLResource = errors.New("web URLs can only be built for organizations, workspaces, runs and policy sets")
 )
 
 // Library errors that usually indicate a bug in the implementation of go-tfe
@@ -44,22 +76,75 @@ var (
 
 	ErrWorkspaceLockedByRun = errors.New("unable to unlock workspace locked by run") // ErrWorkspaceLockedByRun is returned when trying to unlock a
 	// workspace locked by a run
+
+	ErrStateVersionSerialConflict = errors.New("state version serial conflict") // ErrStateVersionSerialConflict is returned when creating a
+	// state version with a serial which conflicts with the current state.
+
+	ErrStateVersionLineageMismatch = errors.New("state version lineage mismatch") // ErrStateVersionLineageMismatch is returned when creating a
+	// state version with a lineage which differs from the current state.
+
+	ErrWorkspaceNameTaken = errors.New("workspace name already taken") // ErrWorkspaceNameTaken is returned when creating a
+	// workspace with the name of an existing workspace.
+
+	ErrWorkspaceManagesResources = errors.New("workspace manages resources") // ErrWorkspaceManagesResources is returned when deleting a
+	// workspace which still has resources under management.
+
+	ErrStructuredRunOutputUnavailable = errors.New("structured run output unavailable") // ErrStructuredRunOutputUnavailable is returned when
+	// a log does not contain machine-readable messages, as structured run output was not enabled.
+
+	ErrRunTaskCallbackFinished = errors.New("final task result already sent") // ErrRunTaskCallbackFinished is returned when sending a
+	// task result after the final result of the task has been sent.
+
+	ErrChecksumMismatch = errors.New("checksum mismatch") // ErrChecksumMismatch is returned when the checksum of
+	// an artifact transferred from or to a signed URL does not match the expected checksum.
+
+	ErrPlanExportNotFinished = errors.New("plan export did not finish") // ErrPlanExportNotFinished is returned when
+	// waiting for a plan export which was canceled, errored or expired.
+
+	ErrRangeNotSupported = errors.New("server does not support range requests") // ErrRangeNotSupported is returned when
+	// continuing or resuming a download fails, because the server ignores range requests.
+
+	ErrRunEventStreamClosed = errors.New("run event stream closed") // ErrRunEventStreamClosed is returned when
+	// receiving or polling a run event after the run event stream has been closed.
+
+	ErrRunWatcherClosed = errors.New("run watcher closed") // ErrRunWatcherClosed is returned when
+	// watching a run after the run watcher has been closed.
+
+	ErrRunAlreadyWatched = errors.New("run already watched") // ErrRunAlreadyWatched is returned when
+	// watching a run which is already watched by the run watcher.
+
+	ErrAmbiguousVariableKey = errors.New("variable key matches several variables") // ErrAmbiguousVariableKey is returned when
+	// replacing a variable by a key which is used by variables of several categories.
 )
 
 // Invalid values for resources/struct fields
 var (
+	ErrInsecureAddress = errors.New("address must use https")
+
+	ErrInvalidPageSize = errors.New("invalid value for page size")
+
 	ErrInvalidWorkspaceID = errors.New("invalid value for workspace ID")
 
 	ErrInvalidWorkspaceValue = errors.New("invalid value for workspace")
 
 	ErrInvalidTerraformVersionID = errors.New("invalid value for terraform version ID")
 
+	ErrInvalidSentinelVersionID = errors.New("invalid value for sentinel version ID")
+
+	ErrInvalidOPAVersionID = errors.New("invalid value for OPA version ID")
+
 	ErrInvalidTerraformVersionType = errors.New("invalid type for terraform version. Please use 'terraform-version'")
 
 	ErrInvalidConfigVersionID = errors.New("invalid value for configuration version ID")
 
 	ErrInvalidCostEstimateID = errors.New("invalid value for cost estimate ID")
 
+	ErrInvalidCostEstimateAmount = errors.New("invalid value for cost estimate amount")
+
+	ErrInvalidCostEstimateOutput = errors.New("invalid value for cost estimate output")
+
+	ErrInvalidAssessmentResultID = errors.New("invalid value for assessment result ID")
+
 	ErrInvalidSMTPAuth = errors.New("invalid smtp auth type")
 
 	ErrInvalidAgentPoolID = errors.New("invalid value for agent pool ID")
@@ -74,20 +159,32 @@ var (
 
 	ErrInvalidRunTaskURL = errors.New("invalid url for run task URL")
 
+	ErrInvalidTaskStage = errors.New(`stage must be "pre_plan", "post_plan" or "pre_apply"`)
+
 	ErrInvalidWorkspaceRunTaskID = errors.New("invalid value for workspace run task ID")
 
 	ErrInvalidWorkspaceRunTaskType = errors.New(`invalid value for type, please use "workspace-tasks"`)
 
 	ErrInvalidTaskResultID = errors.New("invalid value for task result ID")
 
+	ErrInvalidTaskResultsCallbackStatus = errors.New(`task result status must be "running", "passed" or "failed"`)
+
+	ErrInvalidTaskResultOutcome = errors.New("task result outcomes require an outcome ID and description")
+
+	ErrInvalidHeartbeatInterval = errors.New("heartbeat interval must be positive")
+
 	ErrInvalidTaskStageID = errors.New("invalid value for task stage ID")
 
 	ErrInvalidApplyID = errors.New("invalid value for apply ID")
 
 	ErrInvalidOrg = errors.New("invalid value for organization")
 
+	ErrInvalidProjectID = errors.New("invalid value for project ID")
+
 	ErrInvalidName = errors.New("invalid value for name")
 
+	ErrInvalidNamespace = errors.New("invalid value for namespace")
+
 	ErrInvalidNotificationConfigID = errors.New("invalid value for notification configuration ID")
 
 	ErrInvalidMembership = errors.New("invalid value for membership")
@@ -104,6 +201,8 @@ var (
 
 	ErrInvalidTag = errors.New("invalid tag id")
 
+	ErrInvalidTagName = errors.New("invalid value for tag name")
+
 	ErrInvalidPlanExportID = errors.New("invalid value for plan export ID")
 
 	ErrInvalidPlanID = errors.New("invalid value for plan ID")
@@ -153,6 +252,42 @@ var (
 	ErrInvalidCommentID = errors.New("invalid value for comment ID")
 
 	ErrInvalidCommentBody = errors.New("invalid value for comment body")
+
+	ErrInvalidRelationshipName = errors.New("invalid value for relationship name")
+
+	ErrInvalidResourceIdentifier = errors.New("invalid value for resource identifier")
+
+	ErrInvalidVariableExportFormat = errors.New("invalid value for variable export format")
+
+	ErrInvalidTriggerPattern = errors.New("invalid glob syntax in trigger pattern")
+
+	ErrInvalidTagsRegex = errors.New("invalid regular expression syntax in tags regex")
+
+	ErrInvalidWorkspaceNamePattern = errors.New("invalid wildcard syntax in workspace name pattern")
+
+	ErrInvalidNoCodeModuleID = errors.New("invalid value for no-code module ID")
+
+	ErrInvalidRegistryName = errors.New(`invalid value for registry name. It must be either "private" or "public"`)
+
+	ErrInvalidExecutionMode = errors.New(`invalid value for execution mode. It must be one of "remote", "local" or "agent"`)
+
+	ErrInvalidAccessType = errors.New(`invalid value for access. It must be one of "read", "plan", "write", "admin" or "custom"`)
+
+	ErrInvalidRunsPermission = errors.New(`invalid value for runs permission. It must be one of "read", "plan" or "apply"`)
+
+	ErrInvalidVariablesPermission = errors.New(`invalid value for variables permission. It must be one of "none", "read" or "write"`)
+
+	ErrInvalidStateVersionsPermission = errors.New(`invalid value for state versions permission. It must be one of "none", "read-outputs", "read" or "write"`)
+
+	ErrInvalidSentinelMocksPermission = errors.New(`invalid value for Sentinel mocks permission. It must be either "none" or "read"`)
+
+	ErrInvalidAuthPolicy = errors.New(`invalid value for collaborator auth policy. It must be either "password" or "two_factor_mandatory"`)
+
+	ErrInvalidEnforcementLevel = errors.New(`invalid value for enforcement mode. It must be one of "advisory", "soft-mandatory" or "hard-mandatory"`)
+
+	ErrInvalidTaskEnforcementLevel = errors.New(`invalid value for task enforcement level. It must be either "advisory" or "mandatory"`)
+
+	ErrInvalidWorkspaceSort = errors.New(`invalid value for workspace sort. It must be "name" or "current-run.created-at", optionally prefixed with "-"`)
 )
 
 // Missing values for required field/option
@@ -171,6 +306,8 @@ var (
 
 	ErrRequiredKey = errors.New("key is required")
 
+	ErrRequiredKeyID = errors.New("key ID is required")
+
 	ErrRequiredName = errors.New("name is required")
 
 	ErrRequiredEnabled = errors.New("enabled is required")
@@ -201,10 +338,24 @@ var (
 
 	ErrRequiredTestNumber = errors.New("TestNumber is required")
 
+	ErrRequiredTestEmailAddress = errors.New("TestEmailAddress is required")
+
+	ErrRequiredReason = errors.New("reason is required")
+
+	ErrRequiredSAMLCertificateAndKey = errors.New("certificate and private key must be set together")
+
 	ErrMissingTagIdentifier = errors.New("must specify at least one tag by ID or name")
 
 	ErrAgentTokenDescription = errors.New("agent token description can't be blank")
 
+	ErrRequiredRelationships = errors.New("at least one relationship is required")
+
+	ErrRequiredTagName = errors.New("tag name is required")
+
+	ErrRequiredTagBindingKey = errors.New("tag binding key is required")
+
+	ErrRequiredRunTaskAccessToken = errors.New("run task access token is required")
+
 	ErrRequiredTagID = errors.New("you must specify at least one tag id to remove")
 
 	ErrRequiredTagWorkspaceID = errors.New("you must specify at least one workspace to add tag to")
@@ -247,6 +398,10 @@ var (
 
 	ErrRequiredTFVerCreateOps = errors.New("version, URL and sha is required for AdminTerraformVersionCreateOptions")
 
+	ErrRequiredSentinelVerCreateOps = errors.New("version, URL and sha is required for AdminSentinelVersionCreateOptions")
+
+	ErrRequiredOPAVerCreateOps = errors.New("version, URL and sha is required for AdminOPAVersionCreateOptions")
+
 	ErrRequiredSerial = errors.New("serial is required")
 
 	ErrRequiredState = errors.New("state is required")
@@ -266,4 +421,8 @@ var (
 	ErrEmptyTeamName = errors.New("team name can not be empty")
 
 	ErrInvalidEmail = errors.New("email is invalid")
+
+	ErrRequiredWorkspaceTagsFilter = errors.New("a workspace name pattern or tag is required to select the workspaces")
+
+	ErrRequiredRegistryModule = errors.New("registry module is required")
 )
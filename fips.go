@@ -0,0 +1,68 @@
+package tfe
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+)
+
+// fipsCipherSuites are the FIPS 140-2 approved TLS 1.2 cipher suites. The
+// cipher suites of TLS 1.3 are not configurable, and all of them except
+// ChaCha20-Poly1305 are approved, which is only preferred on hardware without
+// AES acceleration.
+var fipsCipherSuites = []uint16{
+	tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+	tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+}
+
+// fipsCurves are the FIPS 140-2 approved elliptic curves.
+var fipsCurves = []tls.CurveID{
+	tls.CurveP256,
+	tls.CurveP384,
+	tls.CurveP521,
+}
+
+// fipsHTTPClient returns a copy of the HTTP client whose transport only
+// negotiates TLS 1.2 and later with FIPS 140-2 approved cipher suites and
+// curves, and rejects requests which do not use https. The given client is
+// not modified.
+func fipsHTTPClient(c *http.Client) (*http.Client, error) {
+	rt := c.Transport
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	transport, ok := rt.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedFIPSTransport, rt)
+	}
+
+	transport = transport.Clone()
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	if transport.TLSClientConfig.MinVersion < tls.VersionTLS12 {
+		transport.TLSClientConfig.MinVersion = tls.VersionTLS12
+	}
+	transport.TLSClientConfig.CipherSuites = fipsCipherSuites
+	transport.TLSClientConfig.CurvePreferences = fipsCurves
+
+	fips := *c
+	fips.Transport = httpsOnlyTransport{transport}
+
+	return &fips, nil
+}
+
+// httpsOnlyTransport rejects the requests which do not use https, including
+// redirects to plaintext addresses.
+type httpsOnlyTransport struct {
+	next http.RoundTripper
+}
+
+func (t httpsOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Scheme != "https" {
+		return nil, fmt.Errorf("%w: %s", ErrInsecureAddress, req.URL.Redacted())
+	}
+	return t.next.RoundTrip(req)
+}
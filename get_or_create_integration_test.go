@@ -0,0 +1,170 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOrCreate(t *testing.T) {
+	// The resources named "existing" already exist, so creating them fails
+	// because their name is taken.
+	var reads []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		if r.Method == "POST" {
+			var payload struct {
+				Data struct {
+					Type       string `json:"type"`
+					Attributes struct {
+						Name string `json:"name"`
+					} `json:"attributes"`
+				} `json:"data"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+
+			switch payload.Data.Attributes.Name {
+			case "existing":
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				fmt.Fprint(w, `{"errors":[{"status":"422","title":"Name has already been taken"}]}`)
+			case "invalid":
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				fmt.Fprint(w, `{"errors":[{"status":"422","title":"Name is invalid"}]}`)
+			default:
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprintf(w, `{"data":{"id":%q,"type":%q,"attributes":{"name":%q}}}`,
+					payload.Data.Attributes.Name+"-id", payload.Data.Type, payload.Data.Attributes.Name)
+			}
+			return
+		}
+
+		reads = append(reads, r.URL.Path)
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/organizations/existing":
+			fmt.Fprint(w, `{"data":{"id":"existing","type":"organizations","attributes":{"name":"existing"}}}`)
+		case "/api/v2/organizations/acme/teams":
+			assert.Equal(t, "existing", r.URL.Query().Get("filter[names]"))
+			fmt.Fprint(w, `{"data":[{"id":"team-1","type":"teams","attributes":{"name":"existing"}}]}`)
+		case "/api/v2/organizations/acme/varsets":
+			if r.URL.Query().Get("page[number]") != "2" {
+				fmt.Fprint(w, `{"data":[{"id":"varset-1","type":"varsets","attributes":{"name":"other"}}],"meta":{"pagination":{"current-page":1,"next-page":2,"total-pages":2}}}`)
+				return
+			}
+			fmt.Fprint(w, `{"data":[{"id":"varset-2","type":"varsets","attributes":{"name":"existing"}}],"meta":{"pagination":{"current-page":2,"total-pages":2}}}`)
+		case "/api/v2/organizations/acme/projects":
+			assert.Equal(t, "existing", r.URL.Query().Get("filter[names]"))
+			fmt.Fprint(w, `{"data":[{"id":"prj-1","type":"projects","attributes":{"name":"existing"}}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("when the organization exists", func(t *testing.T) {
+		org, created, err := client.Organizations.GetOrCreate(ctx, OrganizationCreateOptions{Name: String("existing"), Email: String("a@example.com")})
+		require.NoError(t, err)
+		assert.False(t, created)
+		assert.Equal(t, "existing", org.Name)
+	})
+
+	t.Run("when the organization does not exist", func(t *testing.T) {
+		org, created, err := client.Organizations.GetOrCreate(ctx, OrganizationCreateOptions{Name: String("new"), Email: String("a@example.com")})
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, "new-id", org.Name)
+	})
+
+	t.Run("when the team exists", func(t *testing.T) {
+		team, created, err := client.Teams.GetOrCreate(ctx, "acme", TeamCreateOptions{Name: String("existing")})
+		require.NoError(t, err)
+		assert.False(t, created)
+		assert.Equal(t, "team-1", team.ID)
+	})
+
+	t.Run("when the team does not exist", func(t *testing.T) {
+		team, created, err := client.Teams.GetOrCreate(ctx, "acme", TeamCreateOptions{Name: String("new")})
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, "new-id", team.ID)
+	})
+
+	t.Run("when the variable set exists on a later page", func(t *testing.T) {
+		vs, created, err := client.VariableSets.GetOrCreate(ctx, "acme", &VariableSetCreateOptions{Name: String("existing"), Global: Bool(false)})
+		require.NoError(t, err)
+		assert.False(t, created)
+		assert.Equal(t, "varset-2", vs.ID)
+	})
+
+	t.Run("when the variable set does not exist", func(t *testing.T) {
+		vs, created, err := client.VariableSets.GetOrCreate(ctx, "acme", &VariableSetCreateOptions{Name: String("new"), Global: Bool(false)})
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, "new-id", vs.ID)
+	})
+
+	t.Run("when the project exists", func(t *testing.T) {
+		p, created, err := client.Projects.GetOrCreate(ctx, "acme", ProjectCreateOptions{Name: String("existing")})
+		require.NoError(t, err)
+		assert.False(t, created)
+		assert.Equal(t, "prj-1", p.ID)
+	})
+
+	t.Run("when the project does not exist", func(t *testing.T) {
+		p, created, err := client.Projects.GetOrCreate(ctx, "acme", ProjectCreateOptions{Name: String("new")})
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, "new-id", p.ID)
+	})
+
+	t.Run("when the creation fails otherwise", func(t *testing.T) {
+		reads = nil
+
+		_, _, err := client.Projects.GetOrCreate(ctx, "acme", ProjectCreateOptions{Name: String("invalid")})
+		assert.EqualError(t, err, "Name is invalid")
+		assert.Empty(t, reads)
+	})
+
+	t.Run("never reads resources which were created", func(t *testing.T) {
+		reads = nil
+
+		_, created, err := client.Teams.GetOrCreate(ctx, "acme", TeamCreateOptions{Name: String("other")})
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.Empty(t, reads)
+	})
+
+	t.Run("with invalid options", func(t *testing.T) {
+		_, _, err := client.Organizations.GetOrCreate(ctx, OrganizationCreateOptions{})
+		assert.Equal(t, ErrRequiredName, err)
+
+		_, _, err = client.Teams.GetOrCreate(ctx, badIdentifier, TeamCreateOptions{Name: String("new")})
+		assert.Equal(t, ErrInvalidOrg, err)
+
+		_, _, err = client.VariableSets.GetOrCreate(ctx, "acme", nil)
+		assert.Equal(t, ErrRequiredName, err)
+
+		_, _, err = client.Projects.GetOrCreate(ctx, "acme", ProjectCreateOptions{})
+		assert.Equal(t, ErrRequiredName, err)
+	})
+}
@@ -0,0 +1,68 @@
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+// countOnlyKey is the request context key marking list requests made with
+// options setting CountOnly.
+type countOnlyKey struct{}
+
+// countOnlyOptions is implemented by the list options embedding ListOptions.
+type countOnlyOptions interface {
+	countOnly() bool
+}
+
+func (o ListOptions) countOnly() bool {
+	return o.CountOnly
+}
+
+// isCountOnlyOptions reports whether the options of a list call set
+// CountOnly.
+func isCountOnlyOptions(v interface{}) bool {
+	o, ok := v.(countOnlyOptions)
+	if !ok {
+		return false
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return false
+	}
+	return o.countOnly()
+}
+
+// isCountOnly reports whether the request only counts the elements of the
+// list.
+func isCountOnly(req *retryablehttp.Request) bool {
+	countOnly, _ := req.Context().Value(countOnlyKey{}).(bool)
+	return countOnly
+}
+
+// doCount performs a list request requesting a single element, and decodes
+// only the pagination of the response into the list model v.
+func (c *Client) doCount(ctx context.Context, op string, req *retryablehttp.Request, v interface{}) error {
+	q := req.URL.Query()
+	q.Del("page[number]")
+	q.Set("page[size]", "1")
+	req.URL.RawQuery = encodeQueryParams(q)
+
+	body := bytes.NewBuffer(nil)
+	if err := c.doOnce(ctx, op, req, body); err != nil {
+		return err
+	}
+
+	p, err := parsePagination(body)
+	if err != nil {
+		return err
+	}
+
+	dst := reflect.Indirect(reflect.ValueOf(v))
+	items := dst.FieldByName("Items")
+	items.Set(reflect.MakeSlice(items.Type(), 0, 0))
+	dst.FieldByName("Pagination").Set(reflect.ValueOf(p))
+
+	return nil
+}
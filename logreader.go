@@ -35,7 +35,7 @@ func (r *LogReader) Read(l []byte) (int, error) {
 		select {
 		case <-r.ctx.Done():
 			return 0, r.ctx.Err()
-		case <-time.After(backoff(500, 2000, r.reads)):
+		case <-r.client.clock.After(backoff(500, 2000, r.reads)):
 			if written, err := r.read(l); !errors.Is(err, io.ErrNoProgress) {
 				return written, err
 			}
@@ -67,7 +67,7 @@ func (r *LogReader) read(l []byte) (int, error) {
 	defer resp.Body.Close()
 
 	// Basic response checking.
-	if err := checkResponseCode(resp); err != nil {
+	if err := checkResponseCode(resp, r.client.clock); err != nil {
 		return 0, err
 	}
 
@@ -141,3 +141,16 @@ func backoff(min, max float64, iter int) time.Duration {
 	}
 	return time.Duration(backoff) * time.Millisecond
 }
+
+// logURLExists checks whether the log can still be read from a log read URL,
+// naming the API call op. Log read URLs are signed URLs, so the request is
+// sent like any other request to a signed URL.
+func (c *Client) logURLExists(ctx context.Context, op, logURL string) (bool, error) {
+	u, err := url.Parse(logURL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return false, fmt.Errorf("invalid log URL: %q", logURL)
+	}
+
+	s := &signedURLClient{client: c}
+	return s.exists(ctx, op, u.String())
+}
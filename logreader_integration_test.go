@@ -263,3 +263,54 @@ func TestLogReader_withoutEndOfTextMarker(t *testing.T) {
 		t.Fatalf("expected 42 log reads, got %d reads", logReads)
 	}
 }
+
+func TestLogURLExists(t *testing.T) {
+	ts, lr := testLogReader(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "HEAD" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if r.Header.Get("Authorization") != "" || r.Header.Get("X-Custom") != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch r.URL.Path {
+		case "/logs/current":
+			w.WriteHeader(http.StatusOK)
+		case "/logs/expired":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer ts.Close()
+
+	// The configured headers are not sent to the log read URL.
+	lr.client.headers.Set("X-Custom", "secret")
+
+	ctx := context.Background()
+
+	exists, err := lr.client.Plans.LogURLExists(ctx, ts.URL+"/logs/current")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !exists {
+		t.Fatal("expected the current log URL to exist")
+	}
+
+	exists, err = lr.client.Applies.LogURLExists(ctx, ts.URL+"/logs/expired")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exists {
+		t.Fatal("expected the expired log URL to not exist")
+	}
+
+	if _, err := lr.client.Plans.LogURLExists(ctx, ts.URL+"/logs/broken"); err == nil {
+		t.Fatal("expected an error for a failing log URL")
+	}
+
+	if _, err := lr.client.Plans.LogURLExists(ctx, "not-a-url"); err == nil {
+		t.Fatal("expected an error for an invalid log URL")
+	}
+}
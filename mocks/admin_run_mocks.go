@@ -35,6 +35,21 @@ func (m *MockAdminRuns) EXPECT() *MockAdminRunsMockRecorder {
 	return m.recorder
 }
 
+// CountByStatus mocks base method.
+func (m *MockAdminRuns) CountByStatus(ctx context.Context) (*tfe.AdminRunStatusCounts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByStatus", ctx)
+	ret0, _ := ret[0].(*tfe.AdminRunStatusCounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByStatus indicates an expected call of CountByStatus.
+func (mr *MockAdminRunsMockRecorder) CountByStatus(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByStatus", reflect.TypeOf((*MockAdminRuns)(nil).CountByStatus), ctx)
+}
+
 // ForceCancel mocks base method.
 func (m *MockAdminRuns) ForceCancel(ctx context.Context, runID string, options tfe.AdminRunForceCancelOptions) error {
 	m.ctrl.T.Helper()
@@ -50,6 +50,20 @@ func (mr *MockSMTPSettingsMockRecorder) Read(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockSMTPSettings)(nil).Read), ctx)
 }
 
+// SendTestEmail mocks base method.
+func (m *MockSMTPSettings) SendTestEmail(ctx context.Context, options tfe.AdminSMTPSettingsTestOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTestEmail", ctx, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendTestEmail indicates an expected call of SendTestEmail.
+func (mr *MockSMTPSettingsMockRecorder) SendTestEmail(ctx, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTestEmail", reflect.TypeOf((*MockSMTPSettings)(nil).SendTestEmail), ctx, options)
+}
+
 // Update mocks base method.
 func (m *MockSMTPSettings) Update(ctx context.Context, options tfe.AdminSMTPSettingsUpdateOptions) (*tfe.AdminSMTPSetting, error) {
 	m.ctrl.T.Helper()
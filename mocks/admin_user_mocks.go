@@ -79,6 +79,20 @@ func (mr *MockAdminUsersMockRecorder) GrantAdmin(ctx, userID interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantAdmin", reflect.TypeOf((*MockAdminUsers)(nil).GrantAdmin), ctx, userID)
 }
 
+// Impersonate mocks base method.
+func (m *MockAdminUsers) Impersonate(ctx context.Context, userID string, options tfe.AdminUserImpersonateOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Impersonate", ctx, userID, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Impersonate indicates an expected call of Impersonate.
+func (mr *MockAdminUsersMockRecorder) Impersonate(ctx, userID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Impersonate", reflect.TypeOf((*MockAdminUsers)(nil).Impersonate), ctx, userID, options)
+}
+
 // List mocks base method.
 func (m *MockAdminUsers) List(ctx context.Context, options *tfe.AdminUserListOptions) (*tfe.AdminUserList, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +138,20 @@ func (mr *MockAdminUsersMockRecorder) Suspend(ctx, userID interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suspend", reflect.TypeOf((*MockAdminUsers)(nil).Suspend), ctx, userID)
 }
 
+// Unimpersonate mocks base method.
+func (m *MockAdminUsers) Unimpersonate(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unimpersonate", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unimpersonate indicates an expected call of Unimpersonate.
+func (mr *MockAdminUsersMockRecorder) Unimpersonate(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unimpersonate", reflect.TypeOf((*MockAdminUsers)(nil).Unimpersonate), ctx)
+}
+
 // Unsuspend mocks base method.
 func (m *MockAdminUsers) Unsuspend(ctx context.Context, userID string) (*tfe.AdminUser, error) {
 	m.ctrl.T.Helper()
@@ -49,6 +49,21 @@ func (mr *MockAdminWorkspacesMockRecorder) Delete(ctx, workspaceID interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAdminWorkspaces)(nil).Delete), ctx, workspaceID)
 }
 
+// ForceDelete mocks base method.
+func (m *MockAdminWorkspaces) ForceDelete(ctx context.Context, workspaceID string, options tfe.AdminWorkspaceForceDeleteOptions) ([]*tfe.Run, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForceDelete", ctx, workspaceID, options)
+	ret0, _ := ret[0].([]*tfe.Run)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForceDelete indicates an expected call of ForceDelete.
+func (mr *MockAdminWorkspacesMockRecorder) ForceDelete(ctx, workspaceID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceDelete", reflect.TypeOf((*MockAdminWorkspaces)(nil).ForceDelete), ctx, workspaceID, options)
+}
+
 // List mocks base method.
 func (m *MockAdminWorkspaces) List(ctx context.Context, options *tfe.AdminWorkspaceListOptions) (*tfe.AdminWorkspaceList, error) {
 	m.ctrl.T.Helper()
@@ -36,6 +36,36 @@ func (m *MockApplies) EXPECT() *MockAppliesMockRecorder {
 	return m.recorder
 }
 
+// LogURL mocks base method.
+func (m *MockApplies) LogURL(ctx context.Context, applyID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogURL", ctx, applyID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogURL indicates an expected call of LogURL.
+func (mr *MockAppliesMockRecorder) LogURL(ctx, applyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogURL", reflect.TypeOf((*MockApplies)(nil).LogURL), ctx, applyID)
+}
+
+// LogURLExists mocks base method.
+func (m *MockApplies) LogURLExists(ctx context.Context, logURL string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogURLExists", ctx, logURL)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogURLExists indicates an expected call of LogURLExists.
+func (mr *MockAppliesMockRecorder) LogURLExists(ctx, logURL interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogURLExists", reflect.TypeOf((*MockApplies)(nil).LogURLExists), ctx, logURL)
+}
+
 // Logs mocks base method.
 func (m *MockApplies) Logs(ctx context.Context, applyID string) (io.Reader, error) {
 	m.ctrl.T.Helper()
@@ -65,3 +95,18 @@ func (mr *MockAppliesMockRecorder) Read(ctx, applyID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockApplies)(nil).Read), ctx, applyID)
 }
+
+// Summary mocks base method.
+func (m *MockApplies) Summary(ctx context.Context, applyID string) (*tfe.ApplySummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Summary", ctx, applyID)
+	ret0, _ := ret[0].(*tfe.ApplySummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Summary indicates an expected call of Summary.
+func (mr *MockAppliesMockRecorder) Summary(ctx, applyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockApplies)(nil).Summary), ctx, applyID)
+}
@@ -0,0 +1,141 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: assessment_result.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// MockAssessmentResults is a mock of AssessmentResults interface.
+type MockAssessmentResults struct {
+	ctrl     *gomock.Controller
+	recorder *MockAssessmentResultsMockRecorder
+}
+
+// MockAssessmentResultsMockRecorder is the mock recorder for MockAssessmentResults.
+type MockAssessmentResultsMockRecorder struct {
+	mock *MockAssessmentResults
+}
+
+// NewMockAssessmentResults creates a new mock instance.
+func NewMockAssessmentResults(ctrl *gomock.Controller) *MockAssessmentResults {
+	mock := &MockAssessmentResults{ctrl: ctrl}
+	mock.recorder = &MockAssessmentResultsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAssessmentResults) EXPECT() *MockAssessmentResultsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAssessmentResults) Create(ctx context.Context, workspaceID string) (*tfe.AssessmentResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, workspaceID)
+	ret0, _ := ret[0].(*tfe.AssessmentResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAssessmentResultsMockRecorder) Create(ctx, workspaceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAssessmentResults)(nil).Create), ctx, workspaceID)
+}
+
+// CreateAndWait mocks base method.
+func (m *MockAssessmentResults) CreateAndWait(ctx context.Context, workspaceID string) (*tfe.AssessmentResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAndWait", ctx, workspaceID)
+	ret0, _ := ret[0].(*tfe.AssessmentResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAndWait indicates an expected call of CreateAndWait.
+func (mr *MockAssessmentResultsMockRecorder) CreateAndWait(ctx, workspaceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAndWait", reflect.TypeOf((*MockAssessmentResults)(nil).CreateAndWait), ctx, workspaceID)
+}
+
+// DriftedResources mocks base method.
+func (m *MockAssessmentResults) DriftedResources(ctx context.Context, assessmentResultID string) ([]*tfe.AssessmentDriftedResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DriftedResources", ctx, assessmentResultID)
+	ret0, _ := ret[0].([]*tfe.AssessmentDriftedResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DriftedResources indicates an expected call of DriftedResources.
+func (mr *MockAssessmentResultsMockRecorder) DriftedResources(ctx, assessmentResultID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DriftedResources", reflect.TypeOf((*MockAssessmentResults)(nil).DriftedResources), ctx, assessmentResultID)
+}
+
+// JSONOutput mocks base method.
+func (m *MockAssessmentResults) JSONOutput(ctx context.Context, assessmentResultID string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JSONOutput", ctx, assessmentResultID)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JSONOutput indicates an expected call of JSONOutput.
+func (mr *MockAssessmentResultsMockRecorder) JSONOutput(ctx, assessmentResultID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JSONOutput", reflect.TypeOf((*MockAssessmentResults)(nil).JSONOutput), ctx, assessmentResultID)
+}
+
+// JSONSchema mocks base method.
+func (m *MockAssessmentResults) JSONSchema(ctx context.Context, assessmentResultID string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JSONSchema", ctx, assessmentResultID)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JSONSchema indicates an expected call of JSONSchema.
+func (mr *MockAssessmentResultsMockRecorder) JSONSchema(ctx, assessmentResultID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JSONSchema", reflect.TypeOf((*MockAssessmentResults)(nil).JSONSchema), ctx, assessmentResultID)
+}
+
+// Read mocks base method.
+func (m *MockAssessmentResults) Read(ctx context.Context, assessmentResultID string) (*tfe.AssessmentResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", ctx, assessmentResultID)
+	ret0, _ := ret[0].(*tfe.AssessmentResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockAssessmentResultsMockRecorder) Read(ctx, assessmentResultID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockAssessmentResults)(nil).Read), ctx, assessmentResultID)
+}
+
+// ReadLatest mocks base method.
+func (m *MockAssessmentResults) ReadLatest(ctx context.Context, workspaceID string) (*tfe.AssessmentResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadLatest", ctx, workspaceID)
+	ret0, _ := ret[0].(*tfe.AssessmentResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadLatest indicates an expected call of ReadLatest.
+func (mr *MockAssessmentResultsMockRecorder) ReadLatest(ctx, workspaceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadLatest", reflect.TypeOf((*MockAssessmentResults)(nil).ReadLatest), ctx, workspaceID)
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: comment.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// MockComments is a mock of Comments interface.
+type MockComments struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentsMockRecorder
+}
+
+// MockCommentsMockRecorder is the mock recorder for MockComments.
+type MockCommentsMockRecorder struct {
+	mock *MockComments
+}
+
+// NewMockComments creates a new mock instance.
+func NewMockComments(ctrl *gomock.Controller) *MockComments {
+	mock := &MockComments{ctrl: ctrl}
+	mock.recorder = &MockCommentsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockComments) EXPECT() *MockCommentsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockComments) Create(ctx context.Context, runID string, options tfe.CommentCreateOptions) (*tfe.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, runID, options)
+	ret0, _ := ret[0].(*tfe.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCommentsMockRecorder) Create(ctx, runID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockComments)(nil).Create), ctx, runID, options)
+}
+
+// List mocks base method.
+func (m *MockComments) List(ctx context.Context, runID string) (*tfe.CommentList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, runID)
+	ret0, _ := ret[0].(*tfe.CommentList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockCommentsMockRecorder) List(ctx, runID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockComments)(nil).List), ctx, runID)
+}
+
+// ListWithOptions mocks base method.
+func (m *MockComments) ListWithOptions(ctx context.Context, runID string, options *tfe.CommentListOptions) (*tfe.CommentList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithOptions", ctx, runID, options)
+	ret0, _ := ret[0].(*tfe.CommentList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWithOptions indicates an expected call of ListWithOptions.
+func (mr *MockCommentsMockRecorder) ListWithOptions(ctx, runID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithOptions", reflect.TypeOf((*MockComments)(nil).ListWithOptions), ctx, runID, options)
+}
+
+// Read mocks base method.
+func (m *MockComments) Read(ctx context.Context, commentID string) (*tfe.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", ctx, commentID)
+	ret0, _ := ret[0].(*tfe.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockCommentsMockRecorder) Read(ctx, commentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockComments)(nil).Read), ctx, commentID)
+}
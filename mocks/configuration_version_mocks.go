@@ -94,6 +94,20 @@ func (mr *MockConfigurationVersionsMockRecorder) List(ctx, workspaceID, options
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockConfigurationVersions)(nil).List), ctx, workspaceID, options)
 }
 
+// PermanentlyDeleteBackingData mocks base method.
+func (m *MockConfigurationVersions) PermanentlyDeleteBackingData(ctx context.Context, cvID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PermanentlyDeleteBackingData", ctx, cvID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PermanentlyDeleteBackingData indicates an expected call of PermanentlyDeleteBackingData.
+func (mr *MockConfigurationVersionsMockRecorder) PermanentlyDeleteBackingData(ctx, cvID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentlyDeleteBackingData", reflect.TypeOf((*MockConfigurationVersions)(nil).PermanentlyDeleteBackingData), ctx, cvID)
+}
+
 // Read mocks base method.
 func (m *MockConfigurationVersions) Read(ctx context.Context, cvID string) (*tfe.ConfigurationVersion, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +123,21 @@ func (mr *MockConfigurationVersionsMockRecorder) Read(ctx, cvID interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockConfigurationVersions)(nil).Read), ctx, cvID)
 }
 
+// ReadCurrentForWorkspace mocks base method.
+func (m *MockConfigurationVersions) ReadCurrentForWorkspace(ctx context.Context, workspaceID string) (*tfe.ConfigurationVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadCurrentForWorkspace", ctx, workspaceID)
+	ret0, _ := ret[0].(*tfe.ConfigurationVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadCurrentForWorkspace indicates an expected call of ReadCurrentForWorkspace.
+func (mr *MockConfigurationVersionsMockRecorder) ReadCurrentForWorkspace(ctx, workspaceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadCurrentForWorkspace", reflect.TypeOf((*MockConfigurationVersions)(nil).ReadCurrentForWorkspace), ctx, workspaceID)
+}
+
 // ReadWithOptions mocks base method.
 func (m *MockConfigurationVersions) ReadWithOptions(ctx context.Context, cvID string, options *tfe.ConfigurationVersionReadOptions) (*tfe.ConfigurationVersion, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +153,34 @@ func (mr *MockConfigurationVersionsMockRecorder) ReadWithOptions(ctx, cvID, opti
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWithOptions", reflect.TypeOf((*MockConfigurationVersions)(nil).ReadWithOptions), ctx, cvID, options)
 }
 
+// RestoreBackingData mocks base method.
+func (m *MockConfigurationVersions) RestoreBackingData(ctx context.Context, cvID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreBackingData", ctx, cvID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreBackingData indicates an expected call of RestoreBackingData.
+func (mr *MockConfigurationVersionsMockRecorder) RestoreBackingData(ctx, cvID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreBackingData", reflect.TypeOf((*MockConfigurationVersions)(nil).RestoreBackingData), ctx, cvID)
+}
+
+// SoftDeleteBackingData mocks base method.
+func (m *MockConfigurationVersions) SoftDeleteBackingData(ctx context.Context, cvID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeleteBackingData", ctx, cvID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDeleteBackingData indicates an expected call of SoftDeleteBackingData.
+func (mr *MockConfigurationVersionsMockRecorder) SoftDeleteBackingData(ctx, cvID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteBackingData", reflect.TypeOf((*MockConfigurationVersions)(nil).SoftDeleteBackingData), ctx, cvID)
+}
+
 // Upload mocks base method.
 func (m *MockConfigurationVersions) Upload(ctx context.Context, url, path string) error {
 	m.ctrl.T.Helper()
@@ -137,3 +194,17 @@ func (mr *MockConfigurationVersionsMockRecorder) Upload(ctx, url, path interface
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockConfigurationVersions)(nil).Upload), ctx, url, path)
 }
+
+// UploadWithOptions mocks base method.
+func (m *MockConfigurationVersions) UploadWithOptions(ctx context.Context, url, path string, options tfe.ConfigurationVersionUploadOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadWithOptions", ctx, url, path, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UploadWithOptions indicates an expected call of UploadWithOptions.
+func (mr *MockConfigurationVersionsMockRecorder) UploadWithOptions(ctx, url, path, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadWithOptions", reflect.TypeOf((*MockConfigurationVersions)(nil).UploadWithOptions), ctx, url, path, options)
+}
@@ -65,3 +65,18 @@ func (mr *MockCostEstimatesMockRecorder) Read(ctx, costEstimateID interface{}) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockCostEstimates)(nil).Read), ctx, costEstimateID)
 }
+
+// Resources mocks base method.
+func (m *MockCostEstimates) Resources(ctx context.Context, costEstimateID string) (*tfe.CostEstimateResources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resources", ctx, costEstimateID)
+	ret0, _ := ret[0].(*tfe.CostEstimateResources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resources indicates an expected call of Resources.
+func (mr *MockCostEstimatesMockRecorder) Resources(ctx, costEstimateID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resources", reflect.TypeOf((*MockCostEstimates)(nil).Resources), ctx, costEstimateID)
+}
@@ -64,6 +64,21 @@ func (mr *MockOAuthTokensMockRecorder) List(ctx, organization, options interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockOAuthTokens)(nil).List), ctx, organization, options)
 }
 
+// ListForOAuthClient mocks base method.
+func (m *MockOAuthTokens) ListForOAuthClient(ctx context.Context, oAuthClientID string, options *tfe.OAuthTokenListOptions) (*tfe.OAuthTokenList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForOAuthClient", ctx, oAuthClientID, options)
+	ret0, _ := ret[0].(*tfe.OAuthTokenList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForOAuthClient indicates an expected call of ListForOAuthClient.
+func (mr *MockOAuthTokensMockRecorder) ListForOAuthClient(ctx, oAuthClientID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForOAuthClient", reflect.TypeOf((*MockOAuthTokens)(nil).ListForOAuthClient), ctx, oAuthClientID, options)
+}
+
 // Read mocks base method.
 func (m *MockOAuthTokens) Read(ctx context.Context, oAuthTokenID string) (*tfe.OAuthToken, error) {
 	m.ctrl.T.Helper()
@@ -64,6 +64,22 @@ func (mr *MockOrganizationsMockRecorder) Delete(ctx, organization interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOrganizations)(nil).Delete), ctx, organization)
 }
 
+// GetOrCreate mocks base method.
+func (m *MockOrganizations) GetOrCreate(ctx context.Context, options tfe.OrganizationCreateOptions) (*tfe.Organization, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrCreate", ctx, options)
+	ret0, _ := ret[0].(*tfe.Organization)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrCreate indicates an expected call of GetOrCreate.
+func (mr *MockOrganizationsMockRecorder) GetOrCreate(ctx, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrCreate", reflect.TypeOf((*MockOrganizations)(nil).GetOrCreate), ctx, options)
+}
+
 // List mocks base method.
 func (m *MockOrganizations) List(ctx context.Context, options *tfe.OrganizationListOptions) (*tfe.OrganizationList, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +155,21 @@ func (mr *MockOrganizationsMockRecorder) ReadRunQueue(ctx, organization, options
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRunQueue", reflect.TypeOf((*MockOrganizations)(nil).ReadRunQueue), ctx, organization, options)
 }
 
+// ReadWithOptions mocks base method.
+func (m *MockOrganizations) ReadWithOptions(ctx context.Context, organization string, options *tfe.OrganizationReadOptions) (*tfe.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWithOptions", ctx, organization, options)
+	ret0, _ := ret[0].(*tfe.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWithOptions indicates an expected call of ReadWithOptions.
+func (mr *MockOrganizationsMockRecorder) ReadWithOptions(ctx, organization, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWithOptions", reflect.TypeOf((*MockOrganizations)(nil).ReadWithOptions), ctx, organization, options)
+}
+
 // Update mocks base method.
 func (m *MockOrganizations) Update(ctx context.Context, organization string, options tfe.OrganizationUpdateOptions) (*tfe.Organization, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: organization_tags.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// MockOrganizationTags is a mock of OrganizationTags interface.
+type MockOrganizationTags struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizationTagsMockRecorder
+}
+
+// MockOrganizationTagsMockRecorder is the mock recorder for MockOrganizationTags.
+type MockOrganizationTagsMockRecorder struct {
+	mock *MockOrganizationTags
+}
+
+// NewMockOrganizationTags creates a new mock instance.
+func NewMockOrganizationTags(ctrl *gomock.Controller) *MockOrganizationTags {
+	mock := &MockOrganizationTags{ctrl: ctrl}
+	mock.recorder = &MockOrganizationTagsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizationTags) EXPECT() *MockOrganizationTagsMockRecorder {
+	return m.recorder
+}
+
+// AddWorkspaces mocks base method.
+func (m *MockOrganizationTags) AddWorkspaces(ctx context.Context, tagID string, options tfe.AddWorkspacesToTagOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkspaces", ctx, tagID, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddWorkspaces indicates an expected call of AddWorkspaces.
+func (mr *MockOrganizationTagsMockRecorder) AddWorkspaces(ctx, tagID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkspaces", reflect.TypeOf((*MockOrganizationTags)(nil).AddWorkspaces), ctx, tagID, options)
+}
+
+// Delete mocks base method.
+func (m *MockOrganizationTags) Delete(ctx context.Context, organization string, options tfe.OrganizationTagsDeleteOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, organization, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockOrganizationTagsMockRecorder) Delete(ctx, organization, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOrganizationTags)(nil).Delete), ctx, organization, options)
+}
+
+// List mocks base method.
+func (m *MockOrganizationTags) List(ctx context.Context, organization string, options *tfe.OrganizationTagsListOptions) (*tfe.OrganizationTagsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, organization, options)
+	ret0, _ := ret[0].(*tfe.OrganizationTagsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockOrganizationTagsMockRecorder) List(ctx, organization, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockOrganizationTags)(nil).List), ctx, organization, options)
+}
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -50,6 +51,21 @@ func (mr *MockPlanExportsMockRecorder) Create(ctx, options interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPlanExports)(nil).Create), ctx, options)
 }
 
+// CreateAndWait mocks base method.
+func (m *MockPlanExports) CreateAndWait(ctx context.Context, options tfe.PlanExportCreateOptions, w io.Writer) (*tfe.PlanExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAndWait", ctx, options, w)
+	ret0, _ := ret[0].(*tfe.PlanExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAndWait indicates an expected call of CreateAndWait.
+func (mr *MockPlanExportsMockRecorder) CreateAndWait(ctx, options, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAndWait", reflect.TypeOf((*MockPlanExports)(nil).CreateAndWait), ctx, options, w)
+}
+
 // Delete mocks base method.
 func (m *MockPlanExports) Delete(ctx context.Context, planExportID string) error {
 	m.ctrl.T.Helper()
@@ -79,6 +95,20 @@ func (mr *MockPlanExportsMockRecorder) Download(ctx, planExportID interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockPlanExports)(nil).Download), ctx, planExportID)
 }
 
+// DownloadWithOptions mocks base method.
+func (m *MockPlanExports) DownloadWithOptions(ctx context.Context, planExportID string, w io.Writer, options *tfe.SignedURLDownloadOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadWithOptions", ctx, planExportID, w, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DownloadWithOptions indicates an expected call of DownloadWithOptions.
+func (mr *MockPlanExportsMockRecorder) DownloadWithOptions(ctx, planExportID, w, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadWithOptions", reflect.TypeOf((*MockPlanExports)(nil).DownloadWithOptions), ctx, planExportID, w, options)
+}
+
 // Read mocks base method.
 func (m *MockPlanExports) Read(ctx context.Context, planExportID string) (*tfe.PlanExport, error) {
 	m.ctrl.T.Helper()
@@ -36,6 +36,36 @@ func (m *MockPlans) EXPECT() *MockPlansMockRecorder {
 	return m.recorder
 }
 
+// LogURL mocks base method.
+func (m *MockPlans) LogURL(ctx context.Context, planID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogURL", ctx, planID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogURL indicates an expected call of LogURL.
+func (mr *MockPlansMockRecorder) LogURL(ctx, planID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogURL", reflect.TypeOf((*MockPlans)(nil).LogURL), ctx, planID)
+}
+
+// LogURLExists mocks base method.
+func (m *MockPlans) LogURLExists(ctx context.Context, logURL string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogURLExists", ctx, logURL)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogURLExists indicates an expected call of LogURLExists.
+func (mr *MockPlansMockRecorder) LogURLExists(ctx, logURL interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogURLExists", reflect.TypeOf((*MockPlans)(nil).LogURLExists), ctx, logURL)
+}
+
 // Logs mocks base method.
 func (m *MockPlans) Logs(ctx context.Context, planID string) (io.Reader, error) {
 	m.ctrl.T.Helper()
@@ -80,3 +110,18 @@ func (mr *MockPlansMockRecorder) ReadJSONOutput(ctx, planID interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadJSONOutput", reflect.TypeOf((*MockPlans)(nil).ReadJSONOutput), ctx, planID)
 }
+
+// ReadJSONPlan mocks base method.
+func (m *MockPlans) ReadJSONPlan(ctx context.Context, planID string) (*tfe.PlanJSONOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadJSONPlan", ctx, planID)
+	ret0, _ := ret[0].(*tfe.PlanJSONOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadJSONPlan indicates an expected call of ReadJSONPlan.
+func (mr *MockPlansMockRecorder) ReadJSONPlan(ctx, planID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadJSONPlan", reflect.TypeOf((*MockPlans)(nil).ReadJSONPlan), ctx, planID)
+}
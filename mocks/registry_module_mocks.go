@@ -6,6 +6,7 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -122,6 +123,36 @@ func (mr *MockRegistryModulesMockRecorder) DeleteVersion(ctx, moduleID, version
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVersion", reflect.TypeOf((*MockRegistryModules)(nil).DeleteVersion), ctx, moduleID, version)
 }
 
+// List mocks base method.
+func (m *MockRegistryModules) List(ctx context.Context, organization string, options *tfe.RegistryModuleListOptions) (*tfe.RegistryModuleList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, organization, options)
+	ret0, _ := ret[0].(*tfe.RegistryModuleList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRegistryModulesMockRecorder) List(ctx, organization, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRegistryModules)(nil).List), ctx, organization, options)
+}
+
+// PublishLocalModule mocks base method.
+func (m *MockRegistryModules) PublishLocalModule(ctx context.Context, path string, moduleID tfe.RegistryModuleID, version string) (*tfe.RegistryModuleVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishLocalModule", ctx, path, moduleID, version)
+	ret0, _ := ret[0].(*tfe.RegistryModuleVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishLocalModule indicates an expected call of PublishLocalModule.
+func (mr *MockRegistryModulesMockRecorder) PublishLocalModule(ctx, path, moduleID, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishLocalModule", reflect.TypeOf((*MockRegistryModules)(nil).PublishLocalModule), ctx, path, moduleID, version)
+}
+
 // Read mocks base method.
 func (m *MockRegistryModules) Read(ctx context.Context, moduleID tfe.RegistryModuleID) (*tfe.RegistryModule, error) {
 	m.ctrl.T.Helper()
@@ -150,3 +181,17 @@ func (mr *MockRegistryModulesMockRecorder) Upload(ctx, rmv, path interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockRegistryModules)(nil).Upload), ctx, rmv, path)
 }
+
+// UploadTarGzip mocks base method.
+func (m *MockRegistryModules) UploadTarGzip(ctx context.Context, url string, r io.Reader) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadTarGzip", ctx, url, r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UploadTarGzip indicates an expected call of UploadTarGzip.
+func (mr *MockRegistryModulesMockRecorder) UploadTarGzip(ctx, url, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadTarGzip", reflect.TypeOf((*MockRegistryModules)(nil).UploadTarGzip), ctx, url, r)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: registry_provider.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// MockRegistryProviders is a mock of RegistryProviders interface.
+type MockRegistryProviders struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegistryProvidersMockRecorder
+}
+
+// MockRegistryProvidersMockRecorder is the mock recorder for MockRegistryProviders.
+type MockRegistryProvidersMockRecorder struct {
+	mock *MockRegistryProviders
+}
+
+// NewMockRegistryProviders creates a new mock instance.
+func NewMockRegistryProviders(ctrl *gomock.Controller) *MockRegistryProviders {
+	mock := &MockRegistryProviders{ctrl: ctrl}
+	mock.recorder = &MockRegistryProvidersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegistryProviders) EXPECT() *MockRegistryProvidersMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockRegistryProviders) List(ctx context.Context, organization string, options *tfe.RegistryProviderListOptions) (*tfe.RegistryProviderList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, organization, options)
+	ret0, _ := ret[0].(*tfe.RegistryProviderList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRegistryProvidersMockRecorder) List(ctx, organization, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRegistryProviders)(nil).List), ctx, organization, options)
+}
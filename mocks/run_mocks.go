@@ -150,3 +150,18 @@ func (mr *MockRunsMockRecorder) ReadWithOptions(ctx, runID, options interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWithOptions", reflect.TypeOf((*MockRuns)(nil).ReadWithOptions), ctx, runID, options)
 }
+
+// Retry mocks base method.
+func (m *MockRuns) Retry(ctx context.Context, runID string, options tfe.RunRetryOptions) (*tfe.Run, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retry", ctx, runID, options)
+	ret0, _ := ret[0].(*tfe.Run)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Retry indicates an expected call of Retry.
+func (mr *MockRunsMockRecorder) Retry(ctx, runID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retry", reflect.TypeOf((*MockRuns)(nil).Retry), ctx, runID, options)
+}
@@ -64,6 +64,21 @@ func (mr *MockRunTriggersMockRecorder) Delete(ctx, RunTriggerID interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRunTriggers)(nil).Delete), ctx, RunTriggerID)
 }
 
+// Graph mocks base method.
+func (m *MockRunTriggers) Graph(ctx context.Context, organization string) (*tfe.RunTriggerGraph, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Graph", ctx, organization)
+	ret0, _ := ret[0].(*tfe.RunTriggerGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Graph indicates an expected call of Graph.
+func (mr *MockRunTriggersMockRecorder) Graph(ctx, organization interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Graph", reflect.TypeOf((*MockRunTriggers)(nil).Graph), ctx, organization)
+}
+
 // List mocks base method.
 func (m *MockRunTriggers) List(ctx context.Context, workspaceID string, options *tfe.RunTriggerListOptions) (*tfe.RunTriggerList, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: signed_url.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// MockSignedURLClient is a mock of SignedURLClient interface.
+type MockSignedURLClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignedURLClientMockRecorder
+}
+
+// MockSignedURLClientMockRecorder is the mock recorder for MockSignedURLClient.
+type MockSignedURLClientMockRecorder struct {
+	mock *MockSignedURLClient
+}
+
+// NewMockSignedURLClient creates a new mock instance.
+func NewMockSignedURLClient(ctrl *gomock.Controller) *MockSignedURLClient {
+	mock := &MockSignedURLClient{ctrl: ctrl}
+	mock.recorder = &MockSignedURLClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignedURLClient) EXPECT() *MockSignedURLClientMockRecorder {
+	return m.recorder
+}
+
+// Download mocks base method.
+func (m *MockSignedURLClient) Download(ctx context.Context, signedURL string, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", ctx, signedURL, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockSignedURLClientMockRecorder) Download(ctx, signedURL, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockSignedURLClient)(nil).Download), ctx, signedURL, w)
+}
+
+// DownloadWithOptions mocks base method.
+func (m *MockSignedURLClient) DownloadWithOptions(ctx context.Context, signedURL string, w io.Writer, options *tfe.SignedURLDownloadOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadWithOptions", ctx, signedURL, w, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DownloadWithOptions indicates an expected call of DownloadWithOptions.
+func (mr *MockSignedURLClientMockRecorder) DownloadWithOptions(ctx, signedURL, w, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadWithOptions", reflect.TypeOf((*MockSignedURLClient)(nil).DownloadWithOptions), ctx, signedURL, w, options)
+}
+
+// Upload mocks base method.
+func (m *MockSignedURLClient) Upload(ctx context.Context, signedURL string, r io.Reader, options *tfe.SignedURLUploadOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, signedURL, r, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockSignedURLClientMockRecorder) Upload(ctx, signedURL, r, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockSignedURLClient)(nil).Upload), ctx, signedURL, r, options)
+}
@@ -64,6 +64,22 @@ func (mr *MockTeamsMockRecorder) Delete(ctx, teamID interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTeams)(nil).Delete), ctx, teamID)
 }
 
+// GetOrCreate mocks base method.
+func (m *MockTeams) GetOrCreate(ctx context.Context, organization string, options tfe.TeamCreateOptions) (*tfe.Team, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrCreate", ctx, organization, options)
+	ret0, _ := ret[0].(*tfe.Team)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrCreate indicates an expected call of GetOrCreate.
+func (mr *MockTeamsMockRecorder) GetOrCreate(ctx, organization, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrCreate", reflect.TypeOf((*MockTeams)(nil).GetOrCreate), ctx, organization, options)
+}
+
 // List mocks base method.
 func (m *MockTeams) List(ctx context.Context, organization string, options *tfe.TeamListOptions) (*tfe.TeamList, error) {
 	m.ctrl.T.Helper()
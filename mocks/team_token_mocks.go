@@ -50,6 +50,21 @@ func (mr *MockTeamTokensMockRecorder) Create(ctx, teamID interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTeamTokens)(nil).Create), ctx, teamID)
 }
 
+// CreateWithOptions mocks base method.
+func (m *MockTeamTokens) CreateWithOptions(ctx context.Context, teamID string, options tfe.TeamTokenCreateOptions) (*tfe.TeamToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithOptions", ctx, teamID, options)
+	ret0, _ := ret[0].(*tfe.TeamToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWithOptions indicates an expected call of CreateWithOptions.
+func (mr *MockTeamTokensMockRecorder) CreateWithOptions(ctx, teamID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithOptions", reflect.TypeOf((*MockTeamTokens)(nil).CreateWithOptions), ctx, teamID, options)
+}
+
 // Delete mocks base method.
 func (m *MockTeamTokens) Delete(ctx context.Context, teamID string) error {
 	m.ctrl.T.Helper()
@@ -64,6 +79,35 @@ func (mr *MockTeamTokensMockRecorder) Delete(ctx, teamID interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTeamTokens)(nil).Delete), ctx, teamID)
 }
 
+// DeleteByID mocks base method.
+func (m *MockTeamTokens) DeleteByID(ctx context.Context, tokenID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", ctx, tokenID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByID indicates an expected call of DeleteByID.
+func (mr *MockTeamTokensMockRecorder) DeleteByID(ctx, tokenID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockTeamTokens)(nil).DeleteByID), ctx, tokenID)
+}
+
+// List mocks base method.
+func (m *MockTeamTokens) List(ctx context.Context, teamID string, options *tfe.TeamTokenListOptions) (*tfe.TeamTokenList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, teamID, options)
+	ret0, _ := ret[0].(*tfe.TeamTokenList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockTeamTokensMockRecorder) List(ctx, teamID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTeamTokens)(nil).List), ctx, teamID, options)
+}
+
 // Read mocks base method.
 func (m *MockTeamTokens) Read(ctx context.Context, teamID string) (*tfe.TeamToken, error) {
 	m.ctrl.T.Helper()
@@ -78,3 +122,18 @@ func (mr *MockTeamTokensMockRecorder) Read(ctx, teamID interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockTeamTokens)(nil).Read), ctx, teamID)
 }
+
+// ReadByID mocks base method.
+func (m *MockTeamTokens) ReadByID(ctx context.Context, tokenID string) (*tfe.TeamToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadByID", ctx, tokenID)
+	ret0, _ := ret[0].(*tfe.TeamToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadByID indicates an expected call of ReadByID.
+func (mr *MockTeamTokensMockRecorder) ReadByID(ctx, tokenID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadByID", reflect.TypeOf((*MockTeamTokens)(nil).ReadByID), ctx, tokenID)
+}
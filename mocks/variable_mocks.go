@@ -6,6 +6,7 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -64,6 +65,20 @@ func (mr *MockVariablesMockRecorder) Delete(ctx, workspaceID, variableID interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockVariables)(nil).Delete), ctx, workspaceID, variableID)
 }
 
+// Export mocks base method.
+func (m *MockVariables) Export(ctx context.Context, workspaceID string, format tfe.VariableExportFormat, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, workspaceID, format, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockVariablesMockRecorder) Export(ctx, workspaceID, format, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockVariables)(nil).Export), ctx, workspaceID, format, w)
+}
+
 // List mocks base method.
 func (m *MockVariables) List(ctx context.Context, workspaceID string, options *tfe.VariableListOptions) (*tfe.VariableList, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +109,21 @@ func (mr *MockVariablesMockRecorder) Read(ctx, workspaceID, variableID interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockVariables)(nil).Read), ctx, workspaceID, variableID)
 }
 
+// Replace mocks base method.
+func (m *MockVariables) Replace(ctx context.Context, workspaceID, key, value string) (*tfe.Variable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replace", ctx, workspaceID, key, value)
+	ret0, _ := ret[0].(*tfe.Variable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Replace indicates an expected call of Replace.
+func (mr *MockVariablesMockRecorder) Replace(ctx, workspaceID, key, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replace", reflect.TypeOf((*MockVariables)(nil).Replace), ctx, workspaceID, key, value)
+}
+
 // Update mocks base method.
 func (m *MockVariables) Update(ctx context.Context, workspaceID, variableID string, options tfe.VariableUpdateOptions) (*tfe.Variable, error) {
 	m.ctrl.T.Helper()
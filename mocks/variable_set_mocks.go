@@ -78,6 +78,22 @@ func (mr *MockVariableSetsMockRecorder) Delete(ctx, variableSetID interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockVariableSets)(nil).Delete), ctx, variableSetID)
 }
 
+// GetOrCreate mocks base method.
+func (m *MockVariableSets) GetOrCreate(ctx context.Context, organization string, options *tfe.VariableSetCreateOptions) (*tfe.VariableSet, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrCreate", ctx, organization, options)
+	ret0, _ := ret[0].(*tfe.VariableSet)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrCreate indicates an expected call of GetOrCreate.
+func (mr *MockVariableSetsMockRecorder) GetOrCreate(ctx, organization, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrCreate", reflect.TypeOf((*MockVariableSets)(nil).GetOrCreate), ctx, organization, options)
+}
+
 // List mocks base method.
 func (m *MockVariableSets) List(ctx context.Context, organization string, options *tfe.VariableSetListOptions) (*tfe.VariableSetList, error) {
 	m.ctrl.T.Helper()
@@ -64,6 +64,21 @@ func (mr *MockWorkspacesMockRecorder) AddTags(ctx, workspaceID, options interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTags", reflect.TypeOf((*MockWorkspaces)(nil).AddTags), ctx, workspaceID, options)
 }
 
+// AddTagsByFilter mocks base method.
+func (m *MockWorkspaces) AddTagsByFilter(ctx context.Context, organization string, options tfe.WorkspaceTagsByFilterOptions) ([]*tfe.WorkspaceTagsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTagsByFilter", ctx, organization, options)
+	ret0, _ := ret[0].([]*tfe.WorkspaceTagsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTagsByFilter indicates an expected call of AddTagsByFilter.
+func (mr *MockWorkspacesMockRecorder) AddTagsByFilter(ctx, organization, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTagsByFilter", reflect.TypeOf((*MockWorkspaces)(nil).AddTagsByFilter), ctx, organization, options)
+}
+
 // AssignSSHKey mocks base method.
 func (m *MockWorkspaces) AssignSSHKey(ctx context.Context, workspaceID string, options tfe.WorkspaceAssignSSHKeyOptions) (*tfe.Workspace, error) {
 	m.ctrl.T.Helper()
@@ -137,6 +152,22 @@ func (mr *MockWorkspacesMockRecorder) ForceUnlock(ctx, workspaceID interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceUnlock", reflect.TypeOf((*MockWorkspaces)(nil).ForceUnlock), ctx, workspaceID)
 }
 
+// GetOrCreate mocks base method.
+func (m *MockWorkspaces) GetOrCreate(ctx context.Context, organization string, options tfe.WorkspaceCreateOptions) (*tfe.Workspace, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrCreate", ctx, organization, options)
+	ret0, _ := ret[0].(*tfe.Workspace)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrCreate indicates an expected call of GetOrCreate.
+func (mr *MockWorkspacesMockRecorder) GetOrCreate(ctx, organization, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrCreate", reflect.TypeOf((*MockWorkspaces)(nil).GetOrCreate), ctx, organization, options)
+}
+
 // List mocks base method.
 func (m *MockWorkspaces) List(ctx context.Context, organization string, options *tfe.WorkspaceListOptions) (*tfe.WorkspaceList, error) {
 	m.ctrl.T.Helper()
@@ -197,6 +228,21 @@ func (mr *MockWorkspacesMockRecorder) Lock(ctx, workspaceID, options interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockWorkspaces)(nil).Lock), ctx, workspaceID, options)
 }
 
+// LockStatus mocks base method.
+func (m *MockWorkspaces) LockStatus(ctx context.Context, workspaceID string) (*tfe.WorkspaceLockStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockStatus", ctx, workspaceID)
+	ret0, _ := ret[0].(*tfe.WorkspaceLockStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockStatus indicates an expected call of LockStatus.
+func (mr *MockWorkspacesMockRecorder) LockStatus(ctx, workspaceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockStatus", reflect.TypeOf((*MockWorkspaces)(nil).LockStatus), ctx, workspaceID)
+}
+
 // Read mocks base method.
 func (m *MockWorkspaces) Read(ctx context.Context, organization, workspace string) (*tfe.Workspace, error) {
 	m.ctrl.T.Helper()
@@ -242,6 +288,21 @@ func (mr *MockWorkspacesMockRecorder) ReadByIDWithOptions(ctx, workspaceID, opti
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadByIDWithOptions", reflect.TypeOf((*MockWorkspaces)(nil).ReadByIDWithOptions), ctx, workspaceID, options)
 }
 
+// ReadMany mocks base method.
+func (m *MockWorkspaces) ReadMany(ctx context.Context, organization string, names []string) (map[string]*tfe.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadMany", ctx, organization, names)
+	ret0, _ := ret[0].(map[string]*tfe.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadMany indicates an expected call of ReadMany.
+func (mr *MockWorkspacesMockRecorder) ReadMany(ctx, organization, names interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMany", reflect.TypeOf((*MockWorkspaces)(nil).ReadMany), ctx, organization, names)
+}
+
 // ReadWithOptions mocks base method.
 func (m *MockWorkspaces) ReadWithOptions(ctx context.Context, organization, workspace string, options *tfe.WorkspaceReadOptions) (*tfe.Workspace, error) {
 	m.ctrl.T.Helper()
@@ -272,6 +333,21 @@ func (mr *MockWorkspacesMockRecorder) Readme(ctx, workspaceID interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Readme", reflect.TypeOf((*MockWorkspaces)(nil).Readme), ctx, workspaceID)
 }
 
+// ReconcileSettings mocks base method.
+func (m *MockWorkspaces) ReconcileSettings(ctx context.Context, organization string, options tfe.WorkspaceReconcileOptions) ([]*tfe.WorkspaceReconcileResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileSettings", ctx, organization, options)
+	ret0, _ := ret[0].([]*tfe.WorkspaceReconcileResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileSettings indicates an expected call of ReconcileSettings.
+func (mr *MockWorkspacesMockRecorder) ReconcileSettings(ctx, organization, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileSettings", reflect.TypeOf((*MockWorkspaces)(nil).ReconcileSettings), ctx, organization, options)
+}
+
 // RemoveRemoteStateConsumers mocks base method.
 func (m *MockWorkspaces) RemoveRemoteStateConsumers(ctx context.Context, workspaceID string, options tfe.WorkspaceRemoveRemoteStateConsumersOptions) error {
 	m.ctrl.T.Helper()
@@ -300,6 +376,21 @@ func (mr *MockWorkspacesMockRecorder) RemoveTags(ctx, workspaceID, options inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTags", reflect.TypeOf((*MockWorkspaces)(nil).RemoveTags), ctx, workspaceID, options)
 }
 
+// RemoveTagsByFilter mocks base method.
+func (m *MockWorkspaces) RemoveTagsByFilter(ctx context.Context, organization string, options tfe.WorkspaceTagsByFilterOptions) ([]*tfe.WorkspaceTagsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTagsByFilter", ctx, organization, options)
+	ret0, _ := ret[0].([]*tfe.WorkspaceTagsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTagsByFilter indicates an expected call of RemoveTagsByFilter.
+func (mr *MockWorkspacesMockRecorder) RemoveTagsByFilter(ctx, organization, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTagsByFilter", reflect.TypeOf((*MockWorkspaces)(nil).RemoveTagsByFilter), ctx, organization, options)
+}
+
 // RemoveVCSConnection mocks base method.
 func (m *MockWorkspaces) RemoveVCSConnection(ctx context.Context, organization, workspace string) (*tfe.Workspace, error) {
 	m.ctrl.T.Helper()
@@ -390,6 +481,21 @@ func (mr *MockWorkspacesMockRecorder) UpdateByID(ctx, workspaceID, options inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByID", reflect.TypeOf((*MockWorkspaces)(nil).UpdateByID), ctx, workspaceID, options)
 }
 
+// UpdateRelationshipsByID mocks base method.
+func (m *MockWorkspaces) UpdateRelationshipsByID(ctx context.Context, workspaceID string, options tfe.RelationshipsUpdateOptions) (*tfe.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRelationshipsByID", ctx, workspaceID, options)
+	ret0, _ := ret[0].(*tfe.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRelationshipsByID indicates an expected call of UpdateRelationshipsByID.
+func (mr *MockWorkspacesMockRecorder) UpdateRelationshipsByID(ctx, workspaceID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRelationshipsByID", reflect.TypeOf((*MockWorkspaces)(nil).UpdateRelationshipsByID), ctx, workspaceID, options)
+}
+
 // UpdateRemoteStateConsumers mocks base method.
 func (m *MockWorkspaces) UpdateRemoteStateConsumers(ctx context.Context, workspaceID string, options tfe.WorkspaceUpdateRemoteStateConsumersOptions) error {
 	m.ctrl.T.Helper()
@@ -403,3 +509,18 @@ func (mr *MockWorkspacesMockRecorder) UpdateRemoteStateConsumers(ctx, workspaceI
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRemoteStateConsumers", reflect.TypeOf((*MockWorkspaces)(nil).UpdateRemoteStateConsumers), ctx, workspaceID, options)
 }
+
+// WaitForUnlock mocks base method.
+func (m *MockWorkspaces) WaitForUnlock(ctx context.Context, workspaceID string, options *tfe.WorkspaceUnlockWaitOptions) (*tfe.WorkspaceLockStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForUnlock", ctx, workspaceID, options)
+	ret0, _ := ret[0].(*tfe.WorkspaceLockStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForUnlock indicates an expected call of WaitForUnlock.
+func (mr *MockWorkspacesMockRecorder) WaitForUnlock(ctx, workspaceID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForUnlock", reflect.TypeOf((*MockWorkspaces)(nil).WaitForUnlock), ctx, workspaceID, options)
+}
@@ -59,9 +59,10 @@ type NotificationDestinationType string
 
 // List of available notification destination types.
 const (
-	NotificationDestinationTypeEmail   NotificationDestinationType = "email"
-	NotificationDestinationTypeGeneric NotificationDestinationType = "generic"
-	NotificationDestinationTypeSlack   NotificationDestinationType = "slack"
+	NotificationDestinationTypeEmail          NotificationDestinationType = "email"
+	NotificationDestinationTypeGeneric        NotificationDestinationType = "generic"
+	NotificationDestinationTypeSlack          NotificationDestinationType = "slack"
+	NotificationDestinationTypeMicrosoftTeams NotificationDestinationType = "microsoft-teams"
 )
 
 // NotificationConfigurationList represents a list of Notification
@@ -188,7 +189,7 @@ func (s *notificationConfigurations) List(ctx context.Context, workspaceID strin
 	}
 
 	ncl := &NotificationConfigurationList{}
-	err = s.client.do(ctx, req, ncl)
+	err = s.client.do(ctx, "notificationConfigurations.List", req, ncl)
 	if err != nil {
 		return nil, err
 	}
@@ -212,7 +213,7 @@ func (s *notificationConfigurations) Create(ctx context.Context, workspaceID str
 	}
 
 	nc := &NotificationConfiguration{}
-	err = s.client.do(ctx, req, nc)
+	err = s.client.do(ctx, "notificationConfigurations.Create", req, nc)
 	if err != nil {
 		return nil, err
 	}
@@ -233,7 +234,7 @@ func (s *notificationConfigurations) Read(ctx context.Context, notificationConfi
 	}
 
 	nc := &NotificationConfiguration{}
-	err = s.client.do(ctx, req, nc)
+	err = s.client.do(ctx, "notificationConfigurations.Read", req, nc)
 	if err != nil {
 		return nil, err
 	}
@@ -258,7 +259,7 @@ func (s *notificationConfigurations) Update(ctx context.Context, notificationCon
 	}
 
 	nc := &NotificationConfiguration{}
-	err = s.client.do(ctx, req, nc)
+	err = s.client.do(ctx, "notificationConfigurations.Update", req, nc)
 	if err != nil {
 		return nil, err
 	}
@@ -278,7 +279,7 @@ func (s *notificationConfigurations) Delete(ctx context.Context, notificationCon
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "notificationConfigurations.Delete", req, nil)
 }
 
 // Verify a notification configuration by delivering a verification
@@ -296,7 +297,7 @@ func (s *notificationConfigurations) Verify(ctx context.Context, notificationCon
 	}
 
 	nc := &NotificationConfiguration{}
-	err = s.client.do(ctx, req, nc)
+	err = s.client.do(ctx, "notificationConfigurations.Verify", req, nc)
 	if err != nil {
 		return nil, err
 	}
@@ -319,10 +320,18 @@ func (o NotificationConfigurationCreateOptions) valid() error {
 		return ErrInvalidNotificationTrigger
 	}
 
-	if *o.DestinationType == NotificationDestinationTypeGeneric || *o.DestinationType == NotificationDestinationTypeSlack {
+	switch *o.DestinationType {
+	case NotificationDestinationTypeEmail:
+		if !validEmailUsers(o.EmailUsers) {
+			return ErrInvalidUserID
+		}
+	case NotificationDestinationTypeGeneric, NotificationDestinationTypeSlack, NotificationDestinationTypeMicrosoftTeams:
 		if o.URL == nil {
 			return ErrRequiredURL
 		}
+		if len(o.EmailAddresses) > 0 || len(o.EmailUsers) > 0 {
+			return ErrUnsupportedEmailRecipients
+		}
 	}
 	return nil
 }
@@ -336,23 +345,42 @@ func (o NotificationConfigurationUpdateOptions) valid() error {
 		return ErrInvalidNotificationTrigger
 	}
 
+	if !validEmailUsers(o.EmailUsers) {
+		return ErrInvalidUserID
+	}
+
 	return nil
 }
 
+// validEmailUsers checks that all email users are referenced by their ID.
+func validEmailUsers(users []*User) bool {
+	for _, u := range users {
+		if u == nil || !validStringID(&u.ID) {
+			return false
+		}
+	}
+	return true
+}
+
 func validNotificationTriggerType(triggers []NotificationTriggerType) bool {
 	for _, t := range triggers {
-		switch t {
-		case NotificationTriggerApplying,
-			NotificationTriggerNeedsAttention,
-			NotificationTriggerCompleted,
-			NotificationTriggerCreated,
-			NotificationTriggerErrored,
-			NotificationTriggerPlanning:
-			continue
-		default:
+		if !t.valid() {
 			return false
 		}
 	}
 
 	return true
 }
+
+func (t NotificationTriggerType) valid() bool {
+	switch t {
+	case NotificationTriggerApplying,
+		NotificationTriggerNeedsAttention,
+		NotificationTriggerCompleted,
+		NotificationTriggerCreated,
+		NotificationTriggerErrored,
+		NotificationTriggerPlanning:
+		return true
+	}
+	return false
+}
@@ -171,6 +171,74 @@ func TestNotificationConfigurationCreate(t *testing.T) {
 		_, err := client.NotificationConfigurations.Create(ctx, wTest.ID, options)
 		require.NoError(t, err)
 	})
+
+	t.Run("with email addresses when destination type is email", func(t *testing.T) {
+		skipIfCloud(t)
+
+		options := NotificationConfigurationCreateOptions{
+			DestinationType: NotificationDestination(NotificationDestinationTypeEmail),
+			Enabled:         Bool(false),
+			Name:            String(randomString(t)),
+			EmailAddresses:  []string{"ops@example.com"},
+		}
+
+		nc, err := client.NotificationConfigurations.Create(ctx, wTest.ID, options)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ops@example.com"}, nc.EmailAddresses)
+	})
+
+	t.Run("with email users when destination type is slack", func(t *testing.T) {
+		options := NotificationConfigurationCreateOptions{
+			DestinationType: NotificationDestination(NotificationDestinationTypeSlack),
+			Enabled:         Bool(false),
+			Name:            String(randomString(t)),
+			URL:             String("http://example.com"),
+			EmailUsers:      []*User{orgMemberTest.User},
+		}
+
+		nc, err := client.NotificationConfigurations.Create(ctx, wTest.ID, options)
+		assert.Nil(t, nc)
+		assert.Equal(t, ErrUnsupportedEmailRecipients, err)
+	})
+
+	t.Run("with an email user without an ID", func(t *testing.T) {
+		options := NotificationConfigurationCreateOptions{
+			DestinationType: NotificationDestination(NotificationDestinationTypeEmail),
+			Enabled:         Bool(false),
+			Name:            String(randomString(t)),
+			EmailUsers:      []*User{{}},
+		}
+
+		nc, err := client.NotificationConfigurations.Create(ctx, wTest.ID, options)
+		assert.Nil(t, nc)
+		assert.Equal(t, ErrInvalidUserID, err)
+	})
+
+	t.Run("with destination type microsoft-teams", func(t *testing.T) {
+		options := NotificationConfigurationCreateOptions{
+			DestinationType: NotificationDestination(NotificationDestinationTypeMicrosoftTeams),
+			Enabled:         Bool(false),
+			Name:            String(randomString(t)),
+			URL:             String("http://example.com"),
+			Triggers:        []NotificationTriggerType{NotificationTriggerErrored},
+		}
+
+		nc, err := client.NotificationConfigurations.Create(ctx, wTest.ID, options)
+		require.NoError(t, err)
+		assert.Equal(t, NotificationDestinationTypeMicrosoftTeams, nc.DestinationType)
+	})
+
+	t.Run("without a required value URL when destination type is microsoft-teams", func(t *testing.T) {
+		options := NotificationConfigurationCreateOptions{
+			DestinationType: NotificationDestination(NotificationDestinationTypeMicrosoftTeams),
+			Enabled:         Bool(false),
+			Name:            String(randomString(t)),
+		}
+
+		nc, err := client.NotificationConfigurations.Create(ctx, wTest.ID, options)
+		assert.Nil(t, nc)
+		assert.Equal(t, ErrRequiredURL, err)
+	})
 }
 
 func TestNotificationConfigurationRead(t *testing.T) {
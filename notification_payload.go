@@ -0,0 +1,54 @@
+package tfe
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// NotificationPayloadVersion is the version of the notification payload
+// which can be decoded by DecodeNotificationPayload.
+const NotificationPayloadVersion = 1
+
+// NotificationPayload represents the payload delivered by a generic
+// notification configuration.
+//
+// TFE API docs:
+// https://www.terraform.io/cloud-docs/api-docs/notification-configurations#notification-payload
+type NotificationPayload struct {
+	PayloadVersion              int                         `json:"payload_version"`
+	NotificationConfigurationID string                      `json:"notification_configuration_id"`
+	RunURL                      string                      `json:"run_url"`
+	RunID                       string                      `json:"run_id"`
+	RunMessage                  string                      `json:"run_message"`
+	RunCreatedAt                time.Time                   `json:"run_created_at"`
+	RunCreatedBy                string                      `json:"run_created_by"`
+	WorkspaceID                 string                      `json:"workspace_id"`
+	WorkspaceName               string                      `json:"workspace_name"`
+	OrganizationName            string                      `json:"organization_name"`
+	Notifications               []*NotificationPayloadEvent `json:"notifications"`
+}
+
+// NotificationPayloadEvent represents a single event within a notification
+// payload.
+type NotificationPayloadEvent struct {
+	Message      string                  `json:"message"`
+	Trigger      NotificationTriggerType `json:"trigger"`
+	RunStatus    RunStatus               `json:"run_status"`
+	RunUpdatedAt time.Time               `json:"run_updated_at"`
+	RunUpdatedBy string                  `json:"run_updated_by"`
+}
+
+// DecodeNotificationPayload decodes a notification payload as delivered to
+// the URL of a generic notification configuration.
+func DecodeNotificationPayload(r io.Reader) (*NotificationPayload, error) {
+	p := &NotificationPayload{}
+	if err := json.NewDecoder(r).Decode(p); err != nil {
+		return nil, err
+	}
+	if p.PayloadVersion != NotificationPayloadVersion {
+		return nil, ErrUnsupportedNotificationPayloadVersion
+	}
+
+	return p, nil
+}
@@ -0,0 +1,58 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeNotificationPayload(t *testing.T) {
+	t.Run("with a run event", func(t *testing.T) {
+		p, err := DecodeNotificationPayload(strings.NewReader(`{
+			"payload_version": 1,
+			"notification_configuration_id": "nc-AeUQ2zfKZzW9TiGZ",
+			"run_url": "https://app.terraform.io/app/acme-org/my-workspace/runs/run-FwnENkvDnrpyFC7M",
+			"run_id": "run-FwnENkvDnrpyFC7M",
+			"run_message": "Add five new queue workers",
+			"run_created_at": "2019-01-25T18:34:00.000Z",
+			"run_created_by": "sample-user",
+			"workspace_id": "ws-XdeUVMWShTesDMME",
+			"workspace_name": "my-workspace",
+			"organization_name": "acme-org",
+			"notifications": [{
+				"message": "Run Canceled",
+				"trigger": "run:errored",
+				"run_status": "canceled",
+				"run_updated_at": "2019-01-25T18:37:04.000Z",
+				"run_updated_by": "sample-user"
+			}]
+		}`))
+		require.NoError(t, err)
+
+		assert.Equal(t, "run-FwnENkvDnrpyFC7M", p.RunID)
+		assert.Equal(t, "sample-user", p.RunCreatedBy)
+		assert.Equal(t, "ws-XdeUVMWShTesDMME", p.WorkspaceID)
+		assert.Equal(t, "acme-org", p.OrganizationName)
+		assert.Equal(t, 2019, p.RunCreatedAt.Year())
+		require.Len(t, p.Notifications, 1)
+
+		e := p.Notifications[0]
+		assert.Equal(t, NotificationTriggerErrored, e.Trigger)
+		assert.Equal(t, RunCanceled, e.RunStatus)
+	})
+
+	t.Run("with an unsupported payload version", func(t *testing.T) {
+		_, err := DecodeNotificationPayload(strings.NewReader(`{"payload_version": 2}`))
+		assert.Equal(t, ErrUnsupportedNotificationPayloadVersion, err)
+	})
+
+	t.Run("with an invalid payload", func(t *testing.T) {
+		_, err := DecodeNotificationPayload(strings.NewReader(`{`))
+		assert.Error(t, err)
+	})
+}
@@ -47,6 +47,8 @@ const (
 	ServiceProviderBitbucket           ServiceProviderType = "bitbucket_hosted"
 	// Bitbucket Server v5.4.0 and above
 	ServiceProviderBitbucketServer ServiceProviderType = "bitbucket_server"
+	// Bitbucket Data Center, which connects like Bitbucket Server
+	ServiceProviderBitbucketDataCenter ServiceProviderType = "bitbucket_data_center"
 	// Bitbucket Server v5.3.0 and below
 	ServiceProviderBitbucketServerLegacy ServiceProviderType = "bitbucket_server_legacy"
 	ServiceProviderGithub                ServiceProviderType = "github"
@@ -72,6 +74,7 @@ type OAuthClient struct {
 	CreatedAt           time.Time           `jsonapi:"attr,created-at,iso8601"`
 	HTTPURL             string              `jsonapi:"attr,http-url"`
 	Key                 string              `jsonapi:"attr,key"`
+	Name                *string             `jsonapi:"attr,name"`
 	RSAPublicKey        string              `jsonapi:"attr,rsa-public-key"`
 	Secret              string              `jsonapi:"attr,secret"`
 	ServiceProvider     ServiceProviderType `jsonapi:"attr,service-provider"`
@@ -173,7 +176,7 @@ func (s *oAuthClients) List(ctx context.Context, organization string, options *O
 	}
 
 	ocl := &OAuthClientList{}
-	err = s.client.do(ctx, req, ocl)
+	err = s.client.do(ctx, "oAuthClients.List", req, ocl)
 	if err != nil {
 		return nil, err
 	}
@@ -197,7 +200,7 @@ func (s *oAuthClients) Create(ctx context.Context, organization string, options
 	}
 
 	oc := &OAuthClient{}
-	err = s.client.do(ctx, req, oc)
+	err = s.client.do(ctx, "oAuthClients.Create", req, oc)
 	if err != nil {
 		return nil, err
 	}
@@ -218,7 +221,7 @@ func (s *oAuthClients) Read(ctx context.Context, oAuthClientID string) (*OAuthCl
 	}
 
 	oc := &OAuthClient{}
-	err = s.client.do(ctx, req, oc)
+	err = s.client.do(ctx, "oAuthClients.Read", req, oc)
 	if err != nil {
 		return nil, err
 	}
@@ -239,7 +242,7 @@ func (s *oAuthClients) Update(ctx context.Context, oAuthClientID string, options
 	}
 
 	oc := &OAuthClient{}
-	err = s.client.do(ctx, req, oc)
+	err = s.client.do(ctx, "oAuthClients.Update", req, oc)
 	if err != nil {
 		return nil, err
 	}
@@ -259,7 +262,7 @@ func (s *oAuthClients) Delete(ctx context.Context, oAuthClientID string) error {
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "oAuthClients.Delete", req, nil)
 }
 
 func (o OAuthClientCreateOptions) valid() error {
@@ -272,7 +275,7 @@ func (o OAuthClientCreateOptions) valid() error {
 	if o.ServiceProvider == nil {
 		return ErrRequiredServiceProvider
 	}
-	if !validString(o.OAuthToken) && *o.ServiceProvider != *ServiceProvider(ServiceProviderBitbucketServer) {
+	if !validString(o.OAuthToken) && !o.ServiceProvider.usesApplicationLink() {
 		return ErrRequiredOauthToken
 	}
 	if validString(o.PrivateKey) && *o.ServiceProvider != *ServiceProvider(ServiceProviderAzureDevOpsServer) {
@@ -281,6 +284,12 @@ func (o OAuthClientCreateOptions) valid() error {
 	return nil
 }
 
+// usesApplicationLink reports whether the VCS provider is connected with an
+// application link, using the RSA key pair instead of an OAuth token.
+func (t ServiceProviderType) usesApplicationLink() bool {
+	return t == ServiceProviderBitbucketServer || t == ServiceProviderBitbucketDataCenter
+}
+
 func (o *OAuthClientListOptions) valid() error {
 	if o == nil {
 		return nil // nothing to validate
@@ -96,6 +96,7 @@ func TestOAuthClientsCreate(t *testing.T) {
 
 	t.Run("with valid options", func(t *testing.T) {
 		options := OAuthClientCreateOptions{
+			Name:            String("GitHub"),
 			APIURL:          String("https://api.github.com"),
 			HTTPURL:         String("https://github.com"),
 			OAuthToken:      String(githubToken),
@@ -105,6 +106,8 @@ func TestOAuthClientsCreate(t *testing.T) {
 		oc, err := client.OAuthClients.Create(ctx, orgTest.Name, options)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, oc.ID)
+		require.NotNil(t, oc.Name)
+		assert.Equal(t, "GitHub", *oc.Name)
 		assert.Equal(t, "https://api.github.com", oc.APIURL)
 		assert.Equal(t, "https://github.com", oc.HTTPURL)
 		assert.Equal(t, 1, len(oc.OAuthTokens))
@@ -160,6 +163,20 @@ func TestOAuthClientsCreate(t *testing.T) {
 		assert.Equal(t, err, ErrRequiredOauthToken)
 	})
 
+	t.Run("without an OAuth token for an application link", func(t *testing.T) {
+		for _, sp := range []ServiceProviderType{ServiceProviderBitbucketServer, ServiceProviderBitbucketDataCenter} {
+			options := OAuthClientCreateOptions{
+				APIURL:          String("https://bitbucket.example.com"),
+				HTTPURL:         String("https://bitbucket.example.com"),
+				RSAPublicKey:    String("ssh-rsa AAAA"),
+				ServiceProvider: ServiceProvider(sp),
+			}
+
+			err := options.valid()
+			assert.Nil(t, err, sp)
+		}
+	})
+
 	t.Run("without a service provider", func(t *testing.T) {
 		options := OAuthClientCreateOptions{
 			APIURL:     String("https://api.github.com"),
@@ -18,6 +18,10 @@ var _ OAuthTokens = (*oAuthTokens)(nil)
 type OAuthTokens interface {
 	// List all the OAuth tokens for a given organization.
 	List(ctx context.Context, organization string, options *OAuthTokenListOptions) (*OAuthTokenList, error)
+
+	// ListForOAuthClient lists all the OAuth tokens of a given OAuth client.
+	ListForOAuthClient(ctx context.Context, oAuthClientID string, options *OAuthTokenListOptions) (*OAuthTokenList, error)
+
 	// Read a OAuth token by its ID.
 	Read(ctx context.Context, oAuthTokenID string) (*OAuthToken, error)
 
@@ -66,7 +70,8 @@ type OAuthTokenUpdateOptions struct {
 	// https://jsonapi.org/format/#crud-creating
 	Type string `jsonapi:"primary,oauth-tokens"`
 
-	// Optional: A private SSH key to be used for git clone operations.
+	// Optional: A private SSH key to be used for git clone operations. Setting
+	// it replaces the existing SSH key of the OAuth token, if any.
 	PrivateSSHKey *string `jsonapi:"attr,ssh-key,omitempty"`
 }
 
@@ -83,7 +88,28 @@ func (s *oAuthTokens) List(ctx context.Context, organization string, options *OA
 	}
 
 	otl := &OAuthTokenList{}
-	err = s.client.do(ctx, req, otl)
+	err = s.client.do(ctx, "oAuthTokens.List", req, otl)
+	if err != nil {
+		return nil, err
+	}
+
+	return otl, nil
+}
+
+// ListForOAuthClient lists all the OAuth tokens of a given OAuth client.
+func (s *oAuthTokens) ListForOAuthClient(ctx context.Context, oAuthClientID string, options *OAuthTokenListOptions) (*OAuthTokenList, error) {
+	if !validStringID(&oAuthClientID) {
+		return nil, ErrInvalidOauthClientID
+	}
+
+	u := fmt.Sprintf("oauth-clients/%s/oauth-tokens", url.QueryEscape(oAuthClientID))
+	req, err := s.client.newRequest("GET", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	otl := &OAuthTokenList{}
+	err = s.client.do(ctx, "oAuthTokens.ListForOAuthClient", req, otl)
 	if err != nil {
 		return nil, err
 	}
@@ -104,7 +130,7 @@ func (s *oAuthTokens) Read(ctx context.Context, oAuthTokenID string) (*OAuthToke
 	}
 
 	ot := &OAuthToken{}
-	err = s.client.do(ctx, req, ot)
+	err = s.client.do(ctx, "oAuthTokens.Read", req, ot)
 	if err != nil {
 		return nil, err
 	}
@@ -125,7 +151,7 @@ func (s *oAuthTokens) Update(ctx context.Context, oAuthTokenID string, options O
 	}
 
 	ot := &OAuthToken{}
-	err = s.client.do(ctx, req, ot)
+	err = s.client.do(ctx, "oAuthTokens.Update", req, ot)
 	if err != nil {
 		return nil, err
 	}
@@ -145,5 +171,5 @@ func (s *oAuthTokens) Delete(ctx context.Context, oAuthTokenID string) error {
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "oAuthTokens.Delete", req, nil)
 }
@@ -75,6 +75,41 @@ func TestOAuthTokensList(t *testing.T) {
 	})
 }
 
+func TestOAuthTokensListForOAuthClient(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	otTest, otTestCleanup := createOAuthToken(t, client, orgTest)
+	defer otTestCleanup()
+
+	ot, err := client.OAuthTokens.Read(ctx, otTest.ID)
+	require.NoError(t, err)
+	require.NotNil(t, ot.OAuthClient)
+
+	t.Run("when the OAuth client exists", func(t *testing.T) {
+		otl, err := client.OAuthTokens.ListForOAuthClient(ctx, ot.OAuthClient.ID, nil)
+		require.NoError(t, err)
+		require.Len(t, otl.Items, 1)
+		assert.Equal(t, otTest.ID, otl.Items[0].ID)
+		assert.Equal(t, otTest.HasSSHKey, otl.Items[0].HasSSHKey)
+	})
+
+	t.Run("when the OAuth client does not exist", func(t *testing.T) {
+		otl, err := client.OAuthTokens.ListForOAuthClient(ctx, "nonexisting", nil)
+		assert.Nil(t, otl)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("without a valid OAuth client ID", func(t *testing.T) {
+		otl, err := client.OAuthTokens.ListForOAuthClient(ctx, badIdentifier, nil)
+		assert.Nil(t, otl)
+		assert.EqualError(t, err, ErrInvalidOauthClientID.Error())
+	})
+}
+
 func TestOAuthTokensRead(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -0,0 +1,58 @@
+package tfe
+
+import (
+	"encoding/json"
+)
+
+// Optional represents an attribute of an update request which can be left
+// unchanged, explicitly cleared or set to a new value. Unlike a pointer, which
+// can only express "leave unchanged" (nil) or "set to value", it can also
+// express "clear this attribute", which is sent as null.
+//
+// The zero value leaves the attribute unchanged. Use NewOptional to set a
+// value and Null to clear the attribute.
+type Optional[T any] struct {
+	value     T
+	specified bool
+	null      bool
+}
+
+// NewOptional returns an Optional which sets the attribute to the given value.
+func NewOptional[T any](v T) Optional[T] {
+	return Optional[T]{value: v, specified: true}
+}
+
+// Null returns an Optional which explicitly clears the attribute.
+func Null[T any]() Optional[T] {
+	return Optional[T]{specified: true, null: true}
+}
+
+// IsSpecified returns true if the attribute should be changed, either by
+// setting or by clearing it.
+func (o Optional[T]) IsSpecified() bool {
+	return o.specified
+}
+
+// IsNull returns true if the attribute should be cleared.
+func (o Optional[T]) IsNull() bool {
+	return o.specified && o.null
+}
+
+// Get returns the value the attribute should be set to. The returned bool is
+// false if the attribute is left unchanged or cleared.
+func (o Optional[T]) Get() (T, bool) {
+	if !o.specified || o.null {
+		var zero T
+		return zero, false
+	}
+	return o.value, true
+}
+
+// MarshalJSON implements json.Marshaler. Unspecified attributes are omitted
+// from requests by their omitempty tag before reaching this method.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.specified || o.null {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
@@ -22,9 +22,18 @@ type Organizations interface {
 	// Create a new organization with the given options.
 	Create(ctx context.Context, options OrganizationCreateOptions) (*Organization, error)
 
+	// GetOrCreate creates an organization, or reads it when an organization
+	// with the same name already exists. It reports whether the organization
+	// was created.
+	GetOrCreate(ctx context.Context, options OrganizationCreateOptions) (*Organization, bool, error)
+
 	// Read an organization by its name.
 	Read(ctx context.Context, organization string) (*Organization, error)
 
+	// ReadWithOptions reads an organization by its name using the options
+	// supported.
+	ReadWithOptions(ctx context.Context, organization string, options *OrganizationReadOptions) (*Organization, error)
+
 	// Update attributes of an existing organization.
 	Update(ctx context.Context, organization string, options OrganizationUpdateOptions) (*Organization, error)
 
@@ -67,6 +76,7 @@ type Organization struct {
 	CollaboratorAuthPolicy                            AuthPolicyType           `jsonapi:"attr,collaborator-auth-policy"`
 	CostEstimationEnabled                             bool                     `jsonapi:"attr,cost-estimation-enabled"`
 	CreatedAt                                         time.Time                `jsonapi:"attr,created-at,iso8601"`
+	DefaultExecutionMode                              ExecutionModeType        `jsonapi:"attr,default-execution-mode"`
 	Email                                             string                   `jsonapi:"attr,email"`
 	ExternalID                                        string                   `jsonapi:"attr,external-id"`
 	OwnersTeamSAMLRoleID                              string                   `jsonapi:"attr,owners-team-saml-role-id"`
@@ -77,6 +87,11 @@ type Organization struct {
 	TrialExpiresAt                                    time.Time                `jsonapi:"attr,trial-expires-at,iso8601"`
 	TwoFactorConformant                               bool                     `jsonapi:"attr,two-factor-conformant"`
 	SendPassingStatusesForUntriggeredSpeculativePlans bool                     `jsonapi:"attr,send-passing-statuses-for-untriggered-speculative-plans"`
+
+	// Relations
+	DefaultAgentPool *AgentPool    `jsonapi:"relation,default-agent-pool"`
+	DefaultProject   *Project      `jsonapi:"relation,default-project"`
+	EntitlementSet   *Entitlements `jsonapi:"relation,entitlement-set"`
 }
 
 // Capacity represents the current run capacity of an organization.
@@ -91,14 +106,18 @@ type Entitlements struct {
 	ID                    string `jsonapi:"primary,entitlement-sets"`
 	Agents                bool   `jsonapi:"attr,agents"`
 	AuditLogging          bool   `jsonapi:"attr,audit-logging"`
+	ConfigurationDesigner bool   `jsonapi:"attr,configuration-designer"`
 	CostEstimation        bool   `jsonapi:"attr,cost-estimation"`
 	Operations            bool   `jsonapi:"attr,operations"`
 	PrivateModuleRegistry bool   `jsonapi:"attr,private-module-registry"`
 	RunTasks              bool   `jsonapi:"attr,run-tasks"`
+	SelfServeBilling      bool   `jsonapi:"attr,self-serve-billing"`
 	SSO                   bool   `jsonapi:"attr,sso"`
 	Sentinel              bool   `jsonapi:"attr,sentinel"`
 	StateStorage          bool   `jsonapi:"attr,state-storage"`
 	Teams                 bool   `jsonapi:"attr,teams"`
+	UsageReporting        bool   `jsonapi:"attr,usage-reporting"`
+	UserLimit             int    `jsonapi:"attr,user-limit"`
 	VCSIntegrations       bool   `jsonapi:"attr,vcs-integrations"`
 }
 
@@ -122,6 +141,22 @@ type OrganizationPermissions struct {
 	CanUpdateSentinel           bool `jsonapi:"attr,can-update-sentinel"`
 }
 
+// OrganizationIncludeOpt represents the available options for include query
+// params.
+// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/organizations#available-related-resources
+type OrganizationIncludeOpt string
+
+const (
+	OrganizationEntitlementSet OrganizationIncludeOpt = "entitlement-set"
+	OrganizationDefaultProject OrganizationIncludeOpt = "default-project"
+)
+
+// OrganizationReadOptions represents the options for reading an organization.
+type OrganizationReadOptions struct {
+	// Optional: A list of relations to include.
+	Include []OrganizationIncludeOpt `url:"include,omitempty"`
+}
+
 // OrganizationListOptions represents the options for listing organizations.
 type OrganizationListOptions struct {
 	ListOptions
@@ -153,7 +188,9 @@ type OrganizationCreateOptions struct {
 	// Optional: Enable Cost Estimation
 	CostEstimationEnabled *bool `jsonapi:"attr,cost-estimation-enabled,omitempty"`
 
-	// Optional: The name of the "owners" team
+	// Optional: The SAML role ID mapped to the "owners" team, used to grant
+	// owners team membership to SAML users. Only supported in Terraform
+	// Enterprise.
 	OwnersTeamSAMLRoleID *string `jsonapi:"attr,owners-team-saml-role-id,omitempty"`
 
 	// Optional: SendPassingStatusesForUntriggeredSpeculativePlans toggles behavior of untriggered speculative plans to send status updates to version control systems like GitHub.
@@ -186,11 +223,20 @@ type OrganizationUpdateOptions struct {
 	// Enable Cost Estimation
 	CostEstimationEnabled *bool `jsonapi:"attr,cost-estimation-enabled,omitempty"`
 
-	// The name of the "owners" team
+	// The SAML role ID mapped to the "owners" team. Only supported in
+	// Terraform Enterprise.
 	OwnersTeamSAMLRoleID *string `jsonapi:"attr,owners-team-saml-role-id,omitempty"`
 
 	// SendPassingStatusesForUntriggeredSpeculativePlans toggles behavior of untriggered speculative plans to send status updates to version control systems like GitHub.
 	SendPassingStatusesForUntriggeredSpeculativePlans *bool `jsonapi:"attr,send-passing-statuses-for-untriggered-speculative-plans,omitempty"`
+
+	// The execution mode inherited by workspaces which do not overwrite it.
+	// Valid values are remote, local, and agent.
+	DefaultExecutionMode *ExecutionModeType `jsonapi:"attr,default-execution-mode,omitempty"`
+
+	// The agent pool inherited by workspaces which do not overwrite it. It
+	// is required when the default execution mode is agent.
+	DefaultAgentPool *AgentPool `jsonapi:"relation,default-agent-pool,omitempty"`
 }
 
 // ReadRunQueueOptions represents the options for showing the queue.
@@ -206,7 +252,7 @@ func (s *organizations) List(ctx context.Context, options *OrganizationListOptio
 	}
 
 	orgl := &OrganizationList{}
-	err = s.client.do(ctx, req, orgl)
+	err = s.client.do(ctx, "organizations.List", req, orgl)
 	if err != nil {
 		return nil, err
 	}
@@ -226,7 +272,7 @@ func (s *organizations) Create(ctx context.Context, options OrganizationCreateOp
 	}
 
 	org := &Organization{}
-	err = s.client.do(ctx, req, org)
+	err = s.client.do(ctx, "organizations.Create", req, org)
 	if err != nil {
 		return nil, err
 	}
@@ -234,20 +280,55 @@ func (s *organizations) Create(ctx context.Context, options OrganizationCreateOp
 	return org, nil
 }
 
+// GetOrCreate creates an organization, or reads it when creating it failed
+// because an organization with the same name already exists. Creating first
+// makes it safe against concurrent callers creating the same organization.
+// The settings of an existing organization are not compared to the options.
+func (s *organizations) GetOrCreate(ctx context.Context, options OrganizationCreateOptions) (*Organization, bool, error) {
+	org, err := s.Create(ctx, options)
+	if err == nil {
+		return org, true, nil
+	}
+	if !isCreateConflict(err) {
+		return nil, false, err
+	}
+
+	org, err = s.Read(ctx, *options.Name)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return org, false, nil
+}
+
 // Read an organization by its name.
 func (s *organizations) Read(ctx context.Context, organization string) (*Organization, error) {
+	return s.readWithOptions(ctx, "organizations.Read", organization, nil)
+}
+
+// ReadWithOptions reads an organization by its name using the options
+// supported.
+func (s *organizations) ReadWithOptions(ctx context.Context, organization string, options *OrganizationReadOptions) (*Organization, error) {
+	return s.readWithOptions(ctx, "organizations.ReadWithOptions", organization, options)
+}
+
+// readWithOptions implements Read and ReadWithOptions, naming the API call op.
+func (s *organizations) readWithOptions(ctx context.Context, op, organization string, options *OrganizationReadOptions) (*Organization, error) {
 	if !validStringID(&organization) {
 		return nil, ErrInvalidOrg
 	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
 
 	u := fmt.Sprintf("organizations/%s", url.QueryEscape(organization))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("GET", u, options)
 	if err != nil {
 		return nil, err
 	}
 
 	org := &Organization{}
-	err = s.client.do(ctx, req, org)
+	err = s.client.do(ctx, op, req, org)
 	if err != nil {
 		return nil, err
 	}
@@ -261,6 +342,10 @@ func (s *organizations) Update(ctx context.Context, organization string, options
 		return nil, ErrInvalidOrg
 	}
 
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
 	u := fmt.Sprintf("organizations/%s", url.QueryEscape(organization))
 	req, err := s.client.newRequest("PATCH", u, &options)
 	if err != nil {
@@ -268,7 +353,7 @@ func (s *organizations) Update(ctx context.Context, organization string, options
 	}
 
 	org := &Organization{}
-	err = s.client.do(ctx, req, org)
+	err = s.client.do(ctx, "organizations.Update", req, org)
 	if err != nil {
 		return nil, err
 	}
@@ -288,7 +373,7 @@ func (s *organizations) Delete(ctx context.Context, organization string) error {
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "organizations.Delete", req, nil)
 }
 
 // ReadCapacity shows the currently used capacity of an organization.
@@ -304,7 +389,7 @@ func (s *organizations) ReadCapacity(ctx context.Context, organization string) (
 	}
 
 	c := &Capacity{}
-	err = s.client.do(ctx, req, c)
+	err = s.client.do(ctx, "organizations.ReadCapacity", req, c)
 	if err != nil {
 		return nil, err
 	}
@@ -312,7 +397,8 @@ func (s *organizations) ReadCapacity(ctx context.Context, organization string) (
 	return c, nil
 }
 
-// ReadEntitlements shows the entitlements of an organization.
+// ReadEntitlements shows the entitlements of an organization, and caches them
+// to report requests failing for a missing entitlement as ErrFeatureNotEntitled.
 func (s *organizations) ReadEntitlements(ctx context.Context, organization string) (*Entitlements, error) {
 	if !validStringID(&organization) {
 		return nil, ErrInvalidOrg
@@ -325,11 +411,15 @@ func (s *organizations) ReadEntitlements(ctx context.Context, organization strin
 	}
 
 	e := &Entitlements{}
-	err = s.client.do(ctx, req, e)
+	err = s.client.do(ctx, "organizations.ReadEntitlements", req, e)
 	if err != nil {
 		return nil, err
 	}
 
+	// Cache the entitlements to report failed requests of the organization
+	// as ErrFeatureNotEntitled.
+	s.client.entitlements.put(organization, e, s.client.clock.Now())
+
 	return e, nil
 }
 
@@ -346,7 +436,7 @@ func (s *organizations) ReadRunQueue(ctx context.Context, organization string, o
 	}
 
 	rq := &RunQueue{}
-	err = s.client.do(ctx, req, rq)
+	err = s.client.do(ctx, "organizations.ReadRunQueue", req, rq)
 	if err != nil {
 		return nil, err
 	}
@@ -364,5 +454,49 @@ func (o OrganizationCreateOptions) valid() error {
 	if !validString(o.Email) {
 		return ErrRequiredEmail
 	}
+	if o.CollaboratorAuthPolicy != nil && !o.CollaboratorAuthPolicy.valid() {
+		return ErrInvalidAuthPolicy
+	}
 	return nil
 }
+
+func (o OrganizationUpdateOptions) valid() error {
+	if o.CollaboratorAuthPolicy != nil && !o.CollaboratorAuthPolicy.valid() {
+		return ErrInvalidAuthPolicy
+	}
+	if o.DefaultExecutionMode != nil && !o.DefaultExecutionMode.valid() {
+		return ErrInvalidExecutionMode
+	}
+	if o.DefaultAgentPool != nil && (o.DefaultExecutionMode == nil || *o.DefaultExecutionMode != ExecutionModeAgent) {
+		return ErrRequiredAgentMode
+	}
+	if o.DefaultAgentPool == nil && (o.DefaultExecutionMode != nil && *o.DefaultExecutionMode == ExecutionModeAgent) {
+		return ErrRequiredAgentPoolID
+	}
+	return nil
+}
+
+func (o *OrganizationReadOptions) valid() error {
+	if o == nil {
+		return nil // nothing to validate
+	}
+
+	for _, i := range o.Include {
+		switch i {
+		case OrganizationEntitlementSet, OrganizationDefaultProject:
+			// do nothing
+		default:
+			return ErrInvalidIncludeValue
+		}
+	}
+
+	return nil
+}
+
+func (p AuthPolicyType) valid() bool {
+	switch p {
+	case AuthPolicyPassword, AuthPolicyTwoFactor:
+		return true
+	}
+	return false
+}
@@ -125,6 +125,27 @@ func TestOrganizationsRead(t *testing.T) {
 		})
 	})
 
+	t.Run("with included relations", func(t *testing.T) {
+		org, err := client.Organizations.ReadWithOptions(ctx, orgTest.Name, &OrganizationReadOptions{
+			Include: []OrganizationIncludeOpt{OrganizationEntitlementSet, OrganizationDefaultProject},
+		})
+		require.NoError(t, err)
+
+		require.NotNil(t, org.EntitlementSet)
+		assert.NotEmpty(t, org.EntitlementSet.ID)
+		assert.True(t, org.EntitlementSet.StateStorage)
+
+		require.NotNil(t, org.DefaultProject)
+		assert.NotEmpty(t, org.DefaultProject.Name)
+	})
+
+	t.Run("with an invalid include", func(t *testing.T) {
+		_, err := client.Organizations.ReadWithOptions(ctx, orgTest.Name, &OrganizationReadOptions{
+			Include: []OrganizationIncludeOpt{"workspaces"},
+		})
+		assert.Equal(t, ErrInvalidIncludeValue, err)
+	})
+
 	t.Run("with invalid name", func(t *testing.T) {
 		org, err := client.Organizations.Read(ctx, badIdentifier)
 		assert.Nil(t, org)
@@ -157,6 +178,26 @@ func TestOrganizationsUpdate(t *testing.T) {
 		assert.Equal(t, false, org.SendPassingStatusesForUntriggeredSpeculativePlans)
 	})
 
+	t.Run("with TFE-only options", func(t *testing.T) {
+		skipIfCloud(t)
+
+		orgTest, orgTestCleanup := createOrganization(t, client)
+		t.Cleanup(orgTestCleanup)
+
+		options := OrganizationUpdateOptions{
+			OwnersTeamSAMLRoleID: String("tfe-owners"),
+		}
+
+		org, err := client.Organizations.Update(ctx, orgTest.Name, options)
+		require.NoError(t, err)
+		assert.Equal(t, "tfe-owners", org.OwnersTeamSAMLRoleID)
+
+		refreshed, err := client.Organizations.Read(ctx, orgTest.Name)
+		require.NoError(t, err)
+		assert.Equal(t, "tfe-owners", refreshed.OwnersTeamSAMLRoleID)
+		assert.Equal(t, org.SAMLEnabled, refreshed.SAMLEnabled)
+	})
+
 	t.Run("with valid options", func(t *testing.T) {
 		orgTest, orgTestCleanup := createOrganization(t, client)
 
@@ -433,11 +474,27 @@ func TestOrganization_Unmarshal(t *testing.T) {
 				"collaborator-auth-policy": AuthPolicyPassword,
 				"cost-estimation-enabled":  true,
 				"created-at":               "2018-03-02T23:42:06.651Z",
+				"default-execution-mode":   "agent",
 				"email":                    "test@hashicorp.com",
 				"permissions": map[string]interface{}{
 					"can-create-team": true,
 				},
 			},
+			"relationships": map[string]interface{}{
+				"default-agent-pool": map[string]interface{}{
+					"data": map[string]interface{}{"id": "apool-1", "type": "agent-pools"},
+				},
+				"default-project": map[string]interface{}{
+					"data": map[string]interface{}{"id": "prj-1", "type": "projects"},
+				},
+			},
+		},
+		"included": []interface{}{
+			map[string]interface{}{
+				"type":       "projects",
+				"id":         "prj-1",
+				"attributes": map[string]interface{}{"name": "Default Project"},
+			},
 		},
 	}
 	byteData, err := json.Marshal(data)
@@ -458,6 +515,52 @@ func TestOrganization_Unmarshal(t *testing.T) {
 	assert.Equal(t, org.Email, "test@hashicorp.com")
 	assert.NotEmpty(t, org.Permissions)
 	assert.Equal(t, org.Permissions.CanCreateTeam, true)
+	assert.Equal(t, ExecutionModeAgent, org.DefaultExecutionMode)
+	require.NotNil(t, org.DefaultAgentPool)
+	assert.Equal(t, "apool-1", org.DefaultAgentPool.ID)
+	require.NotNil(t, org.DefaultProject)
+	assert.Equal(t, "prj-1", org.DefaultProject.ID)
+	assert.Equal(t, "Default Project", org.DefaultProject.Name)
+}
+
+func TestOrganizationUpdateOptions_valid(t *testing.T) {
+	for name, tc := range map[string]struct {
+		options OrganizationUpdateOptions
+		err     error
+	}{
+		"without a default execution mode": {
+			options: OrganizationUpdateOptions{},
+		},
+		"with the remote default execution mode": {
+			options: OrganizationUpdateOptions{DefaultExecutionMode: ExecutionMode(ExecutionModeRemote)},
+		},
+		"with the agent default execution mode and an agent pool": {
+			options: OrganizationUpdateOptions{
+				DefaultExecutionMode: ExecutionMode(ExecutionModeAgent),
+				DefaultAgentPool:     &AgentPool{ID: "apool-1"},
+			},
+		},
+		"with the agent default execution mode and no agent pool": {
+			options: OrganizationUpdateOptions{DefaultExecutionMode: ExecutionMode(ExecutionModeAgent)},
+			err:     ErrRequiredAgentPoolID,
+		},
+		"with an invalid default execution mode": {
+			options: OrganizationUpdateOptions{DefaultExecutionMode: ExecutionMode("cloud")},
+			err:     ErrInvalidExecutionMode,
+		},
+		"with an invalid collaborator auth policy": {
+			options: OrganizationUpdateOptions{CollaboratorAuthPolicy: AuthPolicy("sso")},
+			err:     ErrInvalidAuthPolicy,
+		},
+		"with an agent pool and no agent default execution mode": {
+			options: OrganizationUpdateOptions{DefaultAgentPool: &AgentPool{ID: "apool-1"}},
+			err:     ErrRequiredAgentMode,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.err, tc.options.valid())
+		})
+	}
 }
 
 func TestOrganizationsReadRunTasksPermission(t *testing.T) {
@@ -501,3 +604,37 @@ func TestOrganizationsReadRunTasksEntitlement(t *testing.T) {
 		assert.True(t, entitlements.RunTasks)
 	})
 }
+
+func TestOrganizationsEntitlements_Unmarshal(t *testing.T) {
+	data := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "entitlement-sets",
+			"id":   "org-cfg-ent",
+			"attributes": map[string]interface{}{
+				"agents":                  true,
+				"configuration-designer":  true,
+				"operations":              true,
+				"private-module-registry": true,
+				"self-serve-billing":      false,
+				"usage-reporting":         true,
+				"user-limit":              5,
+			},
+		},
+	}
+	byteData, err := json.Marshal(data)
+	require.NoError(t, err)
+
+	entitlements := &Entitlements{}
+	responseBody := bytes.NewReader(byteData)
+	err = unmarshalResponse(responseBody, entitlements)
+	require.NoError(t, err)
+
+	assert.Equal(t, "org-cfg-ent", entitlements.ID)
+	assert.True(t, entitlements.Agents)
+	assert.True(t, entitlements.ConfigurationDesigner)
+	assert.True(t, entitlements.Operations)
+	assert.True(t, entitlements.PrivateModuleRegistry)
+	assert.False(t, entitlements.SelfServeBilling)
+	assert.True(t, entitlements.UsageReporting)
+	assert.Equal(t, 5, entitlements.UserLimit)
+}
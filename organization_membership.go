@@ -117,7 +117,7 @@ func (s *organizationMemberships) List(ctx context.Context, organization string,
 	}
 
 	ml := &OrganizationMembershipList{}
-	err = s.client.do(ctx, req, ml)
+	err = s.client.do(ctx, "organizationMemberships.List", req, ml)
 	if err != nil {
 		return nil, err
 	}
@@ -141,7 +141,7 @@ func (s *organizationMemberships) Create(ctx context.Context, organization strin
 	}
 
 	m := &OrganizationMembership{}
-	err = s.client.do(ctx, req, m)
+	err = s.client.do(ctx, "organizationMemberships.Create", req, m)
 	if err != nil {
 		return nil, err
 	}
@@ -151,11 +151,16 @@ func (s *organizationMemberships) Create(ctx context.Context, organization strin
 
 // Read an organization membership by its ID.
 func (s *organizationMemberships) Read(ctx context.Context, organizationMembershipID string) (*OrganizationMembership, error) {
-	return s.ReadWithOptions(ctx, organizationMembershipID, OrganizationMembershipReadOptions{})
+	return s.readWithOptions(ctx, "organizationMemberships.Read", organizationMembershipID, OrganizationMembershipReadOptions{})
 }
 
 // Read an organization membership by ID with options
 func (s *organizationMemberships) ReadWithOptions(ctx context.Context, organizationMembershipID string, options OrganizationMembershipReadOptions) (*OrganizationMembership, error) {
+	return s.readWithOptions(ctx, "organizationMemberships.ReadWithOptions", organizationMembershipID, options)
+}
+
+// readWithOptions implements Read and ReadWithOptions, naming the API call op.
+func (s *organizationMemberships) readWithOptions(ctx context.Context, op, organizationMembershipID string, options OrganizationMembershipReadOptions) (*OrganizationMembership, error) {
 	if !validStringID(&organizationMembershipID) {
 		return nil, ErrInvalidMembership
 	}
@@ -170,7 +175,7 @@ func (s *organizationMemberships) ReadWithOptions(ctx context.Context, organizat
 	}
 
 	mem := &OrganizationMembership{}
-	err = s.client.do(ctx, req, mem)
+	err = s.client.do(ctx, op, req, mem)
 	if err != nil {
 		return nil, err
 	}
@@ -190,7 +195,7 @@ func (s *organizationMemberships) Delete(ctx context.Context, organizationMember
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "organizationMemberships.Delete", req, nil)
 }
 
 func (o OrganizationMembershipCreateOptions) valid() error {
@@ -9,7 +9,7 @@ import (
 
 var _ OrganizationTags = (*organizationTags)(nil)
 
-// OrganizationMemberships describes all the list of tags used with all resources across the organization.
+// OrganizationTags describes all the list of tags used with all resources across the organization.
 //
 // TFE API docs:
 // https://www.terraform.io/cloud-docs/api-docs/organization-tags
@@ -20,8 +20,8 @@ type OrganizationTags interface {
 	// Delete tags from an organization
 	Delete(ctx context.Context, organization string, options OrganizationTagsDeleteOptions) error
 
-	// Associate an organization's workspace with a tag
-	AddWorkspaces(ctx context.Context, tag string, options AddWorkspacesToTagOptions) error
+	// Associate an organization's workspaces with a tag
+	AddWorkspaces(ctx context.Context, tagID string, options AddWorkspacesToTagOptions) error
 }
 
 // organizationTags implements OrganizationTags.
@@ -51,8 +51,13 @@ type OrganizationTag struct {
 // OrganizationTagsListOptions represents the options for listing organization tags
 type OrganizationTagsListOptions struct {
 	ListOptions
-	// Optional:
+
+	// Optional: The ID of a workspace whose tags are omitted from the
+	// results, e.g. to list the tags which can still be added to it.
 	Filter string `url:"filter[exclude][taggable][id],omitempty"`
+
+	// Optional: A search query string. Tags are searchable by name likeness.
+	Query string `url:"q,omitempty"`
 }
 
 // OrganizationTagsDeleteOptions represents the request body for deleting a tag in an organization
@@ -88,7 +93,7 @@ func (s *organizationTags) List(ctx context.Context, organization string, option
 	}
 
 	tags := &OrganizationTagsList{}
-	err = s.client.do(ctx, req, tags)
+	err = s.client.do(ctx, "organizationTags.List", req, tags)
 	if err != nil {
 		return nil, err
 	}
@@ -117,12 +122,12 @@ func (s *organizationTags) Delete(ctx context.Context, organization string, opti
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "organizationTags.Delete", req, nil)
 }
 
 // Add workspaces to a tag
-func (s *organizationTags) AddWorkspaces(ctx context.Context, tag string, options AddWorkspacesToTagOptions) error {
-	if !validStringID(&tag) {
+func (s *organizationTags) AddWorkspaces(ctx context.Context, tagID string, options AddWorkspacesToTagOptions) error {
+	if !validStringID(&tagID) {
 		return ErrInvalidTag
 	}
 
@@ -135,13 +140,13 @@ func (s *organizationTags) AddWorkspaces(ctx context.Context, tag string, option
 		workspaces = append(workspaces, &workspaceID{ID: id})
 	}
 
-	u := fmt.Sprintf("tags/%s/relationships/workspaces", url.QueryEscape(tag))
+	u := fmt.Sprintf("tags/%s/relationships/workspaces", url.QueryEscape(tagID))
 	req, err := s.client.newRequest("POST", u, workspaces)
 	if err != nil {
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "organizationTags.AddWorkspaces", req, nil)
 }
 
 func (opts *OrganizationTagsDeleteOptions) valid() error {
@@ -78,6 +78,24 @@ func TestOrganizationTagsList(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("with a search query", func(t *testing.T) {
+		tags, err := client.OrganizationTags.List(ctx, orgTest.Name, &OrganizationTagsListOptions{
+			Query: "tag1",
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, 1, len(tags.Items))
+		assert.Equal(t, "tag1", tags.Items[0].Name)
+	})
+
+	t.Run("when excluding the tags of a workspace", func(t *testing.T) {
+		tags, err := client.OrganizationTags.List(ctx, orgTest.Name, &OrganizationTagsListOptions{
+			Filter: workspaceTest.ID,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, tags.Items)
+	})
 }
 
 func TestOrganizationTagsDelete(t *testing.T) {
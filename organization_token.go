@@ -19,11 +19,21 @@ type OrganizationTokens interface {
 	// Create a new organization token, replacing any existing token.
 	Create(ctx context.Context, organization string) (*OrganizationToken, error)
 
+	// CreateWithOptions creates a new organization token with options,
+	// replacing any existing token.
+	CreateWithOptions(ctx context.Context, organization string, options OrganizationTokenCreateOptions) (*OrganizationToken, error)
+
 	// Read an organization token.
 	Read(ctx context.Context, organization string) (*OrganizationToken, error)
 
+	// ReadWithOptions reads an organization token of the given type.
+	ReadWithOptions(ctx context.Context, organization string, options OrganizationTokenReadOptions) (*OrganizationToken, error)
+
 	// Delete an organization token.
 	Delete(ctx context.Context, organization string) error
+
+	// DeleteWithOptions deletes an organization token of the given type.
+	DeleteWithOptions(ctx context.Context, organization string, options OrganizationTokenDeleteOptions) error
 }
 
 // organizationTokens implements OrganizationTokens.
@@ -36,10 +46,53 @@ type OrganizationToken struct {
 	ID          string    `jsonapi:"primary,authentication-tokens"`
 	CreatedAt   time.Time `jsonapi:"attr,created-at,iso8601"`
 	Description string    `jsonapi:"attr,description"`
+	ExpiredAt   time.Time `jsonapi:"attr,expired-at,iso8601"`
 	LastUsedAt  time.Time `jsonapi:"attr,last-used-at,iso8601"`
 	Token       string    `jsonapi:"attr,token"`
 }
 
+// TokenType represents the type of an organization token.
+type TokenType string
+
+// List of available organization token types.
+const (
+	// AuditTrailToken is a token which can only read the audit trail of the
+	// organization, for feeding it into log pipelines.
+	AuditTrailToken TokenType = "audit-trails"
+)
+
+// OrganizationTokenCreateOptions represents the options for creating an
+// organization token.
+type OrganizationTokenCreateOptions struct {
+	// Type is a public field utilized by JSON:API to
+	// set the resource type via the field tag.
+	// It is not a user-defined value and does not need to be set.
+	// https://jsonapi.org/format/#crud-creating
+	Type string `jsonapi:"primary,authentication-tokens"`
+
+	// Optional: The time the token expires at. Tokens without an expiry
+	// do not expire.
+	ExpiredAt *time.Time `jsonapi:"attr,expired-at,iso8601,omitempty"`
+
+	// Optional: The type of the token. It defaults to a token with access to
+	// the organization API.
+	TokenType *TokenType
+}
+
+// OrganizationTokenReadOptions represents the options for reading an
+// organization token.
+type OrganizationTokenReadOptions struct {
+	// Optional: The type of the token to read.
+	TokenType *TokenType `url:"token,omitempty"`
+}
+
+// OrganizationTokenDeleteOptions represents the options for deleting an
+// organization token.
+type OrganizationTokenDeleteOptions struct {
+	// Optional: The type of the token to delete.
+	TokenType *TokenType
+}
+
 // Create a new organization token, replacing any existing token.
 func (s *organizationTokens) Create(ctx context.Context, organization string) (*OrganizationToken, error) {
 	if !validStringID(&organization) {
@@ -53,7 +106,29 @@ func (s *organizationTokens) Create(ctx context.Context, organization string) (*
 	}
 
 	ot := &OrganizationToken{}
-	err = s.client.do(ctx, req, ot)
+	err = s.client.do(ctx, "organizationTokens.Create", req, ot)
+	if err != nil {
+		return nil, err
+	}
+
+	return ot, err
+}
+
+// CreateWithOptions creates a new organization token with options, replacing
+// any existing token.
+func (s *organizationTokens) CreateWithOptions(ctx context.Context, organization string, options OrganizationTokenCreateOptions) (*OrganizationToken, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+
+	u := organizationTokenURL(organization, options.TokenType)
+	req, err := s.client.newRequest("POST", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	ot := &OrganizationToken{}
+	err = s.client.do(ctx, "organizationTokens.CreateWithOptions", req, ot)
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +149,7 @@ func (s *organizationTokens) Read(ctx context.Context, organization string) (*Or
 	}
 
 	ot := &OrganizationToken{}
-	err = s.client.do(ctx, req, ot)
+	err = s.client.do(ctx, "organizationTokens.Read", req, ot)
 	if err != nil {
 		return nil, err
 	}
@@ -94,5 +169,52 @@ func (s *organizationTokens) Delete(ctx context.Context, organization string) er
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "organizationTokens.Delete", req, nil)
+}
+
+// ReadWithOptions reads an organization token of the given type.
+func (s *organizationTokens) ReadWithOptions(ctx context.Context, organization string, options OrganizationTokenReadOptions) (*OrganizationToken, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+
+	u := fmt.Sprintf("organizations/%s/authentication-token", url.QueryEscape(organization))
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	ot := &OrganizationToken{}
+	err = s.client.do(ctx, "organizationTokens.ReadWithOptions", req, ot)
+	if err != nil {
+		return nil, err
+	}
+
+	return ot, err
+}
+
+// DeleteWithOptions deletes an organization token of the given type.
+func (s *organizationTokens) DeleteWithOptions(ctx context.Context, organization string, options OrganizationTokenDeleteOptions) error {
+	if !validStringID(&organization) {
+		return ErrInvalidOrg
+	}
+
+	u := organizationTokenURL(organization, options.TokenType)
+	req, err := s.client.newRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.client.do(ctx, "organizationTokens.DeleteWithOptions", req, nil)
+}
+
+// organizationTokenURL returns the URL of the organization token of the given
+// type, which is passed as a query parameter as the request body is the token
+// itself.
+func organizationTokenURL(organization string, tokenType *TokenType) string {
+	u := fmt.Sprintf("organizations/%s/authentication-token", url.QueryEscape(organization))
+	if tokenType != nil {
+		u += "?token=" + url.QueryEscape(string(*tokenType))
+	}
+	return u
 }
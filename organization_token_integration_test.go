@@ -6,6 +6,7 @@ package tfe
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -40,6 +41,30 @@ func TestOrganizationTokensCreate(t *testing.T) {
 	})
 }
 
+func TestOrganizationTokensCreateWithOptions(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	t.Run("with an expiry", func(t *testing.T) {
+		expiredAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+		ot, err := client.OrganizationTokens.CreateWithOptions(ctx, orgTest.Name, OrganizationTokenCreateOptions{
+			ExpiredAt: &expiredAt,
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, ot.Token)
+		assert.True(t, expiredAt.Equal(ot.ExpiredAt))
+	})
+
+	t.Run("without valid organization", func(t *testing.T) {
+		ot, err := client.OrganizationTokens.CreateWithOptions(ctx, badIdentifier, OrganizationTokenCreateOptions{})
+		assert.Nil(t, ot)
+		assert.EqualError(t, err, ErrInvalidOrg.Error())
+	})
+}
+
 func TestOrganizationTokensRead(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
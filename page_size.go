@@ -0,0 +1,153 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+// MaxPageSize is the maximum number of elements the API returns in a single
+// page of a list.
+const MaxPageSize = 100
+
+// PageSizePolicy determines how list requests with a PageSize above
+// MaxPageSize are handled.
+type PageSizePolicy int
+
+const (
+	// PageSizeClamp clamps the page size to MaxPageSize, like the API does.
+	// The pagination of the result is based on MaxPageSize. This is the
+	// default.
+	PageSizeClamp PageSizePolicy = iota
+
+	// PageSizeStrict fails list requests with an error wrapping
+	// ErrInvalidPageSize.
+	PageSizeStrict
+
+	// PageSizeChunked requests the page in chunks of MaxPageSize elements
+	// and returns them as a single page of the requested size. The
+	// pagination of the result is based on the requested page size.
+	PageSizeChunked
+)
+
+// isListModel reports whether v is a list model, which is decoded into its
+// Items and Pagination fields.
+func isListModel(v interface{}) bool {
+	if v == nil {
+		return false
+	}
+	dst := reflect.Indirect(reflect.ValueOf(v))
+	if dst.Kind() != reflect.Struct {
+		return false
+	}
+	items := dst.FieldByName("Items")
+	pagination := dst.FieldByName("Pagination")
+	return items.IsValid() && items.Kind() == reflect.Slice &&
+		pagination.IsValid() && pagination.Type() == reflect.TypeOf(&Pagination{})
+}
+
+// doList performs a list request, applying the page size policy of the
+// client when the requested page size exceeds MaxPageSize.
+func (c *Client) doList(ctx context.Context, op string, req *retryablehttp.Request, v interface{}) error {
+	q := req.URL.Query()
+	size, _ := strconv.Atoi(q.Get("page[size]"))
+	if size <= MaxPageSize {
+		return c.doOnce(ctx, op, req, v)
+	}
+
+	switch c.pageSizePolicy {
+	case PageSizeStrict:
+		return fmt.Errorf("%w: %d exceeds the maximum of %d", ErrInvalidPageSize, size, MaxPageSize)
+	case PageSizeChunked:
+		return c.doChunked(ctx, op, req, v, size)
+	default:
+		q.Set("page[size]", strconv.Itoa(MaxPageSize))
+		req.URL.RawQuery = encodeQueryParams(q)
+		return c.doOnce(ctx, op, req, v)
+	}
+}
+
+// doChunked requests the page of the given size in chunks of MaxPageSize
+// elements, and decodes them into the list model v as a single page.
+func (c *Client) doChunked(ctx context.Context, op string, req *retryablehttp.Request, v interface{}, size int) error {
+	q := req.URL.Query()
+	number, _ := strconv.Atoi(q.Get("page[number]"))
+	if number < 1 {
+		number = 1
+	}
+
+	dst := reflect.Indirect(reflect.ValueOf(v))
+	items := reflect.MakeSlice(dst.FieldByName("Items").Type(), 0, size)
+
+	// The elements of the requested page, by their offset in the list.
+	start := (number - 1) * size
+	end := start + size
+
+	total := 0
+	for offset := start; offset < end; {
+		page := offset/MaxPageSize + 1
+
+		q.Set("page[number]", strconv.Itoa(page))
+		q.Set("page[size]", strconv.Itoa(MaxPageSize))
+		u := *req.URL
+		u.RawQuery = encodeQueryParams(q)
+
+		chunkReq, err := retryablehttp.NewRequest(req.Method, u.String(), nil)
+		if err != nil {
+			return err
+		}
+		chunkReq.Header = req.Header.Clone()
+
+		chunk := reflect.New(dst.Type())
+		if err := c.doOnce(ctx, op, chunkReq, chunk.Interface()); err != nil {
+			return err
+		}
+
+		chunkItems := chunk.Elem().FieldByName("Items")
+		first := offset - (page-1)*MaxPageSize
+		last := end - (page-1)*MaxPageSize
+		if last > chunkItems.Len() {
+			last = chunkItems.Len()
+		}
+		if first < last {
+			items = reflect.AppendSlice(items, chunkItems.Slice(first, last))
+		}
+
+		// Keep any other fields of the list, like metadata.
+		dst.Set(chunk.Elem())
+
+		pagination := chunk.Elem().FieldByName("Pagination").Interface().(*Pagination)
+		if pagination == nil || pagination.NextPage == 0 {
+			if pagination != nil {
+				total = pagination.TotalCount
+			}
+			break
+		}
+		total = pagination.TotalCount
+		offset = page * MaxPageSize
+	}
+
+	pages := (total + size - 1) / size
+	if pages == 0 {
+		pages = 1
+	}
+	pagination := &Pagination{
+		CurrentPage: number,
+		TotalPages:  pages,
+		TotalCount:  total,
+	}
+	if number > 1 {
+		pagination.PreviousPage = number - 1
+	}
+	if number < pages {
+		pagination.NextPage = number + 1
+	}
+
+	dst.FieldByName("Items").Set(items)
+	dst.FieldByName("Pagination").Set(reflect.ValueOf(pagination))
+
+	return nil
+}
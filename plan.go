@@ -3,6 +3,7 @@ package tfe
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/url"
@@ -23,8 +24,20 @@ type Plans interface {
 	// Logs retrieves the logs of a plan.
 	Logs(ctx context.Context, planID string) (io.Reader, error)
 
+	// LogURL reads only the log read URL of a plan, without the rest of
+	// the plan. The URL expires shortly, use LogURLExists to check whether it
+	// can still be used.
+	LogURL(ctx context.Context, planID string) (string, error)
+
+	// LogURLExists reports whether the log can still be read from the given
+	// log read URL, using a HEAD request.
+	LogURLExists(ctx context.Context, logURL string) (bool, error)
+
 	// Retrieve the JSON execution plan
 	ReadJSONOutput(ctx context.Context, planID string) ([]byte, error)
+
+	// ReadJSONPlan retrieves and decodes the JSON execution plan.
+	ReadJSONPlan(ctx context.Context, planID string) (*PlanJSONOutput, error)
 }
 
 // plans implements Plans.
@@ -61,6 +74,9 @@ type Plan struct {
 
 	// Relations
 	Exports []*PlanExport `jsonapi:"relation,exports"`
+
+	// Links
+	Links map[string]interface{} `jsonapi:"links,omitempty"`
 }
 
 // PlanStatusTimestamps holds the timestamps for individual plan statuses.
@@ -86,7 +102,7 @@ func (s *plans) Read(ctx context.Context, planID string) (*Plan, error) {
 	}
 
 	p := &Plan{}
-	err = s.client.do(ctx, req, p)
+	err = s.client.do(ctx, "plans.Read", req, p)
 	if err != nil {
 		return nil, err
 	}
@@ -151,10 +167,57 @@ func (s *plans) ReadJSONOutput(ctx context.Context, planID string) ([]byte, erro
 	}
 
 	var buf bytes.Buffer
-	err = s.client.do(ctx, req, &buf)
+	err = s.client.do(ctx, "plans.ReadJSONOutput", req, &buf)
 	if err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
+
+// ReadJSONPlan retrieves and decodes the JSON execution plan.
+func (s *plans) ReadJSONPlan(ctx context.Context, planID string) (*PlanJSONOutput, error) {
+	b, err := s.ReadJSONOutput(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &PlanJSONOutput{}
+	if err := json.Unmarshal(b, p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// LogURL reads only the log read URL of a plan.
+func (s *plans) LogURL(ctx context.Context, planID string) (string, error) {
+	if !validStringID(&planID) {
+		return "", ErrInvalidPlanID
+	}
+
+	// Use a sparse fieldset to only retrieve the log read URL.
+	u := fmt.Sprintf("plans/%s?fields[plans]=log-read-url", url.QueryEscape(planID))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return "", err
+	}
+
+	p := &Plan{}
+	err = s.client.do(ctx, "plans.LogURL", req, p)
+	if err != nil {
+		return "", err
+	}
+
+	if p.LogReadURL == "" {
+		return "", fmt.Errorf("plan %s does not have a log URL", planID)
+	}
+
+	return p.LogReadURL, nil
+}
+
+// LogURLExists reports whether the log can still be read from the log read
+// URL.
+func (s *plans) LogURLExists(ctx context.Context, logURL string) (bool, error) {
+	return s.client.logURLExists(ctx, "plans.LogURLExists", logURL)
+}
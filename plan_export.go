@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/url"
 	"time"
 )
@@ -19,6 +20,10 @@ type PlanExports interface {
 	// Export a plan by its ID with the given options.
 	Create(ctx context.Context, options PlanExportCreateOptions) (*PlanExport, error)
 
+	// CreateAndWait exports a plan, waits until the export has finished, and
+	// downloads its data into the writer.
+	CreateAndWait(ctx context.Context, options PlanExportCreateOptions, w io.Writer) (*PlanExport, error)
+
 	// Read a plan export by its ID.
 	Read(ctx context.Context, planExportID string) (*PlanExport, error)
 
@@ -27,6 +32,10 @@ type PlanExports interface {
 
 	// Download the data of an plan export.
 	Download(ctx context.Context, planExportID string) ([]byte, error)
+
+	// DownloadWithOptions downloads the data of a plan export into the
+	// writer, verifying its checksum and reporting the progress.
+	DownloadWithOptions(ctx context.Context, planExportID string, w io.Writer, options *SignedURLDownloadOptions) error
 }
 
 // planExports implements PlanExports.
@@ -34,7 +43,9 @@ type planExports struct {
 	client *Client
 }
 
-// PlanExportDataType represents the type of data exported from a plan.
+// PlanExportDataType represents the type of data exported from a plan. Data
+// types which are not listed can be used as well, to export data types added
+// to the API later.
 type PlanExportDataType string
 
 // List all available plan export data types.
@@ -42,6 +53,26 @@ const (
 	PlanExportSentinelMockBundleV0 PlanExportDataType = "sentinel-mock-bundle-v0"
 )
 
+// PlanExportFormat represents the file format of exported plan data.
+type PlanExportFormat string
+
+// List all available plan export formats.
+const (
+	PlanExportFormatTarGz PlanExportFormat = "tar.gz"
+)
+
+// planExportFormats maps the known data types to their format.
+var planExportFormats = map[PlanExportDataType]PlanExportFormat{
+	PlanExportSentinelMockBundleV0: PlanExportFormatTarGz,
+}
+
+// Format returns the file format of the data type, and whether the data type
+// is known.
+func (t PlanExportDataType) Format() (PlanExportFormat, bool) {
+	f, ok := planExportFormats[t]
+	return f, ok
+}
+
 // PlanExportStatus represents a plan export state.
 type PlanExportStatus string
 
@@ -99,7 +130,7 @@ func (s *planExports) Create(ctx context.Context, options PlanExportCreateOption
 	}
 
 	pe := &PlanExport{}
-	err = s.client.do(ctx, req, pe)
+	err = s.client.do(ctx, "planExports.Create", req, pe)
 	if err != nil {
 		return nil, err
 	}
@@ -107,6 +138,35 @@ func (s *planExports) Create(ctx context.Context, options PlanExportCreateOption
 	return pe, err
 }
 
+// CreateAndWait exports a plan, waits until the export has finished, and
+// downloads its data into the writer. When the export does not finish, the
+// export is returned with an error wrapping ErrPlanExportNotFinished.
+func (s *planExports) CreateAndWait(ctx context.Context, options PlanExportCreateOptions, w io.Writer) (*PlanExport, error) {
+	pe, err := s.Create(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; !pe.completed(); i++ {
+		select {
+		case <-ctx.Done():
+			return pe, ctx.Err()
+		case <-s.client.clock.After(backoff(500, 5000, i)):
+		}
+
+		pe, err = s.Read(ctx, pe.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if pe.Status != PlanExportFinished {
+		return pe, fmt.Errorf("%w: plan export %s is %s", ErrPlanExportNotFinished, pe.ID, pe.Status)
+	}
+
+	return pe, s.DownloadWithOptions(ctx, pe.ID, w, nil)
+}
+
 // Read a plan export by its ID.
 func (s *planExports) Read(ctx context.Context, planExportID string) (*PlanExport, error) {
 	if !validStringID(&planExportID) {
@@ -120,7 +180,7 @@ func (s *planExports) Read(ctx context.Context, planExportID string) (*PlanExpor
 	}
 
 	pe := &PlanExport{}
-	err = s.client.do(ctx, req, pe)
+	err = s.client.do(ctx, "planExports.Read", req, pe)
 	if err != nil {
 		return nil, err
 	}
@@ -140,36 +200,69 @@ func (s *planExports) Delete(ctx context.Context, planExportID string) error {
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "planExports.Delete", req, nil)
 }
 
 // Download a plan export's data. Data is exported in a .tar.gz format.
 func (s *planExports) Download(ctx context.Context, planExportID string) ([]byte, error) {
+	var buf bytes.Buffer
+	err := s.downloadWithOptions(ctx, "planExports.Download", planExportID, &buf, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// DownloadWithOptions downloads a plan export's data into the writer. The
+// API redirects to a signed URL, from which large exports are downloaded in
+// chunks as configured by the Transfer options of the client.
+func (s *planExports) DownloadWithOptions(ctx context.Context, planExportID string, w io.Writer, options *SignedURLDownloadOptions) error {
+	return s.downloadWithOptions(ctx, "planExports.DownloadWithOptions", planExportID, w, options)
+}
+
+// downloadWithOptions implements Download and DownloadWithOptions, naming the
+// API call op.
+func (s *planExports) downloadWithOptions(ctx context.Context, op, planExportID string, w io.Writer, options *SignedURLDownloadOptions) error {
 	if !validStringID(&planExportID) {
-		return nil, ErrInvalidPlanExportID
+		return ErrInvalidPlanExportID
 	}
 
 	u := fmt.Sprintf("plan-exports/%s/download", url.QueryEscape(planExportID))
 	req, err := s.client.newRequest("GET", u, nil)
 	if err != nil {
-		return nil, err
+		return err
 	}
+	req.Header.Set("Range", s.client.firstChunkRange())
 
-	var buf bytes.Buffer
-	err = s.client.do(ctx, req, &buf)
+	resp, err := s.client.send(ctx, op, req)
 	if err != nil {
-		return nil, err
+		// If we got an error, and the context has been canceled,
+		// the context's error is probably more useful.
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return err
 	}
 
-	return buf.Bytes(), nil
+	return s.client.download(ctx, op, resp, w, options)
 }
 
 func (o PlanExportCreateOptions) valid() error {
 	if o.Plan == nil {
 		return ErrRequiredPlan
 	}
-	if o.DataType == nil {
+	if o.DataType == nil || *o.DataType == "" {
 		return ErrRequiredDataType
 	}
 	return nil
 }
+
+func (pe *PlanExport) completed() bool {
+	switch pe.Status {
+	case PlanExportCanceled, PlanExportErrored, PlanExportExpired, PlanExportFinished:
+		return true
+	default:
+		return false
+	}
+}
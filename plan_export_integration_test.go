@@ -7,6 +7,11 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -160,3 +165,98 @@ func TestPlanExport_Unmarshal(t *testing.T) {
 	assert.Equal(t, pe.StatusTimestamps.QueuedAt, queuedParsedTime)
 	assert.Equal(t, pe.StatusTimestamps.ErroredAt, erroredParsedTime)
 }
+
+func TestPlanExportsCreateAndWait(t *testing.T) {
+	statuses := map[string][]PlanExportStatus{
+		"plan-finished": {PlanExportQueued, PlanExportPending, PlanExportFinished},
+		"plan-errored":  {PlanExportQueued, PlanExportErrored},
+	}
+
+	var current []PlanExportStatus
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/plan-exports":
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.Contains(t, string(body), `"data-type":"sentinel-mock-bundle-v1"`)
+
+			for plan, s := range statuses {
+				if bytes.Contains(body, []byte(plan)) {
+					current = s
+				}
+			}
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"data":{"id":"pe-1","type":"plan-exports","attributes":{"status":%q}}}`, current[0])
+		case "/api/v2/plan-exports/pe-1":
+			current = current[1:]
+			fmt.Fprintf(w, `{"data":{"id":"pe-1","type":"plan-exports","attributes":{"status":%q}}}`, current[0])
+		case "/api/v2/plan-exports/pe-1/download":
+			require.Equal(t, PlanExportFinished, current[0])
+			w.Header().Set("Content-Type", "application/x-gzip")
+			_, _ = w.Write([]byte("exported data"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	clock := NewFakeClock(time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC))
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Clock:      clock,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	// A data type which is not known yet is exported as well.
+	dataType := PlanExportDataType("sentinel-mock-bundle-v1")
+
+	t.Run("when the export finishes", func(t *testing.T) {
+		var buf bytes.Buffer
+		pe, err := client.PlanExports.CreateAndWait(ctx, PlanExportCreateOptions{
+			Plan:     &Plan{ID: "plan-finished"},
+			DataType: &dataType,
+		}, &buf)
+		require.NoError(t, err)
+		assert.Equal(t, PlanExportFinished, pe.Status)
+		assert.Equal(t, "exported data", buf.String())
+		assert.Len(t, clock.Waits(), 2)
+	})
+
+	t.Run("when the export errors", func(t *testing.T) {
+		var buf bytes.Buffer
+		pe, err := client.PlanExports.CreateAndWait(ctx, PlanExportCreateOptions{
+			Plan:     &Plan{ID: "plan-errored"},
+			DataType: &dataType,
+		}, &buf)
+		assert.True(t, errors.Is(err, ErrPlanExportNotFinished))
+		require.NotNil(t, pe)
+		assert.Equal(t, PlanExportErrored, pe.Status)
+		assert.Empty(t, buf.Bytes())
+	})
+
+	t.Run("without a data type", func(t *testing.T) {
+		empty := PlanExportDataType("")
+		_, err := client.PlanExports.CreateAndWait(ctx, PlanExportCreateOptions{
+			Plan:     &Plan{ID: "plan-finished"},
+			DataType: &empty,
+		}, io.Discard)
+		assert.Equal(t, ErrRequiredDataType, err)
+	})
+}
+
+func TestPlanExportDataType_Format(t *testing.T) {
+	format, ok := PlanExportSentinelMockBundleV0.Format()
+	assert.True(t, ok)
+	assert.Equal(t, PlanExportFormatTarGz, format)
+
+	_, ok = PlanExportDataType("sentinel-mock-bundle-v1").Format()
+	assert.False(t, ok)
+}
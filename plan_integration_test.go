@@ -72,6 +72,34 @@ func TestPlansLogs(t *testing.T) {
 	})
 }
 
+func TestPlansLogURL(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	rTest, rTestCleanup := createPlannedRun(t, client, nil)
+	defer rTestCleanup()
+
+	t.Run("when the plan exists", func(t *testing.T) {
+		logURL, err := client.Plans.LogURL(ctx, rTest.Plan.ID)
+		require.NoError(t, err)
+		assert.NotEmpty(t, logURL)
+
+		exists, err := client.Plans.LogURLExists(ctx, logURL)
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("when the plan does not exist", func(t *testing.T) {
+		_, err := client.Plans.LogURL(ctx, "nonexisting")
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("with invalid plan ID", func(t *testing.T) {
+		_, err := client.Plans.LogURL(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidPlanID, err)
+	})
+}
+
 func TestPlan_Unmarshal(t *testing.T) {
 	data := map[string]interface{}{
 		"data": map[string]interface{}{
@@ -89,6 +117,10 @@ func TestPlan_Unmarshal(t *testing.T) {
 					"errored-at": "2019-03-16T23:23:59+00:00",
 				},
 			},
+			"links": map[string]interface{}{
+				"self":        "/api/v2/plans/1",
+				"json-output": "/api/v2/plans/1/json-output",
+			},
 		},
 	}
 
@@ -114,6 +146,7 @@ func TestPlan_Unmarshal(t *testing.T) {
 	assert.NotEmpty(t, plan.StatusTimestamps)
 	assert.Equal(t, plan.StatusTimestamps.QueuedAt, queuedParsedTime)
 	assert.Equal(t, plan.StatusTimestamps.ErroredAt, erroredParsedTime)
+	assert.Equal(t, plan.Links["json-output"], "/api/v2/plans/1/json-output")
 }
 
 func TestPlansJSONOutput(t *testing.T) {
@@ -132,9 +165,56 @@ func TestPlansJSONOutput(t *testing.T) {
 		assert.Contains(t, m, "terraform_version")
 	})
 
+	t.Run("when decoding the JSON output", func(t *testing.T) {
+		p, err := client.Plans.ReadJSONPlan(ctx, rTest.Plan.ID)
+		require.NoError(t, err)
+		assert.NotEmpty(t, p.FormatVersion)
+		assert.NotEmpty(t, p.TerraformVersion)
+		assert.NotEmpty(t, p.PlannedValues)
+	})
+
 	t.Run("when the JSON output does not exist", func(t *testing.T) {
 		d, err := client.Plans.ReadJSONOutput(ctx, "nonexisting")
 		assert.Nil(t, d)
 		assert.Error(t, err)
 	})
 }
+
+func TestPlanJSONOutputDecode(t *testing.T) {
+	data := []byte(`{
+		"format_version": "1.2",
+		"terraform_version": "1.5.2",
+		"resource_changes": [
+			{
+				"address": "null_resource.foo[0]",
+				"mode": "managed",
+				"type": "null_resource",
+				"name": "foo",
+				"index": 0,
+				"provider_name": "registry.terraform.io/hashicorp/null",
+				"change": {"actions": ["delete", "create"], "before": {"id": "1"}, "after": null},
+				"action_reason": "replace_because_tainted"
+			}
+		],
+		"output_changes": {
+			"id": {"actions": ["no-op"], "before": "1", "after": "1"}
+		},
+		"errored": false
+	}`)
+
+	p := &PlanJSONOutput{}
+	require.NoError(t, json.Unmarshal(data, p))
+
+	assert.Equal(t, "1.5.2", p.TerraformVersion)
+	require.Len(t, p.ResourceChanges, 1)
+
+	rc := p.ResourceChanges[0]
+	assert.Equal(t, "null_resource.foo[0]", rc.Address)
+	assert.Equal(t, "replace_because_tainted", rc.ActionReason)
+	assert.Equal(t, []PlanJSONAction{PlanJSONActionDelete, PlanJSONActionCreate}, rc.Change.Actions)
+	assert.True(t, rc.Change.IsReplace())
+	assert.JSONEq(t, `{"id": "1"}`, string(rc.Change.Before))
+
+	assert.True(t, p.OutputChanges["id"].IsNoop())
+	assert.False(t, p.OutputChanges["id"].IsReplace())
+}
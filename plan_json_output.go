@@ -0,0 +1,92 @@
+package tfe
+
+import (
+	"encoding/json"
+)
+
+// PlanJSONOutput represents the machine-readable JSON execution plan of a
+// plan, in the JSON output format of Terraform. Only the commonly used parts
+// are decoded, values are kept as raw JSON.
+// https://developer.hashicorp.com/terraform/internals/json-format#plan-representation
+type PlanJSONOutput struct {
+	FormatVersion    string                       `json:"format_version"`
+	TerraformVersion string                       `json:"terraform_version"`
+	Variables        map[string]*PlanJSONVariable `json:"variables,omitempty"`
+	PlannedValues    json.RawMessage              `json:"planned_values,omitempty"`
+	ResourceDrift    []*PlanJSONResourceChange    `json:"resource_drift,omitempty"`
+	ResourceChanges  []*PlanJSONResourceChange    `json:"resource_changes,omitempty"`
+	OutputChanges    map[string]*PlanJSONChange   `json:"output_changes,omitempty"`
+	PriorState       json.RawMessage              `json:"prior_state,omitempty"`
+	Configuration    json.RawMessage              `json:"configuration,omitempty"`
+	RelevantAttrs    []*PlanJSONRelevantAttribute `json:"relevant_attributes,omitempty"`
+	Checks           json.RawMessage              `json:"checks,omitempty"`
+	Errored          bool                         `json:"errored"`
+	Timestamp        string                       `json:"timestamp,omitempty"`
+}
+
+// PlanJSONVariable represents the value of an input variable of a plan.
+type PlanJSONVariable struct {
+	Value json.RawMessage `json:"value"`
+}
+
+// PlanJSONResourceChange represents a planned change of a resource instance.
+type PlanJSONResourceChange struct {
+	Address         string          `json:"address"`
+	PreviousAddress string          `json:"previous_address,omitempty"`
+	ModuleAddress   string          `json:"module_address,omitempty"`
+	Mode            string          `json:"mode"`
+	Type            string          `json:"type"`
+	Name            string          `json:"name"`
+	Index           json.RawMessage `json:"index,omitempty"`
+	ProviderName    string          `json:"provider_name"`
+	DeposedKey      string          `json:"deposed,omitempty"`
+	Change          *PlanJSONChange `json:"change"`
+	ActionReason    string          `json:"action_reason,omitempty"`
+}
+
+// PlanJSONChange represents the change of a resource instance or output.
+type PlanJSONChange struct {
+	Actions         []PlanJSONAction `json:"actions"`
+	Before          json.RawMessage  `json:"before,omitempty"`
+	After           json.RawMessage  `json:"after,omitempty"`
+	AfterUnknown    json.RawMessage  `json:"after_unknown,omitempty"`
+	BeforeSensitive json.RawMessage  `json:"before_sensitive,omitempty"`
+	AfterSensitive  json.RawMessage  `json:"after_sensitive,omitempty"`
+	ReplacePaths    json.RawMessage  `json:"replace_paths,omitempty"`
+}
+
+// PlanJSONRelevantAttribute represents a resource attribute which
+// contributed to the planned changes.
+type PlanJSONRelevantAttribute struct {
+	Resource  string          `json:"resource"`
+	Attribute json.RawMessage `json:"attribute"`
+}
+
+// PlanJSONAction represents an action planned for a resource instance or
+// output.
+type PlanJSONAction string
+
+// List all available plan JSON actions.
+const (
+	PlanJSONActionNoop   PlanJSONAction = "no-op"
+	PlanJSONActionCreate PlanJSONAction = "create"
+	PlanJSONActionRead   PlanJSONAction = "read"
+	PlanJSONActionUpdate PlanJSONAction = "update"
+	PlanJSONActionDelete PlanJSONAction = "delete"
+)
+
+// IsReplace reports whether the change replaces the resource instance, by
+// deleting and creating it in either order.
+func (c *PlanJSONChange) IsReplace() bool {
+	if len(c.Actions) != 2 {
+		return false
+	}
+	return (c.Actions[0] == PlanJSONActionDelete && c.Actions[1] == PlanJSONActionCreate) ||
+		(c.Actions[0] == PlanJSONActionCreate && c.Actions[1] == PlanJSONActionDelete)
+}
+
+// IsNoop reports whether the change leaves the resource instance or output
+// unchanged.
+func (c *PlanJSONChange) IsNoop() bool {
+	return len(c.Actions) == 1 && c.Actions[0] == PlanJSONActionNoop
+}
@@ -138,7 +138,7 @@ func (s *policies) List(ctx context.Context, organization string, options *Polic
 	}
 
 	pl := &PolicyList{}
-	err = s.client.do(ctx, req, pl)
+	err = s.client.do(ctx, "policies.List", req, pl)
 	if err != nil {
 		return nil, err
 	}
@@ -162,7 +162,7 @@ func (s *policies) Create(ctx context.Context, organization string, options Poli
 	}
 
 	p := &Policy{}
-	err = s.client.do(ctx, req, p)
+	err = s.client.do(ctx, "policies.Create", req, p)
 	if err != nil {
 		return nil, err
 	}
@@ -183,7 +183,7 @@ func (s *policies) Read(ctx context.Context, policyID string) (*Policy, error) {
 	}
 
 	p := &Policy{}
-	err = s.client.do(ctx, req, p)
+	err = s.client.do(ctx, "policies.Read", req, p)
 	if err != nil {
 		return nil, err
 	}
@@ -197,6 +197,10 @@ func (s *policies) Update(ctx context.Context, policyID string, options PolicyUp
 		return nil, ErrInvalidPolicyID
 	}
 
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
 	u := fmt.Sprintf("policies/%s", url.QueryEscape(policyID))
 	req, err := s.client.newRequest("PATCH", u, &options)
 	if err != nil {
@@ -204,7 +208,7 @@ func (s *policies) Update(ctx context.Context, policyID string, options PolicyUp
 	}
 
 	p := &Policy{}
-	err = s.client.do(ctx, req, p)
+	err = s.client.do(ctx, "policies.Update", req, p)
 	if err != nil {
 		return nil, err
 	}
@@ -224,7 +228,7 @@ func (s *policies) Delete(ctx context.Context, policyID string) error {
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "policies.Delete", req, nil)
 }
 
 // Upload the policy content of the policy.
@@ -239,7 +243,7 @@ func (s *policies) Upload(ctx context.Context, policyID string, content []byte)
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "policies.Upload", req, nil)
 }
 
 // Download the policy content of the policy.
@@ -255,7 +259,7 @@ func (s *policies) Download(ctx context.Context, policyID string) ([]byte, error
 	}
 
 	var buf bytes.Buffer
-	err = s.client.do(ctx, req, &buf)
+	err = s.client.do(ctx, "policies.Download", req, &buf)
 	if err != nil {
 		return nil, err
 	}
@@ -280,6 +284,26 @@ func (o PolicyCreateOptions) valid() error {
 		if e.Mode == nil {
 			return ErrRequiredEnforcementMode
 		}
+		if !e.Mode.valid() {
+			return ErrInvalidEnforcementLevel
+		}
+	}
+	return nil
+}
+
+func (o PolicyUpdateOptions) valid() error {
+	for _, e := range o.Enforce {
+		if e.Mode != nil && !e.Mode.valid() {
+			return ErrInvalidEnforcementLevel
+		}
 	}
 	return nil
 }
+
+func (l EnforcementLevel) valid() bool {
+	switch l {
+	case EnforcementAdvisory, EnforcementSoft, EnforcementHard:
+		return true
+	}
+	return false
+}
@@ -98,6 +98,10 @@ type PolicyResult struct {
 	Result         bool `jsonapi:"attr,result"`
 	SoftFailed     int  `jsonapi:"attr,soft-failed"`
 	TotalFailed    int  `jsonapi:"attr,total-failed"`
+
+	// The raw Sentinel result, holding the result of each policy by policy
+	// set.
+	Sentinel map[string]interface{} `jsonapi:"attr,sentinel"`
 }
 
 // PolicyStatusTimestamps holds the timestamps for individual policy check
@@ -144,7 +148,7 @@ func (s *policyChecks) List(ctx context.Context, runID string, options *PolicyCh
 	}
 
 	pcl := &PolicyCheckList{}
-	err = s.client.do(ctx, req, pcl)
+	err = s.client.do(ctx, "policyChecks.List", req, pcl)
 	if err != nil {
 		return nil, err
 	}
@@ -165,7 +169,7 @@ func (s *policyChecks) Read(ctx context.Context, policyCheckID string) (*PolicyC
 	}
 
 	pc := &PolicyCheck{}
-	err = s.client.do(ctx, req, pc)
+	err = s.client.do(ctx, "policyChecks.Read", req, pc)
 	if err != nil {
 		return nil, err
 	}
@@ -186,7 +190,7 @@ func (s *policyChecks) Override(ctx context.Context, policyCheckID string) (*Pol
 	}
 
 	pc := &PolicyCheck{}
-	err = s.client.do(ctx, req, pc)
+	err = s.client.do(ctx, "policyChecks.Override", req, pc)
 	if err != nil {
 		return nil, err
 	}
@@ -214,7 +218,7 @@ func (s *policyChecks) Logs(ctx context.Context, policyCheckID string) (io.Reade
 			select {
 			case <-ctx.Done():
 				return nil, ctx.Err()
-			case <-time.After(500 * time.Millisecond):
+			case <-s.client.clock.After(500 * time.Millisecond):
 				continue
 			}
 		}
@@ -226,7 +230,7 @@ func (s *policyChecks) Logs(ctx context.Context, policyCheckID string) (io.Reade
 		}
 
 		logs := bytes.NewBuffer(nil)
-		err = s.client.do(ctx, req, logs)
+		err = s.client.do(ctx, "policyChecks.Logs", req, logs)
 		if err != nil {
 			return nil, err
 		}
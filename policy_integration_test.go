@@ -518,3 +518,23 @@ func TestPolicyUpdateOptions_Marshal(t *testing.T) {
 `
 	assert.Equal(t, expectedBody, string(bodyBytes))
 }
+
+func TestPolicyOptions_validEnforcementLevel(t *testing.T) {
+	t.Run("with a valid enforcement level", func(t *testing.T) {
+		options := PolicyCreateOptions{
+			Name:    String("policy"),
+			Enforce: []*EnforcementOptions{{Path: String("policy.sentinel"), Mode: EnforcementMode(EnforcementSoft)}},
+		}
+		assert.NoError(t, options.valid())
+		assert.NoError(t, PolicyUpdateOptions{Enforce: options.Enforce}.valid())
+	})
+
+	t.Run("with an invalid enforcement level", func(t *testing.T) {
+		options := PolicyCreateOptions{
+			Name:    String("policy"),
+			Enforce: []*EnforcementOptions{{Path: String("policy.sentinel"), Mode: EnforcementMode("soft")}},
+		}
+		assert.Equal(t, ErrInvalidEnforcementLevel, options.valid())
+		assert.Equal(t, ErrInvalidEnforcementLevel, PolicyUpdateOptions{Enforce: options.Enforce}.valid())
+	})
+}
@@ -0,0 +1,272 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultPolicyOverrideReportRuns is the number of recent runs of each
+	// workspace checked by default.
+	defaultPolicyOverrideReportRuns = 20
+
+	// defaultPolicyOverrideReportConcurrency is the number of workspaces
+	// checked concurrently by default.
+	defaultPolicyOverrideReportConcurrency = 8
+)
+
+// PolicyOverrideReportOptions represents the options for generating a policy
+// override report.
+type PolicyOverrideReportOptions struct {
+	// Optional: Only runs created at or after this time are reported.
+	Since time.Time
+
+	// Optional: The number of recent runs of each workspace which are
+	// checked. Defaults to 20.
+	RunsPerWorkspace int
+
+	// Optional: The number of workspaces checked concurrently. Defaults
+	// to 8.
+	Concurrency int
+}
+
+// PolicyOverrideReport represents the policy checks of the recent runs of an
+// organization which soft failed, including those which were overridden.
+type PolicyOverrideReport struct {
+	Organization string                       `json:"organization"`
+	GeneratedAt  time.Time                    `json:"generated_at"`
+	Entries      []*PolicyOverrideReportEntry `json:"entries"`
+}
+
+// PolicyOverrideReportEntry represents a policy check which soft failed.
+type PolicyOverrideReportEntry struct {
+	Workspace     string       `json:"workspace"`
+	WorkspaceID   string       `json:"workspace_id"`
+	RunID         string       `json:"run_id"`
+	RunCreatedAt  time.Time    `json:"run_created_at"`
+	PolicyCheckID string       `json:"policy_check_id"`
+	Status        PolicyStatus `json:"status"`
+
+	// The number of soft-mandatory and advisory policies which failed.
+	SoftFailed     int `json:"soft_failed"`
+	AdvisoryFailed int `json:"advisory_failed"`
+
+	// The names of the policies which failed, as reported by Sentinel.
+	Policies []string `json:"policies"`
+
+	// The username of the user who overrode the policy check, and when, if
+	// it was overridden.
+	OverriddenBy string    `json:"overridden_by,omitempty"`
+	OverriddenAt time.Time `json:"overridden_at"`
+}
+
+// runEvent represents an event in the timeline of a run. It is only used to
+// find out who overrode a policy check.
+type runEvent struct {
+	ID        string    `jsonapi:"primary,run-events"`
+	Action    string    `jsonapi:"attr,action"`
+	CreatedAt time.Time `jsonapi:"attr,created-at,iso8601"`
+	Actor     *User     `jsonapi:"relation,actor"`
+}
+
+// runEventList represents a list of run events.
+type runEventList struct {
+	*Pagination
+	Items []*runEvent
+}
+
+// runEventOverridden is the action of the run event of a policy override.
+const runEventOverridden = "overridden"
+
+// GeneratePolicyOverrideReport lists the policy checks which soft failed
+// within the recent runs of all workspaces of an organization, including who
+// overrode them. The workspaces are checked concurrently, and the entries are
+// ordered by workspace name and run creation time.
+func GeneratePolicyOverrideReport(ctx context.Context, client *Client, organization string, options *PolicyOverrideReportOptions) (*PolicyOverrideReport, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+	opts := PolicyOverrideReportOptions{}
+	if options != nil {
+		opts = *options
+	}
+	if opts.RunsPerWorkspace <= 0 {
+		opts.RunsPerWorkspace = defaultPolicyOverrideReportRuns
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultPolicyOverrideReportConcurrency
+	}
+
+	report := &PolicyOverrideReport{
+		Organization: organization,
+		GeneratedAt:  client.clock.Now(),
+		Entries:      []*PolicyOverrideReportEntry{},
+	}
+
+	workspaces, err := listAllWorkspaces(ctx, client, organization, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	sem := make(chan struct{}, opts.Concurrency)
+	var wg sync.WaitGroup
+	for _, w := range workspaces {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(w *Workspace) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			entries, err := policyOverrideReportEntries(ctx, client, w, opts)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err != nil && firstErr == nil:
+				// Stop the workspaces in progress.
+				firstErr = fmt.Errorf("workspace %s: %w", w.Name, err)
+				cancel()
+			case err == nil:
+				report.Entries = append(report.Entries, entries...)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	sort.SliceStable(report.Entries, func(i, j int) bool {
+		a, b := report.Entries[i], report.Entries[j]
+		if a.Workspace != b.Workspace {
+			return a.Workspace < b.Workspace
+		}
+		return a.RunCreatedAt.Before(b.RunCreatedAt)
+	})
+
+	return report, nil
+}
+
+// policyOverrideReportEntries returns the entries of the policy checks which
+// soft failed within the recent runs of the workspace.
+func policyOverrideReportEntries(ctx context.Context, client *Client, w *Workspace, opts PolicyOverrideReportOptions) ([]*PolicyOverrideReportEntry, error) {
+	rl, err := client.Runs.List(ctx, w.ID, &RunListOptions{
+		ListOptions: ListOptions{PageSize: opts.RunsPerWorkspace},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*PolicyOverrideReportEntry
+	for _, r := range rl.Items {
+		if r.CreatedAt.Before(opts.Since) {
+			continue
+		}
+		if r.StatusTimestamps == nil || r.StatusTimestamps.PolicySoftFailedAt.IsZero() {
+			continue
+		}
+
+		pcl, err := client.PolicyChecks.List(ctx, r.ID, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, pc := range pcl.Items {
+			if pc.Status != PolicySoftFailed && pc.Status != PolicyOverridden {
+				continue
+			}
+
+			entry := &PolicyOverrideReportEntry{
+				Workspace:     w.Name,
+				WorkspaceID:   w.ID,
+				RunID:         r.ID,
+				RunCreatedAt:  r.CreatedAt,
+				PolicyCheckID: pc.ID,
+				Status:        pc.Status,
+				Policies:      []string{},
+			}
+			if pc.Result != nil {
+				entry.SoftFailed = pc.Result.SoftFailed
+				entry.AdvisoryFailed = pc.Result.AdvisoryFailed
+				entry.Policies = pc.Result.failedPolicies()
+			}
+			if pc.Status == PolicyOverridden {
+				event, err := policyOverrideEvent(ctx, client, r.ID)
+				if err != nil {
+					return nil, err
+				}
+				if event != nil {
+					entry.OverriddenAt = event.CreatedAt
+					if event.Actor != nil {
+						entry.OverriddenBy = event.Actor.Username
+					}
+				}
+			}
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, nil
+}
+
+// policyOverrideEvent returns the last policy override event of a run, or
+// nil if there is none.
+func policyOverrideEvent(ctx context.Context, client *Client, runID string) (*runEvent, error) {
+	u := fmt.Sprintf("runs/%s/run-events", url.QueryEscape(runID))
+	req, err := client.newRequest("GET", u, &struct {
+		Include string `url:"include"`
+	}{Include: "actor"})
+	if err != nil {
+		return nil, err
+	}
+
+	rel := &runEventList{}
+	if err := client.do(ctx, "tfe.GeneratePolicyOverrideReport", req, rel); err != nil {
+		return nil, err
+	}
+
+	var override *runEvent
+	for _, e := range rel.Items {
+		if e.Action == runEventOverridden {
+			override = e
+		}
+	}
+
+	return override, nil
+}
+
+// failedPolicies returns the names of the policies which failed, as
+// reported in the Sentinel result.
+func (r *PolicyResult) failedPolicies() []string {
+	policies := []string{}
+
+	sets, _ := r.Sentinel["data"].(map[string]interface{})
+	for _, set := range sets {
+		set, _ := set.(map[string]interface{})
+		list, _ := set["policies"].([]interface{})
+		for _, p := range list {
+			p, _ := p.(map[string]interface{})
+			if passed, _ := p["result"].(bool); passed {
+				continue
+			}
+			if name, _ := p["policy"].(string); name != "" {
+				policies = append(policies, name)
+			}
+		}
+	}
+
+	sort.Strings(policies)
+	return policies
+}
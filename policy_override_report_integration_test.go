@@ -0,0 +1,101 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratePolicyOverrideReport(t *testing.T) {
+	sentinel := `"sentinel":{"schema-version":"1.0.0","data":{"networking":{"can-override":true,"policies":[` +
+		`{"policy":"networking/block-ssh","result":false,"allowed-failure":true},` +
+		`{"policy":"networking/require-tags","result":true,"allowed-failure":true}]}}}`
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/organizations/acme/workspaces":
+			fmt.Fprint(w, `{"data":[{"id":"ws-app","type":"workspaces","attributes":{"name":"app"}},{"id":"ws-db","type":"workspaces","attributes":{"name":"db"}}]}`)
+		case "/api/v2/workspaces/ws-app/runs":
+			assert.Equal(t, "5", r.URL.Query().Get("page[size]"))
+			fmt.Fprint(w, `{"data":[`+
+				`{"id":"run-new","type":"runs","attributes":{"created-at":"2023-03-01T10:00:00Z","status-timestamps":{"policy-soft-failed-at":"2023-03-01T10:05:00Z"}}},`+
+				`{"id":"run-passed","type":"runs","attributes":{"created-at":"2023-02-28T10:00:00Z","status-timestamps":{"policy-checked-at":"2023-02-28T10:05:00Z"}}},`+
+				`{"id":"run-old","type":"runs","attributes":{"created-at":"2023-01-01T10:00:00Z","status-timestamps":{"policy-soft-failed-at":"2023-01-01T10:05:00Z"}}}]}`)
+		case "/api/v2/workspaces/ws-db/runs":
+			fmt.Fprint(w, `{"data":[{"id":"run-db","type":"runs","attributes":{"created-at":"2023-02-15T10:00:00Z","status-timestamps":{"policy-soft-failed-at":"2023-02-15T10:05:00Z"}}}]}`)
+		case "/api/v2/runs/run-new/policy-checks":
+			fmt.Fprintf(w, `{"data":[{"id":"polchk-new","type":"policy-checks","attributes":{"status":"overridden","result":{"soft-failed":1,"passed":1,%s}}}]}`, sentinel)
+		case "/api/v2/runs/run-db/policy-checks":
+			fmt.Fprintf(w, `{"data":[{"id":"polchk-db","type":"policy-checks","attributes":{"status":"soft_failed","result":{"soft-failed":1,%s}}}]}`, sentinel)
+		case "/api/v2/runs/run-new/run-events":
+			assert.Equal(t, "actor", r.URL.Query().Get("include"))
+			fmt.Fprint(w, `{"data":[`+
+				`{"id":"re-1","type":"run-events","attributes":{"action":"queued","created-at":"2023-03-01T10:00:00Z"}},`+
+				`{"id":"re-2","type":"run-events","attributes":{"action":"overridden","created-at":"2023-03-01T10:10:00Z"},"relationships":{"actor":{"data":{"id":"user-1","type":"users"}}}}],`+
+				`"included":[{"id":"user-1","type":"users","attributes":{"username":"jane"}}]}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	now := time.Date(2023, 3, 2, 12, 0, 0, 0, time.UTC)
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Clock:      NewFakeClock(now),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("when generating the report", func(t *testing.T) {
+		report, err := GeneratePolicyOverrideReport(ctx, client, "acme", &PolicyOverrideReportOptions{
+			Since:            time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+			RunsPerWorkspace: 5,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "acme", report.Organization)
+		assert.Equal(t, now, report.GeneratedAt)
+		require.Len(t, report.Entries, 2)
+
+		overridden := report.Entries[0]
+		assert.Equal(t, "app", overridden.Workspace)
+		assert.Equal(t, "run-new", overridden.RunID)
+		assert.Equal(t, PolicyOverridden, overridden.Status)
+		assert.Equal(t, 1, overridden.SoftFailed)
+		assert.Equal(t, []string{"networking/block-ssh"}, overridden.Policies)
+		assert.Equal(t, "jane", overridden.OverriddenBy)
+		assert.Equal(t, time.Date(2023, 3, 1, 10, 10, 0, 0, time.UTC), overridden.OverriddenAt)
+
+		softFailed := report.Entries[1]
+		assert.Equal(t, "db", softFailed.Workspace)
+		assert.Equal(t, PolicySoftFailed, softFailed.Status)
+		assert.Empty(t, softFailed.OverriddenBy)
+
+		b, err := json.Marshal(softFailed)
+		require.NoError(t, err)
+		assert.Contains(t, string(b), `"policies":["networking/block-ssh"]`)
+	})
+
+	t.Run("without a valid organization", func(t *testing.T) {
+		_, err := GeneratePolicyOverrideReport(ctx, client, badIdentifier, nil)
+		assert.Equal(t, ErrInvalidOrg, err)
+	})
+}
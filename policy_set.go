@@ -223,7 +223,7 @@ func (s *policySets) List(ctx context.Context, organization string, options *Pol
 	}
 
 	psl := &PolicySetList{}
-	err = s.client.do(ctx, req, psl)
+	err = s.client.do(ctx, "policySets.List", req, psl)
 	if err != nil {
 		return nil, err
 	}
@@ -247,7 +247,7 @@ func (s *policySets) Create(ctx context.Context, organization string, options Po
 	}
 
 	ps := &PolicySet{}
-	err = s.client.do(ctx, req, ps)
+	err = s.client.do(ctx, "policySets.Create", req, ps)
 	if err != nil {
 		return nil, err
 	}
@@ -257,11 +257,16 @@ func (s *policySets) Create(ctx context.Context, organization string, options Po
 
 // Read a policy set by its ID.
 func (s *policySets) Read(ctx context.Context, policySetID string) (*PolicySet, error) {
-	return s.ReadWithOptions(ctx, policySetID, nil)
+	return s.readWithOptions(ctx, "policySets.Read", policySetID, nil)
 }
 
 // ReadWithOptions reads a policy by its ID using the options supplied.
 func (s *policySets) ReadWithOptions(ctx context.Context, policySetID string, options *PolicySetReadOptions) (*PolicySet, error) {
+	return s.readWithOptions(ctx, "policySets.ReadWithOptions", policySetID, options)
+}
+
+// readWithOptions implements Read and ReadWithOptions, naming the API call op.
+func (s *policySets) readWithOptions(ctx context.Context, op, policySetID string, options *PolicySetReadOptions) (*PolicySet, error) {
 	if !validStringID(&policySetID) {
 		return nil, ErrInvalidPolicySetID
 	}
@@ -276,7 +281,7 @@ func (s *policySets) ReadWithOptions(ctx context.Context, policySetID string, op
 	}
 
 	ps := &PolicySet{}
-	err = s.client.do(ctx, req, ps)
+	err = s.client.do(ctx, op, req, ps)
 	if err != nil {
 		return nil, err
 	}
@@ -300,7 +305,7 @@ func (s *policySets) Update(ctx context.Context, policySetID string, options Pol
 	}
 
 	ps := &PolicySet{}
-	err = s.client.do(ctx, req, ps)
+	err = s.client.do(ctx, "policySets.Update", req, ps)
 	if err != nil {
 		return nil, err
 	}
@@ -323,7 +328,7 @@ func (s *policySets) AddPolicies(ctx context.Context, policySetID string, option
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "policySets.AddPolicies", req, nil)
 }
 
 // RemovePolicies remove policies from a policy set
@@ -341,7 +346,7 @@ func (s *policySets) RemovePolicies(ctx context.Context, policySetID string, opt
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "policySets.RemovePolicies", req, nil)
 }
 
 // Addworkspaces adds workspaces to a policy set.
@@ -359,7 +364,7 @@ func (s *policySets) AddWorkspaces(ctx context.Context, policySetID string, opti
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "policySets.AddWorkspaces", req, nil)
 }
 
 // RemoveWorkspaces removes workspaces from a policy set.
@@ -377,7 +382,7 @@ func (s *policySets) RemoveWorkspaces(ctx context.Context, policySetID string, o
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "policySets.RemoveWorkspaces", req, nil)
 }
 
 // Delete a policy set by its ID.
@@ -392,7 +397,7 @@ func (s *policySets) Delete(ctx context.Context, policySetID string) error {
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "policySets.Delete", req, nil)
 }
 
 func (o PolicySetCreateOptions) valid() error {
@@ -110,7 +110,7 @@ func (s *policySetParameters) List(ctx context.Context, policySetID string, opti
 	}
 
 	vl := &PolicySetParameterList{}
-	err = s.client.do(ctx, req, vl)
+	err = s.client.do(ctx, "policySetParameters.List", req, vl)
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +134,7 @@ func (s *policySetParameters) Create(ctx context.Context, policySetID string, op
 	}
 
 	p := &PolicySetParameter{}
-	err = s.client.do(ctx, req, p)
+	err = s.client.do(ctx, "policySetParameters.Create", req, p)
 	if err != nil {
 		return nil, err
 	}
@@ -158,7 +158,7 @@ func (s *policySetParameters) Read(ctx context.Context, policySetID, parameterID
 	}
 
 	p := &PolicySetParameter{}
-	err = s.client.do(ctx, req, p)
+	err = s.client.do(ctx, "policySetParameters.Read", req, p)
 	if err != nil {
 		return nil, err
 	}
@@ -182,7 +182,7 @@ func (s *policySetParameters) Update(ctx context.Context, policySetID, parameter
 	}
 
 	p := &PolicySetParameter{}
-	err = s.client.do(ctx, req, p)
+	err = s.client.do(ctx, "policySetParameters.Update", req, p)
 	if err != nil {
 		return nil, err
 	}
@@ -205,7 +205,7 @@ func (s *policySetParameters) Delete(ctx context.Context, policySetID, parameter
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "policySetParameters.Delete", req, nil)
 }
 
 func (o PolicySetParameterCreateOptions) valid() error {
@@ -108,7 +108,7 @@ func (p *policySetVersions) Create(ctx context.Context, policySetID string) (*Po
 	}
 
 	psv := &PolicySetVersion{}
-	err = p.client.do(ctx, req, psv)
+	err = p.client.do(ctx, "policySetVersions.Create", req, psv)
 	if err != nil {
 		return nil, err
 	}
@@ -129,7 +129,7 @@ func (p *policySetVersions) Read(ctx context.Context, policySetVersionID string)
 	}
 
 	psv := &PolicySetVersion{}
-	err = p.client.do(ctx, req, psv)
+	err = p.client.do(ctx, "policySetVersions.Read", req, psv)
 	if err != nil {
 		return nil, err
 	}
@@ -150,11 +150,7 @@ func (p *policySetVersions) Upload(ctx context.Context, psv PolicySetVersion, pa
 	if err != nil {
 		return err
 	}
+	defer body.Close()
 
-	req, err := p.client.newRequest("PUT", uploadURL, body)
-	if err != nil {
-		return err
-	}
-
-	return p.client.do(ctx, req, nil)
+	return p.client.SignedURLs.Upload(ctx, uploadURL, body, nil)
 }
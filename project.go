@@ -0,0 +1,233 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Compile-time proof of interface implementation.
+var _ Projects = (*projects)(nil)
+
+// Projects describes all the project related methods that the Terraform
+// Enterprise API supports.
+//
+// TFE API docs: https://developer.hashicorp.com/terraform/cloud-docs/api-docs/projects
+type Projects interface {
+	// List all the projects of the given organization.
+	List(ctx context.Context, organization string, options *ProjectListOptions) (*ProjectList, error)
+
+	// Create a new project with the given options.
+	Create(ctx context.Context, organization string, options ProjectCreateOptions) (*Project, error)
+
+	// GetOrCreate creates a project, or reads it when a project with the
+	// same name already exists. It reports whether the project was created.
+	GetOrCreate(ctx context.Context, organization string, options ProjectCreateOptions) (*Project, bool, error)
+
+	// Read a project by its ID.
+	Read(ctx context.Context, projectID string) (*Project, error)
+
+	// Update a project by its ID.
+	Update(ctx context.Context, projectID string, options ProjectUpdateOptions) (*Project, error)
+
+	// Delete a project by its ID.
+	Delete(ctx context.Context, projectID string) error
+}
+
+// projects implements Projects.
+type projects struct {
+	client *Client
+}
+
+// ProjectList represents a list of projects.
+type ProjectList struct {
+	*Pagination
+	Items []*Project
+}
+
+// Project represents a Terraform Cloud project, which groups the workspaces
+// of an organization.
+type Project struct {
+	ID   string `jsonapi:"primary,projects"`
+	Name string `jsonapi:"attr,name"`
+
+	// Relations
+	Organization *Organization `jsonapi:"relation,organization"`
+}
+
+// ProjectListOptions represents the options for listing projects.
+type ProjectListOptions struct {
+	ListOptions
+
+	// Optional: Only list the project with the given name.
+	Name string `url:"filter[names],omitempty"`
+}
+
+// ProjectCreateOptions represents the options for creating a project.
+type ProjectCreateOptions struct {
+	// Type is a public field utilized by JSON:API to
+	// set the resource type via the field tag.
+	// It is not a user-defined value and does not need to be set.
+	// https://jsonapi.org/format/#crud-creating
+	Type string `jsonapi:"primary,projects"`
+
+	// Required: The name of the project.
+	Name *string `jsonapi:"attr,name"`
+}
+
+// ProjectUpdateOptions represents the options for updating a project.
+type ProjectUpdateOptions struct {
+	// Type is a public field utilized by JSON:API to
+	// set the resource type via the field tag.
+	// It is not a user-defined value and does not need to be set.
+	// https://jsonapi.org/format/#crud-creating
+	Type string `jsonapi:"primary,projects"`
+
+	// Optional: The new name of the project.
+	Name *string `jsonapi:"attr,name,omitempty"`
+}
+
+// List all the projects of the given organization.
+func (s *projects) List(ctx context.Context, organization string, options *ProjectListOptions) (*ProjectList, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+
+	u := fmt.Sprintf("organizations/%s/projects", url.QueryEscape(organization))
+	req, err := s.client.newRequest("GET", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	pl := &ProjectList{}
+	err = s.client.do(ctx, "projects.List", req, pl)
+	if err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
+
+// Create a new project with the given options.
+func (s *projects) Create(ctx context.Context, organization string, options ProjectCreateOptions) (*Project, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("organizations/%s/projects", url.QueryEscape(organization))
+	req, err := s.client.newRequest("POST", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Project{}
+	err = s.client.do(ctx, "projects.Create", req, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// GetOrCreate creates a project, or reads it when creating it failed because
+// a project with the same name already exists. Creating first makes it safe
+// against concurrent callers creating the same project. The settings of an
+// existing project are not compared to the options.
+func (s *projects) GetOrCreate(ctx context.Context, organization string, options ProjectCreateOptions) (*Project, bool, error) {
+	p, err := s.Create(ctx, organization, options)
+	if err == nil {
+		return p, true, nil
+	}
+	if !isCreateConflict(err) {
+		return nil, false, err
+	}
+
+	pl, lerr := s.List(ctx, organization, &ProjectListOptions{Name: *options.Name})
+	if lerr != nil {
+		return nil, false, lerr
+	}
+	for _, p := range pl.Items {
+		if p.Name == *options.Name {
+			return p, false, nil
+		}
+	}
+
+	return nil, false, err
+}
+
+// Read a project by its ID.
+func (s *projects) Read(ctx context.Context, projectID string) (*Project, error) {
+	if !validStringID(&projectID) {
+		return nil, ErrInvalidProjectID
+	}
+
+	u := fmt.Sprintf("projects/%s", url.QueryEscape(projectID))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Project{}
+	err = s.client.do(ctx, "projects.Read", req, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Update a project by its ID.
+func (s *projects) Update(ctx context.Context, projectID string, options ProjectUpdateOptions) (*Project, error) {
+	if !validStringID(&projectID) {
+		return nil, ErrInvalidProjectID
+	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("projects/%s", url.QueryEscape(projectID))
+	req, err := s.client.newRequest("PATCH", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Project{}
+	err = s.client.do(ctx, "projects.Update", req, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Delete a project by its ID.
+func (s *projects) Delete(ctx context.Context, projectID string) error {
+	if !validStringID(&projectID) {
+		return ErrInvalidProjectID
+	}
+
+	u := fmt.Sprintf("projects/%s", url.QueryEscape(projectID))
+	req, err := s.client.newRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.client.do(ctx, "projects.Delete", req, nil)
+}
+
+func (o ProjectCreateOptions) valid() error {
+	if !validString(o.Name) {
+		return ErrRequiredName
+	}
+	return nil
+}
+
+func (o ProjectUpdateOptions) valid() error {
+	if o.Name != nil && !validString(o.Name) {
+		return ErrRequiredName
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package tfe
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RateLimit holds the rate limit of the API, as reported by the headers of
+// the last response.
+type RateLimit struct {
+	// The number of requests allowed per second.
+	Limit float64 `json:"limit"`
+
+	// The number of requests which can still be made before the rate limit
+	// is exceeded.
+	Remaining float64 `json:"remaining"`
+
+	// The time until the rate limit resets, relative to ReceivedAt.
+	Reset time.Duration `json:"reset"`
+
+	// When the response reporting the rate limit was received.
+	ReceivedAt time.Time `json:"received_at"`
+}
+
+// RateLimit returns the rate limit reported by the last response which held
+// rate limit headers, including the responses of retried requests. It
+// returns nil when no such response was received yet. Batch jobs can use it
+// to adapt their concurrency to the remaining requests.
+func (c *Client) RateLimit() *RateLimit {
+	c.rateLimitMu.Lock()
+	defer c.rateLimitMu.Unlock()
+
+	if c.rateLimit == nil {
+		return nil
+	}
+	rl := *c.rateLimit
+	return &rl
+}
+
+// recordRateLimit saves the rate limit reported by the headers of the
+// response. Responses without rate limit headers are ignored, as are
+// malformed values, which are logged when the rate limiter or the retries
+// use them.
+func (c *Client) recordRateLimit(resp *http.Response) {
+	if resp == nil || resp.Header.Get(_headerRateLimit) == "" {
+		return
+	}
+
+	rl := &RateLimit{ReceivedAt: c.clock.Now()}
+	rl.Limit, _ = parseRateLimit(resp.Header.Get(_headerRateLimit))
+	if v := resp.Header.Get(_headerRateRemaining); v != "" {
+		rl.Remaining, _ = strconv.ParseFloat(v, 64)
+	}
+	if v := resp.Header.Get(_headerRateReset); v != "" {
+		rl.Reset, _ = parseRateLimitReset(v)
+	}
+
+	c.rateLimitMu.Lock()
+	defer c.rateLimitMu.Unlock()
+	c.rateLimit = rl
+}
+
+// waitRateLimit blocks until the rate limiter allows a request to be made,
+// or returns an error if the given context is canceled. It returns the time
+// waited, which is reported to the OnRateLimitWait callback.
+func (c *Client) waitRateLimit(ctx context.Context) (time.Duration, error) {
+	start := c.clock.Now()
+	if err := c.requestLimiter().Wait(ctx); err != nil {
+		return 0, err
+	}
+
+	wait := c.clock.Now().Sub(start)
+	if wait > 0 && c.onRateLimitWait != nil {
+		c.onRateLimitWait(wait)
+	}
+
+	return wait, nil
+}
@@ -3,6 +3,7 @@ package tfe
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/url"
 )
 
@@ -14,6 +15,9 @@ var _ RegistryModules = (*registryModules)(nil)
 //
 // TFE API docs: https://www.terraform.io/docs/cloud/api/modules.html
 type RegistryModules interface {
+	// List all the registry modules within an organization.
+	List(ctx context.Context, organization string, options *RegistryModuleListOptions) (*RegistryModuleList, error)
+
 	// Create a registry module without a VCS repo
 	Create(ctx context.Context, organization string, options RegistryModuleCreateOptions) (*RegistryModule, error)
 
@@ -39,6 +43,14 @@ type RegistryModules interface {
 	// requires a path to the configuration files on disk, which will be packaged by
 	// hashicorp/go-slug before being uploaded.
 	Upload(ctx context.Context, rmv RegistryModuleVersion, path string) error
+
+	// UploadTarGzip uploads an already packaged tar.gz archive of a module to
+	// the upload URL of a registry module version.
+	UploadTarGzip(ctx context.Context, url string, r io.Reader) error
+
+	// PublishLocalModule publishes the module files on disk as a new version
+	// of a registry module, creating the module when it does not exist yet.
+	PublishLocalModule(ctx context.Context, path string, moduleID RegistryModuleID, version string) (*RegistryModuleVersion, error)
 }
 
 // registryModules implements RegistryModules.
@@ -57,6 +69,15 @@ const (
 	RegistryModuleStatusSetupComplete RegistryModuleStatus = "setup_complete"
 )
 
+// RegistryName represents the registry a module is published in.
+type RegistryName string
+
+// List of available registry names
+const (
+	PrivateRegistry RegistryName = "private"
+	PublicRegistry  RegistryName = "public"
+)
+
 // RegistryModuleVersionStatus represents the status of a specific version of a registry module
 type RegistryModuleVersionStatus string
 
@@ -81,6 +102,31 @@ type RegistryModuleID struct {
 	Provider string
 }
 
+// RegistryModuleList represents a list of registry modules
+type RegistryModuleList struct {
+	*Pagination
+	Items []*RegistryModule
+}
+
+// RegistryModuleListOptions represents the options for listing registry modules
+type RegistryModuleListOptions struct {
+	ListOptions
+
+	// Optional: A search query string, matching the name and provider of the
+	// modules.
+	Search string `url:"q,omitempty"`
+
+	// Optional: The registry the modules are published in.
+	RegistryName RegistryName `url:"filter[registry_name],omitempty"`
+
+	// Optional: The provider of the modules, e.g. "aws".
+	Provider string `url:"filter[provider],omitempty"`
+
+	// Optional: The organization the modules were published by. Public
+	// modules can be published by other organizations.
+	OrganizationName string `url:"filter[organization_name],omitempty"`
+}
+
 // RegistryModule represents a registry module
 type RegistryModule struct {
 	ID              string                          `jsonapi:"primary,registry-modules"`
@@ -90,11 +136,13 @@ type RegistryModule struct {
 	Status          RegistryModuleStatus            `jsonapi:"attr,status"`
 	VCSRepo         *VCSRepo                        `jsonapi:"attr,vcs-repo"`
 	VersionStatuses []RegistryModuleVersionStatuses `jsonapi:"attr,version-statuses"`
+	NoCode          bool                            `jsonapi:"attr,no-code"`
 	CreatedAt       string                          `jsonapi:"attr,created-at"`
 	UpdatedAt       string                          `jsonapi:"attr,updated-at"`
 
 	// Relations
-	Organization *Organization `jsonapi:"relation,organization"`
+	Organization          *Organization           `jsonapi:"relation,organization"`
+	RegistryNoCodeModules []*RegistryNoCodeModule `jsonapi:"relation,no-code-modules"`
 }
 
 // RegistryModuleVersion represents a registry module version
@@ -171,22 +219,84 @@ type RegistryModuleVCSRepoOptions struct {
 // requires a path to the configuration files on disk, which will be packaged by
 // hashicorp/go-slug before being uploaded.
 func (r *registryModules) Upload(ctx context.Context, rmv RegistryModuleVersion, path string) error {
-	uploadURL, ok := rmv.Links["upload"].(string)
-	if !ok {
-		return fmt.Errorf("provided RegistryModuleVersion does not contain an upload link")
+	uploadURL, err := rmv.uploadURL()
+	if err != nil {
+		return err
 	}
 
 	body, err := packContents(path)
 	if err != nil {
 		return err
 	}
+	defer body.Close()
+
+	return r.UploadTarGzip(ctx, uploadURL, body)
+}
+
+// UploadTarGzip uploads an already packaged tar.gz archive of a module to the
+// upload URL of a registry module version. Readers which are not seekable are
+// buffered in memory, so they can be retried.
+func (r *registryModules) UploadTarGzip(ctx context.Context, uploadURL string, archive io.Reader) error {
+	return r.client.SignedURLs.Upload(ctx, uploadURL, archive, nil)
+}
 
-	req, err := r.client.newRequest("PUT", uploadURL, body)
+// PublishLocalModule publishes the module files on disk as a new version of a
+// registry module. The module is created when it does not exist yet, then a
+// version is created and the packaged files are uploaded to it.
+func (r *registryModules) PublishLocalModule(ctx context.Context, path string, moduleID RegistryModuleID, version string) (*RegistryModuleVersion, error) {
+	if err := moduleID.valid(); err != nil {
+		return nil, err
+	}
+	options := RegistryModuleCreateVersionOptions{Version: &version}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	_, err := r.Read(ctx, moduleID)
+	if err == ErrResourceNotFound {
+		_, err = r.Create(ctx, moduleID.Organization, RegistryModuleCreateOptions{
+			Name:     &moduleID.Name,
+			Provider: &moduleID.Provider,
+		})
+	}
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	rmv, err := r.CreateVersion(ctx, moduleID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.Upload(ctx, *rmv, path); err != nil {
+		return nil, err
+	}
+
+	return rmv, nil
+}
+
+// List all the registry modules within an organization.
+func (r *registryModules) List(ctx context.Context, organization string, options *RegistryModuleListOptions) (*RegistryModuleList, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("organizations/%s/registry-modules", url.QueryEscape(organization))
+	req, err := r.client.newRequest("GET", u, options)
+	if err != nil {
+		return nil, err
 	}
 
-	return r.client.do(ctx, req, nil)
+	ml := &RegistryModuleList{}
+	err = r.client.do(ctx, "registryModules.List", req, ml)
+	if err != nil {
+		return nil, err
+	}
+
+	return ml, nil
 }
 
 // Create a new registry module without a VCS repo
@@ -208,7 +318,7 @@ func (r *registryModules) Create(ctx context.Context, organization string, optio
 	}
 
 	rm := &RegistryModule{}
-	err = r.client.do(ctx, req, rm)
+	err = r.client.do(ctx, "registryModules.Create", req, rm)
 	if err != nil {
 		return nil, err
 	}
@@ -238,7 +348,7 @@ func (r *registryModules) CreateVersion(ctx context.Context, moduleID RegistryMo
 	}
 
 	rmv := &RegistryModuleVersion{}
-	err = r.client.do(ctx, req, rmv)
+	err = r.client.do(ctx, "registryModules.CreateVersion", req, rmv)
 	if err != nil {
 		return nil, err
 	}
@@ -258,7 +368,7 @@ func (r *registryModules) CreateWithVCSConnection(ctx context.Context, options R
 	}
 
 	rm := &RegistryModule{}
-	err = r.client.do(ctx, req, rm)
+	err = r.client.do(ctx, "registryModules.CreateWithVCSConnection", req, rm)
 	if err != nil {
 		return nil, err
 	}
@@ -284,7 +394,7 @@ func (r *registryModules) Read(ctx context.Context, moduleID RegistryModuleID) (
 	}
 
 	rm := &RegistryModule{}
-	err = r.client.do(ctx, req, rm)
+	err = r.client.do(ctx, "registryModules.Read", req, rm)
 	if err != nil {
 		return nil, err
 	}
@@ -314,7 +424,7 @@ func (r *registryModules) Delete(ctx context.Context, organization, name string)
 		return err
 	}
 
-	return r.client.do(ctx, req, nil)
+	return r.client.do(ctx, "registryModules.Delete", req, nil)
 }
 
 // DeleteProvider is used to delete the specific registry module provider
@@ -334,7 +444,7 @@ func (r *registryModules) DeleteProvider(ctx context.Context, moduleID RegistryM
 		return err
 	}
 
-	return r.client.do(ctx, req, nil)
+	return r.client.do(ctx, "registryModules.DeleteProvider", req, nil)
 }
 
 // DeleteVersion is used to delete the specific registry module version
@@ -361,7 +471,29 @@ func (r *registryModules) DeleteVersion(ctx context.Context, moduleID RegistryMo
 		return err
 	}
 
-	return r.client.do(ctx, req, nil)
+	return r.client.do(ctx, "registryModules.DeleteVersion", req, nil)
+}
+
+func (v RegistryModuleVersion) uploadURL() (string, error) {
+	uploadURL, ok := v.Links["upload"].(string)
+	if !ok {
+		return uploadURL, fmt.Errorf("provided RegistryModuleVersion does not contain an upload link")
+	}
+
+	return uploadURL, nil
+}
+
+func (o *RegistryModuleListOptions) valid() error {
+	if o == nil {
+		return nil // nothing to validate
+	}
+
+	switch o.RegistryName {
+	case "", PrivateRegistry, PublicRegistry:
+		return nil
+	}
+
+	return ErrInvalidRegistryName
 }
 
 func (o RegistryModuleID) valid() error {
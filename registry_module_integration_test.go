@@ -8,6 +8,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
@@ -17,6 +20,74 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestRegistryModulesList(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	rmTest1, _ := createRegistryModule(t, client, orgTest)
+	rmTest2, _ := createRegistryModule(t, client, orgTest)
+
+	t.Run("without list options", func(t *testing.T) {
+		ml, err := client.RegistryModules.List(ctx, orgTest.Name, nil)
+		require.NoError(t, err)
+
+		var ids []string
+		for _, rm := range ml.Items {
+			ids = append(ids, rm.ID)
+		}
+		assert.ElementsMatch(t, []string{rmTest1.ID, rmTest2.ID}, ids)
+		assert.Equal(t, 2, ml.TotalCount)
+	})
+
+	t.Run("with pagination", func(t *testing.T) {
+		ml, err := client.RegistryModules.List(ctx, orgTest.Name, &RegistryModuleListOptions{
+			ListOptions: ListOptions{PageNumber: 1, PageSize: 1},
+		})
+		require.NoError(t, err)
+		assert.Len(t, ml.Items, 1)
+		assert.Equal(t, 2, ml.TotalPages)
+	})
+
+	t.Run("with a search query", func(t *testing.T) {
+		ml, err := client.RegistryModules.List(ctx, orgTest.Name, &RegistryModuleListOptions{
+			Search: rmTest1.Name,
+		})
+		require.NoError(t, err)
+		require.Len(t, ml.Items, 1)
+		assert.Equal(t, rmTest1.ID, ml.Items[0].ID)
+	})
+
+	t.Run("with filters", func(t *testing.T) {
+		ml, err := client.RegistryModules.List(ctx, orgTest.Name, &RegistryModuleListOptions{
+			RegistryName: PrivateRegistry,
+			Provider:     rmTest1.Provider,
+		})
+		require.NoError(t, err)
+		assert.Len(t, ml.Items, 2)
+
+		ml, err = client.RegistryModules.List(ctx, orgTest.Name, &RegistryModuleListOptions{
+			RegistryName: PublicRegistry,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, ml.Items)
+	})
+
+	t.Run("with an invalid registry name", func(t *testing.T) {
+		_, err := client.RegistryModules.List(ctx, orgTest.Name, &RegistryModuleListOptions{
+			RegistryName: "invalid",
+		})
+		assert.Equal(t, ErrInvalidRegistryName, err)
+	})
+
+	t.Run("without a valid organization", func(t *testing.T) {
+		_, err := client.RegistryModules.List(ctx, badIdentifier, nil)
+		assert.Equal(t, ErrInvalidOrg, err)
+	})
+}
+
 func TestRegistryModulesCreate(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -806,3 +877,73 @@ func TestRegistryCreateOptions_Marshal(t *testing.T) {
 `
 	assert.Equal(t, expectedBody, string(bodyBytes))
 }
+
+func TestRegistryModulesPublishLocalModule(t *testing.T) {
+	var requests []string
+	var uploaded []byte
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch r.URL.Path {
+		case "/api/v2/registry-modules/show/acme/vpc/aws":
+			w.WriteHeader(http.StatusNotFound)
+		case "/api/v2/organizations/acme/registry-modules":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"data":{"id":"mod-1","type":"registry-modules","attributes":{"name":"vpc","provider":"aws"}}}`)
+		case "/api/v2/registry-modules/acme/vpc/aws/versions":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"data":{"id":"modver-1","type":"registry-module-versions","attributes":{"version":"1.2.0"},"links":{"upload":"%s/upload"}}}`, "http://"+r.Host)
+		case "/upload":
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			uploaded = body
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	moduleID := RegistryModuleID{Organization: "acme", Name: "vpc", Provider: "aws"}
+
+	t.Run("when the module does not exist", func(t *testing.T) {
+		rmv, err := client.RegistryModules.PublishLocalModule(ctx, "test-fixtures/config-version", moduleID, "1.2.0")
+		require.NoError(t, err)
+		assert.Equal(t, "modver-1", rmv.ID)
+		assert.Equal(t, []string{
+			"GET /api/v2/registry-modules/show/acme/vpc/aws",
+			"POST /api/v2/organizations/acme/registry-modules",
+			"POST /api/v2/registry-modules/acme/vpc/aws/versions",
+			"PUT /upload",
+		}, requests)
+		assert.NotEmpty(t, uploaded)
+	})
+
+	t.Run("when uploading a tar.gz archive", func(t *testing.T) {
+		err := client.RegistryModules.UploadTarGzip(ctx, ts.URL+"/upload", strings.NewReader("archive"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("archive"), uploaded)
+	})
+
+	t.Run("with an invalid version", func(t *testing.T) {
+		_, err := client.RegistryModules.PublishLocalModule(ctx, "test-fixtures/config-version", moduleID, "")
+		assert.Equal(t, ErrRequiredVersion, err)
+	})
+
+	t.Run("with an invalid module ID", func(t *testing.T) {
+		_, err := client.RegistryModules.PublishLocalModule(ctx, "test-fixtures/config-version", RegistryModuleID{Organization: "acme"}, "1.2.0")
+		assert.Equal(t, ErrRequiredName, err)
+	})
+}
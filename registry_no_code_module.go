@@ -0,0 +1,296 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Compile-time proof of interface implementation.
+var _ RegistryNoCodeModules = (*registryNoCodeModules)(nil)
+
+// RegistryNoCodeModules describes all the registry no-code module related
+// methods that the Terraform Enterprise API supports. No-code modules allow
+// provisioning workspaces from a registry module without writing any
+// configuration.
+//
+// TFE API docs: https://developer.hashicorp.com/terraform/cloud-docs/api-docs/no-code-provisioning
+type RegistryNoCodeModules interface {
+	// Create a no-code module for a registry module of an organization.
+	Create(ctx context.Context, organization string, options RegistryNoCodeModuleCreateOptions) (*RegistryNoCodeModule, error)
+
+	// Read a no-code module by its ID.
+	Read(ctx context.Context, noCodeModuleID string, options *RegistryNoCodeModuleReadOptions) (*RegistryNoCodeModule, error)
+
+	// Update a no-code module by its ID.
+	Update(ctx context.Context, noCodeModuleID string, options RegistryNoCodeModuleUpdateOptions) (*RegistryNoCodeModule, error)
+
+	// Delete a no-code module by its ID.
+	Delete(ctx context.Context, noCodeModuleID string) error
+
+	// CreateWorkspace provisions a workspace from a no-code module.
+	CreateWorkspace(ctx context.Context, noCodeModuleID string, options RegistryNoCodeModuleCreateWorkspaceOptions) (*Workspace, error)
+}
+
+// registryNoCodeModules implements RegistryNoCodeModules.
+type registryNoCodeModules struct {
+	client *Client
+}
+
+// RegistryNoCodeModule represents a registry no-code module.
+type RegistryNoCodeModule struct {
+	ID         string `jsonapi:"primary,no-code-modules"`
+	Enabled    bool   `jsonapi:"attr,enabled"`
+	VersionPin string `jsonapi:"attr,version-pin"`
+
+	// Relations
+	Organization    *Organization           `jsonapi:"relation,organization"`
+	RegistryModule  *RegistryModule         `jsonapi:"relation,registry-module"`
+	VariableOptions []*NoCodeVariableOption `jsonapi:"relation,variable-options"`
+}
+
+// NoCodeVariableOption represents the values a variable of a no-code module
+// is restricted to.
+type NoCodeVariableOption struct {
+	// Type is a public field utilized by JSON:API to
+	// set the resource type via the field tag.
+	// It is not a user-defined value and does not need to be set.
+	// https://jsonapi.org/format/#crud-creating
+	Type string `jsonapi:"primary,variable-options"`
+
+	// Required: The name of the variable.
+	VariableName string `jsonapi:"attr,variable-name"`
+
+	// Required: The type of the variable, e.g. "string".
+	VariableType string `jsonapi:"attr,variable-type"`
+
+	// Optional: The values the variable can be set to.
+	Options []string `jsonapi:"attr,options"`
+}
+
+// RegistryNoCodeModuleIncludeOpt represents the available options for include
+// query params.
+type RegistryNoCodeModuleIncludeOpt string
+
+const (
+	RegistryNoCodeIncludeVariableOptions RegistryNoCodeModuleIncludeOpt = "variable_options"
+)
+
+// RegistryNoCodeModuleReadOptions represents the options for reading a
+// no-code module.
+type RegistryNoCodeModuleReadOptions struct {
+	// Optional: A list of relations to include.
+	Include []RegistryNoCodeModuleIncludeOpt `url:"include,omitempty"`
+}
+
+// RegistryNoCodeModuleCreateOptions represents the options for creating a
+// no-code module.
+type RegistryNoCodeModuleCreateOptions struct {
+	// Type is a public field utilized by JSON:API to
+	// set the resource type via the field tag.
+	// It is not a user-defined value and does not need to be set.
+	// https://jsonapi.org/format/#crud-creating
+	Type string `jsonapi:"primary,no-code-modules"`
+
+	// Optional: The version of the registry module workspaces are
+	// provisioned with. Defaults to the latest version.
+	VersionPin *string `jsonapi:"attr,version-pin,omitempty"`
+
+	// Optional: Whether the no-code module is enabled. Defaults to true.
+	Enabled *bool `jsonapi:"attr,enabled,omitempty"`
+
+	// Required: The registry module to create the no-code module for.
+	RegistryModule *RegistryModule `jsonapi:"relation,registry-module"`
+
+	// Optional: The values variables of the module are restricted to.
+	VariableOptions []*NoCodeVariableOption `jsonapi:"relation,variable-options,omitempty"`
+}
+
+// RegistryNoCodeModuleUpdateOptions represents the options for updating a
+// no-code module.
+type RegistryNoCodeModuleUpdateOptions struct {
+	// Type is a public field utilized by JSON:API to
+	// set the resource type via the field tag.
+	// It is not a user-defined value and does not need to be set.
+	// https://jsonapi.org/format/#crud-creating
+	Type string `jsonapi:"primary,no-code-modules"`
+
+	// Optional: The version of the registry module workspaces are
+	// provisioned with.
+	VersionPin *string `jsonapi:"attr,version-pin,omitempty"`
+
+	// Optional: Whether the no-code module is enabled.
+	Enabled *bool `jsonapi:"attr,enabled,omitempty"`
+
+	// Optional: The values variables of the module are restricted to,
+	// replacing the current variable options.
+	VariableOptions []*NoCodeVariableOption `jsonapi:"relation,variable-options,omitempty"`
+}
+
+// RegistryNoCodeModuleCreateWorkspaceOptions represents the options for
+// provisioning a workspace from a no-code module.
+type RegistryNoCodeModuleCreateWorkspaceOptions struct {
+	// Type is a public field utilized by JSON:API to
+	// set the resource type via the field tag.
+	// It is not a user-defined value and does not need to be set.
+	// https://jsonapi.org/format/#crud-creating
+	Type string `jsonapi:"primary,workspaces"`
+
+	// Required: The name of the workspace.
+	Name string `jsonapi:"attr,name"`
+
+	// Optional: A description of the workspace.
+	Description *string `jsonapi:"attr,description,omitempty"`
+
+	// Optional: Whether to automatically apply changes.
+	AutoApply *bool `jsonapi:"attr,auto-apply,omitempty"`
+
+	// Optional: The project to create the workspace in. Defaults to the
+	// default project of the organization.
+	Project *Project `jsonapi:"relation,project,omitempty"`
+
+	// Optional: The values of the variables of the module. Only the key,
+	// value and category of the variables are used.
+	Vars []*Variable `jsonapi:"relation,vars,omitempty"`
+}
+
+// Create a no-code module for a registry module of an organization.
+func (s *registryNoCodeModules) Create(ctx context.Context, organization string, options RegistryNoCodeModuleCreateOptions) (*RegistryNoCodeModule, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("organizations/%s/no-code-modules", url.QueryEscape(organization))
+	req, err := s.client.newRequest("POST", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	nc := &RegistryNoCodeModule{}
+	err = s.client.do(ctx, "registryNoCodeModules.Create", req, nc)
+	if err != nil {
+		return nil, err
+	}
+
+	return nc, nil
+}
+
+// Read a no-code module by its ID.
+func (s *registryNoCodeModules) Read(ctx context.Context, noCodeModuleID string, options *RegistryNoCodeModuleReadOptions) (*RegistryNoCodeModule, error) {
+	if !validStringID(&noCodeModuleID) {
+		return nil, ErrInvalidNoCodeModuleID
+	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("no-code-modules/%s", url.QueryEscape(noCodeModuleID))
+	req, err := s.client.newRequest("GET", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	nc := &RegistryNoCodeModule{}
+	err = s.client.do(ctx, "registryNoCodeModules.Read", req, nc)
+	if err != nil {
+		return nil, err
+	}
+
+	return nc, nil
+}
+
+// Update a no-code module by its ID.
+func (s *registryNoCodeModules) Update(ctx context.Context, noCodeModuleID string, options RegistryNoCodeModuleUpdateOptions) (*RegistryNoCodeModule, error) {
+	if !validStringID(&noCodeModuleID) {
+		return nil, ErrInvalidNoCodeModuleID
+	}
+
+	u := fmt.Sprintf("no-code-modules/%s", url.QueryEscape(noCodeModuleID))
+	req, err := s.client.newRequest("PATCH", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	nc := &RegistryNoCodeModule{}
+	err = s.client.do(ctx, "registryNoCodeModules.Update", req, nc)
+	if err != nil {
+		return nil, err
+	}
+
+	return nc, nil
+}
+
+// Delete a no-code module by its ID.
+func (s *registryNoCodeModules) Delete(ctx context.Context, noCodeModuleID string) error {
+	if !validStringID(&noCodeModuleID) {
+		return ErrInvalidNoCodeModuleID
+	}
+
+	u := fmt.Sprintf("no-code-modules/%s", url.QueryEscape(noCodeModuleID))
+	req, err := s.client.newRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.client.do(ctx, "registryNoCodeModules.Delete", req, nil)
+}
+
+// CreateWorkspace provisions a workspace from a no-code module.
+func (s *registryNoCodeModules) CreateWorkspace(ctx context.Context, noCodeModuleID string, options RegistryNoCodeModuleCreateWorkspaceOptions) (*Workspace, error) {
+	if !validStringID(&noCodeModuleID) {
+		return nil, ErrInvalidNoCodeModuleID
+	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("no-code-modules/%s/workspaces", url.QueryEscape(noCodeModuleID))
+	req, err := s.client.newRequest("POST", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Workspace{}
+	err = s.client.do(ctx, "registryNoCodeModules.CreateWorkspace", req, w)
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func (o RegistryNoCodeModuleCreateOptions) valid() error {
+	if o.RegistryModule == nil || !validStringID(&o.RegistryModule.ID) {
+		return ErrRequiredRegistryModule
+	}
+
+	return nil
+}
+
+func (o *RegistryNoCodeModuleReadOptions) valid() error {
+	if o == nil {
+		return nil // nothing to validate
+	}
+
+	for _, i := range o.Include {
+		if i != RegistryNoCodeIncludeVariableOptions {
+			return ErrInvalidIncludeValue
+		}
+	}
+
+	return nil
+}
+
+func (o RegistryNoCodeModuleCreateWorkspaceOptions) valid() error {
+	if !validString(&o.Name) {
+		return ErrRequiredName
+	}
+	if !validStringID(&o.Name) {
+		return ErrInvalidName
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryNoCodeModulesCreate(t *testing.T) {
+	skipIfFreeOnly(t)
+
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	rm, _ := createRegistryModule(t, client, orgTest)
+
+	t.Run("with valid options", func(t *testing.T) {
+		nc, err := client.RegistryNoCodeModules.Create(ctx, orgTest.Name, RegistryNoCodeModuleCreateOptions{
+			RegistryModule: rm,
+			VariableOptions: []*NoCodeVariableOption{{
+				VariableName: "region",
+				VariableType: "string",
+				Options:      []string{"us-east-1", "eu-west-1"},
+			}},
+		})
+		require.NoError(t, err)
+		assert.True(t, nc.Enabled)
+		assert.Equal(t, rm.ID, nc.RegistryModule.ID)
+
+		t.Run("when reading it with its variable options", func(t *testing.T) {
+			read, err := client.RegistryNoCodeModules.Read(ctx, nc.ID, &RegistryNoCodeModuleReadOptions{
+				Include: []RegistryNoCodeModuleIncludeOpt{RegistryNoCodeIncludeVariableOptions},
+			})
+			require.NoError(t, err)
+			require.Len(t, read.VariableOptions, 1)
+			assert.Equal(t, "region", read.VariableOptions[0].VariableName)
+		})
+
+		t.Run("when disabling it", func(t *testing.T) {
+			updated, err := client.RegistryNoCodeModules.Update(ctx, nc.ID, RegistryNoCodeModuleUpdateOptions{
+				Enabled: Bool(false),
+			})
+			require.NoError(t, err)
+			assert.False(t, updated.Enabled)
+		})
+
+		t.Run("when deleting it", func(t *testing.T) {
+			err := client.RegistryNoCodeModules.Delete(ctx, nc.ID)
+			require.NoError(t, err)
+
+			_, err = client.RegistryNoCodeModules.Read(ctx, nc.ID, nil)
+			assert.Equal(t, ErrResourceNotFound, err)
+		})
+	})
+
+	t.Run("without a registry module", func(t *testing.T) {
+		_, err := client.RegistryNoCodeModules.Create(ctx, orgTest.Name, RegistryNoCodeModuleCreateOptions{})
+		assert.Equal(t, ErrRequiredRegistryModule, err)
+	})
+
+	t.Run("with an invalid organization", func(t *testing.T) {
+		_, err := client.RegistryNoCodeModules.Create(ctx, badIdentifier, RegistryNoCodeModuleCreateOptions{RegistryModule: rm})
+		assert.Equal(t, ErrInvalidOrg, err)
+	})
+}
+
+func TestRegistryNoCodeModulesCreateWorkspace(t *testing.T) {
+	var body string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "POST /api/v2/no-code-modules/nocode-1/workspaces":
+			b, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			body = string(b)
+
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"data":{"id":"ws-1","type":"workspaces","attributes":{"name":"app"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("with variables", func(t *testing.T) {
+		w, err := client.RegistryNoCodeModules.CreateWorkspace(ctx, "nocode-1", RegistryNoCodeModuleCreateWorkspaceOptions{
+			Name:    "app",
+			Project: &Project{ID: "prj-1"},
+			Vars: []*Variable{{
+				Key:      "region",
+				Value:    "eu-west-1",
+				Category: CategoryTerraform,
+			}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "ws-1", w.ID)
+
+		assert.Contains(t, body, `"name":"app"`)
+		assert.Contains(t, body, `"id":"prj-1"`)
+		assert.Contains(t, body, `"key":"region"`)
+		assert.Contains(t, body, `"value":"eu-west-1"`)
+	})
+
+	t.Run("without a name", func(t *testing.T) {
+		_, err := client.RegistryNoCodeModules.CreateWorkspace(ctx, "nocode-1", RegistryNoCodeModuleCreateWorkspaceOptions{})
+		assert.Equal(t, ErrRequiredName, err)
+	})
+
+	t.Run("with an invalid no-code module ID", func(t *testing.T) {
+		_, err := client.RegistryNoCodeModules.CreateWorkspace(ctx, badIdentifier, RegistryNoCodeModuleCreateWorkspaceOptions{Name: "app"})
+		assert.Equal(t, ErrInvalidNoCodeModuleID, err)
+	})
+}
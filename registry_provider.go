@@ -0,0 +1,184 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Compile-time proof of interface implementation.
+var _ RegistryProviders = (*registryProviders)(nil)
+
+// RegistryProviders describes all the registry provider related methods that
+// the Terraform Enterprise API supports.
+//
+// TFE API docs: https://developer.hashicorp.com/terraform/cloud-docs/api-docs/private-registry/providers
+type RegistryProviders interface {
+	// List all the providers of the registry of the given organization.
+	List(ctx context.Context, organization string, options *RegistryProviderListOptions) (*RegistryProviderList, error)
+}
+
+// registryProviders implements RegistryProviders.
+type registryProviders struct {
+	client *Client
+}
+
+// RegistryProviderIncludeOps represents which related resources to include
+// when listing registry providers.
+type RegistryProviderIncludeOps string
+
+// List of available include options for registry providers.
+const (
+	RegistryProviderVersionsInclude RegistryProviderIncludeOps = "registry-provider-versions"
+)
+
+// RegistryProviderList represents a list of registry providers.
+type RegistryProviderList struct {
+	*Pagination
+	Items []*RegistryProvider
+}
+
+// RegistryProvider represents a provider published in a registry.
+type RegistryProvider struct {
+	ID           string                       `jsonapi:"primary,registry-providers"`
+	Name         string                       `jsonapi:"attr,name"`
+	Namespace    string                       `jsonapi:"attr,namespace"`
+	RegistryName RegistryName                 `jsonapi:"attr,registry-name"`
+	Permissions  *RegistryProviderPermissions `jsonapi:"attr,permissions"`
+	CreatedAt    string                       `jsonapi:"attr,created-at"`
+	UpdatedAt    string                       `jsonapi:"attr,updated-at"`
+
+	// Relations
+	Organization             *Organization              `jsonapi:"relation,organization"`
+	RegistryProviderVersions []*RegistryProviderVersion `jsonapi:"relation,registry-provider-versions"`
+
+	// Links
+	Links map[string]interface{} `jsonapi:"links,omitempty"`
+}
+
+// RegistryProviderPermissions represents the permissions of the current user
+// or token on a registry provider.
+type RegistryProviderPermissions struct {
+	CanDelete bool `jsonapi:"attr,can-delete"`
+}
+
+// RegistryProviderListOptions represents the options for listing registry
+// providers.
+type RegistryProviderListOptions struct {
+	ListOptions
+
+	// Optional: A search query string, matching the name and namespace of
+	// the providers.
+	Search string `url:"q,omitempty"`
+
+	// Optional: The registry the providers are published in.
+	RegistryName RegistryName `url:"filter[registry_name],omitempty"`
+
+	// Optional: The organization the providers were published by.
+	OrganizationName string `url:"filter[organization_name],omitempty"`
+
+	// Optional: A list of relations to include. Including the versions
+	// decodes them on every listed provider, so the number of versions and
+	// the latest version are known without reading every provider.
+	Include []RegistryProviderIncludeOps `url:"include,omitempty"`
+}
+
+// List all the providers of the registry of the given organization.
+func (r *registryProviders) List(ctx context.Context, organization string, options *RegistryProviderListOptions) (*RegistryProviderList, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("organizations/%s/registry-providers", url.QueryEscape(organization))
+	req, err := r.client.newRequest("GET", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	pl := &RegistryProviderList{}
+	err = r.client.do(ctx, "registryProviders.List", req, pl)
+	if err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
+
+// LatestVersion returns the highest version of the provider, or nil when the
+// provider was not listed or read with its versions included.
+func (p *RegistryProvider) LatestVersion() *RegistryProviderVersion {
+	var latest *RegistryProviderVersion
+	for _, v := range p.RegistryProviderVersions {
+		if latest == nil || compareProviderVersions(v.Version, latest.Version) > 0 {
+			latest = v
+		}
+	}
+	return latest
+}
+
+// compareProviderVersions compares two semantic versions, returning -1, 0 or
+// 1 when a is lower than, equal to, or higher than b. Pre-releases are lower
+// than the release they precede.
+func compareProviderVersions(a, b string) int {
+	aCore, aPre, _ := strings.Cut(strings.TrimPrefix(a, "v"), "-")
+	bCore, bPre, _ := strings.Cut(strings.TrimPrefix(b, "v"), "-")
+
+	aParts := strings.Split(aCore, ".")
+	bParts := strings.Split(bCore, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var an, bn int
+		if i < len(aParts) {
+			an, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			bn, _ = strconv.Atoi(bParts[i])
+		}
+		switch {
+		case an < bn:
+			return -1
+		case an > bn:
+			return 1
+		}
+	}
+
+	switch {
+	case aPre == bPre:
+		return 0
+	case aPre == "":
+		return 1
+	case bPre == "":
+		return -1
+	case aPre < bPre:
+		return -1
+	default:
+		return 1
+	}
+}
+
+func (o *RegistryProviderListOptions) valid() error {
+	if o == nil {
+		return nil // nothing to validate
+	}
+
+	switch o.RegistryName {
+	case "", PrivateRegistry, PublicRegistry:
+	default:
+		return ErrInvalidRegistryName
+	}
+
+	for _, i := range o.Include {
+		switch i {
+		case RegistryProviderVersionsInclude:
+			// do nothing
+		default:
+			return ErrInvalidIncludeValue
+		}
+	}
+
+	return nil
+}
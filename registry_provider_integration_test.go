@@ -0,0 +1,112 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryProvidersList(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/organizations/my-org/registry-providers":
+			assert.Equal(t, "registry-provider-versions", r.URL.Query().Get("include"))
+			assert.Equal(t, "private", r.URL.Query().Get("filter[registry_name]"))
+			fmt.Fprint(w, `{
+				"data": [
+					{"id": "prov-1", "type": "registry-providers",
+						"attributes": {"name": "aws", "namespace": "my-org", "registry-name": "private", "permissions": {"can-delete": true}},
+						"relationships": {"registry-provider-versions": {"data": [
+							{"id": "provver-1", "type": "registry-provider-versions"},
+							{"id": "provver-2", "type": "registry-provider-versions"},
+							{"id": "provver-3", "type": "registry-provider-versions"}
+						]}},
+						"links": {"self": "/api/v2/organizations/my-org/registry-providers/private/my-org/aws"}},
+					{"id": "prov-2", "type": "registry-providers",
+						"attributes": {"name": "empty", "namespace": "my-org", "registry-name": "private"},
+						"relationships": {"registry-provider-versions": {"data": []}}}
+				],
+				"included": [
+					{"id": "provver-1", "type": "registry-provider-versions", "attributes": {"version": "1.10.0", "shasums-uploaded": true}},
+					{"id": "provver-2", "type": "registry-provider-versions", "attributes": {"version": "1.9.2"}},
+					{"id": "provver-3", "type": "registry-provider-versions", "attributes": {"version": "1.11.0-beta1"}}
+				],
+				"meta": {"pagination": {"current-page": 1, "total-count": 2}}
+			}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("with the versions included", func(t *testing.T) {
+		pl, err := client.RegistryProviders.List(ctx, "my-org", &RegistryProviderListOptions{
+			RegistryName: PrivateRegistry,
+			Include:      []RegistryProviderIncludeOps{RegistryProviderVersionsInclude},
+		})
+		require.NoError(t, err)
+		require.Len(t, pl.Items, 2)
+		assert.Equal(t, 2, pl.TotalCount)
+
+		p := pl.Items[0]
+		assert.Equal(t, "aws", p.Name)
+		assert.Equal(t, PrivateRegistry, p.RegistryName)
+		assert.True(t, p.Permissions.CanDelete)
+		assert.NotEmpty(t, p.Links["self"])
+		require.Len(t, p.RegistryProviderVersions, 3)
+		assert.True(t, p.RegistryProviderVersions[0].ShasumsUploaded)
+
+		latest := p.LatestVersion()
+		require.NotNil(t, latest)
+		assert.Equal(t, "1.11.0-beta1", latest.Version)
+
+		assert.Nil(t, pl.Items[1].LatestVersion())
+	})
+
+	t.Run("with an invalid include", func(t *testing.T) {
+		_, err := client.RegistryProviders.List(ctx, "my-org", &RegistryProviderListOptions{
+			Include: []RegistryProviderIncludeOps{"platforms"},
+		})
+		assert.Equal(t, ErrInvalidIncludeValue, err)
+	})
+
+	t.Run("with an invalid registry name", func(t *testing.T) {
+		_, err := client.RegistryProviders.List(ctx, "my-org", &RegistryProviderListOptions{
+			RegistryName: "internal",
+		})
+		assert.Equal(t, ErrInvalidRegistryName, err)
+	})
+
+	t.Run("with an invalid organization", func(t *testing.T) {
+		_, err := client.RegistryProviders.List(ctx, badIdentifier, nil)
+		assert.Equal(t, ErrInvalidOrg, err)
+	})
+}
+
+func TestCompareProviderVersions(t *testing.T) {
+	assert.Equal(t, 1, compareProviderVersions("1.10.0", "1.9.2"))
+	assert.Equal(t, -1, compareProviderVersions("1.0.0-beta1", "1.0.0"))
+	assert.Equal(t, 0, compareProviderVersions("v2.0.0", "2.0.0"))
+	assert.Equal(t, -1, compareProviderVersions("2.0.0-alpha", "2.0.0-beta"))
+}
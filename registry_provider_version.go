@@ -0,0 +1,290 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// defaultShasumsTimeout is how long WaitForShasums waits by default.
+const defaultShasumsTimeout = 5 * time.Minute
+
+// defaultShasumsPollInterval is the interval WaitForShasums polls at by
+// default.
+const defaultShasumsPollInterval = 2 * time.Second
+
+// Compile-time proof of interface implementation.
+var _ RegistryProviderVersions = (*registryProviderVersions)(nil)
+
+// RegistryProviderVersions describes all the registry provider version
+// related methods that the Terraform Enterprise API supports.
+//
+// TFE API docs: https://developer.hashicorp.com/terraform/cloud-docs/api-docs/private-registry/provider-versions-platforms
+type RegistryProviderVersions interface {
+	// Create a version of a private registry provider. The shasums and
+	// their signature are uploaded to the links of the version afterwards.
+	Create(ctx context.Context, providerID RegistryProviderID, options RegistryProviderVersionCreateOptions) (*RegistryProviderVersion, error)
+
+	// Read a version of a registry provider.
+	Read(ctx context.Context, versionID RegistryProviderVersionID) (*RegistryProviderVersion, error)
+
+	// Delete a version of a private registry provider.
+	Delete(ctx context.Context, versionID RegistryProviderVersionID) error
+
+	// WaitForShasums waits until the shasums of a version of a registry
+	// provider and their signature were uploaded and processed.
+	WaitForShasums(ctx context.Context, versionID RegistryProviderVersionID, options *RegistryProviderVersionWaitOptions) (*RegistryProviderVersion, error)
+}
+
+// registryProviderVersions implements RegistryProviderVersions.
+type registryProviderVersions struct {
+	client *Client
+}
+
+// RegistryProviderID identifies a registry provider.
+type RegistryProviderID struct {
+	OrganizationName string
+	RegistryName     RegistryName
+	Namespace        string
+	Name             string
+}
+
+// RegistryProviderVersionID identifies a version of a registry provider.
+type RegistryProviderVersionID struct {
+	RegistryProviderID
+	Version string
+}
+
+// RegistryProviderVersion represents a version of a provider published in a
+// registry.
+type RegistryProviderVersion struct {
+	ID                 string                              `jsonapi:"primary,registry-provider-versions"`
+	Version            string                              `jsonapi:"attr,version"`
+	KeyID              string                              `jsonapi:"attr,key-id"`
+	Protocols          []string                            `jsonapi:"attr,protocols"`
+	Permissions        *RegistryProviderVersionPermissions `jsonapi:"attr,permissions"`
+	ShasumsUploaded    bool                                `jsonapi:"attr,shasums-uploaded"`
+	ShasumsSigUploaded bool                                `jsonapi:"attr,shasums-sig-uploaded"`
+	CreatedAt          string                              `jsonapi:"attr,created-at"`
+	UpdatedAt          string                              `jsonapi:"attr,updated-at"`
+
+	// Relations
+	RegistryProvider *RegistryProvider `jsonapi:"relation,registry-provider"`
+
+	// Links
+	Links map[string]interface{} `jsonapi:"links,omitempty"`
+}
+
+// RegistryProviderVersionPermissions represents the permissions of the
+// current user or token on a registry provider version.
+type RegistryProviderVersionPermissions struct {
+	CanDelete      bool `jsonapi:"attr,can-delete"`
+	CanUploadAsset bool `jsonapi:"attr,can-upload-asset"`
+}
+
+// RegistryProviderVersionCreateOptions represents the options for creating a
+// version of a registry provider.
+type RegistryProviderVersionCreateOptions struct {
+	// Type is a public field utilized by JSON:API to
+	// set the resource type via the field tag.
+	// It is not a user-defined value and does not need to be set.
+	// https://jsonapi.org/format/#crud-creating
+	Type string `jsonapi:"primary,registry-provider-versions"`
+
+	// Required: A valid semantic version.
+	Version string `jsonapi:"attr,version"`
+
+	// Required: The ID of the GPG key the shasums are signed with.
+	KeyID string `jsonapi:"attr,key-id"`
+
+	// Optional: The plugin protocol versions the provider supports.
+	Protocols []string `jsonapi:"attr,protocols,omitempty"`
+}
+
+// RegistryProviderVersionWaitOptions represents the options for waiting
+// until the shasums of a registry provider version were uploaded.
+type RegistryProviderVersionWaitOptions struct {
+	// Optional: How long to wait. It defaults to 5 minutes.
+	Timeout time.Duration
+
+	// Optional: The interval the version is polled at. It defaults to 2
+	// seconds.
+	PollInterval time.Duration
+}
+
+// ShasumsTimeoutError is returned when the shasums of a registry provider
+// version were not uploaded within the timeout. It matches
+// context.DeadlineExceeded when compared using errors.Is.
+type ShasumsTimeoutError struct {
+	// The version as it was last read.
+	Version *RegistryProviderVersion
+
+	// How long was waited.
+	Timeout time.Duration
+}
+
+// Error implements the error interface.
+func (e *ShasumsTimeoutError) Error() string {
+	return fmt.Sprintf("timed out after %s waiting for the shasums of provider version %s (shasums uploaded: %t, signature uploaded: %t)",
+		e.Timeout, e.Version.Version, e.Version.ShasumsUploaded, e.Version.ShasumsSigUploaded)
+}
+
+// Unwrap returns context.DeadlineExceeded.
+func (e *ShasumsTimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// Create a version of a private registry provider.
+func (r *registryProviderVersions) Create(ctx context.Context, providerID RegistryProviderID, options RegistryProviderVersionCreateOptions) (*RegistryProviderVersion, error) {
+	if err := providerID.valid(); err != nil {
+		return nil, err
+	}
+	if providerID.RegistryName != PrivateRegistry {
+		return nil, ErrInvalidRegistryName
+	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("%s/versions", providerID.path())
+	req, err := r.client.newRequest("POST", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	pv := &RegistryProviderVersion{}
+	err = r.client.do(ctx, "registryProviderVersions.Create", req, pv)
+	if err != nil {
+		return nil, err
+	}
+
+	return pv, nil
+}
+
+// Read a version of a registry provider.
+func (r *registryProviderVersions) Read(ctx context.Context, versionID RegistryProviderVersionID) (*RegistryProviderVersion, error) {
+	if err := versionID.valid(); err != nil {
+		return nil, err
+	}
+
+	req, err := r.client.newRequest("GET", versionID.path(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pv := &RegistryProviderVersion{}
+	err = r.client.do(ctx, "registryProviderVersions.Read", req, pv)
+	if err != nil {
+		return nil, err
+	}
+
+	return pv, nil
+}
+
+// Delete a version of a private registry provider.
+func (r *registryProviderVersions) Delete(ctx context.Context, versionID RegistryProviderVersionID) error {
+	if err := versionID.valid(); err != nil {
+		return err
+	}
+
+	req, err := r.client.newRequest("DELETE", versionID.path(), nil)
+	if err != nil {
+		return err
+	}
+
+	return r.client.do(ctx, "registryProviderVersions.Delete", req, nil)
+}
+
+// WaitForShasums waits until the shasums of a version of a registry provider
+// and their signature were uploaded and processed, and returns the version.
+// When this does not happen within the timeout, an error of the type
+// *ShasumsTimeoutError is returned.
+func (r *registryProviderVersions) WaitForShasums(ctx context.Context, versionID RegistryProviderVersionID, options *RegistryProviderVersionWaitOptions) (*RegistryProviderVersion, error) {
+	timeout := defaultShasumsTimeout
+	interval := defaultShasumsPollInterval
+	if options != nil {
+		if options.Timeout > 0 {
+			timeout = options.Timeout
+		}
+		if options.PollInterval > 0 {
+			interval = options.PollInterval
+		}
+	}
+
+	deadline := r.client.clock.Now().Add(timeout)
+	for {
+		pv, err := r.Read(ctx, versionID)
+		if err != nil {
+			return nil, err
+		}
+		if pv.ShasumsUploaded && pv.ShasumsSigUploaded {
+			return pv, nil
+		}
+		if !r.client.clock.Now().Before(deadline) {
+			return pv, &ShasumsTimeoutError{Version: pv, Timeout: timeout}
+		}
+
+		select {
+		case <-ctx.Done():
+			return pv, ctx.Err()
+		case <-r.client.clock.After(interval):
+		}
+	}
+}
+
+// path returns the path of the registry provider.
+func (id RegistryProviderID) path() string {
+	return fmt.Sprintf("organizations/%s/registry-providers/%s/%s/%s",
+		url.QueryEscape(id.OrganizationName),
+		url.QueryEscape(string(id.RegistryName)),
+		url.QueryEscape(id.Namespace),
+		url.QueryEscape(id.Name),
+	)
+}
+
+// path returns the path of the registry provider version.
+func (id RegistryProviderVersionID) path() string {
+	return fmt.Sprintf("%s/versions/%s", id.RegistryProviderID.path(), url.QueryEscape(id.Version))
+}
+
+func (id RegistryProviderID) valid() error {
+	if !validStringID(&id.OrganizationName) {
+		return ErrInvalidOrg
+	}
+	switch id.RegistryName {
+	case PrivateRegistry, PublicRegistry:
+	default:
+		return ErrInvalidRegistryName
+	}
+	if !validStringID(&id.Namespace) {
+		return ErrInvalidNamespace
+	}
+	if !validStringID(&id.Name) {
+		return ErrInvalidName
+	}
+
+	return nil
+}
+
+func (id RegistryProviderVersionID) valid() error {
+	if err := id.RegistryProviderID.valid(); err != nil {
+		return err
+	}
+	if !validStringID(&id.Version) {
+		return ErrInvalidVersion
+	}
+
+	return nil
+}
+
+func (o RegistryProviderVersionCreateOptions) valid() error {
+	if !validString(&o.Version) {
+		return ErrRequiredVersion
+	}
+	if !validString(&o.KeyID) {
+		return ErrRequiredKeyID
+	}
+
+	return nil
+}
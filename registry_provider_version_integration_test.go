@@ -0,0 +1,135 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryProviderVersions(t *testing.T) {
+	const versionPath = "/api/v2/organizations/acme/registry-providers/private/acme/aws/versions/1.0.0"
+
+	reads := 0
+	uploadedAfter := 2
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/organizations/acme/registry-providers/private/acme/aws/versions":
+			require.Equal(t, http.MethodPost, r.Method)
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.Contains(t, string(body), `"key-id":"32966F3FB5AC1129"`)
+
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"data":{"id":"provver-1","type":"registry-provider-versions","attributes":{"version":"1.0.0","key-id":"32966F3FB5AC1129"},`+
+				`"links":{"shasums-upload":"https://archivist.example.com/shasums","shasums-sig-upload":"https://archivist.example.com/sig"}}}`)
+		case versionPath:
+			if r.Method == http.MethodDelete {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			reads++
+			uploaded := reads >= uploadedAfter
+			fmt.Fprintf(w, `{"data":{"id":"provver-1","type":"registry-provider-versions","attributes":{"version":"1.0.0","shasums-uploaded":%t,"shasums-sig-uploaded":%t}}}`,
+				uploaded, uploaded)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	clock := NewFakeClock(time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC))
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Clock:      clock,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	providerID := RegistryProviderID{
+		OrganizationName: "acme",
+		RegistryName:     PrivateRegistry,
+		Namespace:        "acme",
+		Name:             "aws",
+	}
+	versionID := RegistryProviderVersionID{RegistryProviderID: providerID, Version: "1.0.0"}
+
+	t.Run("when creating a version", func(t *testing.T) {
+		pv, err := client.RegistryProviderVersions.Create(ctx, providerID, RegistryProviderVersionCreateOptions{
+			Version: "1.0.0",
+			KeyID:   "32966F3FB5AC1129",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "provver-1", pv.ID)
+		assert.Equal(t, "https://archivist.example.com/shasums", pv.Links["shasums-upload"])
+	})
+
+	t.Run("when the shasums are uploaded", func(t *testing.T) {
+		reads = 0
+		pv, err := client.RegistryProviderVersions.WaitForShasums(ctx, versionID, &RegistryProviderVersionWaitOptions{
+			PollInterval: time.Second,
+		})
+		require.NoError(t, err)
+		assert.True(t, pv.ShasumsUploaded)
+		assert.True(t, pv.ShasumsSigUploaded)
+		assert.Equal(t, 2, reads)
+	})
+
+	t.Run("when the shasums are not uploaded in time", func(t *testing.T) {
+		reads = 0
+		uploadedAfter = 100
+		defer func() { uploadedAfter = 2 }()
+
+		_, err := client.RegistryProviderVersions.WaitForShasums(ctx, versionID, &RegistryProviderVersionWaitOptions{
+			Timeout:      10 * time.Second,
+			PollInterval: 2 * time.Second,
+		})
+		var timeoutErr *ShasumsTimeoutError
+		require.True(t, errors.As(err, &timeoutErr))
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		assert.Equal(t, 10*time.Second, timeoutErr.Timeout)
+		assert.False(t, timeoutErr.Version.ShasumsUploaded)
+		assert.Equal(t, 6, reads)
+	})
+
+	t.Run("when deleting a version", func(t *testing.T) {
+		err := client.RegistryProviderVersions.Delete(ctx, versionID)
+		require.NoError(t, err)
+	})
+
+	t.Run("with invalid values", func(t *testing.T) {
+		_, err := client.RegistryProviderVersions.Create(ctx, providerID, RegistryProviderVersionCreateOptions{Version: "1.0.0"})
+		assert.Equal(t, ErrRequiredKeyID, err)
+
+		public := providerID
+		public.RegistryName = PublicRegistry
+		_, err = client.RegistryProviderVersions.Create(ctx, public, RegistryProviderVersionCreateOptions{Version: "1.0.0", KeyID: "key"})
+		assert.Equal(t, ErrInvalidRegistryName, err)
+
+		invalid := versionID
+		invalid.Namespace = badIdentifier
+		_, err = client.RegistryProviderVersions.Read(ctx, invalid)
+		assert.Equal(t, ErrInvalidNamespace, err)
+
+		invalid = versionID
+		invalid.Version = ""
+		_, err = client.RegistryProviderVersions.Read(ctx, invalid)
+		assert.Equal(t, ErrInvalidVersion, err)
+	})
+}
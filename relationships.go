@@ -0,0 +1,102 @@
+package tfe
+
+import (
+	"context"
+)
+
+// ResourceIdentifier identifies a single resource by its type and ID, as used
+// in JSON:API relationship linkage.
+type ResourceIdentifier struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// RelationshipsUpdateOptions represents a relationship-only update of a
+// resource. Only the given relationships are sent, so none of the resource
+// attributes are changed by the update.
+type RelationshipsUpdateOptions struct {
+	// Optional: To-one relationships keyed by relationship name. A nil
+	// identifier clears the relationship.
+	ToOne map[string]*ResourceIdentifier
+
+	// Optional: To-many relationships keyed by relationship name. The given
+	// identifiers replace all current members of the relationship, so an
+	// empty slice clears the relationship.
+	ToMany map[string][]*ResourceIdentifier
+}
+
+// relationshipsPatch is the request body of a relationship-only update.
+type relationshipsPatch struct {
+	Data relationshipsPatchData `json:"data"`
+}
+
+type relationshipsPatchData struct {
+	Type          string                               `json:"type"`
+	ID            string                               `json:"id"`
+	Relationships map[string]relationshipsPatchLinkage `json:"relationships"`
+}
+
+type relationshipsPatchLinkage struct {
+	Data interface{} `json:"data"`
+}
+
+// updateRelationships sends a PATCH request to the given path containing only
+// the relationships described by options. The response is decoded into v.
+func (c *Client) updateRelationships(ctx context.Context, op, path, resourceType, resourceID string, options RelationshipsUpdateOptions, v interface{}) error {
+	if err := options.valid(); err != nil {
+		return err
+	}
+
+	body := &relationshipsPatch{
+		Data: relationshipsPatchData{
+			Type:          resourceType,
+			ID:            resourceID,
+			Relationships: make(map[string]relationshipsPatchLinkage),
+		},
+	}
+	for name, ri := range options.ToOne {
+		// Explicitly use a nil interface, so a cleared relationship is
+		// encoded as null.
+		var data interface{}
+		if ri != nil {
+			data = ri
+		}
+		body.Data.Relationships[name] = relationshipsPatchLinkage{Data: data}
+	}
+	for name, ris := range options.ToMany {
+		if ris == nil {
+			ris = []*ResourceIdentifier{}
+		}
+		body.Data.Relationships[name] = relationshipsPatchLinkage{Data: ris}
+	}
+
+	req, err := c.newRequest("PATCH", path, body)
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, op, req, v)
+}
+
+func (o RelationshipsUpdateOptions) valid() error {
+	if len(o.ToOne) == 0 && len(o.ToMany) == 0 {
+		return ErrRequiredRelationships
+	}
+	for name := range o.ToOne {
+		if !validString(&name) {
+			return ErrInvalidRelationshipName
+		}
+	}
+	for name, ris := range o.ToMany {
+		if !validString(&name) {
+			return ErrInvalidRelationshipName
+		}
+		for _, ri := range ris {
+			if ri == nil {
+				return ErrInvalidResourceIdentifier
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package tfe
+
+import (
+	"context"
+	"strings"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+// noRetryKey is the context key marking requests which are not retried after
+// server errors or connection failures.
+type noRetryKey struct{}
+
+// prepareRetries returns the context to send a request with, which marks
+// requests whose method is not retried after server errors or connection
+// failures.
+func (c *Client) prepareRetries(ctx context.Context, req *retryablehttp.Request) context.Context {
+	if c.noRetryMethods[req.Method] {
+		ctx = context.WithValue(ctx, noRetryKey{}, true)
+	}
+	return ctx
+}
+
+// isNoRetry reports whether the request sent with the context is not retried
+// after server errors or connection failures.
+func isNoRetry(ctx context.Context) bool {
+	noRetry, _ := ctx.Value(noRetryKey{}).(bool)
+	return noRetry
+}
+
+// methodSet returns the set of the given HTTP methods, in upper case.
+func methodSet(methods []string) map[string]bool {
+	if len(methods) == 0 {
+		return nil
+	}
+
+	set := make(map[string]bool, len(methods))
+	for _, m := range methods {
+		set[strings.ToUpper(m)] = true
+	}
+	return set
+}
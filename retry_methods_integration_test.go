@@ -0,0 +1,71 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientNoRetryMethods(t *testing.T) {
+	var mu sync.Mutex
+	var methods []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		mu.Lock()
+		methods = append(methods, r.Method)
+		mu.Unlock()
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer ts.Close()
+
+	newClient := func(t *testing.T, config *Config) *Client {
+		config.Address = ts.URL
+		config.Token = "dummy-token"
+		config.HTTPClient = ts.Client()
+		config.RetryMax = 2
+		config.RetryWaitMin = time.Millisecond
+		config.RetryWaitMax = time.Millisecond
+		config.Backoff = func(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+			return 0
+		}
+
+		client, err := NewClient(config)
+		require.NoError(t, err)
+		client.RetryServerErrors(true)
+
+		methods = nil
+		return client
+	}
+
+	ctx := context.Background()
+	send := func(t *testing.T, ctx context.Context, client *Client, method string) {
+		req, err := client.newRequest(method, "foo", nil)
+		require.NoError(t, err)
+		require.Error(t, client.do(ctx, "tfe.Test", req, nil))
+	}
+
+	t.Run("when a method is not retried", func(t *testing.T) {
+		client := newClient(t, &Config{NoRetryMethods: []string{"post", "PATCH"}})
+
+		send(t, ctx, client, "POST")
+		assert.Equal(t, []string{"POST"}, methods)
+
+		methods = nil
+		send(t, ctx, client, "GET")
+		assert.Equal(t, []string{"GET", "GET", "GET"}, methods)
+	})
+}
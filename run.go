@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strings"
 	"time"
 )
 
@@ -38,6 +39,10 @@ type Runs interface {
 
 	// Discard a run by its ID.
 	Discard(ctx context.Context, runID string, options RunDiscardOptions) error
+
+	// Retry creates a new run with the configuration version and options of
+	// a previous run.
+	Retry(ctx context.Context, runID string, options RunRetryOptions) (*Run, error)
 }
 
 // runs implements Runs.
@@ -83,6 +88,33 @@ const (
 	RunSourceUI                   RunSource = "tfe-ui"
 )
 
+// RunOperation represents an operation type of a run.
+type RunOperation string
+
+// List all available run operations.
+const (
+	RunOperationPlanApply   RunOperation = "plan_and_apply"
+	RunOperationPlanOnly    RunOperation = "plan_only"
+	RunOperationRefreshOnly RunOperation = "refresh_only"
+	RunOperationDestroy     RunOperation = "destroy"
+	RunOperationEmptyApply  RunOperation = "empty_apply"
+)
+
+// RunStatusGroup represents a group of run statuses.
+type RunStatusGroup string
+
+// List all available run status groups.
+const (
+	// RunStatusGroupNonFinal matches the runs which are still in progress.
+	RunStatusGroupNonFinal RunStatusGroup = "non_final"
+
+	// RunStatusGroupFinal matches the runs which have completed.
+	RunStatusGroupFinal RunStatusGroup = "final"
+
+	// RunStatusGroupDiscardable matches the runs which can be discarded.
+	RunStatusGroupDiscardable RunStatusGroup = "discardable"
+)
+
 // RunList represents a list of runs.
 type RunList struct {
 	*Pagination
@@ -120,6 +152,9 @@ type Run struct {
 	TaskStages           []*TaskStage          `jsonapi:"relation,task-stages,omitempty"`
 	Workspace            *Workspace            `jsonapi:"relation,workspace"`
 	Comments             []*Comment            `jsonapi:"relation,comments"`
+
+	// Links
+	Links map[string]interface{} `jsonapi:"links,omitempty"`
 }
 
 // RunActions represents the run actions.
@@ -180,6 +215,33 @@ const (
 // RunListOptions represents the options for listing runs.
 type RunListOptions struct {
 	ListOptions
+
+	// Optional: Searches runs that match the supplied VCS username.
+	User string `url:"search[user],omitempty"`
+
+	// Optional: Searches runs that match the supplied commit sha.
+	Commit string `url:"search[commit],omitempty"`
+
+	// Optional: Searches runs that match the supplied VCS username, commit
+	// sha, run ID or run message.
+	Search string `url:"search[basic],omitempty"`
+
+	// Optional: A comma-separated list of run statuses used to filter the
+	// results, see RunStatus for the available values.
+	Status string `url:"filter[status],omitempty"`
+
+	// Optional: A comma-separated list of run sources used to filter the
+	// results, see RunSource for the available values.
+	Source string `url:"filter[source],omitempty"`
+
+	// Optional: A comma-separated list of run operations used to filter the
+	// results, see RunOperation for the available values.
+	Operation string `url:"filter[operation],omitempty"`
+
+	// Optional: A run status group used to filter the results, see
+	// RunStatusGroup for the available values.
+	StatusGroup RunStatusGroup `url:"filter[status_group],omitempty"`
+
 	// Optional: A list of relations to include. See available resources:
 	// https://www.terraform.io/docs/cloud/api/run.html#available-related-resources
 	Include []RunIncludeOpt `url:"include,omitempty"`
@@ -282,6 +344,25 @@ type RunDiscardOptions struct {
 	Comment *string `json:"comment,omitempty"`
 }
 
+// RunRetryOptions represents the options for retrying a run. Unset options
+// default to the ones of the retried run.
+type RunRetryOptions struct {
+	// Optional: The message of the new run. It defaults to a message
+	// referring to the retried run.
+	Message *string
+
+	// Optional: The configuration version to use instead of the one of the
+	// retried run.
+	ConfigurationVersion *ConfigurationVersion
+
+	// Optional: Whether the new run should be applied automatically.
+	AutoApply *bool
+
+	// Optional: The input variables of the new run, replacing the ones of the
+	// retried run.
+	Variables []*RunVariable
+}
+
 // List all the runs of the given workspace.
 func (s *runs) List(ctx context.Context, workspaceID string, options *RunListOptions) (*RunList, error) {
 	if !validStringID(&workspaceID) {
@@ -298,7 +379,7 @@ func (s *runs) List(ctx context.Context, workspaceID string, options *RunListOpt
 	}
 
 	rl := &RunList{}
-	err = s.client.do(ctx, req, rl)
+	err = s.client.do(ctx, "runs.List", req, rl)
 	if err != nil {
 		return nil, err
 	}
@@ -318,7 +399,7 @@ func (s *runs) Create(ctx context.Context, options RunCreateOptions) (*Run, erro
 	}
 
 	r := &Run{}
-	err = s.client.do(ctx, req, r)
+	err = s.client.do(ctx, "runs.Create", req, r)
 	if err != nil {
 		return nil, err
 	}
@@ -328,11 +409,16 @@ func (s *runs) Create(ctx context.Context, options RunCreateOptions) (*Run, erro
 
 // Read a run by its ID.
 func (s *runs) Read(ctx context.Context, runID string) (*Run, error) {
-	return s.ReadWithOptions(ctx, runID, nil)
+	return s.readWithOptions(ctx, "runs.Read", runID, nil)
 }
 
 // Read a run by its ID with the given options.
 func (s *runs) ReadWithOptions(ctx context.Context, runID string, options *RunReadOptions) (*Run, error) {
+	return s.readWithOptions(ctx, "runs.ReadWithOptions", runID, options)
+}
+
+// readWithOptions implements Read and ReadWithOptions, naming the API call op.
+func (s *runs) readWithOptions(ctx context.Context, op, runID string, options *RunReadOptions) (*Run, error) {
 	if !validStringID(&runID) {
 		return nil, ErrInvalidRunID
 	}
@@ -347,7 +433,7 @@ func (s *runs) ReadWithOptions(ctx context.Context, runID string, options *RunRe
 	}
 
 	r := &Run{}
-	err = s.client.do(ctx, req, r)
+	err = s.client.do(ctx, op, req, r)
 	if err != nil {
 		return nil, err
 	}
@@ -367,7 +453,7 @@ func (s *runs) Apply(ctx context.Context, runID string, options RunApplyOptions)
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "runs.Apply", req, nil)
 }
 
 // Cancel a run by its ID.
@@ -382,7 +468,7 @@ func (s *runs) Cancel(ctx context.Context, runID string, options RunCancelOption
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "runs.Cancel", req, nil)
 }
 
 // ForceCancel is used to forcefully cancel a run by its ID.
@@ -397,7 +483,7 @@ func (s *runs) ForceCancel(ctx context.Context, runID string, options RunForceCa
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "runs.ForceCancel", req, nil)
 }
 
 // Discard a run by its ID.
@@ -412,7 +498,50 @@ func (s *runs) Discard(ctx context.Context, runID string, options RunDiscardOpti
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "runs.Discard", req, nil)
+}
+
+// Retry creates a new run with the configuration version and options of a
+// previous run, for re-running a failed run.
+func (s *runs) Retry(ctx context.Context, runID string, options RunRetryOptions) (*Run, error) {
+	if !validStringID(&runID) {
+		return nil, ErrInvalidRunID
+	}
+
+	r, err := s.Read(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+	if r.Workspace == nil {
+		return nil, ErrRequiredWorkspace
+	}
+
+	createOptions := RunCreateOptions{
+		IsDestroy:            Bool(r.IsDestroy),
+		Refresh:              Bool(r.Refresh),
+		RefreshOnly:          Bool(r.RefreshOnly),
+		Message:              String(fmt.Sprintf("Retry of %s", r.ID)),
+		ConfigurationVersion: r.ConfigurationVersion,
+		Workspace:            &Workspace{ID: r.Workspace.ID},
+		TargetAddrs:          r.TargetAddrs,
+		ReplaceAddrs:         r.ReplaceAddrs,
+		AutoApply:            Bool(r.AutoApply),
+		Variables:            r.Variables,
+	}
+	if options.Message != nil {
+		createOptions.Message = options.Message
+	}
+	if options.ConfigurationVersion != nil {
+		createOptions.ConfigurationVersion = options.ConfigurationVersion
+	}
+	if options.AutoApply != nil {
+		createOptions.AutoApply = options.AutoApply
+	}
+	if options.Variables != nil {
+		createOptions.Variables = options.Variables
+	}
+
+	return s.Create(ctx, createOptions)
 }
 
 func (o RunCreateOptions) valid() error {
@@ -438,12 +567,76 @@ func (o *RunListOptions) valid() error {
 		return nil // nothing to validate
 	}
 
+	if err := validateRunFilterParam("status", o.Status, runStatuses); err != nil {
+		return err
+	}
+	if err := validateRunFilterParam("source", o.Source, runSources); err != nil {
+		return err
+	}
+	if err := validateRunFilterParam("operation", o.Operation, runOperations); err != nil {
+		return err
+	}
+	if err := validateRunFilterParam("status group", string(o.StatusGroup), runStatusGroups); err != nil {
+		return err
+	}
+
 	if err := validateRunIncludeParam(o.Include); err != nil {
 		return err
 	}
 	return nil
 }
 
+// The valid values of the run list filters.
+var (
+	runStatuses = []string{
+		string(RunApplied), string(RunApplyQueued), string(RunApplying), string(RunCanceled),
+		string(RunConfirmed), string(RunCostEstimated), string(RunCostEstimating), string(RunDiscarded),
+		string(RunErrored), string(RunFetching), string(RunPending), string(RunPlanQueued),
+		string(RunPlanned), string(RunPlannedAndFinished), string(RunPlanning), string(RunPolicyChecked),
+		string(RunPolicyChecking), string(RunPolicyOverride), string(RunPolicySoftFailed),
+		string(RunPostPlanRunning), string(RunPostPlanCompleted),
+	}
+	runSources = []string{
+		string(RunSourceAPI), string(RunSourceConfigurationVersion), string(RunSourceUI),
+	}
+	runOperations = []string{
+		string(RunOperationPlanApply), string(RunOperationPlanOnly), string(RunOperationRefreshOnly),
+		string(RunOperationDestroy), string(RunOperationEmptyApply),
+	}
+	runStatusGroups = []string{
+		string(RunStatusGroupNonFinal), string(RunStatusGroupFinal), string(RunStatusGroupDiscardable),
+	}
+)
+
+// validateRunFilterParam checks every value of a comma-separated filter is
+// valid. For the platform, an invalid filter value is a semantically
+// understood query that returns an empty set, but for go-tfe, an invalid
+// value is good enough reason to error prior to a network call.
+func validateRunFilterParam(name, filter string, valid []string) error {
+	if !validString(&filter) {
+		return nil
+	}
+
+	for _, value := range strings.Split(strings.TrimSpace(filter), ",") {
+		if value == "" {
+			continue
+		}
+
+		found := false
+		for _, v := range valid {
+			if value == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("invalid value %q for run %s", value, name)
+		}
+	}
+
+	return nil
+}
+
 func validateRunIncludeParam(params []RunIncludeOpt) error {
 	for _, p := range params {
 		switch p {
@@ -0,0 +1,311 @@
+package tfe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// defaultRunDiagnosticsLogTail is the number of bytes kept from the end of
+// each log by default.
+const defaultRunDiagnosticsLogTail = 8 * 1024
+
+// RunDiagnosticsOptions represents the options for gathering the
+// diagnostics of a run.
+type RunDiagnosticsOptions struct {
+	// Optional: The number of bytes kept from the end of the plan, apply and
+	// policy check logs. Defaults to 8 KiB, a negative value omits the logs.
+	LogTailBytes int
+}
+
+// RunDiagnostics represents the diagnostics of a run, gathering everything
+// needed to triage a failed run into a single report.
+type RunDiagnostics struct {
+	RunID       string    `json:"run_id"`
+	WorkspaceID string    `json:"workspace_id,omitempty"`
+	Status      RunStatus `json:"status"`
+	Message     string    `json:"message,omitempty"`
+	GeneratedAt time.Time `json:"generated_at"`
+
+	StatusTimestamps *RunStatusTimestamps `json:"status_timestamps,omitempty"`
+
+	Plan  *RunDiagnosticsPhase `json:"plan,omitempty"`
+	Apply *RunDiagnosticsPhase `json:"apply,omitempty"`
+
+	PolicyFailures []*RunDiagnosticsPolicyFailure `json:"policy_failures,omitempty"`
+	TaskFailures   []*RunDiagnosticsTaskFailure   `json:"task_failures,omitempty"`
+}
+
+// RunDiagnosticsPhase represents the plan or apply of a run.
+type RunDiagnosticsPhase struct {
+	ID         string    `json:"id"`
+	Status     string    `json:"status"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+
+	// The end of the log, when the phase has completed.
+	LogTail string `json:"log_tail,omitempty"`
+}
+
+// RunDiagnosticsPolicyFailure represents a policy check of a run which did
+// not pass.
+type RunDiagnosticsPolicyFailure struct {
+	PolicyCheckID  string       `json:"policy_check_id"`
+	Scope          PolicyScope  `json:"scope"`
+	Status         PolicyStatus `json:"status"`
+	HardFailed     int          `json:"hard_failed"`
+	SoftFailed     int          `json:"soft_failed"`
+	AdvisoryFailed int          `json:"advisory_failed"`
+	LogTail        string       `json:"log_tail,omitempty"`
+}
+
+// RunDiagnosticsTaskFailure represents a run task result of a run which did
+// not pass.
+type RunDiagnosticsTaskFailure struct {
+	TaskStageID      string               `json:"task_stage_id"`
+	Stage            Stage                `json:"stage"`
+	TaskName         string               `json:"task_name"`
+	Status           TaskResultStatus     `json:"status"`
+	EnforcementLevel TaskEnforcementLevel `json:"enforcement_level"`
+	Message          string               `json:"message,omitempty"`
+	URL              string               `json:"url,omitempty"`
+}
+
+// GenerateRunDiagnostics gathers the status timestamps, the end of the plan
+// and apply logs, the failed policy checks and the failed run tasks of a
+// run. It is meant for runs which have completed, typically because they
+// failed; the logs of phases which are still in progress are not read.
+func GenerateRunDiagnostics(ctx context.Context, client *Client, runID string, options *RunDiagnosticsOptions) (*RunDiagnostics, error) {
+	if !validStringID(&runID) {
+		return nil, ErrInvalidRunID
+	}
+	if options == nil {
+		options = &RunDiagnosticsOptions{}
+	}
+	logTail := options.LogTailBytes
+	if logTail == 0 {
+		logTail = defaultRunDiagnosticsLogTail
+	}
+
+	r, err := client.Runs.ReadWithOptions(ctx, runID, &RunReadOptions{
+		Include: []RunIncludeOpt{RunPlan, RunApply},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	d := &RunDiagnostics{
+		RunID:            r.ID,
+		Status:           r.Status,
+		Message:          r.Message,
+		GeneratedAt:      client.clock.Now(),
+		StatusTimestamps: r.StatusTimestamps,
+	}
+	if r.Workspace != nil {
+		d.WorkspaceID = r.Workspace.ID
+	}
+
+	if r.Plan != nil {
+		d.Plan = &RunDiagnosticsPhase{ID: r.Plan.ID, Status: string(r.Plan.Status)}
+		if ts := r.Plan.StatusTimestamps; ts != nil {
+			d.Plan.StartedAt = ts.StartedAt
+			d.Plan.FinishedAt = ts.FinishedAt
+		}
+		switch r.Plan.Status {
+		case PlanCanceled, PlanErrored, PlanFinished:
+			if logTail > 0 {
+				d.Plan.LogTail, err = readLogTail(func() (io.Reader, error) {
+					return client.Plans.Logs(ctx, r.Plan.ID)
+				}, logTail)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	if r.Apply != nil {
+		d.Apply = &RunDiagnosticsPhase{ID: r.Apply.ID, Status: string(r.Apply.Status)}
+		if ts := r.Apply.StatusTimestamps; ts != nil {
+			d.Apply.StartedAt = ts.StartedAt
+			d.Apply.FinishedAt = ts.FinishedAt
+		}
+		switch r.Apply.Status {
+		case ApplyCanceled, ApplyErrored, ApplyFinished:
+			if logTail > 0 {
+				d.Apply.LogTail, err = readLogTail(func() (io.Reader, error) {
+					return client.Applies.Logs(ctx, r.Apply.ID)
+				}, logTail)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	pcl, err := client.PolicyChecks.List(ctx, runID, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, pc := range pcl.Items {
+		switch pc.Status {
+		case PolicyErrored, PolicyHardFailed, PolicySoftFailed, PolicyOverridden:
+		default:
+			continue
+		}
+
+		f := &RunDiagnosticsPolicyFailure{
+			PolicyCheckID: pc.ID,
+			Scope:         pc.Scope,
+			Status:        pc.Status,
+		}
+		if pc.Result != nil {
+			f.HardFailed = pc.Result.HardFailed
+			f.SoftFailed = pc.Result.SoftFailed
+			f.AdvisoryFailed = pc.Result.AdvisoryFailed
+		}
+		if logTail > 0 {
+			f.LogTail, err = readLogTail(func() (io.Reader, error) {
+				return client.PolicyChecks.Logs(ctx, pc.ID)
+			}, logTail)
+			if err != nil {
+				return nil, err
+			}
+		}
+		d.PolicyFailures = append(d.PolicyFailures, f)
+	}
+
+	tsl, err := client.TaskStages.List(ctx, runID, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, stage := range tsl.Items {
+		ts, err := client.TaskStages.Read(ctx, stage.ID, &TaskStageReadOptions{
+			Include: []TaskStageIncludeOpt{TaskStageTaskResults},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, tr := range ts.TaskResults {
+			if tr.Status != TaskFailed && tr.Status != TaskUnreachable {
+				continue
+			}
+			d.TaskFailures = append(d.TaskFailures, &RunDiagnosticsTaskFailure{
+				TaskStageID:      ts.ID,
+				Stage:            ts.Stage,
+				TaskName:         tr.TaskName,
+				Status:           tr.Status,
+				EnforcementLevel: tr.WorkspaceTaskEnforcementLevel,
+				Message:          tr.Message,
+				URL:              tr.URL,
+			})
+		}
+	}
+
+	return d, nil
+}
+
+// WriteJSON writes the run diagnostics as indented JSON.
+func (d *RunDiagnostics) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(d)
+}
+
+// WriteMarkdown writes the run diagnostics as markdown, suited for posting
+// to chat and incident channels.
+func (d *RunDiagnostics) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "### Run %s: %s\n\n", d.RunID, d.Status)
+	if d.WorkspaceID != "" {
+		fmt.Fprintf(&b, "- Workspace: %s\n", d.WorkspaceID)
+	}
+	if d.Message != "" {
+		fmt.Fprintf(&b, "- Message: %s\n", d.Message)
+	}
+	for _, phase := range []struct {
+		name string
+		p    *RunDiagnosticsPhase
+	}{{"Plan", d.Plan}, {"Apply", d.Apply}} {
+		if phase.p == nil {
+			continue
+		}
+		fmt.Fprintf(&b, "- %s %s: %s", phase.name, phase.p.ID, phase.p.Status)
+		if !phase.p.StartedAt.IsZero() && !phase.p.FinishedAt.IsZero() {
+			fmt.Fprintf(&b, " after %s", phase.p.FinishedAt.Sub(phase.p.StartedAt))
+		}
+		b.WriteString("\n")
+	}
+
+	if len(d.PolicyFailures) > 0 {
+		b.WriteString("\n#### Policy failures\n\n")
+		for _, f := range d.PolicyFailures {
+			fmt.Fprintf(&b, "- %s (%s): %s, %d hard failed, %d soft failed, %d advisory failed\n",
+				f.PolicyCheckID, f.Scope, f.Status, f.HardFailed, f.SoftFailed, f.AdvisoryFailed)
+		}
+	}
+
+	if len(d.TaskFailures) > 0 {
+		b.WriteString("\n#### Task failures\n\n")
+		for _, f := range d.TaskFailures {
+			fmt.Fprintf(&b, "- %s (%s, %s): %s", f.TaskName, f.Stage, f.EnforcementLevel, f.Status)
+			if f.Message != "" {
+				fmt.Fprintf(&b, ": %s", f.Message)
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	for _, log := range []struct {
+		name string
+		tail string
+	}{{"Plan log", phaseLogTail(d.Plan)}, {"Apply log", phaseLogTail(d.Apply)}} {
+		if log.tail == "" {
+			continue
+		}
+		fmt.Fprintf(&b, "\n#### %s\n\n```\n%s\n```\n", log.name, strings.TrimRight(log.tail, "\n"))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func phaseLogTail(p *RunDiagnosticsPhase) string {
+	if p == nil {
+		return ""
+	}
+	return p.LogTail
+}
+
+// readLogTail reads a log to its end, keeping at most the last n bytes.
+func readLogTail(open func() (io.Reader, error), n int) (string, error) {
+	logs, err := open()
+	if err != nil {
+		return "", err
+	}
+
+	t := &tailWriter{n: n}
+	if _, err := io.Copy(t, logs); err != nil {
+		return "", err
+	}
+
+	return string(t.buf), nil
+}
+
+// tailWriter keeps the last n bytes written to it.
+type tailWriter struct {
+	n   int
+	buf []byte
+}
+
+func (t *tailWriter) Write(p []byte) (int, error) {
+	t.buf = append(t.buf, p...)
+	if len(t.buf) > t.n {
+		t.buf = append(t.buf[:0], t.buf[len(t.buf)-t.n:]...)
+	}
+	return len(p), nil
+}
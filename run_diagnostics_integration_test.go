@@ -0,0 +1,146 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateRunDiagnostics(t *testing.T) {
+	planLog := strings.Repeat("x", 100) + "Error: Invalid reference"
+
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/runs/run-1":
+			assert.Equal(t, "plan,apply", r.URL.Query().Get("include"))
+			fmt.Fprint(w, `{
+				"data": {"id": "run-1", "type": "runs",
+					"attributes": {"status": "errored", "message": "Queued manually", "status-timestamps": {"errored-at": "2023-01-01T12:02:00+00:00"}},
+					"relationships": {
+						"plan": {"data": {"id": "plan-1", "type": "plans"}},
+						"apply": {"data": {"id": "apply-1", "type": "applies"}},
+						"workspace": {"data": {"id": "ws-1", "type": "workspaces"}}
+					}},
+				"included": [
+					{"id": "plan-1", "type": "plans", "attributes": {"status": "errored", "status-timestamps": {"started-at": "2023-01-01T12:00:00+00:00", "finished-at": "2023-01-01T12:01:30+00:00"}}},
+					{"id": "apply-1", "type": "applies", "attributes": {"status": "unreachable"}}
+				]}`)
+		case "/api/v2/plans/plan-1":
+			fmt.Fprintf(w, `{"data": {"id": "plan-1", "type": "plans", "attributes": {"status": "errored", "log-read-url": "%s/logs/plan-1"}}}`, ts.URL)
+		case "/logs/plan-1":
+			if r.URL.Query().Get("offset") == "0" {
+				fmt.Fprint(w, "\x02"+planLog+"\x03")
+			}
+		case "/api/v2/runs/run-1/policy-checks":
+			fmt.Fprint(w, `{"data": [
+				{"id": "polchk-1", "type": "policy-checks", "attributes": {"status": "passed", "scope": "organization"}},
+				{"id": "polchk-2", "type": "policy-checks", "attributes": {"status": "hard_failed", "scope": "organization", "result": {"hard-failed": 1, "passed": 2}}}
+			]}`)
+		case "/api/v2/policy-checks/polchk-2":
+			fmt.Fprint(w, `{"data": {"id": "polchk-2", "type": "policy-checks", "attributes": {"status": "hard_failed"}}}`)
+		case "/api/v2/policy-checks/polchk-2/output":
+			w.Header().Set("Content-Type", "text/plain")
+			fmt.Fprint(w, "Policy restrict-instance-types: FALSE")
+		case "/api/v2/runs/run-1/task-stages":
+			fmt.Fprint(w, `{"data": [{"id": "ts-1", "type": "task-stages", "attributes": {"stage": "post_plan"}}]}`)
+		case "/api/v2/task-stages/ts-1":
+			assert.Equal(t, "task_results", r.URL.Query().Get("include"))
+			fmt.Fprint(w, `{
+				"data": {"id": "ts-1", "type": "task-stages", "attributes": {"stage": "post_plan"},
+					"relationships": {"task-results": {"data": [{"id": "taskrs-1", "type": "task-results"}, {"id": "taskrs-2", "type": "task-results"}]}}},
+				"included": [
+					{"id": "taskrs-1", "type": "task-results", "attributes": {"status": "passed", "task-name": "scanner"}},
+					{"id": "taskrs-2", "type": "task-results", "attributes": {"status": "failed", "task-name": "cost-guard", "message": "Budget exceeded", "workspace-task-enforcement-level": "mandatory"}}
+				]}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Clock:      NewFakeClock(time.Now()),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("with a failed run", func(t *testing.T) {
+		d, err := GenerateRunDiagnostics(ctx, client, "run-1", &RunDiagnosticsOptions{LogTailBytes: 24})
+		require.NoError(t, err)
+
+		assert.Equal(t, "run-1", d.RunID)
+		assert.Equal(t, "ws-1", d.WorkspaceID)
+		assert.Equal(t, RunErrored, d.Status)
+		require.NotNil(t, d.StatusTimestamps)
+		assert.False(t, d.StatusTimestamps.ErroredAt.IsZero())
+
+		require.NotNil(t, d.Plan)
+		assert.Equal(t, "errored", d.Plan.Status)
+		assert.Equal(t, "Error: Invalid reference", d.Plan.LogTail)
+		require.NotNil(t, d.Apply)
+		assert.Equal(t, "unreachable", d.Apply.Status)
+		assert.Empty(t, d.Apply.LogTail)
+
+		require.Len(t, d.PolicyFailures, 1)
+		assert.Equal(t, "polchk-2", d.PolicyFailures[0].PolicyCheckID)
+		assert.Equal(t, 1, d.PolicyFailures[0].HardFailed)
+		assert.Equal(t, "ct-instance-types: FALSE", d.PolicyFailures[0].LogTail)
+
+		require.Len(t, d.TaskFailures, 1)
+		assert.Equal(t, &RunDiagnosticsTaskFailure{
+			TaskStageID:      "ts-1",
+			Stage:            PostPlan,
+			TaskName:         "cost-guard",
+			Status:           TaskFailed,
+			EnforcementLevel: Mandatory,
+			Message:          "Budget exceeded",
+		}, d.TaskFailures[0])
+
+		var md bytes.Buffer
+		require.NoError(t, d.WriteMarkdown(&md))
+		assert.Contains(t, md.String(), "### Run run-1: errored")
+		assert.Contains(t, md.String(), "- Plan plan-1: errored after 1m30s")
+		assert.Contains(t, md.String(), "- cost-guard (post_plan, mandatory): failed: Budget exceeded")
+		assert.Contains(t, md.String(), "```\nError: Invalid reference\n```")
+
+		var js bytes.Buffer
+		require.NoError(t, d.WriteJSON(&js))
+		decoded := &RunDiagnostics{}
+		require.NoError(t, json.Unmarshal(js.Bytes(), decoded))
+		assert.Equal(t, d.TaskFailures, decoded.TaskFailures)
+	})
+
+	t.Run("without logs", func(t *testing.T) {
+		d, err := GenerateRunDiagnostics(ctx, client, "run-1", &RunDiagnosticsOptions{LogTailBytes: -1})
+		require.NoError(t, err)
+		assert.Empty(t, d.Plan.LogTail)
+		assert.Empty(t, d.PolicyFailures[0].LogTail)
+	})
+
+	t.Run("with an invalid run ID", func(t *testing.T) {
+		d, err := GenerateRunDiagnostics(ctx, client, badIdentifier, nil)
+		assert.Nil(t, d)
+		assert.Equal(t, ErrInvalidRunID, err)
+	})
+}
@@ -0,0 +1,372 @@
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// notificationSignatureHeader is the header holding the HMAC signature of a
+// notification payload, when the notification configuration has a token.
+const notificationSignatureHeader = "X-TFE-Notification-Signature"
+
+// defaultRunEventPollInterval is the interval the runs of the workspaces are
+// polled at by default.
+const defaultRunEventPollInterval = 10 * time.Second
+
+// defaultRunEventBufferSize is the number of events buffered by default.
+const defaultRunEventBufferSize = 100
+
+// runEventRetention is how long the final status of a run of a workspace
+// which is not polled is remembered, to drop redelivered notifications.
+const runEventRetention = time.Hour
+
+// RunEventSource represents where a run event was received from.
+type RunEventSource string
+
+// List all available run event sources.
+const (
+	RunEventSourceWebhook RunEventSource = "webhook"
+	RunEventSourcePolling RunEventSource = "polling"
+)
+
+// RunEvent represents a change of the status of a run, received either from
+// a notification webhook or by polling the runs of a workspace.
+type RunEvent struct {
+	RunID       string
+	WorkspaceID string
+	Status      RunStatus
+	Source      RunEventSource
+
+	// The notification trigger of webhook events. Polled events have no
+	// trigger.
+	Trigger NotificationTriggerType
+
+	// The notification message of webhook events, or the run message of
+	// polled events.
+	Message string
+
+	// When the run was updated for webhook events, or when the change was
+	// observed for polled events.
+	Timestamp time.Time
+}
+
+// RunEventStreamOptions represents the options for creating a run event
+// stream.
+type RunEventStreamOptions struct {
+	// Optional: The token of the notification configurations delivering to
+	// the stream. When set, webhook payloads without a valid signature are
+	// rejected.
+	Token string
+
+	// Optional: The IDs of the workspaces whose runs are polled by Poll.
+	WorkspaceIDs []string
+
+	// Optional: The interval the runs of the workspaces are polled at.
+	// Defaults to 10 seconds.
+	PollInterval time.Duration
+
+	// Optional: The number of events buffered before receiving them blocks
+	// the webhook handler and the poller. Defaults to 100.
+	BufferSize int
+
+	// Optional: A function called with the errors of polling the runs of a
+	// workspace. Polling continues at the next interval.
+	OnPollError func(workspaceID string, err error)
+}
+
+// RunEventStream unifies the intake of run events, so the same channel of
+// events is consumed whether they are delivered to a generic notification
+// configuration, polled, or both. A run status received from both sources is
+// only emitted once.
+type RunEventStream struct {
+	client  *Client
+	options RunEventStreamOptions
+
+	events chan *RunEvent
+	done   chan struct{}
+
+	mu       sync.Mutex
+	closed   bool
+	sending  sync.WaitGroup
+	polled   map[string]bool
+	statuses map[string]runEventState
+}
+
+// runEventState is the last emitted status of a run.
+type runEventState struct {
+	status      RunStatus
+	workspaceID string
+
+	// When the run was seen with a final status, or zero.
+	finalAt time.Time
+}
+
+// NewRunEventStream creates a run event stream. Webhook events are received
+// by serving the stream as the HTTP handler of the URL of a generic
+// notification configuration, and run events are polled by calling Poll.
+func NewRunEventStream(client *Client, options RunEventStreamOptions) (*RunEventStream, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	if options.PollInterval <= 0 {
+		options.PollInterval = defaultRunEventPollInterval
+	}
+	if options.BufferSize <= 0 {
+		options.BufferSize = defaultRunEventBufferSize
+	}
+
+	polled := make(map[string]bool, len(options.WorkspaceIDs))
+	for _, workspaceID := range options.WorkspaceIDs {
+		polled[workspaceID] = true
+	}
+
+	return &RunEventStream{
+		client:   client,
+		options:  options,
+		events:   make(chan *RunEvent, options.BufferSize),
+		done:     make(chan struct{}),
+		polled:   polled,
+		statuses: make(map[string]runEventState),
+	}, nil
+}
+
+// Events returns the channel of run events. It is closed by Close.
+func (s *RunEventStream) Events() <-chan *RunEvent {
+	return s.events
+}
+
+// Close stops receiving events and closes the events channel.
+func (s *RunEventStream) Close() {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+	s.closed = true
+	close(s.done)
+	s.mu.Unlock()
+
+	s.sending.Wait()
+	close(s.events)
+}
+
+// ServeHTTP receives the notification payloads of generic notification
+// configurations. Run notifications are emitted as events, other
+// notifications such as verifications are acknowledged and ignored.
+func (s *RunEventStream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if s.options.Token != "" && !VerifyHMAC(body, r.Header.Get(notificationSignatureHeader), s.options.Token) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	payload, err := DecodeNotificationPayload(bytes.NewReader(body))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	for _, n := range payload.Notifications {
+		if !strings.HasPrefix(string(n.Trigger), "run:") {
+			continue
+		}
+
+		err := s.emit(r.Context(), &RunEvent{
+			RunID:       payload.RunID,
+			WorkspaceID: payload.WorkspaceID,
+			Status:      n.RunStatus,
+			Source:      RunEventSourceWebhook,
+			Trigger:     n.Trigger,
+			Message:     n.Message,
+			Timestamp:   n.RunUpdatedAt,
+		})
+		if err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// Poll polls the most recent runs of the workspaces at the poll interval,
+// and emits an event for every run whose status changed since the previous
+// poll. The runs listed by the first poll of a workspace are only recorded,
+// to not emit events for the history of the workspace. Poll blocks until the
+// context is canceled or the stream is closed.
+func (s *RunEventStream) Poll(ctx context.Context) error {
+	seeded := make(map[string]bool)
+
+	for {
+		for _, workspaceID := range s.options.WorkspaceIDs {
+			err := s.poll(ctx, workspaceID, !seeded[workspaceID])
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				if s.isClosed() {
+					return nil
+				}
+				if s.options.OnPollError != nil {
+					s.options.OnPollError(workspaceID, err)
+				}
+				continue
+			}
+			seeded[workspaceID] = true
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.done:
+			return nil
+		case <-s.client.clock.After(s.options.PollInterval):
+		}
+	}
+}
+
+func (s *RunEventStream) poll(ctx context.Context, workspaceID string, seed bool) error {
+	runs, err := s.client.Runs.List(ctx, workspaceID, &RunListOptions{})
+	if err != nil {
+		return err
+	}
+
+	listed := make(map[string]bool, len(runs.Items))
+	for _, r := range runs.Items {
+		listed[r.ID] = true
+
+		if seed {
+			s.mu.Lock()
+			s.statuses[r.ID] = s.newRunEventState(r.Status, workspaceID)
+			s.mu.Unlock()
+			continue
+		}
+
+		err := s.emit(ctx, &RunEvent{
+			RunID:       r.ID,
+			WorkspaceID: workspaceID,
+			Status:      r.Status,
+			Source:      RunEventSourcePolling,
+			Message:     r.Message,
+			Timestamp:   s.client.clock.Now(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Forget the finished runs which are no longer listed, as later polls
+	// will not list them again.
+	s.mu.Lock()
+	for runID, state := range s.statuses {
+		if state.workspaceID == workspaceID && !state.finalAt.IsZero() && !listed[runID] {
+			delete(s.statuses, runID)
+		}
+	}
+	s.mu.Unlock()
+
+	return nil
+}
+
+// emit sends the event, unless the status of the run was already emitted. It
+// returns an error if the context is canceled or the stream is closed before
+// the event could be buffered, in which case the status is not recorded so
+// a redelivery or the next poll emits it again.
+func (s *RunEventStream) emit(ctx context.Context, event *RunEvent) error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return ErrRunEventStreamClosed
+	}
+	s.pruneLocked()
+
+	previous, seen := s.statuses[event.RunID]
+	if seen && previous.status == event.Status {
+		s.mu.Unlock()
+		return nil
+	}
+
+	// Record the status while sending, so the same status received
+	// concurrently from the other source is not emitted twice.
+	state := s.newRunEventState(event.Status, event.WorkspaceID)
+	s.statuses[event.RunID] = state
+	s.sending.Add(1)
+	s.mu.Unlock()
+	defer s.sending.Done()
+
+	var err error
+	select {
+	case s.events <- event:
+		return nil
+	case <-s.done:
+		err = ErrRunEventStreamClosed
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	s.mu.Lock()
+	if s.statuses[event.RunID] == state {
+		if seen {
+			s.statuses[event.RunID] = previous
+		} else {
+			delete(s.statuses, event.RunID)
+		}
+	}
+	s.mu.Unlock()
+
+	return err
+}
+
+// newRunEventState returns the state of a run with the given status.
+func (s *RunEventStream) newRunEventState(status RunStatus, workspaceID string) runEventState {
+	state := runEventState{status: status, workspaceID: workspaceID}
+	if isFinalRunStatus(status) {
+		state.finalAt = s.client.clock.Now()
+	}
+	return state
+}
+
+// pruneLocked forgets the finished runs of the workspaces which are not
+// polled after the retention. The finished runs of polled workspaces are
+// forgotten by the poll which no longer lists them. It must be called with
+// the lock held.
+func (s *RunEventStream) pruneLocked() {
+	now := s.client.clock.Now()
+	for runID, state := range s.statuses {
+		if !state.finalAt.IsZero() && !s.polled[state.workspaceID] && now.Sub(state.finalAt) > runEventRetention {
+			delete(s.statuses, runID)
+		}
+	}
+}
+
+func (s *RunEventStream) isClosed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closed
+}
+
+func (o RunEventStreamOptions) valid() error {
+	for _, workspaceID := range o.WorkspaceIDs {
+		if !validStringID(&workspaceID) {
+			return ErrInvalidWorkspaceID
+		}
+	}
+
+	return nil
+}
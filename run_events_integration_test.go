@@ -0,0 +1,211 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunEventStreamWebhook(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	stream, err := NewRunEventStream(client, RunEventStreamOptions{Token: "secret"})
+	require.NoError(t, err)
+
+	body := `{"payload_version":1,"run_id":"run-1","workspace_id":"ws-1","notifications":[` +
+		`{"message":"Run Planning","trigger":"run:planning","run_status":"planning","run_updated_at":"2023-01-01T12:00:00Z"}]}`
+	deliver := func(body, signature string) int {
+		req := httptest.NewRequest("POST", "/", strings.NewReader(body))
+		req.Header.Set("X-TFE-Notification-Signature", signature)
+		rec := httptest.NewRecorder()
+		stream.ServeHTTP(rec, req)
+		return rec.Code
+	}
+	sign := func(body string) string {
+		mac := hmac.New(sha512.New, []byte("secret"))
+		mac.Write([]byte(body))
+		return hex.EncodeToString(mac.Sum(nil))
+	}
+
+	t.Run("with a valid signature", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, deliver(body, sign(body)))
+
+		event := <-stream.Events()
+		assert.Equal(t, &RunEvent{
+			RunID:       "run-1",
+			WorkspaceID: "ws-1",
+			Status:      RunPlanning,
+			Source:      RunEventSourceWebhook,
+			Trigger:     NotificationTriggerPlanning,
+			Message:     "Run Planning",
+			Timestamp:   time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
+		}, event)
+	})
+
+	t.Run("when the status was already received", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, deliver(body, sign(body)))
+		assert.Len(t, stream.Events(), 0)
+	})
+
+	t.Run("with an invalid signature", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, deliver(body, "invalid"))
+	})
+
+	t.Run("with a verification notification", func(t *testing.T) {
+		verification := `{"payload_version":1,"notifications":[{"trigger":"verification"}]}`
+		assert.Equal(t, http.StatusOK, deliver(verification, sign(verification)))
+		assert.Len(t, stream.Events(), 0)
+	})
+
+	t.Run("when the stream is closed", func(t *testing.T) {
+		stream.Close()
+
+		_, ok := <-stream.Events()
+		assert.False(t, ok)
+
+		applying := strings.ReplaceAll(body, "planning", "applying")
+		assert.Equal(t, http.StatusServiceUnavailable, deliver(applying, sign(applying)))
+	})
+}
+
+func TestRunEventStreamRedelivery(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	clock := NewFakeClock(time.Now())
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Clock:      clock,
+	})
+	require.NoError(t, err)
+
+	stream, err := NewRunEventStream(client, RunEventStreamOptions{BufferSize: 1})
+	require.NoError(t, err)
+	defer stream.Close()
+
+	deliver := func(ctx context.Context, runID, status string) int {
+		body := fmt.Sprintf(`{"payload_version":1,"run_id":%q,"workspace_id":"ws-1","notifications":[`+
+			`{"trigger":"run:%s","run_status":%q}]}`, runID, status, status)
+		req := httptest.NewRequest("POST", "/", strings.NewReader(body)).WithContext(ctx)
+		rec := httptest.NewRecorder()
+		stream.ServeHTTP(rec, req)
+		return rec.Code
+	}
+	ctx := context.Background()
+
+	t.Run("when the event can not be buffered", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, deliver(ctx, "run-1", "planning"))
+
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		assert.Equal(t, http.StatusServiceUnavailable, deliver(canceled, "run-2", "planning"))
+		assert.Equal(t, "run-1", (<-stream.Events()).RunID)
+
+		// The redelivered notification is emitted, as it was not before.
+		assert.Equal(t, http.StatusOK, deliver(ctx, "run-2", "planning"))
+		assert.Equal(t, "run-2", (<-stream.Events()).RunID)
+	})
+
+	t.Run("when a run is finished", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, deliver(ctx, "run-1", "applied"))
+		<-stream.Events()
+		assert.Equal(t, http.StatusOK, deliver(ctx, "run-1", "applied"))
+		assert.Len(t, stream.Events(), 0)
+
+		// The finished run is forgotten after the retention.
+		clock.After(2 * time.Hour)
+		assert.Equal(t, http.StatusOK, deliver(ctx, "run-2", "applied"))
+		<-stream.Events()
+
+		stream.mu.Lock()
+		_, ok := stream.statuses["run-1"]
+		stream.mu.Unlock()
+		assert.False(t, ok)
+	})
+}
+
+func TestRunEventStreamPoll(t *testing.T) {
+	var polls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/workspaces/ws-1/runs":
+			if atomic.AddInt32(&polls, 1) == 1 {
+				fmt.Fprint(w, `{"data":[{"id":"run-1","type":"runs","attributes":{"status":"planning"}}]}`)
+				return
+			}
+			fmt.Fprint(w, `{"data":[`+
+				`{"id":"run-2","type":"runs","attributes":{"status":"pending","message":"Queued"}},`+
+				`{"id":"run-1","type":"runs","attributes":{"status":"planned"}}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Clock:      NewFakeClock(time.Now()),
+	})
+	require.NoError(t, err)
+
+	t.Run("when the run statuses change", func(t *testing.T) {
+		stream, err := NewRunEventStream(client, RunEventStreamOptions{WorkspaceIDs: []string{"ws-1"}})
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() { done <- stream.Poll(ctx) }()
+
+		first := <-stream.Events()
+		second := <-stream.Events()
+		cancel()
+		assert.Equal(t, context.Canceled, <-done)
+
+		assert.Equal(t, "run-2", first.RunID)
+		assert.Equal(t, RunPending, first.Status)
+		assert.Equal(t, RunEventSourcePolling, first.Source)
+		assert.Equal(t, "Queued", first.Message)
+		assert.Equal(t, "run-1", second.RunID)
+		assert.Equal(t, RunPlanned, second.Status)
+		assert.Len(t, stream.Events(), 0)
+	})
+
+	t.Run("with an invalid workspace ID", func(t *testing.T) {
+		_, err := NewRunEventStream(client, RunEventStreamOptions{WorkspaceIDs: []string{badIdentifier}})
+		assert.Equal(t, ErrInvalidWorkspaceID, err)
+	})
+}
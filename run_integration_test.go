@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -87,6 +88,43 @@ func TestRunsList(t *testing.T) {
 		assert.NotEmpty(t, rl.Items[0].Workspace.Name)
 	})
 
+	t.Run("with filters and search", func(t *testing.T) {
+		rl, err := client.Runs.List(ctx, wTest.ID, &RunListOptions{
+			Source:    string(RunSourceAPI),
+			Operation: fmt.Sprintf("%s,%s", RunOperationPlanApply, RunOperationPlanOnly),
+			Search:    rTest1.ID,
+		})
+		require.NoError(t, err)
+		require.Len(t, rl.Items, 1)
+		assert.Equal(t, rTest1.ID, rl.Items[0].ID)
+	})
+
+	t.Run("with a status group", func(t *testing.T) {
+		rl, err := client.Runs.List(ctx, wTest.ID, &RunListOptions{
+			StatusGroup: RunStatusGroupFinal,
+		})
+		require.NoError(t, err)
+		for _, r := range rl.Items {
+			assert.NotEqual(t, RunPending, r.Status)
+		}
+	})
+
+	t.Run("with invalid filters", func(t *testing.T) {
+		_, err := client.Runs.List(ctx, wTest.ID, &RunListOptions{
+			Status: fmt.Sprintf("%s,%s", RunPending, "random_status"),
+		})
+		assert.EqualError(t, err, `invalid value "random_status" for run status`)
+
+		_, err = client.Runs.List(ctx, wTest.ID, &RunListOptions{Source: "tfe-cli"})
+		assert.EqualError(t, err, `invalid value "tfe-cli" for run source`)
+
+		_, err = client.Runs.List(ctx, wTest.ID, &RunListOptions{Operation: "apply"})
+		assert.EqualError(t, err, `invalid value "apply" for run operation`)
+
+		_, err = client.Runs.List(ctx, wTest.ID, &RunListOptions{StatusGroup: "done"})
+		assert.EqualError(t, err, `invalid value "done" for run status group`)
+	})
+
 	t.Run("without a valid workspace ID", func(t *testing.T) {
 		rl, err := client.Runs.List(ctx, badIdentifier, nil)
 		assert.Nil(t, rl)
@@ -445,6 +483,47 @@ func TestRunsDiscard(t *testing.T) {
 	})
 }
 
+func TestRunsRetry(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	wTest, wTestCleanup := createWorkspace(t, client, nil)
+	defer wTestCleanup()
+
+	rTest, _ := createPlannedRun(t, client, wTest)
+
+	t.Run("with the options of the retried run", func(t *testing.T) {
+		r, err := client.Runs.Retry(ctx, rTest.ID, RunRetryOptions{})
+		require.NoError(t, err)
+
+		assert.NotEqual(t, rTest.ID, r.ID)
+		assert.Equal(t, "Retry of "+rTest.ID, r.Message)
+		assert.Equal(t, rTest.IsDestroy, r.IsDestroy)
+		assert.Equal(t, rTest.ConfigurationVersion.ID, r.ConfigurationVersion.ID)
+		assert.Equal(t, wTest.ID, r.Workspace.ID)
+	})
+
+	t.Run("with a message", func(t *testing.T) {
+		r, err := client.Runs.Retry(ctx, rTest.ID, RunRetryOptions{
+			Message: String("Re-run failed job"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Re-run failed job", r.Message)
+	})
+
+	t.Run("when the run does not exist", func(t *testing.T) {
+		r, err := client.Runs.Retry(ctx, "nonexisting", RunRetryOptions{})
+		assert.Nil(t, r)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("with invalid run ID", func(t *testing.T) {
+		r, err := client.Runs.Retry(ctx, badIdentifier, RunRetryOptions{})
+		assert.Nil(t, r)
+		assert.Equal(t, ErrInvalidRunID, err)
+	})
+}
+
 func TestRun_Unmarshal(t *testing.T) {
 	data := map[string]interface{}{
 		"data": map[string]interface{}{
@@ -473,6 +552,9 @@ func TestRun_Unmarshal(t *testing.T) {
 					"errored-at":     "2019-03-16T23:23:59+00:00",
 				},
 			},
+			"links": map[string]interface{}{
+				"self": "/api/v2/runs/1",
+			},
 		},
 	}
 	byteData, err := json.Marshal(data)
@@ -507,4 +589,5 @@ func TestRun_Unmarshal(t *testing.T) {
 	assert.Equal(t, run.Permissions.CanForceCancel, true)
 	assert.Equal(t, run.StatusTimestamps.PlanQueuedAt, planQueuedParsedTime)
 	assert.Equal(t, run.StatusTimestamps.ErroredAt, erroredParsedTime)
+	assert.Equal(t, run.Links["self"], "/api/v2/runs/1")
 }
@@ -43,12 +43,13 @@ type runTasks struct {
 
 // RunTask represents a TFC/E run task
 type RunTask struct {
-	ID       string  `jsonapi:"primary,tasks"`
-	Name     string  `jsonapi:"attr,name"`
-	URL      string  `jsonapi:"attr,url"`
-	Category string  `jsonapi:"attr,category"`
-	HMACKey  *string `jsonapi:"attr,hmac-key,omitempty"`
-	Enabled  bool    `jsonapi:"attr,enabled"`
+	ID          string  `jsonapi:"primary,tasks"`
+	Name        string  `jsonapi:"attr,name"`
+	URL         string  `jsonapi:"attr,url"`
+	Description string  `jsonapi:"attr,description"`
+	Category    string  `jsonapi:"attr,category"`
+	HMACKey     *string `jsonapi:"attr,hmac-key,omitempty"`
+	Enabled     bool    `jsonapi:"attr,enabled"`
 
 	Organization      *Organization       `jsonapi:"relation,organization"`
 	WorkspaceRunTasks []*WorkspaceRunTask `jsonapi:"relation,workspace-tasks"`
@@ -98,6 +99,9 @@ type RunTaskCreateOptions struct {
 	// Required: The URL to send a run task payload
 	URL string `jsonapi:"attr,url"`
 
+	// Optional: The description of the run task
+	Description *string `jsonapi:"attr,description,omitempty"`
+
 	// Required: Must be "task"
 	Category string `jsonapi:"attr,category"`
 
@@ -122,6 +126,9 @@ type RunTaskUpdateOptions struct {
 	// Optional: The URL to send a run task payload, defaults to previous value
 	URL *string `jsonapi:"attr,url,omitempty"`
 
+	// Optional: The description of the run task, defaults to previous value
+	Description *string `jsonapi:"attr,description,omitempty"`
+
 	// Optional: Must be "task", defaults to "task"
 	Category *string `jsonapi:"attr,category,omitempty"`
 
@@ -149,7 +156,7 @@ func (s *runTasks) Create(ctx context.Context, organization string, options RunT
 	}
 
 	r := &RunTask{}
-	err = s.client.do(ctx, req, r)
+	err = s.client.do(ctx, "runTasks.Create", req, r)
 	if err != nil {
 		return nil, err
 	}
@@ -173,7 +180,7 @@ func (s *runTasks) List(ctx context.Context, organization string, options *RunTa
 	}
 
 	rl := &RunTaskList{}
-	err = s.client.do(ctx, req, rl)
+	err = s.client.do(ctx, "runTasks.List", req, rl)
 	if err != nil {
 		return nil, err
 	}
@@ -183,11 +190,16 @@ func (s *runTasks) List(ctx context.Context, organization string, options *RunTa
 
 // Read is used to read an organization's run task by ID
 func (s *runTasks) Read(ctx context.Context, runTaskID string) (*RunTask, error) {
-	return s.ReadWithOptions(ctx, runTaskID, nil)
+	return s.readWithOptions(ctx, "runTasks.Read", runTaskID, nil)
 }
 
 // Read is used to read an organization's run task by ID with options
 func (s *runTasks) ReadWithOptions(ctx context.Context, runTaskID string, options *RunTaskReadOptions) (*RunTask, error) {
+	return s.readWithOptions(ctx, "runTasks.ReadWithOptions", runTaskID, options)
+}
+
+// readWithOptions implements Read and ReadWithOptions, naming the API call op.
+func (s *runTasks) readWithOptions(ctx context.Context, op, runTaskID string, options *RunTaskReadOptions) (*RunTask, error) {
 	if !validStringID(&runTaskID) {
 		return nil, ErrInvalidRunTaskID
 	}
@@ -202,7 +214,7 @@ func (s *runTasks) ReadWithOptions(ctx context.Context, runTaskID string, option
 	}
 
 	r := &RunTask{}
-	err = s.client.do(ctx, req, r)
+	err = s.client.do(ctx, op, req, r)
 	if err != nil {
 		return nil, err
 	}
@@ -227,7 +239,7 @@ func (s *runTasks) Update(ctx context.Context, runTaskID string, options RunTask
 	}
 
 	r := &RunTask{}
-	err = s.client.do(ctx, req, r)
+	err = s.client.do(ctx, "runTasks.Update", req, r)
 	if err != nil {
 		return nil, err
 	}
@@ -247,7 +259,7 @@ func (s *runTasks) Delete(ctx context.Context, runTaskID string) error {
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "runTasks.Delete", req, nil)
 }
 
 // AttachToWorkspace is a convenient method to attach a run task to a workspace. See: WorkspaceRunTasks.Create()
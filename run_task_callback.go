@@ -0,0 +1,429 @@
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+// RunTaskSignatureHeader is the header holding the HMAC signature of a run
+// task request, when the run task has an HMAC key.
+const RunTaskSignatureHeader = "X-TFC-Task-Signature"
+
+// RunTaskCallbackTimeout is how long Terraform Cloud waits for a run task
+// integration to report the final result of a task.
+const RunTaskCallbackTimeout = 10 * time.Minute
+
+// RunTaskCallback reports the result of a run task to Terraform Cloud, by
+// sending PATCH requests to the task result callback URL of the run task
+// request. Failed requests are retried with backoff until the callback
+// deadline.
+type RunTaskCallback struct {
+	url         string
+	accessToken string
+	deadline    time.Time
+	clock       Clock
+	http        *retryablehttp.Client
+
+	// The state of the results, which is not locked while sending them.
+	mu       sync.Mutex
+	running  *runTaskCallbackSend
+	final    bool
+	finished bool
+}
+
+// runTaskCallbackSend is a running result being sent, which is canceled when
+// it is superseded by another result.
+type runTaskCallbackSend struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// RunTaskCallbackOptions represents the options of a run task callback.
+type RunTaskCallbackOptions struct {
+	// Optional: The HTTP client used to send the results.
+	HTTPClient *http.Client
+
+	// Optional: The maximum number of retries of a single result. Defaults
+	// to 5, a negative value disables retries.
+	RetryMax int
+
+	// Optional: The minimum and maximum time to wait between retries.
+	// Default to 1 and 30 seconds. When only one of them is set beyond the
+	// default of the other, the other is set to the same value.
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+
+	// Optional: When the task result has to be reported. Defaults to
+	// RunTaskCallbackTimeout after creating the callback, which should be
+	// done as soon as the run task request is received.
+	Deadline time.Time
+
+	// Optional: The clock used for the deadline and the heartbeats. Defaults
+	// to the real time.
+	Clock Clock
+}
+
+// TaskResultCallbackOptions represents the result of a run task reported
+// to Terraform Cloud.
+type TaskResultCallbackOptions struct {
+	// Required: The status of the task, which is either TaskRunning,
+	// TaskPassed or TaskFailed.
+	Status TaskResultStatus
+
+	// Optional: A short message describing the result.
+	Message string
+
+	// Optional: A URL with the details of the result.
+	URL string
+
+	// Optional: Detailed outcomes of the task.
+	Outcomes []*TaskResultOutcome
+}
+
+// TaskResultOutcome represents a detailed, structured outcome of a run
+// task.
+type TaskResultOutcome struct {
+	// Required: The ID of the outcome, unique within the task result.
+	OutcomeID string `json:"outcome-id"`
+
+	// Required: A one line description of the outcome.
+	Description string `json:"description"`
+
+	// Optional: The details of the outcome, in markdown.
+	Body string `json:"body,omitempty"`
+
+	// Optional: A URL with the details of the outcome.
+	URL string `json:"url,omitempty"`
+
+	// Optional: Tags of the outcome, keyed by the tag name such as
+	// "Status" or "Severity".
+	Tags map[string][]*TaskResultTag `json:"tags,omitempty"`
+}
+
+// TaskResultTag represents a tag of a run task outcome.
+type TaskResultTag struct {
+	// Required: The label of the tag.
+	Label string `json:"label"`
+
+	// Optional: The level of the tag: "none", "info", "warning" or "error".
+	Level string `json:"level,omitempty"`
+}
+
+// NewRunTaskCallback creates a callback sending results to the task result
+// callback URL using the access token of a run task request.
+func NewRunTaskCallback(callbackURL, accessToken string, options *RunTaskCallbackOptions) (*RunTaskCallback, error) {
+	if !validString(&callbackURL) {
+		return nil, ErrRequiredURL
+	}
+	if !validString(&accessToken) {
+		return nil, ErrRequiredRunTaskAccessToken
+	}
+	if options == nil {
+		options = &RunTaskCallbackOptions{}
+	}
+
+	c := &RunTaskCallback{
+		url:         callbackURL,
+		accessToken: accessToken,
+		deadline:    options.Deadline,
+		clock:       options.Clock,
+		http: &retryablehttp.Client{
+			HTTPClient:   options.HTTPClient,
+			Backoff:      retryablehttp.DefaultBackoff,
+			CheckRetry:   retryablehttp.DefaultRetryPolicy,
+			ErrorHandler: retryablehttp.PassthroughErrorHandler,
+			RetryMax:     5,
+			RetryWaitMin: time.Second,
+			RetryWaitMax: 30 * time.Second,
+		},
+	}
+	if c.clock == nil {
+		c.clock = realClock{}
+	}
+	if c.deadline.IsZero() {
+		c.deadline = c.clock.Now().Add(RunTaskCallbackTimeout)
+	}
+	if c.http.HTTPClient == nil {
+		c.http.HTTPClient = cleanhttp.DefaultPooledClient()
+	}
+	if options.RetryMax != 0 {
+		c.http.RetryMax = options.RetryMax
+	}
+	if c.http.RetryMax < 0 {
+		c.http.RetryMax = 0
+	}
+	if options.RetryWaitMin != 0 {
+		c.http.RetryWaitMin = options.RetryWaitMin
+
+		if options.RetryWaitMax == 0 && c.http.RetryWaitMax < options.RetryWaitMin {
+			c.http.RetryWaitMax = options.RetryWaitMin
+		}
+	}
+	if options.RetryWaitMax != 0 {
+		c.http.RetryWaitMax = options.RetryWaitMax
+
+		if options.RetryWaitMin == 0 && c.http.RetryWaitMin > options.RetryWaitMax {
+			c.http.RetryWaitMin = options.RetryWaitMax
+		}
+	}
+	if c.http.RetryWaitMin > c.http.RetryWaitMax {
+		return nil, fmt.Errorf("invalid retry wait: minimum %s exceeds maximum %s", c.http.RetryWaitMin, c.http.RetryWaitMax)
+	}
+
+	return c, nil
+}
+
+// VerifyHMAC reports whether the signature is the hex encoded HMAC-SHA512 of
+// the payload, keyed with the HMAC key of a run task or the token of a
+// notification configuration. Integrations verify the body of run task
+// requests against the RunTaskSignatureHeader header with it.
+func VerifyHMAC(payload []byte, signature, key string) bool {
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha512.New, []byte(key))
+	mac.Write(payload)
+
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// Deadline returns when the final task result has to be reported.
+func (c *RunTaskCallback) Deadline() time.Time {
+	return c.deadline
+}
+
+// Send reports the task result. Failed requests are retried until the
+// callback deadline. A running result still being sent is canceled when it
+// is superseded by another result, so a final result is never held up by
+// the retries of a heartbeat. While and after a final result is sent, no
+// further results can be sent.
+func (c *RunTaskCallback) Send(ctx context.Context, options TaskResultCallbackOptions) error {
+	if err := options.valid(); err != nil {
+		return err
+	}
+
+	if options.Status == TaskRunning {
+		return c.sendRunning(ctx, options)
+	}
+
+	c.mu.Lock()
+	if c.final || c.finished {
+		c.mu.Unlock()
+		return ErrRunTaskCallbackFinished
+	}
+	c.final = true
+	running := c.running
+	c.mu.Unlock()
+
+	// Wait for the canceled running result, so it does not arrive after
+	// the final result.
+	if running != nil {
+		running.cancel()
+		<-running.done
+	}
+
+	err := c.send(ctx, options)
+
+	c.mu.Lock()
+	c.final = false
+	c.finished = err == nil
+	c.mu.Unlock()
+
+	return err
+}
+
+// sendRunning sends a running result, superseding the running result still
+// being sent, if any.
+func (c *RunTaskCallback) sendRunning(ctx context.Context, options TaskResultCallbackOptions) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	c.mu.Lock()
+	if c.final || c.finished {
+		c.mu.Unlock()
+		return ErrRunTaskCallbackFinished
+	}
+	if c.running != nil {
+		c.running.cancel()
+	}
+	running := &runTaskCallbackSend{cancel: cancel, done: make(chan struct{})}
+	c.running = running
+	c.mu.Unlock()
+
+	err := c.send(ctx, options)
+
+	c.mu.Lock()
+	if c.running == running {
+		c.running = nil
+	}
+	final := c.final || c.finished
+	superseded := final || c.running != nil
+	c.mu.Unlock()
+	close(running.done)
+
+	// A superseded result is not an error of its own.
+	if err != nil && superseded && errors.Is(err, context.Canceled) {
+		if final {
+			return ErrRunTaskCallbackFinished
+		}
+		return nil
+	}
+
+	return err
+}
+
+// StartHeartbeat periodically reports the task as running with the given
+// message, until the returned stop function is called, the context is
+// canceled, the deadline is reached, or a final result is sent. The stop
+// function returns the error of the last heartbeat, if any.
+func (c *RunTaskCallback) StartHeartbeat(ctx context.Context, interval time.Duration, message string) (stop func() error, err error) {
+	if interval <= 0 {
+		return nil, ErrInvalidHeartbeatInterval
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	var lastErr error
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-c.clock.After(interval):
+				if !now.Before(c.deadline) {
+					return
+				}
+				err := c.Send(ctx, TaskResultCallbackOptions{Status: TaskRunning, Message: message})
+				if err == ErrRunTaskCallbackFinished {
+					return
+				}
+				if err != nil && ctx.Err() == nil {
+					lastErr = err
+				}
+			}
+		}
+	}()
+
+	return func() error {
+		cancel()
+		<-done
+		return lastErr
+	}, nil
+}
+
+// send sends a result, retrying failed requests until the deadline.
+func (c *RunTaskCallback) send(ctx context.Context, options TaskResultCallbackOptions) error {
+	ctx, cancel := context.WithTimeout(ctx, c.deadline.Sub(c.clock.Now()))
+	defer cancel()
+
+	body, err := json.Marshal(options.payload())
+	if err != nil {
+		return err
+	}
+
+	req, err := retryablehttp.NewRequest("PATCH", c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	req.Header.Set("Content-Type", "application/vnd.api+json")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := checkResponseCode(resp, c.clock); err != nil {
+		return fmt.Errorf("failed to send task result: %w", err)
+	}
+
+	return nil
+}
+
+// taskResultCallbackPayload is the JSON:API document of a task result. The
+// outcomes are sent as part of the relationship data, which the jsonapi
+// package does not support.
+type taskResultCallbackPayload struct {
+	Data struct {
+		Type          string                       `json:"type"`
+		Attributes    taskResultCallbackAttributes `json:"attributes"`
+		Relationships *taskResultCallbackRelations `json:"relationships,omitempty"`
+	} `json:"data"`
+}
+
+type taskResultCallbackAttributes struct {
+	Status  TaskResultStatus `json:"status"`
+	Message string           `json:"message,omitempty"`
+	URL     string           `json:"url,omitempty"`
+}
+
+type taskResultCallbackRelations struct {
+	Outcomes struct {
+		Data []taskResultOutcomeNode `json:"data"`
+	} `json:"outcomes"`
+}
+
+type taskResultOutcomeNode struct {
+	Type       string             `json:"type"`
+	Attributes *TaskResultOutcome `json:"attributes"`
+}
+
+func (o TaskResultCallbackOptions) payload() *taskResultCallbackPayload {
+	p := &taskResultCallbackPayload{}
+	p.Data.Type = "task-results"
+	p.Data.Attributes = taskResultCallbackAttributes{
+		Status:  o.Status,
+		Message: o.Message,
+		URL:     o.URL,
+	}
+
+	if len(o.Outcomes) > 0 {
+		p.Data.Relationships = &taskResultCallbackRelations{}
+		for _, outcome := range o.Outcomes {
+			p.Data.Relationships.Outcomes.Data = append(p.Data.Relationships.Outcomes.Data, taskResultOutcomeNode{
+				Type:       "task-result-outcomes",
+				Attributes: outcome,
+			})
+		}
+	}
+
+	return p
+}
+
+func (o TaskResultCallbackOptions) valid() error {
+	switch o.Status {
+	case TaskRunning, TaskPassed, TaskFailed:
+	default:
+		return ErrInvalidTaskResultsCallbackStatus
+	}
+
+	for _, outcome := range o.Outcomes {
+		if outcome == nil || !validString(&outcome.OutcomeID) || !validString(&outcome.Description) {
+			return ErrInvalidTaskResultOutcome
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,270 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCallbackServer records the task results it receives, failing the
+// first failures requests with a 503.
+type testCallbackServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	failures int
+	requests []map[string]interface{}
+}
+
+func newTestCallbackServer(t *testing.T, failures int) *testCallbackServer {
+	s := &testCallbackServer{failures: failures}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, "Bearer callback-token", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/vnd.api+json", r.Header.Get("Content-Type"))
+
+		if s.failures > 0 {
+			s.failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		var payload map[string]interface{}
+		require.NoError(t, json.Unmarshal(body, &payload))
+		s.requests = append(s.requests, payload)
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	return s
+}
+
+func (s *testCallbackServer) statuses() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var statuses []string
+	for _, r := range s.requests {
+		attrs := r["data"].(map[string]interface{})["attributes"].(map[string]interface{})
+		statuses = append(statuses, attrs["status"].(string))
+	}
+	return statuses
+}
+
+func TestRunTaskCallback(t *testing.T) {
+	ctx := context.Background()
+
+	options := &RunTaskCallbackOptions{
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: time.Millisecond,
+	}
+
+	t.Run("with a final result and outcomes", func(t *testing.T) {
+		ts := newTestCallbackServer(t, 0)
+		defer ts.Close()
+
+		callback, err := NewRunTaskCallback(ts.URL, "callback-token", options)
+		require.NoError(t, err)
+
+		err = callback.Send(ctx, TaskResultCallbackOptions{
+			Status:  TaskFailed,
+			Message: "1 critical finding",
+			URL:     "https://scanner.example.com/results/1",
+			Outcomes: []*TaskResultOutcome{{
+				OutcomeID:   "CVE-2023-0001",
+				Description: "Public bucket",
+				Tags: map[string][]*TaskResultTag{
+					"Severity": {{Label: "Critical", Level: "error"}},
+				},
+			}},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, ts.requests, 1)
+		data := ts.requests[0]["data"].(map[string]interface{})
+		assert.Equal(t, "task-results", data["type"])
+		assert.Equal(t, map[string]interface{}{
+			"status":  "failed",
+			"message": "1 critical finding",
+			"url":     "https://scanner.example.com/results/1",
+		}, data["attributes"])
+
+		outcomes := data["relationships"].(map[string]interface{})["outcomes"].(map[string]interface{})["data"].([]interface{})
+		require.Len(t, outcomes, 1)
+		outcome := outcomes[0].(map[string]interface{})
+		assert.Equal(t, "task-result-outcomes", outcome["type"])
+		assert.Equal(t, "CVE-2023-0001", outcome["attributes"].(map[string]interface{})["outcome-id"])
+
+		err = callback.Send(ctx, TaskResultCallbackOptions{Status: TaskPassed})
+		assert.Equal(t, ErrRunTaskCallbackFinished, err)
+	})
+
+	t.Run("when the callback URL fails temporarily", func(t *testing.T) {
+		ts := newTestCallbackServer(t, 2)
+		defer ts.Close()
+
+		callback, err := NewRunTaskCallback(ts.URL, "callback-token", options)
+		require.NoError(t, err)
+
+		err = callback.Send(ctx, TaskResultCallbackOptions{Status: TaskPassed})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"passed"}, ts.statuses())
+	})
+
+	t.Run("when the deadline has passed", func(t *testing.T) {
+		ts := newTestCallbackServer(t, 0)
+		defer ts.Close()
+
+		callback, err := NewRunTaskCallback(ts.URL, "callback-token", &RunTaskCallbackOptions{
+			Deadline: time.Now().Add(-time.Second),
+		})
+		require.NoError(t, err)
+
+		err = callback.Send(ctx, TaskResultCallbackOptions{Status: TaskPassed})
+		assert.Equal(t, context.DeadlineExceeded, err)
+		assert.Empty(t, ts.statuses())
+	})
+
+	t.Run("with heartbeats", func(t *testing.T) {
+		ts := newTestCallbackServer(t, 0)
+		defer ts.Close()
+
+		callback, err := NewRunTaskCallback(ts.URL, "callback-token", options)
+		require.NoError(t, err)
+
+		stop, err := callback.StartHeartbeat(ctx, 5*time.Millisecond, "scanning")
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			return len(ts.statuses()) >= 2
+		}, time.Second, time.Millisecond)
+
+		err = callback.Send(ctx, TaskResultCallbackOptions{Status: TaskPassed})
+		require.NoError(t, err)
+		require.NoError(t, stop())
+
+		statuses := ts.statuses()
+		assert.Equal(t, "passed", statuses[len(statuses)-1])
+		for _, status := range statuses[:len(statuses)-1] {
+			assert.Equal(t, "running", status)
+		}
+	})
+
+	t.Run("with heartbeats until the deadline", func(t *testing.T) {
+		ts := newTestCallbackServer(t, 0)
+		defer ts.Close()
+
+		clock := NewFakeClock(time.Now())
+		callback, err := NewRunTaskCallback(ts.URL, "callback-token", &RunTaskCallbackOptions{
+			Deadline: clock.Now().Add(3 * time.Minute),
+			Clock:    clock,
+		})
+		require.NoError(t, err)
+
+		stop, err := callback.StartHeartbeat(ctx, time.Minute, "scanning")
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			return len(clock.Waits()) == 3
+		}, time.Second, time.Millisecond)
+		require.NoError(t, stop())
+
+		assert.Equal(t, []string{"running", "running"}, ts.statuses())
+	})
+
+	t.Run("with a final result while a heartbeat is retried", func(t *testing.T) {
+		received := make(chan struct{}, 1)
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var payload taskResultCallbackPayload
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+
+			if payload.Data.Attributes.Status == TaskRunning {
+				select {
+				case received <- struct{}{}:
+				default:
+				}
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer ts.Close()
+
+		callback, err := NewRunTaskCallback(ts.URL, "callback-token", &RunTaskCallbackOptions{
+			RetryWaitMin: time.Hour,
+		})
+		require.NoError(t, err)
+
+		heartbeat := make(chan error, 1)
+		go func() {
+			heartbeat <- callback.Send(ctx, TaskResultCallbackOptions{Status: TaskRunning})
+		}()
+		<-received
+
+		err = callback.Send(ctx, TaskResultCallbackOptions{Status: TaskPassed})
+		require.NoError(t, err)
+		assert.Equal(t, ErrRunTaskCallbackFinished, <-heartbeat)
+	})
+
+	t.Run("with an invalid heartbeat interval", func(t *testing.T) {
+		callback, err := NewRunTaskCallback("https://example.com", "callback-token", nil)
+		require.NoError(t, err)
+
+		_, err = callback.StartHeartbeat(ctx, 0, "scanning")
+		assert.Equal(t, ErrInvalidHeartbeatInterval, err)
+	})
+
+	t.Run("with an invalid status", func(t *testing.T) {
+		callback, err := NewRunTaskCallback("https://example.com", "callback-token", nil)
+		require.NoError(t, err)
+
+		err = callback.Send(ctx, TaskResultCallbackOptions{Status: TaskPending})
+		assert.Equal(t, ErrInvalidTaskResultsCallbackStatus, err)
+	})
+
+	t.Run("with an invalid outcome", func(t *testing.T) {
+		callback, err := NewRunTaskCallback("https://example.com", "callback-token", nil)
+		require.NoError(t, err)
+
+		err = callback.Send(ctx, TaskResultCallbackOptions{
+			Status:   TaskPassed,
+			Outcomes: []*TaskResultOutcome{{OutcomeID: "1"}},
+		})
+		assert.Equal(t, ErrInvalidTaskResultOutcome, err)
+	})
+
+	t.Run("without an access token", func(t *testing.T) {
+		_, err := NewRunTaskCallback("https://example.com", "", nil)
+		assert.Equal(t, ErrRequiredRunTaskAccessToken, err)
+	})
+}
+
+func TestVerifyHMAC(t *testing.T) {
+	payload := []byte(`{"payload_version":1}`)
+	mac := hmac.New(sha512.New, []byte("secret"))
+	mac.Write(payload)
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	assert.True(t, VerifyHMAC(payload, signature, "secret"))
+	assert.False(t, VerifyHMAC(payload, signature, "other-secret"))
+	assert.False(t, VerifyHMAC([]byte(`{"payload_version":2}`), signature, "secret"))
+	assert.False(t, VerifyHMAC(payload, "not-hex", "secret"))
+	assert.False(t, VerifyHMAC(payload, "", "secret"))
+}
@@ -30,10 +30,11 @@ func TestRunTasksCreate(t *testing.T) {
 
 	t.Run("add run task to organization", func(t *testing.T) {
 		r, err := client.RunTasks.Create(ctx, orgTest.Name, RunTaskCreateOptions{
-			Name:     runTaskName,
-			URL:      runTaskServerURL,
-			Category: "task",
-			Enabled:  Bool(true),
+			Name:        runTaskName,
+			URL:         runTaskServerURL,
+			Category:    "task",
+			Description: String("a run task"),
+			Enabled:     Bool(true),
 		})
 		require.NoError(t, err)
 
@@ -41,6 +42,7 @@ func TestRunTasksCreate(t *testing.T) {
 		assert.Equal(t, r.Name, runTaskName)
 		assert.Equal(t, r.URL, runTaskServerURL)
 		assert.Equal(t, r.Category, "task")
+		assert.Equal(t, r.Description, "a run task")
 
 		t.Run("ensure org is deserialized properly", func(t *testing.T) {
 			assert.Equal(t, r.Organization.Name, orgTest.Name)
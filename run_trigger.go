@@ -27,6 +27,10 @@ type RunTriggers interface {
 
 	// Delete a run trigger by its ID.
 	Delete(ctx context.Context, RunTriggerID string) error
+
+	// Graph lists the run triggers of all the workspaces of an organization
+	// and returns the graph of the workspaces they connect.
+	Graph(ctx context.Context, organization string) (*RunTriggerGraph, error)
 }
 
 // runTriggers implements RunTriggers.
@@ -53,6 +57,8 @@ type RunTrigger struct {
 	Workspace  *Workspace `jsonapi:"relation,workspace"`
 }
 
+// RunTriggerFilterOp represents the direction of run triggers relative to a
+// workspace, used to filter the run triggers of a workspace.
 // https://www.terraform.io/cloud-docs/api-docs/run-triggers#query-parameters
 type RunTriggerFilterOp string
 
@@ -61,7 +67,8 @@ const (
 	RunTriggerInbound  RunTriggerFilterOp = "inbound"  // create runs in the specified workspace
 )
 
-// A list of relations to include
+// RunTriggerIncludeOpt represents the available options for include query
+// params, which are only supported when listing inbound run triggers.
 // https://www.terraform.io/cloud-docs/api-docs/run-triggers#available-related-resources
 type RunTriggerIncludeOpt string
 
@@ -91,6 +98,19 @@ type RunTriggerCreateOptions struct {
 	Sourceable *Workspace `jsonapi:"relation,sourceable"`
 }
 
+// Direction returns whether the run trigger is inbound or outbound for the
+// workspace with the given ID, or an empty string if the run trigger does not
+// relate to that workspace.
+func (r *RunTrigger) Direction(workspaceID string) RunTriggerFilterOp {
+	switch {
+	case r.Workspace != nil && r.Workspace.ID == workspaceID:
+		return RunTriggerInbound
+	case r.Sourceable != nil && r.Sourceable.ID == workspaceID:
+		return RunTriggerOutbound
+	}
+	return ""
+}
+
 // List all the run triggers associated with a workspace.
 func (s *runTriggers) List(ctx context.Context, workspaceID string, options *RunTriggerListOptions) (*RunTriggerList, error) {
 	if !validStringID(&workspaceID) {
@@ -107,7 +127,7 @@ func (s *runTriggers) List(ctx context.Context, workspaceID string, options *Run
 	}
 
 	rtl := &RunTriggerList{}
-	err = s.client.do(ctx, req, rtl)
+	err = s.client.do(ctx, "runTriggers.List", req, rtl)
 	if err != nil {
 		return nil, err
 	}
@@ -131,7 +151,7 @@ func (s *runTriggers) Create(ctx context.Context, workspaceID string, options Ru
 	}
 
 	rt := &RunTrigger{}
-	err = s.client.do(ctx, req, rt)
+	err = s.client.do(ctx, "runTriggers.Create", req, rt)
 	if err != nil {
 		return nil, err
 	}
@@ -152,7 +172,7 @@ func (s *runTriggers) Read(ctx context.Context, runTriggerID string) (*RunTrigge
 	}
 
 	rt := &RunTrigger{}
-	err = s.client.do(ctx, req, rt)
+	err = s.client.do(ctx, "runTriggers.Read", req, rt)
 	if err != nil {
 		return nil, err
 	}
@@ -172,7 +192,7 @@ func (s *runTriggers) Delete(ctx context.Context, runTriggerID string) error {
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "runTriggers.Delete", req, nil)
 }
 
 func (o RunTriggerCreateOptions) valid() error {
@@ -198,12 +218,18 @@ func (o *RunTriggerListOptions) valid() error {
 	return nil
 }
 
-func validateRunTriggerFilterParam(filterParam RunTriggerFilterOp, includeParams []RunTriggerIncludeOpt) error {
-	switch filterParam {
+func (op RunTriggerFilterOp) valid() error {
+	switch op {
 	case RunTriggerOutbound, RunTriggerInbound:
-		// Do nothing
-	default:
-		return ErrInvalidRunTriggerType // return an error even if string is empty because this a required field
+		return nil
+	}
+	return ErrInvalidRunTriggerType
+}
+
+func validateRunTriggerFilterParam(filterParam RunTriggerFilterOp, includeParams []RunTriggerIncludeOpt) error {
+	// Return an error even if the string is empty because this a required field.
+	if err := filterParam.valid(); err != nil {
+		return err
 	}
 
 	if len(includeParams) > 0 {
@@ -0,0 +1,197 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// runTriggerGraphConcurrency is the number of workspaces whose run triggers
+// are listed concurrently when building a run trigger graph.
+const runTriggerGraphConcurrency = 8
+
+// RunTriggerGraph represents the workspaces of an organization connected by
+// run triggers, where a run applied in a workspace queues runs in the
+// workspaces downstream of it.
+type RunTriggerGraph struct {
+	// The workspaces of the organization, by ID.
+	Workspaces map[string]*Workspace
+
+	// The IDs of the workspaces in which a workspace queues runs, and of
+	// the workspaces which queue runs in a workspace, by workspace ID. They
+	// are sorted, and workspaces without run triggers are left out.
+	Downstream map[string][]string
+	Upstream   map[string][]string
+
+	// The run triggers of the organization.
+	Triggers []*RunTrigger
+}
+
+// Graph lists the run triggers of all the workspaces of an organization and
+// returns the graph of the workspaces they connect. The run triggers of the
+// workspaces are listed concurrently.
+func (s *runTriggers) Graph(ctx context.Context, organization string) (*RunTriggerGraph, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+
+	workspaces, err := listAllWorkspaces(ctx, s.client, organization, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &RunTriggerGraph{
+		Workspaces: make(map[string]*Workspace, len(workspaces)),
+		Downstream: make(map[string][]string),
+		Upstream:   make(map[string][]string),
+		Triggers:   []*RunTrigger{},
+	}
+	for _, w := range workspaces {
+		g.Workspaces[w.ID] = w
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	sem := make(chan struct{}, runTriggerGraphConcurrency)
+	var wg sync.WaitGroup
+	for _, w := range workspaces {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(w *Workspace) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			triggers, err := s.listAllInbound(ctx, w.ID)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err != nil && firstErr == nil:
+				// Stop the workspaces in progress.
+				firstErr = fmt.Errorf("workspace %s: %w", w.Name, err)
+				cancel()
+			case err == nil:
+				g.Triggers = append(g.Triggers, triggers...)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	sort.Slice(g.Triggers, func(i, j int) bool {
+		return g.Triggers[i].ID < g.Triggers[j].ID
+	})
+	for _, rt := range g.Triggers {
+		if rt.Sourceable == nil || rt.Workspace == nil {
+			continue
+		}
+		g.Downstream[rt.Sourceable.ID] = append(g.Downstream[rt.Sourceable.ID], rt.Workspace.ID)
+		g.Upstream[rt.Workspace.ID] = append(g.Upstream[rt.Workspace.ID], rt.Sourceable.ID)
+	}
+	for _, ids := range g.Downstream {
+		sort.Strings(ids)
+	}
+	for _, ids := range g.Upstream {
+		sort.Strings(ids)
+	}
+
+	return g, nil
+}
+
+// listAllInbound lists the inbound run triggers of a workspace, following
+// the pagination until all pages are read.
+func (s *runTriggers) listAllInbound(ctx context.Context, workspaceID string) ([]*RunTrigger, error) {
+	opts := &RunTriggerListOptions{RunTriggerType: RunTriggerInbound}
+
+	var triggers []*RunTrigger
+	for {
+		rtl, err := s.List(ctx, workspaceID, opts)
+		if err != nil {
+			return nil, err
+		}
+		triggers = append(triggers, rtl.Items...)
+
+		if rtl.Pagination == nil || rtl.NextPage == 0 {
+			return triggers, nil
+		}
+		opts.PageNumber = rtl.NextPage
+	}
+}
+
+// CreatesCycle reports whether a run trigger from the sourceable workspace
+// to the workspace would create a cycle, in which runs would trigger each
+// other endlessly. This is the case when the sourceable workspace is the
+// workspace itself, or is downstream of it.
+func (g *RunTriggerGraph) CreatesCycle(sourceableID, workspaceID string) bool {
+	return sourceableID == workspaceID || g.reaches(workspaceID, sourceableID)
+}
+
+// Cycles returns the cycles of the graph, each as the IDs of the workspaces
+// it goes through, starting with the lowest ID. Such cycles can only appear
+// when the run triggers were created by a client which did not check them.
+func (g *RunTriggerGraph) Cycles() [][]string {
+	var cycles [][]string
+
+	ids := make([]string, 0, len(g.Downstream))
+	for id := range g.Downstream {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	// Find the cycles starting from each workspace, only going through
+	// workspaces with higher IDs so each cycle is found once.
+	for _, start := range ids {
+		var path []string
+		onPath := make(map[string]bool)
+
+		var visit func(id string)
+		visit = func(id string) {
+			path = append(path, id)
+			onPath[id] = true
+			for _, next := range g.Downstream[id] {
+				switch {
+				case next == start:
+					cycles = append(cycles, append([]string(nil), path...))
+				case next > start && !onPath[next]:
+					visit(next)
+				}
+			}
+			onPath[id] = false
+			path = path[:len(path)-1]
+		}
+		visit(start)
+	}
+
+	return cycles
+}
+
+// reaches reports whether the workspace with ID to is downstream of the
+// workspace with ID from.
+func (g *RunTriggerGraph) reaches(from, to string) bool {
+	visited := map[string]bool{from: true}
+	queue := []string{from}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, next := range g.Downstream[id] {
+			if next == to {
+				return true
+			}
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return false
+}
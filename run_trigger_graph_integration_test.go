@@ -0,0 +1,109 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunTriggersGraph(t *testing.T) {
+	trigger := func(id, source, target string) string {
+		return fmt.Sprintf(`{"id":%q,"type":"run-triggers","relationships":{`+
+			`"sourceable":{"data":{"id":%q,"type":"workspaces"}},`+
+			`"workspace":{"data":{"id":%q,"type":"workspaces"}}}}`, id, source, target)
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		if r.URL.Path != "/api/v2/ping" && r.URL.Path != "/api/v2/organizations/acme/workspaces" {
+			assert.Equal(t, "inbound", r.URL.Query().Get("filter[run-trigger][type]"))
+		}
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/organizations/acme/workspaces":
+			fmt.Fprint(w, `{"data":[`+
+				`{"id":"ws-network","type":"workspaces","attributes":{"name":"network"}},`+
+				`{"id":"ws-cluster","type":"workspaces","attributes":{"name":"cluster"}},`+
+				`{"id":"ws-app","type":"workspaces","attributes":{"name":"app"}},`+
+				`{"id":"ws-dns","type":"workspaces","attributes":{"name":"dns"}}]}`)
+		case "/api/v2/workspaces/ws-network/run-triggers":
+			fmt.Fprint(w, `{"data":[]}`)
+		case "/api/v2/workspaces/ws-cluster/run-triggers":
+			fmt.Fprint(w, `{"data":[`+trigger("rt-1", "ws-network", "ws-cluster")+`]}`)
+		case "/api/v2/workspaces/ws-app/run-triggers":
+			if r.URL.Query().Get("page[number]") == "2" {
+				fmt.Fprint(w, `{"data":[`+trigger("rt-3", "ws-dns", "ws-app")+`],"meta":{"pagination":{"current-page":2,"total-pages":2}}}`)
+				return
+			}
+			fmt.Fprint(w, `{"data":[`+trigger("rt-2", "ws-cluster", "ws-app")+`],"meta":{"pagination":{"current-page":1,"next-page":2,"total-pages":2}}}`)
+		case "/api/v2/workspaces/ws-dns/run-triggers":
+			fmt.Fprint(w, `{"data":[]}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("when building the graph", func(t *testing.T) {
+		g, err := client.RunTriggers.Graph(ctx, "acme")
+		require.NoError(t, err)
+
+		assert.Len(t, g.Workspaces, 4)
+		assert.Len(t, g.Triggers, 3)
+		assert.Equal(t, map[string][]string{
+			"ws-network": {"ws-cluster"},
+			"ws-cluster": {"ws-app"},
+			"ws-dns":     {"ws-app"},
+		}, g.Downstream)
+		assert.Equal(t, map[string][]string{
+			"ws-cluster": {"ws-network"},
+			"ws-app":     {"ws-cluster", "ws-dns"},
+		}, g.Upstream)
+		assert.Empty(t, g.Cycles())
+
+		assert.True(t, g.CreatesCycle("ws-app", "ws-network"))
+		assert.True(t, g.CreatesCycle("ws-app", "ws-app"))
+		assert.False(t, g.CreatesCycle("ws-network", "ws-dns"))
+		assert.False(t, g.CreatesCycle("ws-dns", "ws-network"))
+	})
+
+	t.Run("without a valid organization", func(t *testing.T) {
+		_, err := client.RunTriggers.Graph(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidOrg, err)
+	})
+}
+
+func TestRunTriggerGraphCycles(t *testing.T) {
+	g := &RunTriggerGraph{Downstream: map[string][]string{
+		"ws-a": {"ws-b"},
+		"ws-b": {"ws-a", "ws-c"},
+		"ws-c": {"ws-d"},
+		"ws-d": {"ws-b"},
+	}}
+
+	assert.Equal(t, [][]string{
+		{"ws-a", "ws-b"},
+		{"ws-b", "ws-c", "ws-d"},
+	}, g.Cycles())
+}
@@ -261,3 +261,14 @@ func TestRunTriggerDelete(t *testing.T) {
 		assert.Equal(t, err, ErrInvalidRunTriggerID)
 	})
 }
+
+func TestRunTriggerDirection(t *testing.T) {
+	rt := &RunTrigger{
+		Workspace:  &Workspace{ID: "ws-downstream"},
+		Sourceable: &Workspace{ID: "ws-upstream"},
+	}
+
+	assert.Equal(t, RunTriggerInbound, rt.Direction("ws-downstream"))
+	assert.Equal(t, RunTriggerOutbound, rt.Direction("ws-upstream"))
+	assert.Equal(t, RunTriggerFilterOp(""), rt.Direction("ws-other"))
+}
@@ -0,0 +1,278 @@
+package tfe
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// runWatchBufferSize is the number of events buffered for every watched run,
+// which exceeds the number of statuses a run goes through.
+const runWatchBufferSize = 32
+
+// runWatcherPageSize is the number of recent runs of a workspace listed per
+// poll, which is the maximum page size.
+const runWatcherPageSize = 100
+
+// RunWatcher multiplexes polling the statuses of many runs. The watched runs
+// are polled by listing the recent runs of their workspaces, so a single
+// request per workspace is made per poll, regardless of the number of
+// watched runs. Runs which are not among the recent runs of their workspace
+// are read individually.
+type RunWatcher struct {
+	// Optional: A function called with the errors of polling the runs of a
+	// workspace. Polling continues at the next interval.
+	OnPollError func(workspaceID string, err error)
+
+	client   *Client
+	interval time.Duration
+
+	mu      sync.Mutex
+	closed  bool
+	done    chan struct{}
+	watches map[string]*runWatch
+}
+
+// runWatch represents a watched run.
+type runWatch struct {
+	workspaceID string
+	status      RunStatus
+
+	events  chan *RunEvent
+	done    chan struct{}
+	sending sync.WaitGroup
+}
+
+// NewRunWatcher creates a run watcher polling the watched runs at the given
+// interval, which defaults to 10 seconds. Polling starts by calling Run.
+func NewRunWatcher(client *Client, interval time.Duration) *RunWatcher {
+	if interval <= 0 {
+		interval = defaultRunEventPollInterval
+	}
+
+	return &RunWatcher{
+		client:   client,
+		interval: interval,
+		done:     make(chan struct{}),
+		watches:  make(map[string]*runWatch),
+	}
+}
+
+// Watch starts watching a run. The returned channel receives an event with
+// the current status of the run, and an event for every status change after
+// that. It is closed once the run reaches a final status, or when the run is
+// unwatched or the watcher is closed.
+func (w *RunWatcher) Watch(ctx context.Context, runID string) (<-chan *RunEvent, error) {
+	if !validStringID(&runID) {
+		return nil, ErrInvalidRunID
+	}
+
+	r, err := w.client.Runs.Read(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	watch := &runWatch{
+		events: make(chan *RunEvent, runWatchBufferSize),
+		done:   make(chan struct{}),
+	}
+	if r.Workspace != nil {
+		watch.workspaceID = r.Workspace.ID
+	}
+
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return nil, ErrRunWatcherClosed
+	}
+	if _, ok := w.watches[runID]; ok {
+		w.mu.Unlock()
+		return nil, ErrRunAlreadyWatched
+	}
+	w.watches[runID] = watch
+	w.mu.Unlock()
+
+	w.update(ctx, watch, r)
+
+	return watch.events, nil
+}
+
+// Unwatch stops watching a run and closes its channel.
+func (w *RunWatcher) Unwatch(runID string) {
+	w.mu.Lock()
+	watch := w.watches[runID]
+	w.mu.Unlock()
+
+	if watch != nil {
+		w.remove(runID, watch)
+	}
+}
+
+// Watching returns the number of watched runs.
+func (w *RunWatcher) Watching() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.watches)
+}
+
+// Run polls the watched runs at the interval, until the context is canceled
+// or the watcher is closed.
+func (w *RunWatcher) Run(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-w.done:
+			return nil
+		case <-w.client.clock.After(w.interval):
+		}
+
+		w.Poll(ctx)
+	}
+}
+
+// Poll polls the watched runs once. Run calls it at the interval.
+func (w *RunWatcher) Poll(ctx context.Context) {
+	w.mu.Lock()
+	byWorkspace := make(map[string]map[string]*runWatch)
+	for runID, watch := range w.watches {
+		if byWorkspace[watch.workspaceID] == nil {
+			byWorkspace[watch.workspaceID] = make(map[string]*runWatch)
+		}
+		byWorkspace[watch.workspaceID][runID] = watch
+	}
+	w.mu.Unlock()
+
+	for workspaceID, watches := range byWorkspace {
+		if err := w.poll(ctx, workspaceID, watches); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			if w.OnPollError != nil {
+				w.OnPollError(workspaceID, err)
+			}
+		}
+	}
+}
+
+// Close stops polling and closes the channels of all watched runs.
+func (w *RunWatcher) Close() {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return
+	}
+	w.closed = true
+	close(w.done)
+	watches := w.watches
+	w.watches = make(map[string]*runWatch)
+	w.mu.Unlock()
+
+	for _, watch := range watches {
+		close(watch.done)
+		watch.sending.Wait()
+		close(watch.events)
+	}
+}
+
+func (w *RunWatcher) poll(ctx context.Context, workspaceID string, watches map[string]*runWatch) error {
+	if workspaceID != "" {
+		rl, err := w.client.Runs.List(ctx, workspaceID, &RunListOptions{
+			ListOptions: ListOptions{PageSize: runWatcherPageSize},
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, r := range rl.Items {
+			if watch, ok := watches[r.ID]; ok {
+				w.update(ctx, watch, r)
+				delete(watches, r.ID)
+			}
+		}
+	}
+
+	for runID, watch := range watches {
+		r, err := w.client.Runs.Read(ctx, runID)
+		if err != nil {
+			return err
+		}
+		w.update(ctx, watch, r)
+	}
+
+	return nil
+}
+
+// update emits an event when the status of the run changed, and stops
+// watching the run once it reached a final status. When the event is not
+// delivered, the status is not recorded, so the next poll reports it again.
+func (w *RunWatcher) update(ctx context.Context, watch *runWatch, r *Run) {
+	w.mu.Lock()
+	if w.watches[r.ID] != watch || watch.status == r.Status {
+		w.mu.Unlock()
+		return
+	}
+
+	// Record the status while sending, so the same status read concurrently
+	// is not emitted twice.
+	previous := watch.status
+	watch.status = r.Status
+	watch.sending.Add(1)
+	w.mu.Unlock()
+
+	delivered := false
+	select {
+	case watch.events <- &RunEvent{
+		RunID:       r.ID,
+		WorkspaceID: watch.workspaceID,
+		Status:      r.Status,
+		Source:      RunEventSourcePolling,
+		Message:     r.Message,
+		Timestamp:   w.client.clock.Now(),
+	}:
+		delivered = true
+	case <-watch.done:
+	case <-ctx.Done():
+	}
+	watch.sending.Done()
+
+	if !delivered {
+		w.mu.Lock()
+		if watch.status == r.Status {
+			watch.status = previous
+		}
+		w.mu.Unlock()
+		return
+	}
+
+	if isFinalRunStatus(r.Status) {
+		w.remove(r.ID, watch)
+	}
+}
+
+// remove stops watching the run and closes its channel, unless the run was
+// already removed.
+func (w *RunWatcher) remove(runID string, watch *runWatch) {
+	w.mu.Lock()
+	if w.watches[runID] != watch {
+		w.mu.Unlock()
+		return
+	}
+	delete(w.watches, runID)
+	close(watch.done)
+	w.mu.Unlock()
+
+	watch.sending.Wait()
+	close(watch.events)
+}
+
+// isFinalRunStatus reports whether a run with the status will not change
+// status anymore.
+func isFinalRunStatus(status RunStatus) bool {
+	switch status {
+	case RunApplied, RunCanceled, RunDiscarded, RunErrored, RunPlannedAndFinished:
+		return true
+	default:
+		return false
+	}
+}
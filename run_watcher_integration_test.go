@@ -0,0 +1,231 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunWatcher(t *testing.T) {
+	var mu sync.Mutex
+	statuses := map[string]RunStatus{
+		"run-1": RunPlanning,
+		"run-2": RunPending,
+		"run-3": RunPlanning,
+	}
+	var lists, reads int32
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/workspaces/ws-1/runs":
+			atomic.AddInt32(&lists, 1)
+			assert.Equal(t, "100", r.URL.Query().Get("page[size]"))
+			// run-3 is too old to be listed.
+			fmt.Fprintf(w, `{"data": [
+				{"id": "run-1", "type": "runs", "attributes": {"status": %q}},
+				{"id": "run-2", "type": "runs", "attributes": {"status": %q}}
+			]}`, statuses["run-1"], statuses["run-2"])
+		case "/api/v2/runs/run-1", "/api/v2/runs/run-2", "/api/v2/runs/run-3":
+			atomic.AddInt32(&reads, 1)
+			id := r.URL.Path[len("/api/v2/runs/"):]
+			fmt.Fprintf(w, `{"data": {"id": %q, "type": "runs", "attributes": {"status": %q},
+				"relationships": {"workspace": {"data": {"id": "ws-1", "type": "workspaces"}}}}}`, id, statuses[id])
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Clock:      NewFakeClock(time.Now()),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	watcher := NewRunWatcher(client, time.Minute)
+	defer watcher.Close()
+
+	events1, err := watcher.Watch(ctx, "run-1")
+	require.NoError(t, err)
+	events2, err := watcher.Watch(ctx, "run-2")
+	require.NoError(t, err)
+	events3, err := watcher.Watch(ctx, "run-3")
+	require.NoError(t, err)
+	assert.Equal(t, 3, watcher.Watching())
+
+	t.Run("sends the current status", func(t *testing.T) {
+		e := <-events1
+		assert.Equal(t, "run-1", e.RunID)
+		assert.Equal(t, "ws-1", e.WorkspaceID)
+		assert.Equal(t, RunPlanning, e.Status)
+		assert.Equal(t, RunEventSourcePolling, e.Source)
+		assert.Equal(t, RunPending, (<-events2).Status)
+		assert.Equal(t, RunPlanning, (<-events3).Status)
+	})
+
+	t.Run("when watching a run twice", func(t *testing.T) {
+		_, err := watcher.Watch(ctx, "run-1")
+		assert.Equal(t, ErrRunAlreadyWatched, err)
+	})
+
+	t.Run("with an invalid run ID", func(t *testing.T) {
+		_, err := watcher.Watch(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidRunID, err)
+	})
+
+	t.Run("polls the runs of the workspace at once", func(t *testing.T) {
+		atomic.StoreInt32(&lists, 0)
+		atomic.StoreInt32(&reads, 0)
+
+		mu.Lock()
+		statuses["run-1"] = RunPlanned
+		statuses["run-3"] = RunErrored
+		mu.Unlock()
+
+		watcher.Poll(ctx)
+
+		assert.Equal(t, int32(1), atomic.LoadInt32(&lists))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&reads))
+
+		assert.Equal(t, RunPlanned, (<-events1).Status)
+		assert.Len(t, events2, 0)
+
+		assert.Equal(t, RunErrored, (<-events3).Status)
+		_, ok := <-events3
+		assert.False(t, ok, "expected the channel of a finished run to be closed")
+		assert.Equal(t, 2, watcher.Watching())
+	})
+
+	t.Run("when unwatching a run", func(t *testing.T) {
+		watcher.Unwatch("run-2")
+		_, ok := <-events2
+		assert.False(t, ok)
+		assert.Equal(t, 1, watcher.Watching())
+	})
+
+	t.Run("after closing the watcher", func(t *testing.T) {
+		watcher.Close()
+		_, ok := <-events1
+		assert.False(t, ok)
+
+		_, err := watcher.Watch(ctx, "run-1")
+		assert.Equal(t, ErrRunWatcherClosed, err)
+		assert.NoError(t, watcher.Run(ctx))
+	})
+}
+
+func TestRunWatcherPollError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/runs/run-1":
+			fmt.Fprint(w, `{"data": {"id": "run-1", "type": "runs", "attributes": {"status": "planning"},
+				"relationships": {"workspace": {"data": {"id": "ws-1", "type": "workspaces"}}}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Clock:      NewFakeClock(time.Now()),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	watcher := NewRunWatcher(client, 0)
+	defer watcher.Close()
+
+	var workspaceIDs []string
+	watcher.OnPollError = func(workspaceID string, err error) {
+		workspaceIDs = append(workspaceIDs, workspaceID)
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+	}
+
+	_, err = watcher.Watch(ctx, "run-1")
+	require.NoError(t, err)
+
+	watcher.Poll(ctx)
+	assert.Equal(t, []string{"ws-1"}, workspaceIDs)
+	assert.Equal(t, 1, watcher.Watching())
+}
+
+func TestRunWatcherUndelivered(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/runs/run-1":
+			fmt.Fprint(w, `{"data": {"id": "run-1", "type": "runs", "attributes": {"status": "planning"},
+				"relationships": {"workspace": {"data": {"id": "ws-1", "type": "workspaces"}}}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Clock:      NewFakeClock(time.Now()),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	watcher := NewRunWatcher(client, 0)
+	defer watcher.Close()
+
+	events, err := watcher.Watch(ctx, "run-1")
+	require.NoError(t, err)
+	assert.Equal(t, RunPlanning, (<-events).Status)
+
+	// Fill the buffer, so the next event can not be delivered.
+	watch := watcher.watches["run-1"]
+	for len(watch.events) < cap(watch.events) {
+		watch.events <- &RunEvent{RunID: "run-1", Status: RunPlanning}
+	}
+
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	watcher.update(canceledCtx, watch, &Run{ID: "run-1", Status: RunErrored})
+	assert.Equal(t, 1, watcher.Watching(), "expected the run to be still watched")
+
+	for len(events) > 0 {
+		<-events
+	}
+
+	// The status is reported again once it can be delivered.
+	watcher.update(ctx, watch, &Run{ID: "run-1", Status: RunErrored})
+	assert.Equal(t, RunErrored, (<-events).Status)
+	_, ok := <-events
+	assert.False(t, ok, "expected the channel of a finished run to be closed")
+	assert.Equal(t, 0, watcher.Watching())
+}
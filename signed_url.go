@@ -0,0 +1,279 @@
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+// Compile-time proof of interface implementation.
+var _ SignedURLClient = (*signedURLClient)(nil)
+
+// SignedURLClient describes the methods transferring artifacts from and to
+// the signed URLs returned by the API, like the upload URL of a configuration
+// version or the download URL of a state version. Signed URLs embed their own
+// authorization, so neither the API token nor the configured headers other
+// than the User-Agent are sent along. Requests are retried like API requests.
+type SignedURLClient interface {
+	// Download the content of a signed URL into the writer.
+	Download(ctx context.Context, signedURL string, w io.Writer) error
+
+	// DownloadWithOptions downloads the content of a signed URL into the
+	// writer, verifying its checksum and reporting the progress.
+	DownloadWithOptions(ctx context.Context, signedURL string, w io.Writer, options *SignedURLDownloadOptions) error
+
+	// Upload the content of the reader to a signed URL.
+	Upload(ctx context.Context, signedURL string, r io.Reader, options *SignedURLUploadOptions) error
+}
+
+// signedURLClient implements SignedURLClient.
+type signedURLClient struct {
+	client *Client
+}
+
+// SignedURLProgressFunc is called while transferring an artifact with the
+// number of bytes transferred so far, and the total number of bytes, or -1
+// when unknown. Failed uploads which are retried restart from zero, while
+// downloads report the bytes written in order, which resumed chunks do not
+// repeat.
+type SignedURLProgressFunc func(transferred, total int64)
+
+// SignedURLDownloadOptions represents the options for downloading from a
+// signed URL.
+type SignedURLDownloadOptions struct {
+	// Optional: The expected hex encoded SHA-256 checksum of the content. As
+	// the content is streamed, it has been written when the checksum is found
+	// not to match, so it has to be discarded by the caller on error.
+	SHA256 string
+
+	// Optional: Called while downloading the content.
+	Progress SignedURLProgressFunc
+}
+
+// SignedURLUploadOptions represents the options for uploading to a signed
+// URL.
+type SignedURLUploadOptions struct {
+	// Optional: The content type of the upload. Defaults to
+	// "application/octet-stream".
+	ContentType string
+
+	// Optional: The expected hex encoded SHA-256 checksum of the content,
+	// verified before anything is uploaded.
+	SHA256 string
+
+	// Optional: Called while uploading the content.
+	Progress SignedURLProgressFunc
+}
+
+// Download the content of a signed URL into the writer.
+func (s *signedURLClient) Download(ctx context.Context, signedURL string, w io.Writer) error {
+	return s.DownloadWithOptions(ctx, signedURL, w, nil)
+}
+
+// DownloadWithOptions downloads the content of a signed URL into the writer,
+// verifying its checksum and reporting the progress. Large artifacts are
+// downloaded in chunks as configured by the Transfer options of the client.
+func (s *signedURLClient) DownloadWithOptions(ctx context.Context, signedURL string, w io.Writer, options *SignedURLDownloadOptions) error {
+	if !validString(&signedURL) {
+		return ErrRequiredURL
+	}
+	if options == nil {
+		options = &SignedURLDownloadOptions{}
+	}
+
+	req, err := retryablehttp.NewRequest("GET", signedURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Range", s.client.firstChunkRange())
+
+	resp, err := s.send(ctx, "signedURLs.Download", req)
+	if err != nil {
+		return err
+	}
+
+	return s.client.download(ctx, "signedURLs.Download", resp, w, options)
+}
+
+// Upload the content of the reader to a signed URL. When the reader is an
+// io.ReadSeeker, like an *os.File, the content from its current offset is
+// streamed and rewound to that offset on retries, otherwise it is read into
+// memory first.
+func (s *signedURLClient) Upload(ctx context.Context, signedURL string, r io.Reader, options *SignedURLUploadOptions) error {
+	if !validString(&signedURL) {
+		return ErrRequiredURL
+	}
+	if options == nil {
+		options = &SignedURLUploadOptions{}
+	}
+
+	body, ok := r.(io.ReadSeeker)
+	if !ok {
+		content, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(content)
+	}
+
+	start, err := body.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	end, err := body.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	size := end - start
+
+	if options.SHA256 != "" {
+		if _, err := body.Seek(start, io.SeekStart); err != nil {
+			return err
+		}
+		h := sha256.New()
+		if _, err := io.Copy(h, body); err != nil {
+			return err
+		}
+		if err := verifySHA256(options.SHA256, h); err != nil {
+			return err
+		}
+	}
+
+	// Rewind the body on every attempt. The body is wrapped to hide any
+	// Close method, as the HTTP client closes request bodies after every
+	// attempt.
+	bodyFunc := retryablehttp.ReaderFunc(func() (io.Reader, error) {
+		if _, err := body.Seek(start, io.SeekStart); err != nil {
+			return nil, err
+		}
+		if options.Progress == nil {
+			return struct{ io.Reader }{body}, nil
+		}
+		return &progressReader{r: body, total: size, progress: options.Progress}, nil
+	})
+
+	req, err := retryablehttp.NewRequest("PUT", signedURL, bodyFunc)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+
+	contentType := options.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := s.do(ctx, "signedURLs.Upload", req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// exists sends a HEAD request to a signed URL to check whether it can still
+// be read from. Signed URLs expire, after which they are no longer found.
+func (s *signedURLClient) exists(ctx context.Context, op, signedURL string) (bool, error) {
+	req, err := retryablehttp.NewRequest("HEAD", signedURL, nil)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := s.send(ctx, op, req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusNotFound, http.StatusForbidden, http.StatusGone:
+		return false, nil
+	}
+
+	if err := checkResponseCode(resp, s.client.clock); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// do sends the request without the API token, and checks the response.
+func (s *signedURLClient) do(ctx context.Context, op string, req *retryablehttp.Request) (*http.Response, error) {
+	resp, err := s.send(ctx, op, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkResponseCode(resp, s.client.clock); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// send sends the request without the API token, naming the API call op in
+// the telemetry. Signed URLs are served by a different host than the API, so
+// only the User-Agent of the configured headers is sent along, and the
+// requests are not subject to the rate limiter and circuit breaker of the
+// API.
+func (s *signedURLClient) send(ctx context.Context, op string, req *retryablehttp.Request) (*http.Response, error) {
+	if ua := s.client.headers.Get("User-Agent"); ua != "" {
+		req.Header.Set("User-Agent", ua)
+	}
+
+	ctx, call := s.client.telemetry.start(ctx, op, req)
+
+	// Keep track of the attempts, which are updated by the request log hook.
+	attempts := 0
+	ctx = context.WithValue(ctx, attemptsKey{}, &attempts)
+
+	start := s.client.clock.Now()
+	resp, err := s.client.http.Do(req.WithContext(ctx))
+	call.end(ctx, resp, err, attempts, s.client.clock.Now().Sub(start))
+	if err != nil {
+		// If we got an error, and the context has been canceled,
+		// the context's error is probably more useful.
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// verifySHA256 compares the expected hex encoded checksum with the sum of the
+// hash.
+func verifySHA256(expected string, h hash.Hash) error {
+	if actual := hex.EncodeToString(h.Sum(nil)); actual != expected {
+		return fmt.Errorf("%w: expected %s, got %s", ErrChecksumMismatch, expected, actual)
+	}
+	return nil
+}
+
+// progressReader reports the number of bytes read from the reader.
+type progressReader struct {
+	r           io.Reader
+	transferred int64
+	total       int64
+	progress    SignedURLProgressFunc
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.transferred += int64(n)
+		p.progress(p.transferred, p.total)
+	}
+	return n, err
+}
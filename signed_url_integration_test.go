@@ -0,0 +1,186 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedURLClient(t *testing.T) {
+	content := []byte("signed content")
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+
+	var uploads [][]byte
+	failures := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+			return
+		case "/signed/object":
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		assert.Empty(t, r.Header.Get("Authorization"))
+
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		switch r.Method {
+		case "GET":
+			_, _ = w.Write(content)
+		case "PUT":
+			assert.Equal(t, int64(len(content)), r.ContentLength)
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			uploads = append(uploads, body)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:      ts.URL,
+		Token:        "dummy-token",
+		HTTPClient:   ts.Client(),
+		RetryWaitMin: 1,
+		RetryWaitMax: 1,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	signedURL := ts.URL + "/signed/object"
+
+	t.Run("when downloading", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := client.SignedURLs.Download(ctx, signedURL, &buf)
+		require.NoError(t, err)
+		assert.Equal(t, content, buf.Bytes())
+	})
+
+	t.Run("when downloading with a checksum and progress", func(t *testing.T) {
+		var buf bytes.Buffer
+		var transferred int64
+		err := client.SignedURLs.DownloadWithOptions(ctx, signedURL, &buf, &SignedURLDownloadOptions{
+			SHA256:   checksum,
+			Progress: func(n, _ int64) { transferred = n },
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(content)), transferred)
+	})
+
+	t.Run("when the downloaded checksum does not match", func(t *testing.T) {
+		err := client.SignedURLs.DownloadWithOptions(ctx, signedURL, io.Discard, &SignedURLDownloadOptions{
+			SHA256: "invalid",
+		})
+		assert.True(t, errors.Is(err, ErrChecksumMismatch))
+	})
+
+	t.Run("when the signed URL does not exist", func(t *testing.T) {
+		err := client.SignedURLs.Download(ctx, ts.URL+"/signed/unknown", io.Discard)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("when uploading a file with retries", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "object")
+		require.NoError(t, os.WriteFile(path, content, 0o600))
+		f, err := os.Open(path)
+		require.NoError(t, err)
+		defer f.Close()
+
+		uploads = nil
+		failures = 1
+
+		var transferred, total int64
+		err = client.SignedURLs.Upload(ctx, signedURL, f, &SignedURLUploadOptions{
+			SHA256: checksum,
+			Progress: func(n, size int64) {
+				transferred, total = n, size
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, [][]byte{content}, uploads)
+		assert.Equal(t, int64(len(content)), transferred)
+		assert.Equal(t, int64(len(content)), total)
+	})
+
+	t.Run("when uploading a file with retries and without options", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "object")
+		require.NoError(t, os.WriteFile(path, content, 0o600))
+		f, err := os.Open(path)
+		require.NoError(t, err)
+		defer f.Close()
+
+		uploads = nil
+		failures = 1
+
+		err = client.SignedURLs.Upload(ctx, signedURL, f, nil)
+		require.NoError(t, err)
+		assert.Equal(t, [][]byte{content}, uploads)
+	})
+
+	t.Run("when uploading a file from its current offset", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "object")
+		require.NoError(t, os.WriteFile(path, append([]byte("header"), content...), 0o600))
+		f, err := os.Open(path)
+		require.NoError(t, err)
+		defer f.Close()
+
+		_, err = f.Seek(int64(len("header")), io.SeekStart)
+		require.NoError(t, err)
+
+		uploads = nil
+		failures = 1
+
+		var total int64
+		err = client.SignedURLs.Upload(ctx, signedURL, f, &SignedURLUploadOptions{
+			SHA256:   checksum,
+			Progress: func(_, size int64) { total = size },
+		})
+		require.NoError(t, err)
+		assert.Equal(t, [][]byte{content}, uploads)
+		assert.Equal(t, int64(len(content)), total)
+	})
+
+	t.Run("when uploading a reader", func(t *testing.T) {
+		uploads = nil
+
+		err := client.SignedURLs.Upload(ctx, signedURL, io.MultiReader(bytes.NewReader(content)), nil)
+		require.NoError(t, err)
+		assert.Equal(t, [][]byte{content}, uploads)
+	})
+
+	t.Run("when the uploaded checksum does not match", func(t *testing.T) {
+		uploads = nil
+
+		err := client.SignedURLs.Upload(ctx, signedURL, bytes.NewReader(content), &SignedURLUploadOptions{
+			SHA256: "invalid",
+		})
+		assert.True(t, errors.Is(err, ErrChecksumMismatch))
+		assert.Empty(t, uploads)
+	})
+
+	t.Run("without a signed URL", func(t *testing.T) {
+		err := client.SignedURLs.Upload(ctx, "", bytes.NewReader(content), nil)
+		assert.Equal(t, ErrRequiredURL, err)
+	})
+}
@@ -90,7 +90,7 @@ func (s *sshKeys) List(ctx context.Context, organization string, options *SSHKey
 	}
 
 	kl := &SSHKeyList{}
-	err = s.client.do(ctx, req, kl)
+	err = s.client.do(ctx, "sshKeys.List", req, kl)
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +115,7 @@ func (s *sshKeys) Create(ctx context.Context, organization string, options SSHKe
 	}
 
 	k := &SSHKey{}
-	err = s.client.do(ctx, req, k)
+	err = s.client.do(ctx, "sshKeys.Create", req, k)
 	if err != nil {
 		return nil, err
 	}
@@ -136,7 +136,7 @@ func (s *sshKeys) Read(ctx context.Context, sshKeyID string) (*SSHKey, error) {
 	}
 
 	k := &SSHKey{}
-	err = s.client.do(ctx, req, k)
+	err = s.client.do(ctx, "sshKeys.Read", req, k)
 	if err != nil {
 		return nil, err
 	}
@@ -157,7 +157,7 @@ func (s *sshKeys) Update(ctx context.Context, sshKeyID string, options SSHKeyUpd
 	}
 
 	k := &SSHKey{}
-	err = s.client.do(ctx, req, k)
+	err = s.client.do(ctx, "sshKeys.Update", req, k)
 	if err != nil {
 		return nil, err
 	}
@@ -177,7 +177,7 @@ func (s *sshKeys) Delete(ctx context.Context, sshKeyID string) error {
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "sshKeys.Delete", req, nil)
 }
 
 func (o SSHKeyCreateOptions) valid() error {
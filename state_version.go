@@ -62,9 +62,27 @@ type StateVersion struct {
 	VCSCommitSHA string    `jsonapi:"attr,vcs-commit-sha"`
 	VCSCommitURL string    `jsonapi:"attr,vcs-commit-url"`
 
+	// Whether the resources of the state have been extracted. Until they
+	// are, Resources is empty.
+	ResourcesProcessed bool                     `jsonapi:"attr,resources-processed"`
+	Resources          []*StateVersionResources `jsonapi:"attr,resources"`
+
 	// Relations
 	Run     *Run                  `jsonapi:"relation,run"`
 	Outputs []*StateVersionOutput `jsonapi:"relation,outputs"`
+
+	// Links
+	Links map[string]interface{} `jsonapi:"links,omitempty"`
+}
+
+// StateVersionResources represents a summary of the resources of a given
+// type, module and provider within a state version.
+type StateVersionResources struct {
+	Name     string `jsonapi:"attr,name"`
+	Count    int    `jsonapi:"attr,count"`
+	Type     string `jsonapi:"attr,type"`
+	Module   string `jsonapi:"attr,module"`
+	Provider string `jsonapi:"attr,provider"`
 }
 
 // StateVersionOutputsList represents a list of StateVersionOutput items.
@@ -152,7 +170,7 @@ func (s *stateVersions) List(ctx context.Context, options *StateVersionListOptio
 	}
 
 	svl := &StateVersionList{}
-	err = s.client.do(ctx, req, svl)
+	err = s.client.do(ctx, "stateVersions.List", req, svl)
 	if err != nil {
 		return nil, err
 	}
@@ -176,7 +194,7 @@ func (s *stateVersions) Create(ctx context.Context, workspaceID string, options
 	}
 
 	sv := &StateVersion{}
-	err = s.client.do(ctx, req, sv)
+	err = s.client.do(ctx, "stateVersions.Create", req, sv)
 	if err != nil {
 		return nil, err
 	}
@@ -186,6 +204,11 @@ func (s *stateVersions) Create(ctx context.Context, workspaceID string, options
 
 // Read a state version by its ID.
 func (s *stateVersions) ReadWithOptions(ctx context.Context, svID string, options *StateVersionReadOptions) (*StateVersion, error) {
+	return s.readWithOptions(ctx, "stateVersions.ReadWithOptions", svID, options)
+}
+
+// readWithOptions implements Read and ReadWithOptions, naming the API call op.
+func (s *stateVersions) readWithOptions(ctx context.Context, op, svID string, options *StateVersionReadOptions) (*StateVersion, error) {
 	if !validStringID(&svID) {
 		return nil, ErrInvalidStateVerID
 	}
@@ -200,7 +223,7 @@ func (s *stateVersions) ReadWithOptions(ctx context.Context, svID string, option
 	}
 
 	sv := &StateVersion{}
-	err = s.client.do(ctx, req, sv)
+	err = s.client.do(ctx, op, req, sv)
 	if err != nil {
 		return nil, err
 	}
@@ -210,11 +233,17 @@ func (s *stateVersions) ReadWithOptions(ctx context.Context, svID string, option
 
 // Read a state version by its ID.
 func (s *stateVersions) Read(ctx context.Context, svID string) (*StateVersion, error) {
-	return s.ReadWithOptions(ctx, svID, nil)
+	return s.readWithOptions(ctx, "stateVersions.Read", svID, nil)
 }
 
 // ReadCurrentWithOptions reads the latest available state from the given workspace using the options supplied.
 func (s *stateVersions) ReadCurrentWithOptions(ctx context.Context, workspaceID string, options *StateVersionCurrentOptions) (*StateVersion, error) {
+	return s.readCurrentWithOptions(ctx, "stateVersions.ReadCurrentWithOptions", workspaceID, options)
+}
+
+// readCurrentWithOptions implements ReadCurrent and ReadCurrentWithOptions,
+// naming the API call op.
+func (s *stateVersions) readCurrentWithOptions(ctx context.Context, op, workspaceID string, options *StateVersionCurrentOptions) (*StateVersion, error) {
 	if !validStringID(&workspaceID) {
 		return nil, ErrInvalidWorkspaceID
 	}
@@ -229,7 +258,7 @@ func (s *stateVersions) ReadCurrentWithOptions(ctx context.Context, workspaceID
 	}
 
 	sv := &StateVersion{}
-	err = s.client.do(ctx, req, sv)
+	err = s.client.do(ctx, op, req, sv)
 	if err != nil {
 		return nil, err
 	}
@@ -239,19 +268,29 @@ func (s *stateVersions) ReadCurrentWithOptions(ctx context.Context, workspaceID
 
 // ReadCurrent reads the latest available state from the given workspace.
 func (s *stateVersions) ReadCurrent(ctx context.Context, workspaceID string) (*StateVersion, error) {
-	return s.ReadCurrentWithOptions(ctx, workspaceID, nil)
+	return s.readCurrentWithOptions(ctx, "stateVersions.ReadCurrent", workspaceID, nil)
 }
 
-// Download retrieves the actual stored state of a state version
+// Download retrieves the actual stored state of a state version. Download
+// URLs relative to or on the host of the API are requested like any other API
+// request, while signed URLs of other hosts are downloaded without the API
+// token.
 func (s *stateVersions) Download(ctx context.Context, u string) ([]byte, error) {
+	var buf bytes.Buffer
+	if !s.client.isAPIURL(u) {
+		if err := s.client.SignedURLs.Download(ctx, u, &buf); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
 	req, err := s.client.newRequest("GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Accept", "application/json")
 
-	var buf bytes.Buffer
-	err = s.client.do(ctx, req, &buf)
+	err = s.client.do(ctx, "stateVersions.Download", req, &buf)
 	if err != nil {
 		return nil, err
 	}
@@ -272,7 +311,7 @@ func (s *stateVersions) ListOutputs(ctx context.Context, svID string, options *S
 	}
 
 	sv := &StateVersionOutputsList{}
-	err = s.client.do(ctx, req, sv)
+	err = s.client.do(ctx, "stateVersions.ListOutputs", req, sv)
 	if err != nil {
 		return nil, err
 	}
@@ -4,11 +4,16 @@
 package tfe
 
 import (
+	"bytes"
 	"context"
 	"crypto/md5"
 	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -267,6 +272,64 @@ func TestStateVersionsCreate(t *testing.T) {
 	})
 }
 
+func TestStateVersionsCreate_conflicts(t *testing.T) {
+	var status int
+	var detail string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(204)
+			return
+		}
+		w.WriteHeader(status)
+		fmt.Fprintf(w, `{"errors":[{"status":"%d","title":"conflict","detail":%q}]}`, status, detail)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	options := StateVersionCreateOptions{
+		MD5:    String("d41d8cd98f00b204e9800998ecf8427e"),
+		Serial: Int64(1),
+		State:  String("e30="),
+	}
+
+	cases := []struct {
+		name   string
+		status int
+		detail string
+		err    error
+	}{
+		{"with a serial conflict", 409, "Serial 1 already exists", ErrStateVersionSerialConflict},
+		{"with a lineage mismatch", 409, "Lineage does not match the current state", ErrStateVersionLineageMismatch},
+		{"with an invalid serial", 422, "Serial must be greater than 5", ErrStateVersionSerialConflict},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			status, detail = tc.status, tc.detail
+
+			_, err := client.StateVersions.Create(context.Background(), "ws-123", options)
+			assert.True(t, errors.Is(err, tc.err), "unexpected error: %v", err)
+			assert.Contains(t, err.Error(), tc.detail)
+		})
+	}
+
+	t.Run("with another conflict", func(t *testing.T) {
+		status, detail = 409, "Workspace is not locked"
+
+		_, err := client.StateVersions.Create(context.Background(), "ws-123", options)
+		require.Error(t, err)
+		assert.False(t, errors.Is(err, ErrStateVersionSerialConflict))
+		assert.False(t, errors.Is(err, ErrStateVersionLineageMismatch))
+	})
+}
+
 func TestStateVersionsRead(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -426,6 +489,55 @@ func TestStateVersionsDownload(t *testing.T) {
 	})
 }
 
+func TestStateVersionsDownload_URLs(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/state-versions/sv-api/download":
+			assert.Equal(t, "Bearer dummy-token", r.Header.Get("Authorization"))
+			assert.Equal(t, "application/json", r.Header.Get("Accept"))
+			fmt.Fprint(w, `{"version":4,"serial":1}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	archivist := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"version":4,"serial":2}`)
+	}))
+	defer archivist.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("with a URL relative to the API", func(t *testing.T) {
+		state, err := client.StateVersions.Download(ctx, "/api/v2/state-versions/sv-api/download")
+		require.NoError(t, err)
+		assert.Equal(t, `{"version":4,"serial":1}`, string(state))
+	})
+
+	t.Run("with a URL on the host of the API", func(t *testing.T) {
+		state, err := client.StateVersions.Download(ctx, ts.URL+"/api/v2/state-versions/sv-api/download")
+		require.NoError(t, err)
+		assert.Equal(t, `{"version":4,"serial":1}`, string(state))
+	})
+
+	t.Run("with a signed URL", func(t *testing.T) {
+		state, err := client.StateVersions.Download(ctx, archivist.URL+"/v1/object/signed")
+		require.NoError(t, err)
+		assert.Equal(t, `{"version":4,"serial":2}`, string(state))
+	})
+}
+
 func TestStateVersionOutputs(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -484,3 +596,52 @@ func TestStateVersionOutputs(t *testing.T) {
 	})
 
 }
+
+func TestStateVersion_Unmarshal(t *testing.T) {
+	data := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "state-versions",
+			"id":   "sv-1",
+			"attributes": map[string]interface{}{
+				"serial":              3,
+				"resources-processed": true,
+				"resources": []interface{}{
+					map[string]interface{}{
+						"name":     "web",
+						"count":    2,
+						"type":     "aws_instance",
+						"module":   "root",
+						"provider": "provider[\"registry.terraform.io/hashicorp/aws\"]",
+					},
+					map[string]interface{}{
+						"name":     "id",
+						"count":    1,
+						"type":     "random_pet",
+						"module":   "module.pets",
+						"provider": "provider[\"registry.terraform.io/hashicorp/random\"]",
+					},
+				},
+			},
+		},
+	}
+
+	byteData, err := json.Marshal(data)
+	require.NoError(t, err)
+
+	sv := &StateVersion{}
+	err = unmarshalResponse(bytes.NewReader(byteData), sv)
+	require.NoError(t, err)
+
+	assert.Equal(t, "sv-1", sv.ID)
+	assert.Equal(t, int64(3), sv.Serial)
+	assert.True(t, sv.ResourcesProcessed)
+	require.Len(t, sv.Resources, 2)
+	assert.Equal(t, &StateVersionResources{
+		Name:     "web",
+		Count:    2,
+		Type:     "aws_instance",
+		Module:   "root",
+		Provider: "provider[\"registry.terraform.io/hashicorp/aws\"]",
+	}, sv.Resources[0])
+	assert.Equal(t, "module.pets", sv.Resources[1].Module)
+}
@@ -46,7 +46,7 @@ func (s *stateVersionOutputs) ReadCurrent(ctx context.Context, workspaceID strin
 	}
 
 	so := &StateVersionOutputsList{}
-	err = s.client.do(ctx, req, so)
+	err = s.client.do(ctx, "stateVersionOutputs.ReadCurrent", req, so)
 	if err != nil {
 		return nil, err
 	}
@@ -67,7 +67,7 @@ func (s *stateVersionOutputs) Read(ctx context.Context, outputID string) (*State
 	}
 
 	so := &StateVersionOutput{}
-	err = s.client.do(ctx, req, so)
+	err = s.client.do(ctx, "stateVersionOutputs.Read", req, so)
 	if err != nil {
 		return nil, err
 	}
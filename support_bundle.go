@@ -0,0 +1,277 @@
+package tfe
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultDiagnosticsHistory is the number of recent requests kept for
+	// support bundles by default.
+	defaultDiagnosticsHistory = 50
+
+	// redactedValue replaces the values removed from support bundles.
+	redactedValue = "REDACTED"
+
+	// maxPathSegment is the length above which a path segment is assumed to
+	// hold a secret, like the signed paths of archivist URLs.
+	maxPathSegment = 64
+
+	// _headerRequestID is the header holding the ID of the request, which
+	// identifies it in the logs of Terraform Cloud or Enterprise.
+	_headerRequestID = "X-Request-Id"
+)
+
+// SupportBundle holds diagnostics of a client, to be attached to bug reports
+// against Terraform Cloud, Terraform Enterprise or this SDK. It does not hold
+// the API token, request or response bodies, or query parameter values, and
+// long path segments and URLs in errors are redacted.
+type SupportBundle struct {
+	GeneratedAt time.Time `json:"generated_at"`
+
+	// The client and the platform it runs on.
+	SDK       string `json:"sdk"`
+	GoVersion string `json:"go_version"`
+	OS        string `json:"os"`
+	Arch      string `json:"arch"`
+
+	// The API the client talks to.
+	Address          string `json:"address"`
+	RemoteAPIVersion string `json:"remote_api_version"`
+
+	// The retry policy of the client.
+	RetryMax          int           `json:"retry_max"`
+	RetryWaitMin      time.Duration `json:"retry_wait_min"`
+	RetryWaitMax      time.Duration `json:"retry_wait_max"`
+	RetryServerErrors bool          `json:"retry_server_errors"`
+
+	// The rate limit reported by the API, and the client side rate limiter
+	// derived from it. The limit is nil when the requests are not limited,
+	// and CustomLimiter is true when the limiter was set by the Config.
+	RateLimit     *RateLimit `json:"rate_limit"`
+	LimiterLimit  *float64   `json:"limiter_limit"`
+	LimiterBurst  int        `json:"limiter_burst"`
+	CustomLimiter bool       `json:"custom_limiter"`
+
+	// The most recent requests, oldest first, and the number of retries and
+	// failures among them.
+	Requests       []*RequestSummary `json:"requests"`
+	Retries        int               `json:"retries"`
+	FailedRequests int               `json:"failed_requests"`
+}
+
+// RequestSummary describes a request made by the client.
+type RequestSummary struct {
+	Time time.Time `json:"time"`
+
+	// The service and method of the client which made the request, like
+	// "workspaces.Read".
+	Operation string `json:"operation"`
+
+	Method string `json:"method"`
+	Path   string `json:"path"`
+
+	// The names of the query parameters, whose values are left out.
+	Query []string `json:"query,omitempty"`
+
+	// The status code and request ID of the response, if any.
+	StatusCode int    `json:"status_code,omitempty"`
+	RequestID  string `json:"request_id,omitempty"`
+
+	// The number of times the request was sent, which is above one when it
+	// was retried.
+	Attempts int `json:"attempts"`
+
+	// The time the request waited for the rate limiter, and the time it took
+	// including its retries.
+	RateLimitWait time.Duration `json:"rate_limit_wait"`
+	Duration      time.Duration `json:"duration"`
+
+	// The error returned while sending the request, if any. Errors of the
+	// API, like a 404 status code, are reported by the status code.
+	Error string `json:"error,omitempty"`
+}
+
+// requestHistory keeps the summaries of the most recent requests of a client.
+type requestHistory struct {
+	mu        sync.Mutex
+	summaries []*RequestSummary
+	next      int
+	full      bool
+}
+
+// newRequestHistory creates a request history of the given size. A negative
+// size disables the history, and zero uses the default size.
+func newRequestHistory(size int) *requestHistory {
+	switch {
+	case size < 0:
+		return nil
+	case size == 0:
+		size = defaultDiagnosticsHistory
+	}
+	return &requestHistory{summaries: make([]*RequestSummary, size)}
+}
+
+// record adds a summary to the history, replacing the oldest summary when it
+// is full. It is a no-op on a nil history.
+func (h *requestHistory) record(s *RequestSummary) {
+	if h == nil {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.summaries[h.next] = s
+	h.next = (h.next + 1) % len(h.summaries)
+	if h.next == 0 {
+		h.full = true
+	}
+}
+
+// list returns the summaries of the history, oldest first.
+func (h *requestHistory) list() []*RequestSummary {
+	if h == nil {
+		return nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var summaries []*RequestSummary
+	if h.full {
+		summaries = append(summaries, h.summaries[h.next:]...)
+	}
+	return append(summaries, h.summaries[:h.next]...)
+}
+
+// SupportBundle returns the diagnostics of the client. The recent requests
+// are kept unless Config.DiagnosticsHistory is negative.
+func (c *Client) SupportBundle() *SupportBundle {
+	b := &SupportBundle{
+		GeneratedAt:       c.clock.Now(),
+		SDK:               _userAgent,
+		GoVersion:         runtime.Version(),
+		OS:                runtime.GOOS,
+		Arch:              runtime.GOARCH,
+		Address:           c.baseURL.String(),
+		RemoteAPIVersion:  c.RemoteAPIVersion(),
+		RetryMax:          c.http.RetryMax,
+		RetryWaitMin:      c.http.RetryWaitMin,
+		RetryWaitMax:      c.http.RetryWaitMax,
+		RetryServerErrors: c.retryServerErrors,
+		RateLimit:         c.RateLimit(),
+		CustomLimiter:     c.rateLimiter != nil,
+		Requests:          []*RequestSummary{},
+	}
+
+	c.metaMu.Lock()
+	if limit := float64(c.limiter.Limit()); !math.IsInf(limit, 1) {
+		b.LimiterLimit = &limit
+	}
+	b.LimiterBurst = c.limiter.Burst()
+	c.metaMu.Unlock()
+
+	for _, s := range c.requests.list() {
+		summary := *s
+		summary.Error = c.redactError(s.Error)
+		b.Requests = append(b.Requests, &summary)
+
+		if s.Attempts > 1 {
+			b.Retries += s.Attempts - 1
+		}
+		if s.Error != "" || s.StatusCode >= 400 {
+			b.FailedRequests++
+		}
+	}
+
+	return b
+}
+
+// WriteSupportBundle writes the diagnostics of the client to w, as indented
+// JSON.
+func (c *Client) WriteSupportBundle(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(c.SupportBundle())
+}
+
+// recordRequest adds the summary of a request to the request history.
+func (c *Client) recordRequest(operation string, req *http.Request, resp *http.Response, err error, attempts int, start time.Time, wait, duration time.Duration) {
+	if c.requests == nil {
+		return
+	}
+
+	s := &RequestSummary{
+		Time:          start,
+		Operation:     operation,
+		Method:        req.Method,
+		Path:          redactPath(req.URL.Path),
+		Attempts:      attempts,
+		RateLimitWait: wait,
+		Duration:      duration,
+	}
+	for name := range req.URL.Query() {
+		s.Query = append(s.Query, name)
+	}
+	sort.Strings(s.Query)
+
+	if resp != nil {
+		s.StatusCode = resp.StatusCode
+		s.RequestID = resp.Header.Get(_headerRequestID)
+	}
+	if err != nil {
+		s.Error = err.Error()
+	}
+
+	c.requests.record(s)
+}
+
+// redactPath replaces the segments of a URL path which are long enough to
+// hold a secret.
+func redactPath(p string) string {
+	segments := strings.Split(p, "/")
+	for i, s := range segments {
+		if len(s) > maxPathSegment {
+			segments[i] = redactedValue
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// urlPattern matches the URLs within error messages.
+var urlPattern = regexp.MustCompile(`https?://[^\s"']+`)
+
+// redactError removes the API token, and the query and long path segments of
+// URLs, from an error message.
+func (c *Client) redactError(msg string) string {
+	if msg == "" {
+		return ""
+	}
+	if c.token != "" {
+		msg = strings.ReplaceAll(msg, c.token, redactedValue)
+	}
+
+	return urlPattern.ReplaceAllStringFunc(msg, func(raw string) string {
+		u, err := url.Parse(raw)
+		if err != nil {
+			return redactedValue
+		}
+		u.Path = redactPath(u.Path)
+		u.RawPath = ""
+		u.User = nil
+		if u.RawQuery != "" {
+			u.RawQuery = redactedValue
+		}
+		return u.String()
+	})
+}
@@ -0,0 +1,124 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientSupportBundle(t *testing.T) {
+	var failures int32 = 1
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.Header().Set("X-Request-Id", "req-"+r.URL.Path[len("/api/v2/"):])
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.Header().Set("X-RateLimit-Limit", "30")
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/organizations/acme":
+			if atomic.AddInt32(&failures, -1) >= 0 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			w.Write([]byte(`{"data":{"id":"acme","type":"organizations"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:            ts.URL,
+		Token:              "secret-token",
+		HTTPClient:         ts.Client(),
+		RetryWaitMin:       time.Millisecond,
+		RetryWaitMax:       time.Millisecond,
+		DiagnosticsHistory: 2,
+	})
+	require.NoError(t, err)
+	client.RetryServerErrors(true)
+
+	ctx := context.Background()
+
+	_, err = client.Workspaces.Read(ctx, "acme", "first")
+	assert.Equal(t, ErrResourceNotFound, err)
+	_, err = client.Organizations.Read(ctx, "acme")
+	require.NoError(t, err)
+	_, err = client.Workspaces.List(ctx, "acme", &WorkspaceListOptions{Search: "secret-name"})
+	assert.Equal(t, ErrResourceNotFound, err)
+
+	t.Run("when generating a support bundle", func(t *testing.T) {
+		b := client.SupportBundle()
+		assert.Equal(t, "go-tfe", b.SDK)
+		assert.Equal(t, ts.URL+"/api/v2/", b.Address)
+		assert.True(t, b.RetryServerErrors)
+		require.NotNil(t, b.LimiterLimit)
+		assert.InDelta(t, 19.8, *b.LimiterLimit, 0.01)
+		assert.False(t, b.CustomLimiter)
+
+		// Only the most recent requests are kept, oldest first.
+		require.Len(t, b.Requests, 2)
+		org := b.Requests[0]
+		assert.Equal(t, "organizations.Read", org.Operation)
+		assert.Equal(t, "GET", org.Method)
+		assert.Equal(t, "/api/v2/organizations/acme", org.Path)
+		assert.Equal(t, http.StatusOK, org.StatusCode)
+		assert.Equal(t, "req-organizations/acme", org.RequestID)
+		assert.Equal(t, 2, org.Attempts)
+
+		list := b.Requests[1]
+		assert.Equal(t, "workspaces.List", list.Operation)
+		assert.Equal(t, http.StatusNotFound, list.StatusCode)
+		assert.Contains(t, list.Query, "search[name]")
+
+		assert.Equal(t, 1, b.Retries)
+		assert.Equal(t, 1, b.FailedRequests)
+	})
+
+	t.Run("when writing a support bundle", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, client.WriteSupportBundle(&buf))
+		assert.NotContains(t, buf.String(), "secret-token")
+		assert.NotContains(t, buf.String(), "secret-name")
+
+		var b map[string]interface{}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &b))
+		assert.Len(t, b["requests"], 2)
+	})
+
+	t.Run("when the history is disabled", func(t *testing.T) {
+		client, err := NewClient(&Config{
+			Address:            ts.URL,
+			Token:              "secret-token",
+			HTTPClient:         ts.Client(),
+			DiagnosticsHistory: -1,
+		})
+		require.NoError(t, err)
+
+		_, err = client.Organizations.Read(ctx, "acme")
+		require.NoError(t, err)
+		assert.Empty(t, client.SupportBundle().Requests)
+	})
+
+	t.Run("when redacting errors", func(t *testing.T) {
+		signed := "/v1/object/" + strings.Repeat("a", 100)
+		err := errors.New(`Get "https://archivist.example.com` + signed + `?token=abc": dial tcp: connection refused, token secret-token`)
+
+		redacted := client.redactError(err.Error())
+		assert.Equal(t, `Get "https://archivist.example.com/v1/object/REDACTED?REDACTED": dial tcp: connection refused, token REDACTED`, redacted)
+	})
+}
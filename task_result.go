@@ -81,10 +81,18 @@ func (t *taskResults) Read(ctx context.Context, taskResultID string) (*TaskResul
 	}
 
 	r := &TaskResult{}
-	err = t.client.do(ctx, req, r)
+	err = t.client.do(ctx, "taskResults.Read", req, r)
 	if err != nil {
 		return nil, err
 	}
 
 	return r, nil
 }
+
+func (l TaskEnforcementLevel) valid() bool {
+	switch l {
+	case Advisory, Mandatory:
+		return true
+	}
+	return false
+}
@@ -3,6 +3,7 @@ package tfe
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 )
 
@@ -18,6 +19,10 @@ type TaskStages interface {
 
 	// List all task stages for a given rrun
 	List(ctx context.Context, runID string, options *TaskStageListOptions) (*TaskStageList, error)
+
+	// Override a task stage which failed a mandatory run task, allowing the
+	// run to continue
+	Override(ctx context.Context, taskStageID string, options TaskStageOverrideOptions) (*TaskStage, error)
 }
 
 // taskStages implements TaskStages
@@ -29,14 +34,33 @@ type taskStages struct {
 type Stage string
 
 const (
+	PrePlan  Stage = "pre_plan"
 	PostPlan Stage = "post_plan"
+	PreApply Stage = "pre_apply"
+)
+
+// TaskStageStatus is an enum that represents all possible statuses for a task stage
+type TaskStageStatus string
+
+const (
+	TaskStagePending          TaskStageStatus = "pending"
+	TaskStageRunning          TaskStageStatus = "running"
+	TaskStagePassed           TaskStageStatus = "passed"
+	TaskStageFailed           TaskStageStatus = "failed"
+	TaskStageAwaitingOverride TaskStageStatus = "awaiting_override"
+	TaskStageCanceled         TaskStageStatus = "canceled"
+	TaskStageErrored          TaskStageStatus = "errored"
+	TaskStageUnreachable      TaskStageStatus = "unreachable"
 )
 
 // TaskStage represents a TFC/E run's stage where run tasks can occur
 type TaskStage struct {
 	ID               string                    `jsonapi:"primary,task-stages"`
 	Stage            Stage                     `jsonapi:"attr,stage"`
+	Status           TaskStageStatus           `jsonapi:"attr,status"`
 	StatusTimestamps TaskStageStatusTimestamps `jsonapi:"attr,status-timestamps"`
+	Actions          *TaskStageActions         `jsonapi:"attr,actions"`
+	Permissions      *TaskStagePermissions     `jsonapi:"attr,permissions"`
 	CreatedAt        time.Time                 `jsonapi:"attr,created-at,iso8601"`
 	UpdatedAt        time.Time                 `jsonapi:"attr,updated-at,iso8601"`
 
@@ -44,6 +68,31 @@ type TaskStage struct {
 	TaskResults []*TaskResult `jsonapi:"relation,task-results"`
 }
 
+// TaskStageActions represents the actions available on a task stage
+type TaskStageActions struct {
+	IsOverridable bool `jsonapi:"attr,is-overridable"`
+}
+
+// TaskStagePermissions represents the permissions of the current user or
+// token on a task stage
+type TaskStagePermissions struct {
+	CanOverridePolicy bool `jsonapi:"attr,can-override-policy"`
+	CanOverrideTasks  bool `jsonapi:"attr,can-override-tasks"`
+	CanOverride       bool `jsonapi:"attr,can-override"`
+}
+
+// AwaitingOverride reports whether the task stage failed a mandatory run task
+// and waits for an override before the run can continue
+func (t *TaskStage) AwaitingOverride() bool {
+	return t.Status == TaskStageAwaitingOverride && t.Actions != nil && t.Actions.IsOverridable
+}
+
+// CanOverride reports whether the task stage awaits an override which the
+// current user or token is allowed to perform
+func (t *TaskStage) CanOverride() bool {
+	return t.AwaitingOverride() && t.Permissions != nil && t.Permissions.CanOverride
+}
+
 // TaskStageList represents a list of task stages
 type TaskStageList struct {
 	*Pagination
@@ -75,6 +124,12 @@ type TaskStageListOptions struct {
 	ListOptions
 }
 
+// TaskStageOverrideOptions represents the options for overriding a task stage
+type TaskStageOverrideOptions struct {
+	// An optional comment on why the task stage is overridden
+	Comment *string `json:"comment,omitempty"`
+}
+
 // Read a task stage by ID
 func (s *taskStages) Read(ctx context.Context, taskStageID string, options *TaskStageReadOptions) (*TaskStage, error) {
 	if !validStringID(&taskStageID) {
@@ -91,7 +146,7 @@ func (s *taskStages) Read(ctx context.Context, taskStageID string, options *Task
 	}
 
 	t := &TaskStage{}
-	err = s.client.do(ctx, req, t)
+	err = s.client.do(ctx, "taskStages.Read", req, t)
 	if err != nil {
 		return nil, err
 	}
@@ -113,7 +168,7 @@ func (s *taskStages) List(ctx context.Context, runID string, options *TaskStageL
 
 	tlist := &TaskStageList{}
 
-	err = s.client.do(ctx, req, tlist)
+	err = s.client.do(ctx, "taskStages.List", req, tlist)
 	if err != nil {
 		return nil, err
 	}
@@ -121,6 +176,28 @@ func (s *taskStages) List(ctx context.Context, runID string, options *TaskStageL
 	return tlist, nil
 }
 
+// Override a task stage which failed a mandatory run task, allowing the run
+// to continue
+func (s *taskStages) Override(ctx context.Context, taskStageID string, options TaskStageOverrideOptions) (*TaskStage, error) {
+	if !validStringID(&taskStageID) {
+		return nil, ErrInvalidTaskStageID
+	}
+
+	u := fmt.Sprintf("task-stages/%s/actions/override", url.QueryEscape(taskStageID))
+	req, err := s.client.newRequest("POST", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &TaskStage{}
+	err = s.client.do(ctx, "taskStages.Override", req, t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
 func (o *TaskStageReadOptions) valid() error {
 	if o == nil {
 		return nil // nothing to validate
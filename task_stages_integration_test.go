@@ -5,6 +5,10 @@ package tfe
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -109,3 +113,90 @@ func TestTaskStagesList(t *testing.T) {
 		assert.Equal(t, 2, len(taskStageList.Items[0].TaskResults))
 	})
 }
+
+func TestTaskStagesOverride(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/v2/task-stages/ts-1/actions/override", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "accepted risk", body["comment"])
+
+		fmt.Fprint(w, `{"data":{"id":"ts-1","type":"task-stages","attributes":{"stage":"post_plan","status":"passed","actions":{"is-overridable":false}}}}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("with a comment", func(t *testing.T) {
+		taskStage, err := client.TaskStages.Override(ctx, "ts-1", TaskStageOverrideOptions{
+			Comment: String("accepted risk"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, TaskStagePassed, taskStage.Status)
+		assert.Equal(t, PostPlan, taskStage.Stage)
+		require.NotNil(t, taskStage.Actions)
+		assert.False(t, taskStage.Actions.IsOverridable)
+	})
+
+	t.Run("with an invalid task stage ID", func(t *testing.T) {
+		taskStage, err := client.TaskStages.Override(ctx, badIdentifier, TaskStageOverrideOptions{})
+		assert.Nil(t, taskStage)
+		assert.Equal(t, ErrInvalidTaskStageID, err)
+	})
+}
+
+func TestTaskStage_AwaitingOverride(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		fmt.Fprint(w, `{"data":{"id":"ts-1","type":"task-stages","attributes":{
+			"stage":"post_plan",
+			"status":"awaiting_override",
+			"actions":{"is-overridable":true},
+			"permissions":{"can-override-policy":false,"can-override-tasks":true,"can-override":true}
+		}}}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	taskStage, err := client.TaskStages.Read(context.Background(), "ts-1", nil)
+	require.NoError(t, err)
+
+	require.NotNil(t, taskStage.Permissions)
+	assert.True(t, taskStage.Permissions.CanOverrideTasks)
+	assert.False(t, taskStage.Permissions.CanOverridePolicy)
+	assert.True(t, taskStage.AwaitingOverride())
+	assert.True(t, taskStage.CanOverride())
+
+	taskStage.Permissions.CanOverride = false
+	assert.True(t, taskStage.AwaitingOverride())
+	assert.False(t, taskStage.CanOverride())
+
+	taskStage.Status = TaskStagePassed
+	assert.False(t, taskStage.AwaitingOverride())
+}
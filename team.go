@@ -20,6 +20,10 @@ type Teams interface {
 	// Create a new team with the given options.
 	Create(ctx context.Context, organization string, options TeamCreateOptions) (*Team, error)
 
+	// GetOrCreate creates a team, or reads it when a team with the same name
+	// already exists. It reports whether the team was created.
+	GetOrCreate(ctx context.Context, organization string, options TeamCreateOptions) (*Team, bool, error)
+
 	// Read a team by its ID.
 	Read(ctx context.Context, teamID string) (*Team, error)
 
@@ -161,7 +165,7 @@ func (s *teams) List(ctx context.Context, organization string, options *TeamList
 	}
 
 	tl := &TeamList{}
-	err = s.client.do(ctx, req, tl)
+	err = s.client.do(ctx, "teams.List", req, tl)
 	if err != nil {
 		return nil, err
 	}
@@ -185,7 +189,7 @@ func (s *teams) Create(ctx context.Context, organization string, options TeamCre
 	}
 
 	t := &Team{}
-	err = s.client.do(ctx, req, t)
+	err = s.client.do(ctx, "teams.Create", req, t)
 	if err != nil {
 		return nil, err
 	}
@@ -193,6 +197,32 @@ func (s *teams) Create(ctx context.Context, organization string, options TeamCre
 	return t, nil
 }
 
+// GetOrCreate creates a team, or reads it when creating it failed because a
+// team with the same name already exists. Creating first makes it safe
+// against concurrent callers creating the same team. The settings of an
+// existing team are not compared to the options.
+func (s *teams) GetOrCreate(ctx context.Context, organization string, options TeamCreateOptions) (*Team, bool, error) {
+	t, err := s.Create(ctx, organization, options)
+	if err == nil {
+		return t, true, nil
+	}
+	if !isCreateConflict(err) {
+		return nil, false, err
+	}
+
+	tl, lerr := s.List(ctx, organization, &TeamListOptions{Names: []string{*options.Name}})
+	if lerr != nil {
+		return nil, false, lerr
+	}
+	for _, t := range tl.Items {
+		if t.Name == *options.Name {
+			return t, false, nil
+		}
+	}
+
+	return nil, false, err
+}
+
 // Read a single team by its ID.
 func (s *teams) Read(ctx context.Context, teamID string) (*Team, error) {
 	if !validStringID(&teamID) {
@@ -206,7 +236,7 @@ func (s *teams) Read(ctx context.Context, teamID string) (*Team, error) {
 	}
 
 	t := &Team{}
-	err = s.client.do(ctx, req, t)
+	err = s.client.do(ctx, "teams.Read", req, t)
 	if err != nil {
 		return nil, err
 	}
@@ -227,7 +257,7 @@ func (s *teams) Update(ctx context.Context, teamID string, options TeamUpdateOpt
 	}
 
 	t := &Team{}
-	err = s.client.do(ctx, req, t)
+	err = s.client.do(ctx, "teams.Update", req, t)
 	if err != nil {
 		return nil, err
 	}
@@ -247,7 +277,7 @@ func (s *teams) Delete(ctx context.Context, teamID string) error {
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "teams.Delete", req, nil)
 }
 
 func (o TeamCreateOptions) valid() error {
@@ -171,7 +171,7 @@ func (s *teamAccesses) List(ctx context.Context, options *TeamAccessListOptions)
 	}
 
 	tal := &TeamAccessList{}
-	err = s.client.do(ctx, req, tal)
+	err = s.client.do(ctx, "teamAccesses.List", req, tal)
 	if err != nil {
 		return nil, err
 	}
@@ -191,7 +191,7 @@ func (s *teamAccesses) Add(ctx context.Context, options TeamAccessAddOptions) (*
 	}
 
 	ta := &TeamAccess{}
-	err = s.client.do(ctx, req, ta)
+	err = s.client.do(ctx, "teamAccesses.Add", req, ta)
 	if err != nil {
 		return nil, err
 	}
@@ -212,7 +212,7 @@ func (s *teamAccesses) Read(ctx context.Context, teamAccessID string) (*TeamAcce
 	}
 
 	ta := &TeamAccess{}
-	err = s.client.do(ctx, req, ta)
+	err = s.client.do(ctx, "teamAccesses.Read", req, ta)
 	if err != nil {
 		return nil, err
 	}
@@ -226,6 +226,10 @@ func (s *teamAccesses) Update(ctx context.Context, teamAccessID string, options
 		return nil, ErrInvalidAccessTeamID
 	}
 
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
 	u := fmt.Sprintf("team-workspaces/%s", url.QueryEscape(teamAccessID))
 	req, err := s.client.newRequest("PATCH", u, &options)
 	if err != nil {
@@ -233,7 +237,7 @@ func (s *teamAccesses) Update(ctx context.Context, teamAccessID string, options
 	}
 
 	ta := &TeamAccess{}
-	err = s.client.do(ctx, req, ta)
+	err = s.client.do(ctx, "teamAccesses.Update", req, ta)
 	if err != nil {
 		return nil, err
 	}
@@ -253,7 +257,7 @@ func (s *teamAccesses) Remove(ctx context.Context, teamAccessID string) error {
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "teamAccesses.Remove", req, nil)
 }
 
 func (o *TeamAccessListOptions) valid() error {
@@ -280,5 +284,71 @@ func (o TeamAccessAddOptions) valid() error {
 	if o.Workspace == nil {
 		return ErrRequiredWorkspace
 	}
+	return validateTeamAccessTypes(o.Access, o.Runs, o.Variables, o.StateVersions, o.SentinelMocks)
+}
+
+func (o TeamAccessUpdateOptions) valid() error {
+	return validateTeamAccessTypes(o.Access, o.Runs, o.Variables, o.StateVersions, o.SentinelMocks)
+}
+
+// validateTeamAccessTypes checks the access type and custom permissions of
+// team access options, which are left unchanged when nil.
+func validateTeamAccessTypes(access *AccessType, runs *RunsPermissionType, variables *VariablesPermissionType,
+	stateVersions *StateVersionsPermissionType, sentinelMocks *SentinelMocksPermissionType) error {
+	if access != nil && !access.valid() {
+		return ErrInvalidAccessType
+	}
+	if runs != nil && !runs.valid() {
+		return ErrInvalidRunsPermission
+	}
+	if variables != nil && !variables.valid() {
+		return ErrInvalidVariablesPermission
+	}
+	if stateVersions != nil && !stateVersions.valid() {
+		return ErrInvalidStateVersionsPermission
+	}
+	if sentinelMocks != nil && !sentinelMocks.valid() {
+		return ErrInvalidSentinelMocksPermission
+	}
 	return nil
 }
+
+func (t AccessType) valid() bool {
+	switch t {
+	case AccessAdmin, AccessPlan, AccessRead, AccessWrite, AccessCustom:
+		return true
+	}
+	return false
+}
+
+func (t RunsPermissionType) valid() bool {
+	switch t {
+	case RunsPermissionRead, RunsPermissionPlan, RunsPermissionApply:
+		return true
+	}
+	return false
+}
+
+func (t VariablesPermissionType) valid() bool {
+	switch t {
+	case VariablesPermissionNone, VariablesPermissionRead, VariablesPermissionWrite:
+		return true
+	}
+	return false
+}
+
+func (t StateVersionsPermissionType) valid() bool {
+	switch t {
+	case StateVersionsPermissionNone, StateVersionsPermissionReadOutputs, StateVersionsPermissionRead, StateVersionsPermissionWrite:
+		return true
+	}
+	return false
+}
+
+func (t SentinelMocksPermissionType) valid() bool {
+	switch t {
+	case SentinelMocksPermissionNone, SentinelMocksPermissionRead:
+		return true
+	}
+	return false
+}
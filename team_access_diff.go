@@ -0,0 +1,278 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// TeamAccessOperation represents the operation remediating a difference in
+// the team access of a workspace.
+type TeamAccessOperation string
+
+// List all available team access operations.
+const (
+	TeamAccessOperationAdd    TeamAccessOperation = "add"
+	TeamAccessOperationUpdate TeamAccessOperation = "update"
+	TeamAccessOperationRemove TeamAccessOperation = "remove"
+)
+
+// TeamAccessGrant describes the workspace access of a team. Teams are named,
+// so a template of grants can be applied to workspaces of any organization.
+type TeamAccessGrant struct {
+	Team   string     `json:"team"`
+	Access AccessType `json:"access"`
+
+	// The permissions of custom access, which are ignored for the other
+	// access types, as they are implied by them.
+	Runs             RunsPermissionType          `json:"runs,omitempty"`
+	Variables        VariablesPermissionType     `json:"variables,omitempty"`
+	StateVersions    StateVersionsPermissionType `json:"state_versions,omitempty"`
+	SentinelMocks    SentinelMocksPermissionType `json:"sentinel_mocks,omitempty"`
+	WorkspaceLocking bool                        `json:"workspace_locking,omitempty"`
+	RunTasks         bool                        `json:"run_tasks,omitempty"`
+}
+
+// TeamAccessChange describes a difference in the team access of a workspace,
+// and the operation remediating it.
+type TeamAccessChange struct {
+	Operation TeamAccessOperation `json:"operation"`
+	Team      string              `json:"team"`
+	TeamID    string              `json:"team_id"`
+
+	// The ID of the team access to update or remove.
+	TeamAccessID string `json:"team_access_id,omitempty"`
+
+	// The access the team holds, unless it is added.
+	Current *TeamAccessGrant `json:"current,omitempty"`
+
+	// The access the team should hold, unless it is removed.
+	Desired *TeamAccessGrant `json:"desired,omitempty"`
+}
+
+// TeamAccessDiff holds the differences of the team access of a workspace
+// from a reference workspace or a template, ordered by team name.
+type TeamAccessDiff struct {
+	WorkspaceID string              `json:"workspace_id"`
+	Changes     []*TeamAccessChange `json:"changes"`
+}
+
+// workspaceTeamAccess holds the team access of a workspace, and the teams of
+// its organization, keyed by team name.
+type workspaceTeamAccess struct {
+	teams    map[string]*Team
+	accesses map[string]*TeamAccess
+}
+
+// DiffWorkspaceTeamAccess compares the team access of a workspace with the
+// team access of a reference workspace. Teams are matched by name, so the
+// workspaces can belong to different organizations.
+func DiffWorkspaceTeamAccess(ctx context.Context, client *Client, workspaceID, referenceWorkspaceID string) (*TeamAccessDiff, error) {
+	if !validStringID(&workspaceID) || !validStringID(&referenceWorkspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+
+	reference, err := readWorkspaceTeamAccess(ctx, client, referenceWorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var template []*TeamAccessGrant
+	for name, ta := range reference.accesses {
+		template = append(template, teamAccessGrant(name, ta))
+	}
+
+	return DiffTeamAccessTemplate(ctx, client, workspaceID, template)
+}
+
+// DiffTeamAccessTemplate compares the team access of a workspace with a
+// template, which lists the access of every team which should have access
+// to the workspace. A team of the template which does not exist in the
+// organization of the workspace results in an error wrapping
+// ErrResourceNotFound.
+func DiffTeamAccessTemplate(ctx context.Context, client *Client, workspaceID string, template []*TeamAccessGrant) (*TeamAccessDiff, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+
+	current, err := readWorkspaceTeamAccess(ctx, client, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &TeamAccessDiff{WorkspaceID: workspaceID, Changes: []*TeamAccessChange{}}
+	desired := make(map[string]bool, len(template))
+	for _, grant := range template {
+		desired[grant.Team] = true
+
+		team, ok := current.teams[grant.Team]
+		if !ok {
+			return nil, fmt.Errorf("team %q: %w", grant.Team, ErrResourceNotFound)
+		}
+		grant = teamAccessGrant(grant.Team, grant.teamAccess())
+
+		ta, ok := current.accesses[grant.Team]
+		if !ok {
+			diff.Changes = append(diff.Changes, &TeamAccessChange{
+				Operation: TeamAccessOperationAdd,
+				Team:      grant.Team,
+				TeamID:    team.ID,
+				Desired:   grant,
+			})
+			continue
+		}
+
+		if have := teamAccessGrant(grant.Team, ta); *have != *grant {
+			diff.Changes = append(diff.Changes, &TeamAccessChange{
+				Operation:    TeamAccessOperationUpdate,
+				Team:         grant.Team,
+				TeamID:       team.ID,
+				TeamAccessID: ta.ID,
+				Current:      have,
+				Desired:      grant,
+			})
+		}
+	}
+
+	for name, ta := range current.accesses {
+		if desired[name] {
+			continue
+		}
+		diff.Changes = append(diff.Changes, &TeamAccessChange{
+			Operation:    TeamAccessOperationRemove,
+			Team:         name,
+			TeamID:       ta.Team.ID,
+			TeamAccessID: ta.ID,
+			Current:      teamAccessGrant(name, ta),
+		})
+	}
+
+	sort.Slice(diff.Changes, func(i, j int) bool {
+		return diff.Changes[i].Team < diff.Changes[j].Team
+	})
+
+	return diff, nil
+}
+
+// Remediate applies the operations of the changes to the workspace, in the
+// order of the changes. It stops at the first operation which fails.
+func (d *TeamAccessDiff) Remediate(ctx context.Context, client *Client) error {
+	for _, c := range d.Changes {
+		var err error
+		switch c.Operation {
+		case TeamAccessOperationAdd:
+			_, err = client.TeamAccess.Add(ctx, c.Desired.addOptions(d.WorkspaceID, c.TeamID))
+		case TeamAccessOperationUpdate:
+			_, err = client.TeamAccess.Update(ctx, c.TeamAccessID, c.Desired.updateOptions())
+		case TeamAccessOperationRemove:
+			err = client.TeamAccess.Remove(ctx, c.TeamAccessID)
+		default:
+			err = fmt.Errorf("unknown operation %q", c.Operation)
+		}
+		if err != nil {
+			return fmt.Errorf("%s access of team %q: %w", c.Operation, c.Team, err)
+		}
+	}
+
+	return nil
+}
+
+// readWorkspaceTeamAccess reads the team access of a workspace, and the
+// teams of its organization.
+func readWorkspaceTeamAccess(ctx context.Context, client *Client, workspaceID string) (*workspaceTeamAccess, error) {
+	w, err := client.Workspaces.ReadByID(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if w.Organization == nil {
+		return nil, ErrInvalidOrg
+	}
+
+	teams, err := listAllTeams(ctx, client, w.Organization.Name)
+	if err != nil {
+		return nil, err
+	}
+	accesses, err := listAllTeamAccesses(ctx, client, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &workspaceTeamAccess{
+		teams:    make(map[string]*Team, len(teams)),
+		accesses: make(map[string]*TeamAccess, len(accesses)),
+	}
+	names := make(map[string]string, len(teams))
+	for _, t := range teams {
+		result.teams[t.Name] = t
+		names[t.ID] = t.Name
+	}
+	for _, ta := range accesses {
+		if ta.Team == nil {
+			continue
+		}
+		if name, ok := names[ta.Team.ID]; ok {
+			result.accesses[name] = ta
+		}
+	}
+
+	return result, nil
+}
+
+// teamAccessGrant returns the grant of the team access of the named team.
+func teamAccessGrant(team string, ta *TeamAccess) *TeamAccessGrant {
+	grant := &TeamAccessGrant{Team: team, Access: ta.Access}
+	if ta.Access == AccessCustom {
+		grant.Runs = ta.Runs
+		grant.Variables = ta.Variables
+		grant.StateVersions = ta.StateVersions
+		grant.SentinelMocks = ta.SentinelMocks
+		grant.WorkspaceLocking = ta.WorkspaceLocking
+		grant.RunTasks = ta.RunTasks
+	}
+	return grant
+}
+
+// teamAccess returns the team access of the grant.
+func (g *TeamAccessGrant) teamAccess() *TeamAccess {
+	return &TeamAccess{
+		Access:           g.Access,
+		Runs:             g.Runs,
+		Variables:        g.Variables,
+		StateVersions:    g.StateVersions,
+		SentinelMocks:    g.SentinelMocks,
+		WorkspaceLocking: g.WorkspaceLocking,
+		RunTasks:         g.RunTasks,
+	}
+}
+
+// addOptions returns the options adding the access of the grant.
+func (g *TeamAccessGrant) addOptions(workspaceID, teamID string) TeamAccessAddOptions {
+	options := TeamAccessAddOptions{
+		Access:    Access(g.Access),
+		Team:      &Team{ID: teamID},
+		Workspace: &Workspace{ID: workspaceID},
+	}
+	if g.Access == AccessCustom {
+		options.Runs = RunsPermission(g.Runs)
+		options.Variables = VariablesPermission(g.Variables)
+		options.StateVersions = StateVersionsPermission(g.StateVersions)
+		options.SentinelMocks = SentinelMocksPermission(g.SentinelMocks)
+		options.WorkspaceLocking = Bool(g.WorkspaceLocking)
+		options.RunTasks = Bool(g.RunTasks)
+	}
+	return options
+}
+
+// updateOptions returns the options updating the access to the grant.
+func (g *TeamAccessGrant) updateOptions() TeamAccessUpdateOptions {
+	add := g.addOptions("", "")
+	return TeamAccessUpdateOptions{
+		Access:           add.Access,
+		Runs:             add.Runs,
+		Variables:        add.Variables,
+		StateVersions:    add.StateVersions,
+		SentinelMocks:    add.SentinelMocks,
+		WorkspaceLocking: add.WorkspaceLocking,
+		RunTasks:         add.RunTasks,
+	}
+}
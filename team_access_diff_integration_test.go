@@ -0,0 +1,139 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffWorkspaceTeamAccess(t *testing.T) {
+	accesses := map[string]string{
+		// The reference workspace.
+		"ws-ref": `[` +
+			`{"id":"tws-ref-dev","type":"team-workspaces","attributes":{"access":"custom","runs":"apply","variables":"read","state-versions":"read","sentinel-mocks":"none","workspace-locking":true},` +
+			`"relationships":{"team":{"data":{"id":"team-dev","type":"teams"}}}},` +
+			`{"id":"tws-ref-ops","type":"team-workspaces","attributes":{"access":"admin","runs":"apply","variables":"write","state-versions":"write","sentinel-mocks":"read","workspace-locking":true},` +
+			`"relationships":{"team":{"data":{"id":"team-ops","type":"teams"}}}}]`,
+		// The workspace to compare.
+		"ws-1": `[` +
+			`{"id":"tws-1-dev","type":"team-workspaces","attributes":{"access":"custom","runs":"plan","variables":"read","state-versions":"read","sentinel-mocks":"none","workspace-locking":true},` +
+			`"relationships":{"team":{"data":{"id":"team-dev","type":"teams"}}}},` +
+			`{"id":"tws-1-qa","type":"team-workspaces","attributes":{"access":"read","runs":"read","variables":"none","state-versions":"read","sentinel-mocks":"none"},` +
+			`"relationships":{"team":{"data":{"id":"team-qa","type":"teams"}}}}]`,
+	}
+
+	var mu sync.Mutex
+	var operations []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "GET /api/v2/workspaces/ws-ref", "GET /api/v2/workspaces/ws-1":
+			fmt.Fprintf(w, `{"data":{"id":%q,"type":"workspaces","relationships":{"organization":{"data":{"id":"acme","type":"organizations"}}}}}`,
+				r.URL.Path[len("/api/v2/workspaces/"):])
+		case "GET /api/v2/organizations/acme/teams":
+			fmt.Fprint(w, `{"data":[`+
+				`{"id":"team-dev","type":"teams","attributes":{"name":"dev"}},`+
+				`{"id":"team-ops","type":"teams","attributes":{"name":"ops"}},`+
+				`{"id":"team-qa","type":"teams","attributes":{"name":"qa"}}]}`)
+		case "GET /api/v2/team-workspaces":
+			fmt.Fprintf(w, `{"data":%s}`, accesses[r.URL.Query().Get("filter[workspace][id]")])
+		case "POST /api/v2/team-workspaces", "PATCH /api/v2/team-workspaces/tws-1-dev", "DELETE /api/v2/team-workspaces/tws-1-qa":
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+
+			mu.Lock()
+			operations = append(operations, r.Method+" "+r.URL.Path+" "+string(body))
+			mu.Unlock()
+
+			if r.Method == "DELETE" {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			fmt.Fprint(w, `{"data":{"id":"tws-new","type":"team-workspaces"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("when comparing with a reference workspace", func(t *testing.T) {
+		diff, err := DiffWorkspaceTeamAccess(ctx, client, "ws-1", "ws-ref")
+		require.NoError(t, err)
+		assert.Equal(t, "ws-1", diff.WorkspaceID)
+
+		var changes []string
+		for _, c := range diff.Changes {
+			changes = append(changes, fmt.Sprintf("%s %s %s %s", c.Operation, c.Team, c.TeamID, c.TeamAccessID))
+		}
+		assert.Equal(t, []string{
+			"update dev team-dev tws-1-dev",
+			"add ops team-ops ",
+			"remove qa team-qa tws-1-qa",
+		}, changes)
+
+		update := diff.Changes[0]
+		assert.Equal(t, RunsPermissionPlan, update.Current.Runs)
+		assert.Equal(t, RunsPermissionApply, update.Desired.Runs)
+
+		// The implied permissions of other access types are not compared.
+		assert.Equal(t, &TeamAccessGrant{Team: "ops", Access: AccessAdmin}, diff.Changes[1].Desired)
+
+		b, err := json.Marshal(diff.Changes[2])
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"operation":"remove","team":"qa","team_id":"team-qa","team_access_id":"tws-1-qa","current":{"team":"qa","access":"read"}}`, string(b))
+
+		require.NoError(t, diff.Remediate(ctx, client))
+		require.Len(t, operations, 3)
+		assert.Contains(t, operations[0], `PATCH /api/v2/team-workspaces/tws-1-dev {"data":{"type":"team-workspaces","attributes":{"access":"custom",`)
+		assert.Contains(t, operations[0], `"runs":"apply"`)
+		assert.Contains(t, operations[1], `POST /api/v2/team-workspaces {"data":{"type":"team-workspaces","attributes":{"access":"admin"}`)
+		assert.Contains(t, operations[1], `"team":{"data":{"type":"teams","id":"team-ops"}}`)
+		assert.Equal(t, "DELETE /api/v2/team-workspaces/tws-1-qa ", operations[2])
+	})
+
+	t.Run("when comparing with a template", func(t *testing.T) {
+		diff, err := DiffTeamAccessTemplate(ctx, client, "ws-ref", []*TeamAccessGrant{
+			{Team: "dev", Access: AccessCustom, Runs: RunsPermissionApply, Variables: VariablesPermissionRead,
+				StateVersions: StateVersionsPermissionRead, SentinelMocks: SentinelMocksPermissionNone, WorkspaceLocking: true},
+			{Team: "ops", Access: AccessAdmin, Runs: RunsPermissionRead},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, diff.Changes)
+	})
+
+	t.Run("when a team of the template does not exist", func(t *testing.T) {
+		_, err := DiffTeamAccessTemplate(ctx, client, "ws-1", []*TeamAccessGrant{
+			{Team: "unknown", Access: AccessRead},
+		})
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		_, err := DiffWorkspaceTeamAccess(ctx, client, "ws-1", badIdentifier)
+		assert.Equal(t, ErrInvalidWorkspaceID, err)
+	})
+}
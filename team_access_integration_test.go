@@ -402,3 +402,58 @@ func TestTeamAccessesUpdateRunTasks(t *testing.T) {
 		assert.Equal(t, newAccess, ta.RunTasks)
 	})
 }
+
+func TestTeamAccessOptions_valid(t *testing.T) {
+	team := &Team{ID: "team-1"}
+	workspace := &Workspace{ID: "ws-1"}
+
+	for name, tc := range map[string]struct {
+		options TeamAccessAddOptions
+		err     error
+	}{
+		"with custom access": {
+			options: TeamAccessAddOptions{
+				Access:        Access(AccessCustom),
+				Runs:          RunsPermission(RunsPermissionApply),
+				Variables:     VariablesPermission(VariablesPermissionRead),
+				StateVersions: StateVersionsPermission(StateVersionsPermissionReadOutputs),
+				SentinelMocks: SentinelMocksPermission(SentinelMocksPermissionNone),
+			},
+		},
+		"with an invalid access type": {
+			options: TeamAccessAddOptions{Access: Access("owner")},
+			err:     ErrInvalidAccessType,
+		},
+		"with an invalid runs permission": {
+			options: TeamAccessAddOptions{Access: Access(AccessCustom), Runs: RunsPermission("write")},
+			err:     ErrInvalidRunsPermission,
+		},
+		"with an invalid variables permission": {
+			options: TeamAccessAddOptions{Access: Access(AccessCustom), Variables: VariablesPermission("apply")},
+			err:     ErrInvalidVariablesPermission,
+		},
+		"with an invalid state versions permission": {
+			options: TeamAccessAddOptions{Access: Access(AccessCustom), StateVersions: StateVersionsPermission("outputs")},
+			err:     ErrInvalidStateVersionsPermission,
+		},
+		"with an invalid Sentinel mocks permission": {
+			options: TeamAccessAddOptions{Access: Access(AccessCustom), SentinelMocks: SentinelMocksPermission("write")},
+			err:     ErrInvalidSentinelMocksPermission,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tc.options.Team = team
+			tc.options.Workspace = workspace
+			assert.Equal(t, tc.err, tc.options.valid())
+
+			updateOptions := TeamAccessUpdateOptions{
+				Access:        tc.options.Access,
+				Runs:          tc.options.Runs,
+				Variables:     tc.options.Variables,
+				StateVersions: tc.options.StateVersions,
+				SentinelMocks: tc.options.SentinelMocks,
+			}
+			assert.Equal(t, tc.err, updateOptions.valid())
+		})
+	}
+}
@@ -64,11 +64,16 @@ type TeamMemberRemoveOptions struct {
 // List returns all Users of a team calling ListUsers
 // See ListOrganizationMemberships for fetching memberships
 func (s *teamMembers) List(ctx context.Context, teamID string) ([]*User, error) {
-	return s.ListUsers(ctx, teamID)
+	return s.listUsers(ctx, "teamMembers.List", teamID)
 }
 
 // ListUsers returns the Users of this team.
 func (s *teamMembers) ListUsers(ctx context.Context, teamID string) ([]*User, error) {
+	return s.listUsers(ctx, "teamMembers.ListUsers", teamID)
+}
+
+// listUsers implements List and ListUsers, naming the API call op.
+func (s *teamMembers) listUsers(ctx context.Context, op, teamID string) ([]*User, error) {
 	if !validStringID(&teamID) {
 		return nil, ErrInvalidTeamID
 	}
@@ -86,7 +91,7 @@ func (s *teamMembers) ListUsers(ctx context.Context, teamID string) ([]*User, er
 	}
 
 	t := &Team{}
-	err = s.client.do(ctx, req, t)
+	err = s.client.do(ctx, op, req, t)
 	if err != nil {
 		return nil, err
 	}
@@ -113,7 +118,7 @@ func (s *teamMembers) ListOrganizationMemberships(ctx context.Context, teamID st
 	}
 
 	t := &Team{}
-	err = s.client.do(ctx, req, t)
+	err = s.client.do(ctx, "teamMembers.ListOrganizationMemberships", req, t)
 	if err != nil {
 		return nil, err
 	}
@@ -157,7 +162,7 @@ func (s *teamMembers) Add(ctx context.Context, teamID string, options TeamMember
 		}
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "teamMembers.Add", req, nil)
 }
 
 // Remove multiple users from a team.
@@ -196,7 +201,7 @@ func (s *teamMembers) Remove(ctx context.Context, teamID string, options TeamMem
 		}
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "teamMembers.Remove", req, nil)
 }
 
 // kind returns "users" or "organization-memberships"
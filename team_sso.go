@@ -0,0 +1,116 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// OrganizationSSO represents the single sign-on configuration of an
+// organization, and the teams whose membership is managed by the identity
+// provider.
+type OrganizationSSO struct {
+	// Whether the organization is entitled to single sign-on.
+	Entitled bool
+
+	// Whether SAML single sign-on is enabled for the organization.
+	SAMLEnabled bool
+
+	// The SAML role ID mapped to the "owners" team.
+	OwnersTeamSAMLRoleID string
+
+	// The teams with an SSO team ID, keyed by their SSO team ID, which is
+	// the identifier of the group in the identity provider.
+	Teams map[string]*Team
+}
+
+// ReadOrganizationSSO reads the single sign-on configuration of an
+// organization, along with the teams which are mapped to groups of the
+// identity provider.
+func ReadOrganizationSSO(ctx context.Context, client *Client, organization string) (*OrganizationSSO, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+
+	org, err := client.Organizations.Read(ctx, organization)
+	if err != nil {
+		return nil, err
+	}
+	entitlements, err := client.Organizations.ReadEntitlements(ctx, organization)
+	if err != nil {
+		return nil, err
+	}
+	teams, err := listAllTeams(ctx, client, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	sso := &OrganizationSSO{
+		Entitled:             entitlements.SSO,
+		SAMLEnabled:          org.SAMLEnabled,
+		OwnersTeamSAMLRoleID: org.OwnersTeamSAMLRoleID,
+		Teams:                make(map[string]*Team),
+	}
+	for _, t := range teams {
+		if t.SSOTeamID != nil && *t.SSOTeamID != "" {
+			sso.Teams[*t.SSOTeamID] = t
+		}
+	}
+
+	return sso, nil
+}
+
+// ReconcileTeamSSOIDs maps the teams of an organization to groups of the
+// identity provider. The mapping is keyed by team name, and holds the SSO
+// team ID of each team, which is removed when empty. Only the teams whose SSO
+// team ID differs are updated, and they are returned. All teams are resolved
+// before any team is updated, so no team is updated when one of them does not
+// exist.
+func ReconcileTeamSSOIDs(ctx context.Context, client *Client, organization string, ssoTeamIDs map[string]string) ([]*Team, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+
+	teams, err := listAllTeams(ctx, client, organization)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]*Team, len(teams))
+	for _, t := range teams {
+		byName[t.Name] = t
+	}
+
+	// Update the teams in a stable order.
+	names := make([]string, 0, len(ssoTeamIDs))
+	for name := range ssoTeamIDs {
+		if _, ok := byName[name]; !ok {
+			return nil, fmt.Errorf("%w: team %s", ErrResourceNotFound, name)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var updated []*Team
+	for _, name := range names {
+		t := byName[name]
+		ssoTeamID := ssoTeamIDs[name]
+
+		current := ""
+		if t.SSOTeamID != nil {
+			current = *t.SSOTeamID
+		}
+		if current == ssoTeamID {
+			continue
+		}
+
+		t, err := client.Teams.Update(ctx, t.ID, TeamUpdateOptions{
+			SSOTeamID: String(ssoTeamID),
+		})
+		if err != nil {
+			return updated, err
+		}
+		updated = append(updated, t)
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,105 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamSSO(t *testing.T) {
+	var updates []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/organizations/acme":
+			fmt.Fprint(w, `{"data":{"id":"acme","type":"organizations","attributes":{"name":"acme","saml-enabled":true,"owners-team-saml-role-id":"idp-owners"}}}`)
+		case "/api/v2/organizations/acme/entitlement-set":
+			fmt.Fprint(w, `{"data":{"id":"org-acme","type":"entitlement-sets","attributes":{"sso":true}}}`)
+		case "/api/v2/organizations/acme/teams":
+			fmt.Fprint(w, `{"data":[`+
+				`{"id":"team-owners","type":"teams","attributes":{"name":"owners","sso-team-id":null}},`+
+				`{"id":"team-dev","type":"teams","attributes":{"name":"dev","sso-team-id":"idp-dev"}},`+
+				`{"id":"team-ops","type":"teams","attributes":{"name":"ops","sso-team-id":"idp-ops"}}]}`)
+		case "/api/v2/teams/team-dev", "/api/v2/teams/team-ops":
+			require.Equal(t, http.MethodPatch, r.Method)
+			var body struct {
+				Data struct {
+					Attributes struct {
+						SSOTeamID string `json:"sso-team-id"`
+					} `json:"attributes"`
+				} `json:"data"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			updates = append(updates, r.URL.Path+" "+body.Data.Attributes.SSOTeamID)
+			fmt.Fprintf(w, `{"data":{"id":"%s","type":"teams","attributes":{"name":"x","sso-team-id":"%s"}}}`,
+				r.URL.Path[len("/api/v2/teams/"):], body.Data.Attributes.SSOTeamID)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("when reading the SSO configuration", func(t *testing.T) {
+		sso, err := ReadOrganizationSSO(ctx, client, "acme")
+		require.NoError(t, err)
+		assert.True(t, sso.Entitled)
+		assert.True(t, sso.SAMLEnabled)
+		assert.Equal(t, "idp-owners", sso.OwnersTeamSAMLRoleID)
+		require.Len(t, sso.Teams, 2)
+		assert.Equal(t, "team-dev", sso.Teams["idp-dev"].ID)
+		assert.Equal(t, "team-ops", sso.Teams["idp-ops"].ID)
+	})
+
+	t.Run("when reconciling the SSO team IDs", func(t *testing.T) {
+		updates = nil
+		updated, err := ReconcileTeamSSOIDs(ctx, client, "acme", map[string]string{
+			"dev": "idp-dev",
+			"ops": "idp-platform",
+		})
+		require.NoError(t, err)
+		require.Len(t, updated, 1)
+		assert.Equal(t, "team-ops", updated[0].ID)
+		assert.Equal(t, "idp-platform", *updated[0].SSOTeamID)
+		assert.Equal(t, []string{"/api/v2/teams/team-ops idp-platform"}, updates)
+	})
+
+	t.Run("with an unknown team", func(t *testing.T) {
+		updates = nil
+		_, err := ReconcileTeamSSOIDs(ctx, client, "acme", map[string]string{
+			"dev":     "idp-other",
+			"missing": "idp-missing",
+		})
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
+		assert.Empty(t, updates)
+	})
+
+	t.Run("with an invalid organization", func(t *testing.T) {
+		_, err := ReadOrganizationSSO(ctx, client, badIdentifier)
+		assert.Equal(t, ErrInvalidOrg, err)
+
+		_, err = ReconcileTeamSSOIDs(ctx, client, badIdentifier, nil)
+		assert.Equal(t, ErrInvalidOrg, err)
+	})
+}
@@ -19,11 +19,25 @@ type TeamTokens interface {
 	// Create a new team token, replacing any existing token.
 	Create(ctx context.Context, teamID string) (*TeamToken, error)
 
+	// CreateWithOptions creates a new team token with options. Without a
+	// description the token replaces any existing token, with a description
+	// an additional token is created next to the existing tokens.
+	CreateWithOptions(ctx context.Context, teamID string, options TeamTokenCreateOptions) (*TeamToken, error)
+
+	// List the tokens of a team.
+	List(ctx context.Context, teamID string, options *TeamTokenListOptions) (*TeamTokenList, error)
+
 	// Read a team token by its ID.
 	Read(ctx context.Context, teamID string) (*TeamToken, error)
 
+	// ReadByID reads a team token by the ID of the token.
+	ReadByID(ctx context.Context, tokenID string) (*TeamToken, error)
+
 	// Delete a team token by its ID.
 	Delete(ctx context.Context, teamID string) error
+
+	// DeleteByID deletes a team token by the ID of the token.
+	DeleteByID(ctx context.Context, tokenID string) error
 }
 
 // teamTokens implements TeamTokens.
@@ -36,8 +50,40 @@ type TeamToken struct {
 	ID          string    `jsonapi:"primary,authentication-tokens"`
 	CreatedAt   time.Time `jsonapi:"attr,created-at,iso8601"`
 	Description string    `jsonapi:"attr,description"`
+	ExpiredAt   time.Time `jsonapi:"attr,expired-at,iso8601"`
 	LastUsedAt  time.Time `jsonapi:"attr,last-used-at,iso8601"`
 	Token       string    `jsonapi:"attr,token"`
+
+	// Relations
+	Team *Team `jsonapi:"relation,team"`
+}
+
+// TeamTokenList represents a list of team tokens.
+type TeamTokenList struct {
+	*Pagination
+	Items []*TeamToken
+}
+
+// TeamTokenListOptions represents the options for listing team tokens.
+type TeamTokenListOptions struct {
+	ListOptions
+}
+
+// TeamTokenCreateOptions represents the options for creating a team token.
+type TeamTokenCreateOptions struct {
+	// Type is a public field utilized by JSON:API to
+	// set the resource type via the field tag.
+	// It is not a user-defined value and does not need to be set.
+	// https://jsonapi.org/format/#crud-creating
+	Type string `jsonapi:"primary,authentication-tokens"`
+
+	// Optional: The time the token expires at. Tokens without an expiry
+	// do not expire.
+	ExpiredAt *time.Time `jsonapi:"attr,expired-at,iso8601,omitempty"`
+
+	// Optional: The description of the token. When set, an additional token
+	// is created instead of replacing the existing token of the team.
+	Description *string `jsonapi:"attr,description,omitempty"`
 }
 
 // Create a new team token, replacing any existing token.
@@ -53,7 +99,33 @@ func (s *teamTokens) Create(ctx context.Context, teamID string) (*TeamToken, err
 	}
 
 	tt := &TeamToken{}
-	err = s.client.do(ctx, req, tt)
+	err = s.client.do(ctx, "teamTokens.Create", req, tt)
+	if err != nil {
+		return nil, err
+	}
+
+	return tt, err
+}
+
+// CreateWithOptions creates a new team token with options. Without a
+// description the token replaces any existing token, with a description an
+// additional token is created next to the existing tokens.
+func (s *teamTokens) CreateWithOptions(ctx context.Context, teamID string, options TeamTokenCreateOptions) (*TeamToken, error) {
+	if !validStringID(&teamID) {
+		return nil, ErrInvalidTeamID
+	}
+
+	u := fmt.Sprintf("teams/%s/authentication-token", url.QueryEscape(teamID))
+	if options.Description != nil {
+		u = fmt.Sprintf("teams/%s/authentication-tokens", url.QueryEscape(teamID))
+	}
+	req, err := s.client.newRequest("POST", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	tt := &TeamToken{}
+	err = s.client.do(ctx, "teamTokens.CreateWithOptions", req, tt)
 	if err != nil {
 		return nil, err
 	}
@@ -61,6 +133,27 @@ func (s *teamTokens) Create(ctx context.Context, teamID string) (*TeamToken, err
 	return tt, err
 }
 
+// List the tokens of a team.
+func (s *teamTokens) List(ctx context.Context, teamID string, options *TeamTokenListOptions) (*TeamTokenList, error) {
+	if !validStringID(&teamID) {
+		return nil, ErrInvalidTeamID
+	}
+
+	u := fmt.Sprintf("teams/%s/authentication-tokens", url.QueryEscape(teamID))
+	req, err := s.client.newRequest("GET", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	tl := &TeamTokenList{}
+	err = s.client.do(ctx, "teamTokens.List", req, tl)
+	if err != nil {
+		return nil, err
+	}
+
+	return tl, nil
+}
+
 // Read a team token by its ID.
 func (s *teamTokens) Read(ctx context.Context, teamID string) (*TeamToken, error) {
 	if !validStringID(&teamID) {
@@ -74,7 +167,28 @@ func (s *teamTokens) Read(ctx context.Context, teamID string) (*TeamToken, error
 	}
 
 	tt := &TeamToken{}
-	err = s.client.do(ctx, req, tt)
+	err = s.client.do(ctx, "teamTokens.Read", req, tt)
+	if err != nil {
+		return nil, err
+	}
+
+	return tt, err
+}
+
+// ReadByID reads a team token by the ID of the token.
+func (s *teamTokens) ReadByID(ctx context.Context, tokenID string) (*TeamToken, error) {
+	if !validStringID(&tokenID) {
+		return nil, ErrInvalidTokenID
+	}
+
+	u := fmt.Sprintf("authentication-tokens/%s", url.QueryEscape(tokenID))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tt := &TeamToken{}
+	err = s.client.do(ctx, "teamTokens.ReadByID", req, tt)
 	if err != nil {
 		return nil, err
 	}
@@ -94,5 +208,20 @@ func (s *teamTokens) Delete(ctx context.Context, teamID string) error {
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "teamTokens.Delete", req, nil)
+}
+
+// DeleteByID deletes a team token by the ID of the token.
+func (s *teamTokens) DeleteByID(ctx context.Context, tokenID string) error {
+	if !validStringID(&tokenID) {
+		return ErrInvalidTokenID
+	}
+
+	u := fmt.Sprintf("authentication-tokens/%s", url.QueryEscape(tokenID))
+	req, err := s.client.newRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.client.do(ctx, "teamTokens.DeleteByID", req, nil)
 }
@@ -6,6 +6,7 @@ package tfe
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -41,6 +42,70 @@ func TestTeamTokensCreate(t *testing.T) {
 		assert.Equal(t, err, ErrInvalidTeamID)
 	})
 }
+
+func TestTeamTokensCreateWithOptions(t *testing.T) {
+	skipIfFreeOnly(t)
+
+	client := testClient(t)
+	ctx := context.Background()
+
+	tmTest, tmTestCleanup := createTeam(t, client, nil)
+	defer tmTestCleanup()
+
+	t.Run("with an expiry", func(t *testing.T) {
+		expiredAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+		tt, err := client.TeamTokens.CreateWithOptions(ctx, tmTest.ID, TeamTokenCreateOptions{
+			ExpiredAt: &expiredAt,
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, tt.Token)
+		assert.True(t, expiredAt.Equal(tt.ExpiredAt))
+	})
+
+	t.Run("with descriptions", func(t *testing.T) {
+		tt1, err := client.TeamTokens.CreateWithOptions(ctx, tmTest.ID, TeamTokenCreateOptions{
+			Description: String(randomString(t)),
+		})
+		require.NoError(t, err)
+		tt2, err := client.TeamTokens.CreateWithOptions(ctx, tmTest.ID, TeamTokenCreateOptions{
+			Description: String(randomString(t)),
+		})
+		require.NoError(t, err)
+
+		tl, err := client.TeamTokens.List(ctx, tmTest.ID, nil)
+		require.NoError(t, err)
+		var ids []string
+		for _, tt := range tl.Items {
+			ids = append(ids, tt.ID)
+		}
+		assert.Contains(t, ids, tt1.ID)
+		assert.Contains(t, ids, tt2.ID)
+
+		tt, err := client.TeamTokens.ReadByID(ctx, tt1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, tt1.Description, tt.Description)
+
+		require.NoError(t, client.TeamTokens.DeleteByID(ctx, tt1.ID))
+		_, err = client.TeamTokens.ReadByID(ctx, tt1.ID)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("without valid team ID", func(t *testing.T) {
+		tt, err := client.TeamTokens.CreateWithOptions(ctx, badIdentifier, TeamTokenCreateOptions{})
+		assert.Nil(t, tt)
+		assert.Equal(t, err, ErrInvalidTeamID)
+	})
+
+	t.Run("without valid token ID", func(t *testing.T) {
+		tt, err := client.TeamTokens.ReadByID(ctx, badIdentifier)
+		assert.Nil(t, tt)
+		assert.Equal(t, err, ErrInvalidTokenID)
+
+		err = client.TeamTokens.DeleteByID(ctx, badIdentifier)
+		assert.Equal(t, err, ErrInvalidTokenID)
+	})
+}
+
 func TestTeamTokensRead(t *testing.T) {
 	skipIfFreeOnly(t)
 
@@ -0,0 +1,180 @@
+package tfe
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// _instrumentationName is the name of the OpenTelemetry tracer and meter of
+// the client.
+const _instrumentationName = "github.com/hashicorp/go-tfe"
+
+// Attribute keys of the spans and metrics recorded by the client.
+const (
+	attrService    = attribute.Key("tfe.service")
+	attrMethod     = attribute.Key("tfe.method")
+	attrRetries    = attribute.Key("tfe.retries")
+	attrHTTPMethod = attribute.Key("http.method")
+	attrHTTPURL    = attribute.Key("http.url")
+	attrHTTPStatus = attribute.Key("http.status_code")
+)
+
+// telemetry holds the OpenTelemetry instruments of the client. A nil tracer
+// or instrument disables the corresponding telemetry.
+type telemetry struct {
+	tracer trace.Tracer
+
+	requests      metric.Int64Counter
+	retries       metric.Int64Counter
+	duration      metric.Float64Histogram
+	rateLimitWait metric.Float64Histogram
+}
+
+// attemptsKey is the context key of the number of attempts of a request.
+type attemptsKey struct{}
+
+func newTelemetry(tp trace.TracerProvider, mp metric.MeterProvider) (*telemetry, error) {
+	t := &telemetry{}
+
+	if tp != nil {
+		t.tracer = tp.Tracer(_instrumentationName)
+	}
+
+	if mp != nil {
+		meter := mp.Meter(_instrumentationName)
+
+		var err error
+		t.requests, err = meter.Int64Counter("tfe.client.requests",
+			metric.WithDescription("Number of API calls made by the client."))
+		if err != nil {
+			return nil, err
+		}
+		t.retries, err = meter.Int64Counter("tfe.client.retries",
+			metric.WithDescription("Number of retried requests made by the client."))
+		if err != nil {
+			return nil, err
+		}
+		t.duration, err = meter.Float64Histogram("tfe.client.request.duration",
+			metric.WithDescription("Duration of API calls, including retries."),
+			metric.WithUnit("s"))
+		if err != nil {
+			return nil, err
+		}
+		t.rateLimitWait, err = meter.Float64Histogram("tfe.client.rate_limit.wait",
+			metric.WithDescription("Time spent waiting for the client side rate limiter."),
+			metric.WithUnit("s"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return t, nil
+}
+
+// telemetryCall records the span and metrics of a single API call.
+type telemetryCall struct {
+	t     *telemetry
+	span  trace.Span
+	attrs []attribute.KeyValue
+}
+
+// start starts recording the API call of the given operation, e.g.
+// "workspaces.Read". It returns nil when telemetry is disabled, which records
+// nothing.
+func (t *telemetry) start(ctx context.Context, op string, req *retryablehttp.Request) (context.Context, *telemetryCall) {
+	if t.tracer == nil && t.requests == nil {
+		return ctx, nil
+	}
+
+	service, method := op, ""
+	if i := strings.Index(op, "."); i >= 0 {
+		service, method = op[:i], op[i+1:]
+	}
+	call := &telemetryCall{
+		t:     t,
+		attrs: []attribute.KeyValue{attrService.String(service), attrMethod.String(method)},
+	}
+
+	if t.tracer != nil {
+		ctx, call.span = t.tracer.Start(ctx, "tfe."+op,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(append(call.attrs,
+				attrHTTPMethod.String(req.Method),
+				attrHTTPURL.String(req.URL.String()),
+			)...),
+		)
+	}
+
+	return ctx, call
+}
+
+// rateLimitWait records the time waited for the rate limiter, or the error
+// which ended the API call while waiting.
+func (call *telemetryCall) rateLimitWait(ctx context.Context, wait time.Duration, err error) {
+	if call == nil {
+		return
+	}
+
+	if err != nil {
+		if call.span != nil {
+			call.span.RecordError(err)
+			call.span.SetStatus(codes.Error, err.Error())
+			call.span.End()
+		}
+		return
+	}
+	if call.t.rateLimitWait != nil {
+		call.t.rateLimitWait.Record(ctx, wait.Seconds(), metric.WithAttributes(call.attrs...))
+	}
+}
+
+// end records the outcome of the API call and ends its span.
+func (call *telemetryCall) end(ctx context.Context, resp *http.Response, err error, attempts int, duration time.Duration) {
+	if call == nil {
+		return
+	}
+
+	retries := 0
+	if attempts > 0 {
+		retries = attempts - 1
+	}
+	attrs := call.attrs
+	if resp != nil {
+		attrs = append(attrs, attrHTTPStatus.Int(resp.StatusCode))
+	}
+
+	if span := call.span; span != nil {
+		span.SetAttributes(attrRetries.Int(retries))
+		if resp != nil {
+			span.SetAttributes(attrHTTPStatus.Int(resp.StatusCode))
+		}
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		} else if resp.StatusCode >= 400 {
+			span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+		}
+		span.End()
+	}
+	if t := call.t; t.requests != nil {
+		t.requests.Add(ctx, 1, metric.WithAttributes(attrs...))
+		t.retries.Add(ctx, int64(retries), metric.WithAttributes(attrs...))
+		t.duration.Record(ctx, duration.Seconds(), metric.WithAttributes(attrs...))
+	}
+}
+
+// countAttempts provides a callback for Client.RequestLogHook which records
+// the number of attempts of a request sent by send.
+func countAttempts(_ retryablehttp.Logger, req *http.Request, attemptNum int) {
+	if attempts, ok := req.Context().Value(attemptsKey{}).(*int); ok {
+		*attempts = attemptNum + 1
+	}
+}
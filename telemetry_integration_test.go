@@ -0,0 +1,167 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestClient_telemetry(t *testing.T) {
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(204)
+			return
+		}
+		requests++
+		if requests == 1 {
+			w.WriteHeader(429)
+			return
+		}
+		w.WriteHeader(200)
+		_, err := w.Write([]byte(`{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"foo"}}}`))
+		require.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	client, err := NewClient(&Config{
+		Address:        ts.URL,
+		Token:          "dummy-token",
+		HTTPClient:     ts.Client(),
+		Clock:          NewFakeClock(time.Now()),
+		TracerProvider: tp,
+		MeterProvider:  mp,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	w, err := client.Workspaces.ReadByID(ctx, "ws-123")
+	require.NoError(t, err)
+	assert.Equal(t, "foo", w.Name)
+
+	t.Run("records a span per API call", func(t *testing.T) {
+		spans := sr.Ended()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "tfe.workspaces.ReadByID", spans[0].Name())
+
+		attrs := map[attribute.Key]attribute.Value{}
+		for _, kv := range spans[0].Attributes() {
+			attrs[kv.Key] = kv.Value
+		}
+		assert.Equal(t, "workspaces", attrs[attrService].AsString())
+		assert.Equal(t, "ReadByID", attrs[attrMethod].AsString())
+		assert.Equal(t, "GET", attrs[attrHTTPMethod].AsString())
+		assert.Equal(t, int64(200), attrs[attrHTTPStatus].AsInt64())
+		assert.Equal(t, int64(1), attrs[attrRetries].AsInt64())
+	})
+
+	t.Run("records metrics per API call", func(t *testing.T) {
+		rm := metricdata.ResourceMetrics{}
+		require.NoError(t, reader.Collect(ctx, &rm))
+		require.Len(t, rm.ScopeMetrics, 1)
+
+		metrics := map[string]metricdata.Aggregation{}
+		for _, m := range rm.ScopeMetrics[0].Metrics {
+			metrics[m.Name] = m.Data
+		}
+
+		requests, ok := metrics["tfe.client.requests"].(metricdata.Sum[int64])
+		require.True(t, ok)
+		require.Len(t, requests.DataPoints, 1)
+		assert.Equal(t, int64(1), requests.DataPoints[0].Value)
+
+		retries, ok := metrics["tfe.client.retries"].(metricdata.Sum[int64])
+		require.True(t, ok)
+		require.Len(t, retries.DataPoints, 1)
+		assert.Equal(t, int64(1), retries.DataPoints[0].Value)
+
+		assert.Contains(t, metrics, "tfe.client.request.duration")
+		assert.Contains(t, metrics, "tfe.client.rate_limit.wait")
+	})
+}
+
+func TestClient_telemetryOperation(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		switch {
+		case r.URL.Path == "/api/v2/ping":
+			w.WriteHeader(204)
+		case r.Method == "GET" && r.URL.Path == "/api/v2/organizations/acme/workspaces":
+			_, err := w.Write([]byte(`{"data":[{"id":"ws-123","type":"workspaces","attributes":{"name":"foo"}}]}`))
+			require.NoError(t, err)
+		default:
+			_, err := w.Write([]byte(`{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"foo"}}}`))
+			require.NoError(t, err)
+		}
+	}))
+	defer ts.Close()
+
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+
+	client, err := NewClient(&Config{
+		Address:        ts.URL,
+		Token:          "dummy-token",
+		HTTPClient:     ts.Client(),
+		TracerProvider: tp,
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	for name, tc := range map[string]struct {
+		call func() error
+		span string
+	}{
+		"with a list call": {
+			call: func() error {
+				_, err := client.Workspaces.List(ctx, "acme", nil)
+				return err
+			},
+			span: "tfe.workspaces.List",
+		},
+		"with a read delegating to a read with options": {
+			call: func() error {
+				_, err := client.Workspaces.Read(ctx, "acme", "foo")
+				return err
+			},
+			span: "tfe.workspaces.Read",
+		},
+		"with a relationships update": {
+			call: func() error {
+				_, err := client.Workspaces.UpdateRelationshipsByID(ctx, "ws-123", RelationshipsUpdateOptions{
+					ToOne: map[string]*ResourceIdentifier{"ssh-key": nil},
+				})
+				return err
+			},
+			span: "tfe.workspaces.UpdateRelationshipsByID",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ended := len(sr.Ended())
+			require.NoError(t, tc.call())
+
+			spans := sr.Ended()
+			require.Len(t, spans, ended+1)
+			assert.Equal(t, tc.span, spans[ended].Name())
+		})
+	}
+}
@@ -2,7 +2,9 @@ package tfe
 
 import (
 	"context"
+	"sync"
 	"testing"
+	"time"
 )
 
 // TestAccountDetails represents the basic account information
@@ -28,9 +30,61 @@ func FetchTestAccountDetails(t *testing.T, client *Client) *TestAccountDetails {
 	}
 
 	ctx := context.Background()
-	err = client.do(ctx, req, tad)
+	err = client.do(ctx, "tfe.FetchTestAccountDetails", req, tad)
 	if err != nil {
 		t.Fatalf("could not fetch test user details: %v", err)
 	}
 	return tad
 }
+
+// fakeClockMaxWaits is the number of waits a FakeClock records.
+const fakeClockMaxWaits = 1000
+
+// FakeClock is a Clock for tests which never sleeps. Every wait immediately
+// advances the fake time by the waited duration and is recorded, so retry and
+// polling behavior can be asserted deterministically.
+//
+// As waits never block, a polling loop driven by a FakeClock, like
+// WaitForUnlock or a RunEventStream, polls without pausing until it ends or
+// its context is canceled. Only the first 1000 waits are recorded, so such a
+// loop does not grow the recorded waits without bound.
+type FakeClock struct {
+	mu    sync.Mutex
+	now   time.Time
+	waits []time.Duration
+}
+
+// NewFakeClock returns a FakeClock starting at the given time.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now returns the current fake time.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// After advances the fake time by d and returns a channel which already
+// received the new time.
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+	if len(c.waits) < fakeClockMaxWaits {
+		c.waits = append(c.waits, d)
+	}
+
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+// Waits returns the durations of the waits so far, up to the first 1000.
+func (c *FakeClock) Waits() []time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]time.Duration(nil), c.waits...)
+}
@@ -1,9 +1,11 @@
 package tfe
 
+//go:generate ./generate_mocks.sh
+
 import (
 	"errors"
 	"io/fs"
-	"log"
+	"math"
 	"sort"
 
 	"bytes"
@@ -15,26 +17,31 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"path"
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/go-querystring/query"
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/jsonapi"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/time/rate"
 
 	slug "github.com/hashicorp/go-slug"
 )
 
 const (
-	_userAgent         = "go-tfe"
-	_headerRateLimit   = "X-RateLimit-Limit"
-	_headerRateReset   = "X-RateLimit-Reset"
-	_headerAPIVersion  = "TFP-API-Version"
-	_includeQueryParam = "include"
+	_userAgent           = "go-tfe"
+	_headerRateLimit     = "X-RateLimit-Limit"
+	_headerRateRemaining = "X-RateLimit-Remaining"
+	_headerRateReset     = "X-RateLimit-Reset"
+	_headerAPIVersion    = "TFP-API-Version"
+	_includeQueryParam   = "include"
 
 	DefaultAddress  = "https://app.terraform.io"
 	DefaultBasePath = "/api/v2/"
@@ -46,6 +53,29 @@ const (
 
 type RetryLogHook func(attemptNum int, resp *http.Response)
 
+// RetryBackoff returns the duration to wait before retrying a request. The min
+// and max arguments are the configured RetryWaitMin and RetryWaitMax values.
+type RetryBackoff func(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration
+
+// Logger is the leveled logger used by the client to report requests,
+// responses, retries and rate limiting. The arguments following the message
+// are alternating keys and values. It is satisfied by both an hclog.Logger
+// and a *slog.Logger.
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// noopLogger implements Logger by discarding all messages.
+type noopLogger struct{}
+
+func (noopLogger) Debug(msg string, args ...interface{}) {}
+func (noopLogger) Info(msg string, args ...interface{})  {}
+func (noopLogger) Warn(msg string, args ...interface{})  {}
+func (noopLogger) Error(msg string, args ...interface{}) {}
+
 // Config provides configuration details to the API client.
 
 type Config struct {
@@ -65,18 +95,99 @@ type Config struct {
 	HTTPClient *http.Client
 
 	// RetryLogHook is invoked each time a request is retried.
+	//
+	// Deprecated: Use Logger, which receives all retry events.
 	RetryLogHook RetryLogHook
+
+	// The maximum number of retries of a single request. Zero uses the
+	// default, while a negative value disables retries.
+	RetryMax int
+
+	// The minimum and maximum time to wait between retries. When only one of
+	// them is set, the default of the other is adjusted to it if needed.
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+
+	// Backoff overrides the default backoff policy, including the handling
+	// of rate limited requests. The RetryLogHook is still invoked.
+	Backoff RetryBackoff
+
+	// Logger receives the request, response, retry and rate limit events of
+	// the client, as well as problems which do not fail a request, such as
+	// malformed rate limit headers. Nothing is logged when nil.
+	Logger Logger
+
+	// Clock overrides the real time used to wait between retries and while
+	// polling. It is meant to be used in tests.
+	Clock Clock
+
+	// Limiter overrides the rate limiter which is otherwise configured from
+	// the rate limit announced by the API.
+	Limiter RateLimiter
+
+	// OnRateLimitWait is called with the time a request waited for the rate
+	// limiter, whenever a request was delayed by it.
+	OnRateLimitWait func(wait time.Duration)
+
+	// TracerProvider enables OpenTelemetry tracing, recording a span for
+	// every API call.
+	TracerProvider trace.TracerProvider
+
+	// MeterProvider enables OpenTelemetry metrics, recording the number,
+	// retries and latency of API calls, and the time spent waiting for the
+	// rate limiter.
+	MeterProvider metric.MeterProvider
+
+	// PageSizePolicy determines how list requests with a PageSize above
+	// MaxPageSize are handled. They are clamped to MaxPageSize by default.
+	PageSizePolicy PageSizePolicy
+
+	// FIPS restricts the client to TLS 1.2 and later with FIPS 140-2
+	// approved cipher suites and curves, and rejects addresses which do not
+	// use https, as required in regulated environments. The transport of a
+	// custom HTTPClient must be an *http.Transport, which is not modified.
+	FIPS bool
+
+	// NoPing skips the request made to the ping endpoint when the client is
+	// created. The API version and rate limit are read by the first request
+	// instead, which proceeds when this fails and leaves it to a later
+	// request, so the client can be created while the API is unavailable.
+	NoPing bool
+
+	// Transfer configures how large artifacts, like state versions and plan
+	// exports, are downloaded.
+	Transfer TransferOptions
+
+	// DiagnosticsHistory is the number of recent requests whose summaries
+	// are kept for SupportBundle. Zero uses the default of 50, while a
+	// negative value disables keeping them.
+	DiagnosticsHistory int
+
+	// CircuitBreaker stops sending requests for a cool-down period after
+	// consecutive server errors or connection failures, failing them with a
+	// *CircuitOpenError instead. It is disabled when nil.
+	CircuitBreaker *CircuitBreakerOptions
+
+	// NoRetryMethods are the HTTP methods whose requests are not retried
+	// after server errors or connection failures, like "POST" and "PATCH",
+	// since such requests may have been applied despite the failure.
+	// Requests rejected by the rate limit are still retried, as they were
+	// not applied.
+	NoRetryMethods []string
 }
 
 // DefaultConfig returns a default config structure.
 
 func DefaultConfig() *Config {
 	config := &Config{
-		Address:    os.Getenv("TFE_ADDRESS"),
-		BasePath:   DefaultBasePath,
-		Token:      os.Getenv("TFE_TOKEN"),
-		Headers:    make(http.Header),
-		HTTPClient: cleanhttp.DefaultPooledClient(),
+		Address:      os.Getenv("TFE_ADDRESS"),
+		BasePath:     DefaultBasePath,
+		Token:        os.Getenv("TFE_TOKEN"),
+		Headers:      make(http.Header),
+		HTTPClient:   cleanhttp.DefaultPooledClient(),
+		RetryMax:     30,
+		RetryWaitMin: 100 * time.Millisecond,
+		RetryWaitMax: 400 * time.Millisecond,
 	}
 
 	// Set the default address if none is given.
@@ -102,14 +213,43 @@ type Client struct {
 	headers           http.Header
 	http              *retryablehttp.Client
 	limiter           *rate.Limiter
+	rateLimiter       RateLimiter // Overrides the limiter when not nil.
+	onRateLimitWait   func(wait time.Duration)
+	clock             Clock
+	telemetry         *telemetry
+	entitlements      *entitlementCache
+	capabilities      *capabilityCache
 	retryLogHook      RetryLogHook
+	retryBackoff      RetryBackoff
+	logger            Logger
 	retryServerErrors bool
-	remoteAPIVersion  string
+	pageSizePolicy    PageSizePolicy
+	transfer          TransferOptions
+	requests          *requestHistory
+	breaker           *circuitBreaker
+	noRetryMethods    map[string]bool
+
+	// The rate limit reported by the last response.
+	rateLimitMu sync.Mutex
+	rateLimit   *RateLimit
+
+	// The API metadata, which is read by the first request when the client
+	// was created without pinging the API. Concurrent requests share a
+	// single in-flight read, and failed reads are retried with backoff.
+	metaMu           sync.Mutex
+	metaLoaded       bool
+	metaLoad         *metadataLoad
+	metaErr          error
+	metaFailures     int
+	metaRetryAt      time.Time
+	remoteAPIVersion string
 
 	Admin                      Admin
 	AgentPools                 AgentPools
 	AgentTokens                AgentTokens
 	Applies                    Applies
+	AssessmentResults          AssessmentResults
+	AuditTrails                AuditTrails
 	Comments                   Comments
 	ConfigurationVersions      ConfigurationVersions
 	CostEstimates              CostEstimates
@@ -127,10 +267,15 @@ type Client struct {
 	PolicySetParameters        PolicySetParameters
 	PolicySetVersions          PolicySetVersions
 	PolicySets                 PolicySets
+	Projects                   Projects
 	RegistryModules            RegistryModules
+	RegistryNoCodeModules      RegistryNoCodeModules
+	RegistryProviders          RegistryProviders
+	RegistryProviderVersions   RegistryProviderVersions
 	Runs                       Runs
 	RunTasks                   RunTasks
 	RunTriggers                RunTriggers
+	SignedURLs                 SignedURLClient
 	SSHKeys                    SSHKeys
 	StateVersionOutputs        StateVersionOutputs
 	StateVersions              StateVersions
@@ -159,6 +304,8 @@ type Admin struct {
 	Workspaces        AdminWorkspaces
 	Runs              AdminRuns
 	TerraformVersions AdminTerraformVersions
+	OPAVersions       AdminOPAVersions
+	SentinelVersions  AdminSentinelVersions
 	Users             AdminUsers
 	Settings          *AdminSettings
 }
@@ -170,6 +317,13 @@ type Meta struct {
 
 // NewClient creates a new Terraform Enterprise API client.
 func NewClient(cfg *Config) (*Client, error) {
+	return NewClientWithContext(context.Background(), cfg)
+}
+
+// NewClientWithContext creates a new Terraform Enterprise API client, like
+// NewClient. The context cancels the request made to the ping endpoint, which
+// is skipped when the NoPing option is set.
+func NewClientWithContext(ctx context.Context, cfg *Config) (*Client, error) {
 	config := DefaultConfig()
 
 	// Layer in the provided config for any non-blank values.
@@ -192,6 +346,55 @@ func NewClient(cfg *Config) (*Client, error) {
 		if cfg.RetryLogHook != nil {
 			config.RetryLogHook = cfg.RetryLogHook
 		}
+		if cfg.RetryMax != 0 {
+			config.RetryMax = cfg.RetryMax
+		}
+		if cfg.RetryWaitMin != 0 {
+			config.RetryWaitMin = cfg.RetryWaitMin
+			// Raise the default maximum when only the minimum is above it.
+			if cfg.RetryWaitMax == 0 && config.RetryWaitMax < cfg.RetryWaitMin {
+				config.RetryWaitMax = cfg.RetryWaitMin
+			}
+		}
+		if cfg.RetryWaitMax != 0 {
+			config.RetryWaitMax = cfg.RetryWaitMax
+			// Lower the default minimum when only the maximum is below it.
+			if cfg.RetryWaitMin == 0 && config.RetryWaitMin > cfg.RetryWaitMax {
+				config.RetryWaitMin = cfg.RetryWaitMax
+			}
+		}
+		if cfg.Backoff != nil {
+			config.Backoff = cfg.Backoff
+		}
+		if cfg.Logger != nil {
+			config.Logger = cfg.Logger
+		}
+		if cfg.Clock != nil {
+			config.Clock = cfg.Clock
+		}
+		if cfg.Limiter != nil {
+			config.Limiter = cfg.Limiter
+		}
+		if cfg.OnRateLimitWait != nil {
+			config.OnRateLimitWait = cfg.OnRateLimitWait
+		}
+		if cfg.TracerProvider != nil {
+			config.TracerProvider = cfg.TracerProvider
+		}
+		if cfg.MeterProvider != nil {
+			config.MeterProvider = cfg.MeterProvider
+		}
+		config.FIPS = cfg.FIPS
+		config.PageSizePolicy = cfg.PageSizePolicy
+		config.NoPing = cfg.NoPing
+		config.Transfer = cfg.Transfer
+		config.DiagnosticsHistory = cfg.DiagnosticsHistory
+		config.CircuitBreaker = cfg.CircuitBreaker
+		config.NoRetryMethods = cfg.NoRetryMethods
+	}
+
+	if config.RetryWaitMin > config.RetryWaitMax {
+		return nil, fmt.Errorf("invalid retry wait: minimum %s exceeds maximum %s", config.RetryWaitMin, config.RetryWaitMax)
 	}
 
 	// Parse the address to make sure its a valid URL.
@@ -205,6 +408,16 @@ func NewClient(cfg *Config) (*Client, error) {
 		baseURL.Path += "/"
 	}
 
+	if config.FIPS {
+		if baseURL.Scheme != "https" {
+			return nil, fmt.Errorf("invalid address: %w", ErrInsecureAddress)
+		}
+		config.HTTPClient, err = fipsHTTPClient(config.HTTPClient)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// This value must be provided by the user.
 	if config.Token == "" {
 		return nil, fmt.Errorf("missing API token")
@@ -212,33 +425,68 @@ func NewClient(cfg *Config) (*Client, error) {
 
 	// Create the client.
 	client := &Client{
-		baseURL:      baseURL,
-		token:        config.Token,
-		headers:      config.Headers,
-		retryLogHook: config.RetryLogHook,
+		baseURL:         baseURL,
+		token:           config.Token,
+		headers:         config.Headers,
+		retryLogHook:    config.RetryLogHook,
+		retryBackoff:    config.Backoff,
+		logger:          config.Logger,
+		clock:           config.Clock,
+		pageSizePolicy:  config.PageSizePolicy,
+		onRateLimitWait: config.OnRateLimitWait,
+		transfer:        config.Transfer.withDefaults(),
+		requests:        newRequestHistory(config.DiagnosticsHistory),
+		breaker:         newCircuitBreaker(config.CircuitBreaker),
+		noRetryMethods:  methodSet(config.NoRetryMethods),
+		entitlements:    &entitlementCache{entries: make(map[string]*entitlementCacheEntry)},
+		capabilities:    &capabilityCache{entries: make(map[string]*capabilityCacheEntry)},
 	}
-
-	client.http = &retryablehttp.Client{
-		Backoff:      client.retryHTTPBackoff,
-		CheckRetry:   client.retryHTTPCheck,
-		ErrorHandler: retryablehttp.PassthroughErrorHandler,
-		HTTPClient:   config.HTTPClient,
-		RetryWaitMin: 100 * time.Millisecond,
-		RetryWaitMax: 400 * time.Millisecond,
-		RetryMax:     30,
+	if client.logger == nil {
+		client.logger = noopLogger{}
+	}
+	if client.clock == nil {
+		client.clock = realClock{}
 	}
 
-	meta, err := client.getRawAPIMetadata()
+	client.telemetry, err = newTelemetry(config.TracerProvider, config.MeterProvider)
 	if err != nil {
 		return nil, err
 	}
 
-	// Configure the rate limiter.
-	client.configureLimiter(meta.RateLimit)
+	client.http = &retryablehttp.Client{
+		Backoff:        client.retryHTTPBackoff,
+		CheckRetry:     client.retryHTTPCheck,
+		ErrorHandler:   retryablehttp.PassthroughErrorHandler,
+		HTTPClient:     config.HTTPClient,
+		RequestLogHook: countAttempts,
+		RetryWaitMin:   config.RetryWaitMin,
+		RetryWaitMax:   config.RetryWaitMax,
+		RetryMax:       config.RetryMax,
+	}
+
+	// Let the retrying HTTP client log the requests it performs and retries,
+	// and log each response it receives.
+	if config.Logger != nil {
+		client.http.Logger = config.Logger
+		client.http.ResponseLogHook = client.logResponse
+	}
 
-	// Save the API version so we can return it from the RemoteAPIVersion
-	// method later.
-	client.remoteAPIVersion = meta.APIVersion
+	// Wait on the configured clock instead of leaving the wait between
+	// retries to the retrying HTTP client, which always uses the real time.
+	if config.Clock != nil {
+		client.http.Backoff = client.retryHTTPClockBackoff
+	}
+
+	// Configure the rate limiter, which does not limit the requests until
+	// the rate limit of the API is known.
+	client.configureLimiter("")
+	client.rateLimiter = config.Limiter
+
+	if !config.NoPing {
+		if err := client.loadMetadata(ctx); err != nil {
+			return nil, err
+		}
+	}
 
 	// Create Admin
 	client.Admin = Admin{
@@ -247,6 +495,8 @@ func NewClient(cfg *Config) (*Client, error) {
 		Runs:              &adminRuns{client: client},
 		Settings:          newAdminSettings(client),
 		TerraformVersions: &adminTerraformVersions{client: client},
+		OPAVersions:       &adminOPAVersions{client: client},
+		SentinelVersions:  &adminSentinelVersions{client: client},
 		Users:             &adminUsers{client: client},
 	}
 
@@ -254,6 +504,8 @@ func NewClient(cfg *Config) (*Client, error) {
 	client.AgentPools = &agentPools{client: client}
 	client.AgentTokens = &agentTokens{client: client}
 	client.Applies = &applies{client: client}
+	client.AssessmentResults = &assessmentResults{client: client}
+	client.AuditTrails = &auditTrails{client: client}
 	client.Comments = &comments{client: client}
 	client.ConfigurationVersions = &configurationVersions{client: client}
 	client.CostEstimates = &costEstimates{client: client}
@@ -271,10 +523,15 @@ func NewClient(cfg *Config) (*Client, error) {
 	client.PolicySetParameters = &policySetParameters{client: client}
 	client.PolicySetVersions = &policySetVersions{client: client}
 	client.PolicySets = &policySets{client: client}
+	client.Projects = &projects{client: client}
 	client.RegistryModules = &registryModules{client: client}
+	client.RegistryNoCodeModules = &registryNoCodeModules{client: client}
+	client.RegistryProviders = &registryProviders{client: client}
+	client.RegistryProviderVersions = &registryProviderVersions{client: client}
 	client.Runs = &runs{client: client}
 	client.RunTasks = &runTasks{client: client}
 	client.RunTriggers = &runTriggers{client: client}
+	client.SignedURLs = &signedURLClient{client: client}
 	client.SSHKeys = &sshKeys{client: client}
 	client.StateVersionOutputs = &stateVersionOutputs{client: client}
 	client.StateVersions = &stateVersions{client: client}
@@ -303,7 +560,8 @@ func NewClient(cfg *Config) (*Client, error) {
 // A Terraform Cloud or Enterprise API server returns its API version in an
 // HTTP header field in all responses. The NewClient function saves the
 // version number returned in its initial setup request and RemoteAPIVersion
-// returns that cached value.
+// returns that cached value. When the client was created with the NoPing
+// option, the version is saved by the first request, and is empty before.
 //
 // The API protocol calls for this string to be a dotted-decimal version number
 // like 2.3.0, where the first number indicates the API major version while the
@@ -315,6 +573,8 @@ func NewClient(cfg *Config) (*Client, error) {
 // information. In that case, this function returns an empty string as the
 // version.
 func (c *Client) RemoteAPIVersion() string {
+	c.metaMu.Lock()
+	defer c.metaMu.Unlock()
 	return c.remoteAPIVersion
 }
 
@@ -325,6 +585,9 @@ func (c *Client) RemoteAPIVersion() string {
 // return something different than the actual API version in order to test error handling.
 
 func (c *Client) SetFakeRemoteAPIVersion(fakeAPIVersion string) {
+	c.metaMu.Lock()
+	defer c.metaMu.Unlock()
+	c.metaLoaded = true
 	c.remoteAPIVersion = fakeAPIVersion
 }
 
@@ -339,13 +602,17 @@ func (c *Client) RetryServerErrors(retry bool) {
 // will retry both rate limit (429) and server (>= 500) errors.
 
 func (c *Client) retryHTTPCheck(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	// The check is called with every response, so it keeps track of the
+	// rate limit they report.
+	c.recordRateLimit(resp)
+
 	if ctx.Err() != nil {
 		return false, ctx.Err()
 	}
 	if err != nil {
-		return c.retryServerErrors, err
+		return c.retryServerErrors && !isNoRetry(ctx), err
 	}
-	if resp.StatusCode == 429 || (c.retryServerErrors && resp.StatusCode >= 500) {
+	if resp.StatusCode == 429 || (c.retryServerErrors && resp.StatusCode >= 500 && !isNoRetry(ctx)) {
 		return true, nil
 	}
 	return false, nil
@@ -359,9 +626,16 @@ func (c *Client) retryHTTPBackoff(min, max time.Duration, attemptNum int, resp *
 		c.retryLogHook(attemptNum, resp)
 	}
 
+	// Use the configured backoff policy, if any.
+	if c.retryBackoff != nil {
+		return c.retryBackoff(min, max, attemptNum, resp)
+	}
+
 	// Use the rate limit backoff function when we are rate limited.
 	if resp != nil && resp.StatusCode == 429 {
-		return rateLimitBackoff(min, max, resp)
+		wait := c.rateLimitBackoff(min, max, resp)
+		c.logger.Info("request rate limited", "attempt", attemptNum, "wait", wait)
+		return wait
 	}
 
 	// Set custom duration's when we experience a service interruption.
@@ -371,30 +645,49 @@ func (c *Client) retryHTTPBackoff(min, max time.Duration, attemptNum int, resp *
 	return retryablehttp.LinearJitterBackoff(min, max, attemptNum, resp)
 }
 
+// retryHTTPClockBackoff waits for the duration returned by retryHTTPBackoff on
+// the configured clock, and then lets the retrying HTTP client retry the
+// request right away.
+func (c *Client) retryHTTPClockBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	wait := c.retryHTTPBackoff(min, max, attemptNum, resp)
+
+	var done <-chan struct{}
+	if resp != nil && resp.Request != nil {
+		done = resp.Request.Context().Done()
+	}
+
+	select {
+	case <-c.clock.After(wait):
+	case <-done:
+	}
+
+	return 0
+}
+
 // rateLimitBackoff provides a callback for Client.Backoff which will use the
 // X-RateLimit_Reset header to determine the time to wait. We add some jitter
 // to prevent a thundering herd.
 //
 // min and max are mainly used for bounding the jitter that will be added to
 // the reset time retrieved from the headers. But if the final wait time is
-// less then min, min will be used instead.
-
-func rateLimitBackoff(min, max time.Duration, resp *http.Response) time.Duration {
+// less then min, min will be used instead. A malformed reset header is logged
+// and ignored.
+func (c *Client) rateLimitBackoff(min, max time.Duration, resp *http.Response) time.Duration {
 	// rnd is used to generate pseudo-random numbers.
-	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+	rnd := rand.New(rand.NewSource(c.clock.Now().UnixNano()))
 
 	// First create some jitter bounded by the min and max durations.
 	jitter := time.Duration(rnd.Float64() * float64(max-min))
 
 	if resp != nil && resp.Header.Get(_headerRateReset) != "" {
 		v := resp.Header.Get(_headerRateReset)
-		reset, err := strconv.ParseFloat(v, 64)
+		reset, err := parseRateLimitReset(v)
 		if err != nil {
-			log.Fatal(err)
+			c.logger.Warn("ignoring malformed rate limit reset header", "header", _headerRateReset, "value", v, "error", err)
 		}
 		// Only update min if the given time to wait is longer
-		if reset > 0 && time.Duration(reset*1e9) > min {
-			min = time.Duration(reset * 1e9)
+		if reset > min {
+			min = reset
 		}
 	}
 
@@ -413,7 +706,96 @@ type rawAPIMetadata struct {
 	RateLimit string
 }
 
-func (c *Client) getRawAPIMetadata() (rawAPIMetadata, error) {
+// metadataLoad is an in-flight read of the API metadata, which concurrent
+// requests wait for instead of reading the metadata themselves.
+type metadataLoad struct {
+	done chan struct{}
+	err  error
+
+	// Whether the read was canceled by the context of the request reading
+	// the metadata, which the waiting requests do not share.
+	canceled bool
+}
+
+// loadMetadata reads the API metadata, unless it was read before, and
+// configures the client with it. The metadata is read without holding the
+// lock, so the rate limiter and API version stay available meanwhile, and
+// concurrent calls wait for the same read. After a failed read, the error
+// is returned without reading again until the backoff has passed.
+func (c *Client) loadMetadata(ctx context.Context) error {
+	c.metaMu.Lock()
+	for c.metaLoad != nil {
+		l := c.metaLoad
+		c.metaMu.Unlock()
+		select {
+		case <-l.done:
+			if !l.canceled {
+				return l.err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		c.metaMu.Lock()
+	}
+
+	if c.metaLoaded {
+		c.metaMu.Unlock()
+		return nil
+	}
+
+	if c.metaErr != nil && c.clock.Now().Before(c.metaRetryAt) {
+		err := c.metaErr
+		c.metaMu.Unlock()
+		return err
+	}
+
+	l := &metadataLoad{done: make(chan struct{})}
+	c.metaLoad = l
+	c.metaMu.Unlock()
+
+	meta, err := c.getRawAPIMetadata(ctx)
+
+	c.metaMu.Lock()
+	defer c.metaMu.Unlock()
+	defer close(l.done)
+
+	c.metaLoad = nil
+	l.err = err
+
+	switch {
+	case err == nil:
+		// Configure the rate limiter, and save the API version so we can
+		// return it from the RemoteAPIVersion method later.
+		c.configureLimiter(meta.RateLimit)
+		c.remoteAPIVersion = meta.APIVersion
+		c.metaLoaded = true
+		c.metaErr = nil
+		c.metaFailures = 0
+	case ctx.Err() != nil:
+		// The read was canceled by the caller, which says nothing about the
+		// API, so a waiting or the next request reads the metadata again.
+		l.canceled = true
+	default:
+		c.metaErr = err
+		c.metaFailures++
+		c.metaRetryAt = c.clock.Now().Add(backoff(1000, 60000, c.metaFailures))
+	}
+
+	return err
+}
+
+// requestLimiter returns the rate limiter the requests wait for.
+func (c *Client) requestLimiter() RateLimiter {
+	if c.rateLimiter != nil {
+		return c.rateLimiter
+	}
+
+	c.metaMu.Lock()
+	defer c.metaMu.Unlock()
+	return c.limiter
+}
+
+func (c *Client) getRawAPIMetadata(ctx context.Context) (rawAPIMetadata, error) {
 	var meta rawAPIMetadata
 
 	// Create a new request.
@@ -421,7 +803,7 @@ func (c *Client) getRawAPIMetadata() (rawAPIMetadata, error) {
 	if err != nil {
 		return meta, err
 	}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
 	if err != nil {
 		return meta, err
 	}
@@ -440,12 +822,55 @@ func (c *Client) getRawAPIMetadata() (rawAPIMetadata, error) {
 	}
 	resp.Body.Close()
 
+	c.recordRateLimit(resp)
+
 	meta.APIVersion = resp.Header.Get(_headerAPIVersion)
 	meta.RateLimit = resp.Header.Get(_headerRateLimit)
 
 	return meta, nil
 }
 
+// parseRateLimit parses the value of the rate limit header, returning the
+// number of allowed requests per second.
+func parseRateLimit(v string) (float64, error) {
+	rateLimit, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(rateLimit) || math.IsInf(rateLimit, 0) {
+		return 0, fmt.Errorf("invalid rate limit %q", v)
+	}
+
+	return rateLimit, nil
+}
+
+// parseRateLimitReset parses the value of the rate limit reset header, which
+// holds the number of seconds until the rate limit resets.
+func parseRateLimitReset(v string) (time.Duration, error) {
+	reset, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(reset) || reset > math.MaxInt64/1e9 {
+		return 0, fmt.Errorf("invalid rate limit reset %q", v)
+	}
+	if reset < 0 {
+		return 0, nil
+	}
+
+	return time.Duration(reset * 1e9), nil
+}
+
+// logResponse provides a callback for Client.ResponseLogHook which logs every
+// received response, including the ones which are retried.
+func (c *Client) logResponse(_ retryablehttp.Logger, resp *http.Response) {
+	args := []interface{}{"status", resp.StatusCode}
+	if resp.Request != nil {
+		args = append(args, "method", resp.Request.Method, "url", resp.Request.URL.String())
+	}
+	c.logger.Debug("received response", args...)
+}
+
 // configureLimiter configures the rate limiter.
 
 func (c *Client) configureLimiter(rawLimit string) {
@@ -454,10 +879,11 @@ func (c *Client) configureLimiter(rawLimit string) {
 	burst := 0
 
 	if v := rawLimit; v != "" {
-		if rateLimit, err := strconv.ParseFloat(v, 64); rateLimit > 0 {
-			if err != nil {
-				log.Fatal(err)
-			}
+		rateLimit, err := parseRateLimit(v)
+		if err != nil {
+			c.logger.Warn("disabling rate limiting due to malformed rate limit header", "header", _headerRateLimit, "value", v, "error", err)
+		}
+		if rateLimit > 0 {
 			// Configure the limit and burst using a split of 2/3 for the limit and
 			// 1/3 for the burst. This enables clients to burst 1/3 of the allowed
 			// calls before the limiter kicks in. The remaining calls will then be
@@ -470,6 +896,17 @@ func (c *Client) configureLimiter(rawLimit string) {
 
 	// Create a new limiter using the calculated values.
 	c.limiter = rate.NewLimiter(limit, burst)
+	c.logger.Debug("configured rate limiter", "limit", float64(limit), "burst", burst)
+}
+
+// isAPIURL reports whether the URL is relative to or on the host of the API,
+// so it is requested with the API token.
+func (c *Client) isAPIURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	return u.Host == "" || (u.Scheme == c.baseURL.Scheme && u.Host == c.baseURL.Host)
 }
 
 // newRequest creates an API request with proper headers and serialization.
@@ -533,6 +970,11 @@ func (c *Client) newRequest(method, path string, v interface{}) (*retryablehttp.
 		req.Header[k] = v
 	}
 
+	// Mark list requests which only count the elements of the list.
+	if method == "GET" && isCountOnlyOptions(v) {
+		req = req.WithContext(context.WithValue(req.Context(), countOnlyKey{}, true))
+	}
+
 	return req, nil
 }
 
@@ -637,19 +1079,29 @@ func serializeRequestBody(v interface{}) (interface{}, error) {
 //
 // The provided ctx must be non-nil. If it is canceled or times out, ctx.Err()
 // will be returned.
+//
+// List requests with a page size above MaxPageSize are handled according to
+// the page size policy of the client, and list requests made with options
+// setting CountOnly only decode the pagination.
+//
+// The operation names the API call in telemetry and diagnostics, e.g.
+// "workspaces.Read" for Workspaces.Read.
 
-func (c *Client) do(ctx context.Context, req *retryablehttp.Request, v interface{}) error {
-	// Wait will block until the limiter can obtain a new token
-	// or returns an error if the given context is canceled.
-	if err := c.limiter.Wait(ctx); err != nil {
-		return err
+func (c *Client) do(ctx context.Context, op string, req *retryablehttp.Request, v interface{}) error {
+	if req.Method == "GET" && isListModel(v) {
+		if isCountOnly(req) {
+			return c.doCount(ctx, op, req, v)
+		}
+		return c.doList(ctx, op, req, v)
 	}
+	return c.doOnce(ctx, op, req, v)
+}
 
-	// Add the context to the request.
-	reqWithCxt := req.WithContext(ctx)
-
+// doOnce sends an API request like do, without applying the page size
+// policy.
+func (c *Client) doOnce(ctx context.Context, op string, req *retryablehttp.Request, v interface{}) error {
 	// Execute the request and check the response.
-	resp, err := c.http.Do(reqWithCxt)
+	resp, err := c.send(ctx, op, req)
 	if err != nil {
 		// If we got an error, and the context has been canceled,
 		// the context's error is probably more useful.
@@ -663,8 +1115,8 @@ func (c *Client) do(ctx context.Context, req *retryablehttp.Request, v interface
 	defer resp.Body.Close()
 
 	// Basic response checking.
-	if err := checkResponseCode(resp); err != nil {
-		return err
+	if err := checkResponseCode(resp, c.clock); err != nil {
+		return c.entitlementError(req.URL.Path, err)
 	}
 
 	// Return here if decoding the response isn't needed.
@@ -681,21 +1133,51 @@ func (c *Client) do(ctx context.Context, req *retryablehttp.Request, v interface
 	return unmarshalResponse(resp.Body, v)
 }
 
-// customDo is similar to func (c *Client) do(ctx context.Context, req *retryablehttp.Request, v interface{}) error. Except that The IP ranges API is not returning jsonapi like every other endpoint
-// which means we need to handle it differently.
+// send waits for the rate limiter and sends the request of the given API
+// operation, recording it in the telemetry and diagnostics of the client.
+func (c *Client) send(ctx context.Context, op string, req *retryablehttp.Request) (*http.Response, error) {
+	if err := c.breaker.allow(c.clock.Now()); err != nil {
+		return nil, err
+	}
 
-func (i *ipRanges) customDo(ctx context.Context, req *retryablehttp.Request, ir *IPRange) error {
-	// Wait will block until the limiter can obtain a new token
-	// or returns an error if the given context is canceled.
-	if err := i.client.limiter.Wait(ctx); err != nil {
-		return err
+	ctx = c.prepareRetries(ctx, req)
+
+	// Read the API metadata when the client was created without pinging the
+	// API. The request is made regardless, and a later request retries.
+	if err := c.loadMetadata(ctx); err != nil {
+		c.logger.Warn("failed to read the API metadata", "error", err)
 	}
 
-	// Add the context to the request.
-	req = req.WithContext(ctx)
+	ctx, call := c.telemetry.start(ctx, op, req)
 
+	wait, err := c.waitRateLimit(ctx)
+	call.rateLimitWait(ctx, wait, err)
+	if err != nil {
+		c.breaker.abandon()
+		return nil, err
+	}
+
+	// Keep track of the attempts, which are updated by the request log hook.
+	attempts := 0
+	ctx = context.WithValue(ctx, attemptsKey{}, &attempts)
+
+	start := c.clock.Now()
+	resp, err := c.http.Do(req.WithContext(ctx))
+	duration := c.clock.Now().Sub(start)
+	c.breaker.record(ctx, start.Add(duration), resp, err)
+
+	call.end(ctx, resp, err, attempts, duration)
+	c.recordRequest(op, req.Request, resp, err, attempts, start, wait, duration)
+
+	return resp, err
+}
+
+// customDo is similar to func (c *Client) do(ctx context.Context, req *retryablehttp.Request, v interface{}) error. Except that The IP ranges API is not returning jsonapi like every other endpoint
+// which means we need to handle it differently.
+
+func (i *ipRanges) customDo(ctx context.Context, req *retryablehttp.Request, ir *IPRange) error {
 	// Execute the request and check the response.
-	resp, err := i.client.http.Do(req)
+	resp, err := i.client.send(ctx, "ipRanges.Read", req)
 	if err != nil {
 		// If we got an error, and the context has been canceled,
 		// the context's error is probably more useful.
@@ -737,7 +1219,16 @@ func unmarshalResponse(responseBody io.Reader, model interface{}) error {
 	// Unmarshal a single value if model does not contain the
 	// Items and Pagination struct fields.
 	if !items.IsValid() || !pagination.IsValid() {
-		return jsonapi.UnmarshalPayload(responseBody, model)
+		nu, ok := model.(nodeUnmarshaler)
+		if !ok {
+			return jsonapi.UnmarshalPayload(responseBody, model)
+		}
+
+		body := bytes.NewBuffer(nil)
+		if err := jsonapi.UnmarshalPayload(io.TeeReader(responseBody, body), model); err != nil {
+			return err
+		}
+		return unmarshalOneNode(body.Bytes(), nu)
 	}
 
 	// Return an error if model.Items is not a slice.
@@ -767,6 +1258,17 @@ func unmarshalResponse(responseBody io.Reader, model interface{}) error {
 	// Pointer-swap the result.
 	items.Set(result)
 
+	// Decode what the jsonapi package can not unmarshal.
+	if _, ok := reflect.Zero(items.Type().Elem()).Interface().(nodeUnmarshaler); ok {
+		models := make([]nodeUnmarshaler, 0, len(raw))
+		for _, v := range raw {
+			models = append(models, v.(nodeUnmarshaler))
+		}
+		if err := unmarshalManyNodes(body.Bytes(), models); err != nil {
+			return err
+		}
+	}
+
 	// As we are getting a list of values, we need to decode
 	// the pagination details out of the response body.
 	p, err := parsePagination(body)
@@ -788,6 +1290,13 @@ type ListOptions struct {
 
 	// The number of elements returned in a single page.
 	PageSize int `url:"page[size],omitempty"`
+
+	// Only count the elements of the list. A page of a single element is
+	// requested and its items are not decoded, so the list returned has no
+	// items and its TotalCount holds the number of elements. This makes
+	// checking the existence or size of very large lists cheap, and applies
+	// only to the list call given these options.
+	CountOnly bool `url:"-"`
 }
 
 // Pagination is used to return the pagination details of an API request.
@@ -815,8 +1324,9 @@ func parsePagination(body io.Reader) (*Pagination, error) {
 }
 
 // checkResponseCode can be used to check the status code of an HTTP request.
+// The clock is used to compute how long to wait after maintenance.
 
-func checkResponseCode(r *http.Response) error {
+func checkResponseCode(r *http.Response, clock Clock) error {
 	if r.StatusCode >= 200 && r.StatusCode <= 299 {
 		return nil
 	}
@@ -827,6 +1337,8 @@ func checkResponseCode(r *http.Response) error {
 	switch r.StatusCode {
 	case 401:
 		return ErrUnauthorized
+	case 402:
+		return paymentRequiredError(r)
 	case 404:
 		return ErrResourceNotFound
 	case 409:
@@ -840,13 +1352,33 @@ func checkResponseCode(r *http.Response) error {
 			}
 
 			if errorPayloadContains(errs, "is locked by Run") {
-				return ErrWorkspaceLockedByRun
+				return workspaceLockedByRunError(errs)
 			}
 
 			return ErrWorkspaceNotLocked
 		case strings.HasSuffix(r.Request.URL.Path, "actions/force-unlock"):
 			return ErrWorkspaceNotLocked
+		case isStateVersionCreate(r.Request):
+			return stateVersionCreateError(r)
+		case isWorkspaceDelete(r.Request):
+			return workspaceDeleteError(r)
+		}
+	case 422:
+		if isStateVersionCreate(r.Request) {
+			return stateVersionCreateError(r)
+		}
+		if isWorkspaceCreate(r.Request) {
+			return workspaceCreateError(r)
+		}
+	case 503:
+		errs, err = decodeErrorPayload(r)
+		if isMaintenance(errs) {
+			return maintenanceError(r, errs, clock)
 		}
+		if err != nil {
+			return err
+		}
+		return errors.New(strings.Join(errs, "\n"))
 	}
 
 	errs, err = decodeErrorPayload(r)
@@ -857,6 +1389,173 @@ func checkResponseCode(r *http.Response) error {
 	return fmt.Errorf(strings.Join(errs, "\n"))
 }
 
+// isStateVersionCreate returns whether the request creates a state version.
+func isStateVersionCreate(r *http.Request) bool {
+	return r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/state-versions")
+}
+
+// stateVersionCreateError returns the error of a failed state version
+// creation, wrapping ErrStateVersionLineageMismatch or
+// ErrStateVersionSerialConflict when the state was rejected because of its
+// lineage or serial.
+func stateVersionCreateError(r *http.Response) error {
+	errs, err := decodeErrorPayload(r)
+	if err != nil {
+		return err
+	}
+	msg := strings.Join(errs, "\n")
+
+	switch lower := strings.ToLower(msg); {
+	case strings.Contains(lower, "lineage"):
+		return fmt.Errorf("%w: %s", ErrStateVersionLineageMismatch, msg)
+	case strings.Contains(lower, "serial"):
+		return fmt.Errorf("%w: %s", ErrStateVersionSerialConflict, msg)
+	}
+
+	return errors.New(msg)
+}
+
+// isWorkspaceCreate returns whether the request creates a workspace.
+func isWorkspaceCreate(r *http.Request) bool {
+	return r.Method == "POST" && strings.Contains(r.URL.Path, "/organizations/") && strings.HasSuffix(r.URL.Path, "/workspaces")
+}
+
+// workspaceCreateError returns the error of a failed workspace creation,
+// wrapping ErrWorkspaceNameTaken when the name is already taken.
+func workspaceCreateError(r *http.Response) error {
+	errs, err := decodeErrorPayload(r)
+	if err != nil {
+		return err
+	}
+	msg := strings.Join(errs, "\n")
+
+	if strings.Contains(strings.ToLower(msg), "has already been taken") {
+		return fmt.Errorf("%w: %s", ErrWorkspaceNameTaken, msg)
+	}
+
+	return errors.New(msg)
+}
+
+// isCreateConflict reports whether creating a resource failed because a
+// resource with the same name already exists.
+func isCreateConflict(err error) bool {
+	return errors.Is(err, ErrWorkspaceNameTaken) || strings.Contains(strings.ToLower(err.Error()), "has already been taken")
+}
+
+// isWorkspaceDelete returns whether the request deletes a workspace.
+func isWorkspaceDelete(r *http.Request) bool {
+	return r.Method == "DELETE" && path.Base(path.Dir(r.URL.Path)) == "workspaces"
+}
+
+// workspaceDeleteError returns the error of a failed workspace deletion,
+// wrapping ErrWorkspaceManagesResources when the workspace still manages
+// resources.
+func workspaceDeleteError(r *http.Response) error {
+	errs, err := decodeErrorPayload(r)
+	if err != nil {
+		return err
+	}
+	msg := strings.Join(errs, "\n")
+
+	if strings.Contains(strings.ToLower(msg), "resources") {
+		return fmt.Errorf("%w: %s", ErrWorkspaceManagesResources, msg)
+	}
+
+	return errors.New(msg)
+}
+
+// paymentRequiredError returns the error of a request for a feature which is
+// not in the plan of the organization, wrapping ErrPaymentRequired.
+func paymentRequiredError(r *http.Response) error {
+	errs, err := decodeErrorPayload(r)
+	if err != nil {
+		return ErrPaymentRequired
+	}
+
+	return fmt.Errorf("%w: %s", ErrPaymentRequired, strings.Join(errs, "\n"))
+}
+
+// MaintenanceError is returned when the API is down for maintenance. It wraps
+// ErrServiceUnavailableMaintenance, so it matches it when compared using
+// errors.Is.
+type MaintenanceError struct {
+	// How long to wait before trying again, as announced by the Retry-After
+	// header of the response. It is zero when not announced.
+	RetryAfter time.Duration
+
+	// The message of the API, if any.
+	Message string
+}
+
+// Error implements the error interface.
+func (e *MaintenanceError) Error() string {
+	msg := ErrServiceUnavailableMaintenance.Error()
+	if e.Message != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.Message)
+	}
+	if e.RetryAfter > 0 {
+		msg = fmt.Sprintf("%s (retry after %s)", msg, e.RetryAfter)
+	}
+	return msg
+}
+
+// Unwrap returns ErrServiceUnavailableMaintenance.
+func (e *MaintenanceError) Unwrap() error {
+	return ErrServiceUnavailableMaintenance
+}
+
+// isMaintenance returns whether a 503 response is caused by maintenance,
+// which is announced by an error mentioning it. A Retry-After header alone
+// does not signal maintenance, as it is also sent by overloaded servers.
+func isMaintenance(errs []string) bool {
+	for _, e := range errs {
+		if strings.Contains(strings.ToLower(e), "maintenance") {
+			return true
+		}
+	}
+	return false
+}
+
+// maintenanceError returns the error of a request made while the API is down
+// for maintenance.
+func maintenanceError(r *http.Response, errs []string, clock Clock) error {
+	return &MaintenanceError{
+		RetryAfter: retryAfter(r, clock),
+		Message:    strings.Join(errs, "\n"),
+	}
+}
+
+// retryAfter returns the time to wait announced by the Retry-After header of
+// a response, which holds either a number of seconds or a date. A date is
+// relative to the Date header of the response when set, and to the time of
+// the clock otherwise. It returns zero when the header is missing or invalid.
+func retryAfter(r *http.Response, clock Clock) time.Duration {
+	v := strings.TrimSpace(r.Header.Get("Retry-After"))
+	if v == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	at, err := http.ParseTime(v)
+	if err != nil {
+		return 0
+	}
+	now := clock.Now()
+	if date, err := http.ParseTime(r.Header.Get("Date")); err == nil {
+		now = date
+	}
+	if wait := at.Sub(now); wait > 0 {
+		return wait
+	}
+	return 0
+}
+
 func decodeErrorPayload(r *http.Response) ([]string, error) {
 	// Decode the error payload.
 	var errs []string
@@ -887,24 +1586,51 @@ func errorPayloadContains(payloadErrors []string, match string) bool {
 	return false
 }
 
-func packContents(path string) (*bytes.Buffer, error) {
-	body := bytes.NewBuffer(nil)
+// slugFile is a slug packed into a temporary file, which is removed when the
+// file is closed.
+type slugFile struct {
+	*os.File
+}
 
+// Close closes and removes the temporary file.
+func (f *slugFile) Close() error {
+	err := f.File.Close()
+	if rerr := os.Remove(f.Name()); err == nil {
+		err = rerr
+	}
+	return err
+}
+
+// packContents packs the files under the path into a slug. The slug is
+// written to a temporary file instead of memory, so large directories are
+// streamed when uploaded. The caller has to close the returned file.
+func packContents(path string) (*slugFile, error) {
 	file, err := os.Stat(path)
 	if err != nil {
 		if errors.Is(err, fs.ErrNotExist) {
-			return body, fmt.Errorf(`failed to find files under the path "%v": %w`, path, err)
+			return nil, fmt.Errorf(`failed to find files under the path "%v": %w`, path, err)
 		}
-		return body, fmt.Errorf(`unable to upload files from the path "%v": %w`, path, err)
+		return nil, fmt.Errorf(`unable to upload files from the path "%v": %w`, path, err)
 	}
 
 	if !file.Mode().IsDir() {
-		return body, ErrMissingDirectory
+		return nil, ErrMissingDirectory
+	}
+
+	tmp, err := os.CreateTemp("", "go-tfe-slug-*.tar.gz")
+	if err != nil {
+		return nil, err
 	}
+	body := &slugFile{File: tmp}
 
-	_, errSlug := slug.Pack(path, body, true)
-	if errSlug != nil {
-		return body, errSlug
+	if _, err := slug.Pack(path, body, true); err != nil {
+		body.Close()
+		return nil, err
+	}
+
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		body.Close()
+		return nil, err
 	}
 
 	return body, nil
@@ -6,17 +6,25 @@ package tfe
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/jsonapi"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/time/rate"
 )
 
@@ -430,6 +438,16 @@ func TestClient_configureLimiter(t *testing.T) {
 			limit: rate.Limit(66),
 			burst: 33,
 		},
+		"limit-malformed": {
+			rate:  "thirty",
+			limit: rate.Inf,
+			burst: 0,
+		},
+		"limit-out-of-range": {
+			rate:  "1e400",
+			limit: rate.Inf,
+			burst: 0,
+		},
 	}
 
 	for name, tc := range cases {
@@ -570,6 +588,306 @@ func TestClient_retryHTTPBackoff(t *testing.T) {
 	}
 }
 
+func TestClient_retryPolicy(t *testing.T) {
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(204)
+			return
+		}
+		requests++
+		w.WriteHeader(429)
+	}))
+	defer ts.Close()
+
+	t.Run("with the default retry policy", func(t *testing.T) {
+		client, err := NewClient(&Config{
+			Address:    ts.URL,
+			Token:      "dummy-token",
+			HTTPClient: ts.Client(),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 30, client.http.RetryMax)
+		assert.Equal(t, 100*time.Millisecond, client.http.RetryWaitMin)
+		assert.Equal(t, 400*time.Millisecond, client.http.RetryWaitMax)
+	})
+
+	t.Run("with a custom retry policy", func(t *testing.T) {
+		requests = 0
+
+		var backoffs int
+		client, err := NewClient(&Config{
+			Address:      ts.URL,
+			Token:        "dummy-token",
+			HTTPClient:   ts.Client(),
+			RetryMax:     2,
+			RetryWaitMin: time.Millisecond,
+			RetryWaitMax: 2 * time.Millisecond,
+			Backoff: func(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+				backoffs++
+				assert.Equal(t, time.Millisecond, min)
+				assert.Equal(t, 2*time.Millisecond, max)
+				return 0
+			},
+		})
+		require.NoError(t, err)
+
+		req, err := client.newRequest("GET", "foo", nil)
+		require.NoError(t, err)
+
+		err = client.do(context.Background(), "tfe.Test", req, nil)
+		require.Error(t, err)
+
+		assert.Equal(t, 3, requests)
+		assert.Equal(t, 2, backoffs)
+	})
+
+	t.Run("with retries disabled", func(t *testing.T) {
+		requests = 0
+
+		client, err := NewClient(&Config{
+			Address:    ts.URL,
+			Token:      "dummy-token",
+			HTTPClient: ts.Client(),
+			RetryMax:   -1,
+		})
+		require.NoError(t, err)
+
+		req, err := client.newRequest("GET", "foo", nil)
+		require.NoError(t, err)
+
+		err = client.do(context.Background(), "tfe.Test", req, nil)
+		require.Error(t, err)
+
+		assert.Equal(t, 1, requests)
+	})
+
+	t.Run("with only a minimum retry wait above the default maximum", func(t *testing.T) {
+		client, err := NewClient(&Config{
+			Address:      ts.URL,
+			Token:        "dummy-token",
+			HTTPClient:   ts.Client(),
+			RetryWaitMin: time.Second,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, time.Second, client.http.RetryWaitMin)
+		assert.Equal(t, time.Second, client.http.RetryWaitMax)
+	})
+
+	t.Run("with only a maximum retry wait below the default minimum", func(t *testing.T) {
+		client, err := NewClient(&Config{
+			Address:      ts.URL,
+			Token:        "dummy-token",
+			HTTPClient:   ts.Client(),
+			RetryWaitMax: 10 * time.Millisecond,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 10*time.Millisecond, client.http.RetryWaitMin)
+		assert.Equal(t, 10*time.Millisecond, client.http.RetryWaitMax)
+	})
+
+	t.Run("with an invalid retry wait", func(t *testing.T) {
+		_, err := NewClient(&Config{
+			Address:      ts.URL,
+			Token:        "dummy-token",
+			HTTPClient:   ts.Client(),
+			RetryWaitMin: time.Second,
+			RetryWaitMax: time.Millisecond,
+		})
+		assert.Error(t, err)
+	})
+}
+
+type testLogger struct {
+	debugs   []string
+	infos    []string
+	warnings []string
+	errors   []string
+}
+
+func (l *testLogger) Debug(msg string, args ...interface{}) {
+	l.debugs = append(l.debugs, msg)
+}
+
+func (l *testLogger) Info(msg string, args ...interface{}) {
+	l.infos = append(l.infos, msg)
+}
+
+func (l *testLogger) Warn(msg string, args ...interface{}) {
+	l.warnings = append(l.warnings, msg)
+}
+
+func (l *testLogger) Error(msg string, args ...interface{}) {
+	l.errors = append(l.errors, msg)
+}
+
+func TestClient_logger(t *testing.T) {
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.URL.Path == "/api/v2/ping" {
+			w.Header().Set("X-RateLimit-Limit", "30")
+			w.WriteHeader(204)
+			return
+		}
+		requests++
+		if requests == 1 {
+			w.WriteHeader(429)
+			return
+		}
+		w.WriteHeader(204)
+	}))
+	defer ts.Close()
+
+	logger := &testLogger{}
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Logger:     logger,
+		Clock:      NewFakeClock(time.Now()),
+	})
+	require.NoError(t, err)
+
+	req, err := client.newRequest("GET", "foo", nil)
+	require.NoError(t, err)
+
+	err = client.do(context.Background(), "tfe.Test", req, nil)
+	require.NoError(t, err)
+
+	assert.Contains(t, logger.debugs, "configured rate limiter")
+	assert.Contains(t, logger.debugs, "performing request")
+	assert.Contains(t, logger.debugs, "retrying request")
+	assert.Contains(t, logger.debugs, "received response")
+	assert.Equal(t, []string{"request rate limited"}, logger.infos)
+	assert.Empty(t, logger.warnings)
+	assert.Empty(t, logger.errors)
+}
+
+func TestClient_malformedRateLimitHeaders(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.Header().Set("X-RateLimit-Limit", "thirty")
+		w.WriteHeader(204) // We query the configured ping URL which should return a 204.
+	}))
+	defer ts.Close()
+
+	logger := &testLogger{}
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Logger:     logger,
+	})
+	require.NoError(t, err)
+
+	t.Run("with a malformed rate limit", func(t *testing.T) {
+		assert.Equal(t, rate.Inf, client.limiter.Limit())
+		assert.Len(t, logger.warnings, 1)
+	})
+
+	cases := map[string]string{
+		"malformed":    "soon",
+		"out-of-range": "1e400",
+		"not-a-number": "NaN",
+	}
+
+	for name, reset := range cases {
+		t.Run("with a "+name+" rate limit reset", func(t *testing.T) {
+			logger.warnings = nil
+
+			resp := &http.Response{
+				StatusCode: 429,
+				Header:     http.Header{"X-Ratelimit-Reset": []string{reset}},
+			}
+
+			wait := client.retryHTTPBackoff(time.Second, 2*time.Second, 0, resp)
+			assert.GreaterOrEqual(t, wait, time.Second)
+			assert.LessOrEqual(t, wait, 2*time.Second)
+			assert.Len(t, logger.warnings, 1)
+		})
+	}
+
+	t.Run("with a valid rate limit reset", func(t *testing.T) {
+		logger.warnings = nil
+
+		resp := &http.Response{
+			StatusCode: 429,
+			Header:     http.Header{"X-Ratelimit-Reset": []string{"5"}},
+		}
+
+		wait := client.retryHTTPBackoff(time.Second, 2*time.Second, 0, resp)
+		assert.GreaterOrEqual(t, wait, 5*time.Second)
+		assert.Empty(t, logger.warnings)
+	})
+}
+
+type countingLimiter struct {
+	waits int
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.waits++
+	return nil
+}
+
+func TestClient_clockAndLimiter(t *testing.T) {
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.URL.Path == "/api/v2/ping" {
+			w.Header().Set("X-RateLimit-Limit", "30")
+			w.WriteHeader(204)
+			return
+		}
+		requests++
+		if requests < 3 {
+			w.Header().Set("X-RateLimit-Reset", "60")
+			w.WriteHeader(429)
+			return
+		}
+		w.WriteHeader(204)
+	}))
+	defer ts.Close()
+
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+	limiter := &countingLimiter{}
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Clock:      clock,
+		Limiter:    limiter,
+	})
+	require.NoError(t, err)
+
+	req, err := client.newRequest("GET", "foo", nil)
+	require.NoError(t, err)
+
+	began := time.Now()
+	err = client.do(context.Background(), "tfe.Test", req, nil)
+	require.NoError(t, err)
+
+	// The rate limit resets after a minute, which must not be waited for in
+	// real time.
+	assert.Less(t, time.Since(began), 10*time.Second)
+	assert.Equal(t, 3, requests)
+	assert.Equal(t, 1, limiter.waits)
+
+	waits := clock.Waits()
+	require.Len(t, waits, 2)
+	for _, w := range waits {
+		assert.GreaterOrEqual(t, w, time.Minute)
+	}
+	assert.True(t, clock.Now().After(start.Add(2*time.Minute)))
+}
+
 func setupEnvVars(token, address string) func() {
 	origToken := os.Getenv("TFE_TOKEN")
 	origAddress := os.Getenv("TFE_ADDRESS")
@@ -582,3 +900,574 @@ func setupEnvVars(token, address string) func() {
 		os.Setenv("TFE_ADDRESS", origAddress)
 	}
 }
+
+func TestClient_FIPS(t *testing.T) {
+	var versions []uint16
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		versions = append(versions, r.TLS.Version)
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(204)
+		case "/api/v2/plaintext":
+			http.Redirect(w, r, "http://example.com/", http.StatusFound)
+		default:
+			w.WriteHeader(204)
+		}
+	}))
+	defer ts.Close()
+
+	t.Run("with an https address", func(t *testing.T) {
+		httpClient := ts.Client()
+		transport := httpClient.Transport
+
+		client, err := NewClient(&Config{
+			Address:    ts.URL,
+			Token:      "dummy-token",
+			HTTPClient: httpClient,
+			FIPS:       true,
+		})
+		require.NoError(t, err)
+		assert.Same(t, transport, httpClient.Transport)
+
+		req, err := client.newRequest("GET", "foo", nil)
+		require.NoError(t, err)
+		require.NoError(t, client.do(context.Background(), "tfe.Test", req, nil))
+
+		for _, v := range versions {
+			assert.GreaterOrEqual(t, v, uint16(tls.VersionTLS12))
+		}
+
+		req, err = client.newRequest("GET", "plaintext", nil)
+		require.NoError(t, err)
+		err = client.do(context.Background(), "tfe.Test", req, nil)
+		assert.ErrorIs(t, err, ErrInsecureAddress)
+	})
+
+	t.Run("with a plaintext address", func(t *testing.T) {
+		_, err := NewClient(&Config{
+			Address: "http://tfe.example.com",
+			Token:   "dummy-token",
+			FIPS:    true,
+		})
+		assert.ErrorIs(t, err, ErrInsecureAddress)
+	})
+
+	t.Run("with a custom transport", func(t *testing.T) {
+		_, err := NewClient(&Config{
+			Address: ts.URL,
+			Token:   "dummy-token",
+			HTTPClient: &http.Client{
+				Transport: retryablehttp.NewClient().StandardClient().Transport,
+			},
+			FIPS: true,
+		})
+		assert.ErrorIs(t, err, ErrUnsupportedFIPSTransport)
+	})
+}
+
+func TestClient_pageSizePolicy(t *testing.T) {
+	const total = 250
+
+	var sizes []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(204)
+			return
+		}
+		sizes = append(sizes, r.URL.Query().Get("page[size]"))
+
+		// Serve the pages like the API, which clamps the page size.
+		number, _ := strconv.Atoi(r.URL.Query().Get("page[number]"))
+		if number < 1 {
+			number = 1
+		}
+		size, _ := strconv.Atoi(r.URL.Query().Get("page[size]"))
+		if size < 1 || size > 100 {
+			size = 100
+		}
+		pages := (total + size - 1) / size
+
+		var data []string
+		for i := (number - 1) * size; i < number*size && i < total; i++ {
+			data = append(data, fmt.Sprintf(`{"id": "ws-%d", "type": "workspaces", "attributes": {"name": "ws-%d"}}`, i, i))
+		}
+		next := "null"
+		if number < pages {
+			next = strconv.Itoa(number + 1)
+		}
+		fmt.Fprintf(w, `{"data": [%s], "meta": {"pagination": {"current-page": %d, "next-page": %s, "total-pages": %d, "total-count": %d}}}`,
+			strings.Join(data, ","), number, next, pages, total)
+	}))
+	defer ts.Close()
+
+	newClient := func(policy PageSizePolicy) *Client {
+		client, err := NewClient(&Config{
+			Address:        ts.URL,
+			Token:          "dummy-token",
+			HTTPClient:     ts.Client(),
+			PageSizePolicy: policy,
+		})
+		require.NoError(t, err)
+		return client
+	}
+	ctx := context.Background()
+
+	t.Run("with the default policy", func(t *testing.T) {
+		sizes = nil
+		wl, err := newClient(PageSizeClamp).Workspaces.List(ctx, "acme", &WorkspaceListOptions{
+			ListOptions: ListOptions{PageSize: 150},
+		})
+		require.NoError(t, err)
+		assert.Len(t, wl.Items, 100)
+		assert.Equal(t, 3, wl.TotalPages)
+		assert.Equal(t, []string{"100"}, sizes)
+	})
+
+	t.Run("with the strict policy", func(t *testing.T) {
+		sizes = nil
+		client := newClient(PageSizeStrict)
+
+		_, err := client.Workspaces.List(ctx, "acme", &WorkspaceListOptions{
+			ListOptions: ListOptions{PageSize: 150},
+		})
+		assert.ErrorIs(t, err, ErrInvalidPageSize)
+		assert.Empty(t, sizes)
+
+		wl, err := client.Workspaces.List(ctx, "acme", &WorkspaceListOptions{
+			ListOptions: ListOptions{PageSize: 100},
+		})
+		require.NoError(t, err)
+		assert.Len(t, wl.Items, 100)
+	})
+
+	t.Run("with the chunked policy", func(t *testing.T) {
+		client := newClient(PageSizeChunked)
+
+		sizes = nil
+		wl, err := client.Workspaces.List(ctx, "acme", &WorkspaceListOptions{
+			ListOptions: ListOptions{PageSize: 150},
+		})
+		require.NoError(t, err)
+		require.Len(t, wl.Items, 150)
+		assert.Equal(t, "ws-0", wl.Items[0].ID)
+		assert.Equal(t, "ws-149", wl.Items[149].ID)
+		assert.Equal(t, 1, wl.CurrentPage)
+		assert.Equal(t, 2, wl.NextPage)
+		assert.Equal(t, 2, wl.TotalPages)
+		assert.Equal(t, total, wl.TotalCount)
+		assert.Equal(t, []string{"100", "100"}, sizes)
+
+		wl, err = client.Workspaces.List(ctx, "acme", &WorkspaceListOptions{
+			ListOptions: ListOptions{PageNumber: 2, PageSize: 150},
+		})
+		require.NoError(t, err)
+		require.Len(t, wl.Items, 100)
+		assert.Equal(t, "ws-150", wl.Items[0].ID)
+		assert.Equal(t, "ws-249", wl.Items[99].ID)
+		assert.Equal(t, 1, wl.PreviousPage)
+		assert.Equal(t, 0, wl.NextPage)
+
+		wl, err = client.Workspaces.List(ctx, "acme", &WorkspaceListOptions{
+			ListOptions: ListOptions{PageNumber: 3, PageSize: 150},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, wl.Items)
+	})
+}
+
+func TestClient_countOnly(t *testing.T) {
+	var queries []url.Values
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(204)
+			return
+		}
+		queries = append(queries, r.URL.Query())
+		fmt.Fprint(w, `{"data": [{"id": "ws-1", "type": "workspaces", "attributes": {"name": "network"}}], "meta": {"pagination": {"current-page": 1, "next-page": 2, "total-pages": 4213, "total-count": 4213}}}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	wl, err := client.Workspaces.List(ctx, "acme", &WorkspaceListOptions{
+		ListOptions: ListOptions{PageNumber: 3, PageSize: 50, CountOnly: true},
+		Search:      "net",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, wl.Items)
+	assert.Equal(t, 4213, wl.TotalCount)
+
+	require.Len(t, queries, 1)
+	assert.Equal(t, "1", queries[0].Get("page[size]"))
+	assert.Empty(t, queries[0].Get("page[number]"))
+	assert.Equal(t, "net", queries[0].Get("search[name]"))
+
+	t.Run("without count only", func(t *testing.T) {
+		queries = nil
+		wl, err := client.Workspaces.List(ctx, "acme", &WorkspaceListOptions{
+			ListOptions: ListOptions{PageNumber: 3, PageSize: 50},
+		})
+		require.NoError(t, err)
+		assert.Len(t, wl.Items, 1)
+		require.Len(t, queries, 1)
+		assert.Equal(t, "50", queries[0].Get("page[size]"))
+	})
+}
+
+func TestClient_noPing(t *testing.T) {
+	pings := 0
+	failPing := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			pings++
+			if failPing {
+				panic(http.ErrAbortHandler)
+			}
+			w.Header().Set("TFP-API-Version", "2.6")
+			w.Header().Set("X-RateLimit-Limit", "30")
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/organizations/acme":
+			fmt.Fprint(w, `{"data":{"id":"acme","type":"organizations","attributes":{"name":"acme"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	cfg := &Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	}
+
+	t.Run("when the context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := NewClientWithContext(ctx, cfg)
+		assert.True(t, errors.Is(err, context.Canceled))
+	})
+
+	t.Run("when the ping is skipped", func(t *testing.T) {
+		pings = 0
+		client, err := NewClient(&Config{
+			Address:    ts.URL,
+			Token:      "dummy-token",
+			HTTPClient: ts.Client(),
+			NoPing:     true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 0, pings)
+		assert.Empty(t, client.RemoteAPIVersion())
+
+		_, err = client.Organizations.Read(context.Background(), "acme")
+		require.NoError(t, err)
+		_, err = client.Organizations.Read(context.Background(), "acme")
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, pings)
+		assert.Equal(t, "2.6", client.RemoteAPIVersion())
+		assert.Equal(t, rate.Limit(19.8), client.limiter.Limit())
+	})
+
+	t.Run("when the API is unavailable", func(t *testing.T) {
+		pings = 0
+		failPing = true
+		defer func() { failPing = false }()
+
+		logger := &testLogger{}
+		clock := NewFakeClock(time.Now())
+		client, err := NewClient(&Config{
+			Address:    ts.URL,
+			Token:      "dummy-token",
+			HTTPClient: ts.Client(),
+			Logger:     logger,
+			Clock:      clock,
+			NoPing:     true,
+		})
+		require.NoError(t, err)
+
+		_, err = client.Organizations.Read(context.Background(), "acme")
+		require.NoError(t, err)
+		assert.NotZero(t, pings)
+		assert.Len(t, logger.warnings, 1)
+		assert.Empty(t, client.RemoteAPIVersion())
+
+		// The metadata is not read again until the backoff has passed.
+		failPing = false
+		failed := pings
+		_, err = client.Organizations.Read(context.Background(), "acme")
+		require.NoError(t, err)
+		assert.Equal(t, failed, pings)
+		assert.Len(t, logger.warnings, 2)
+
+		clock.After(time.Minute)
+		_, err = client.Organizations.Read(context.Background(), "acme")
+		require.NoError(t, err)
+		assert.Equal(t, failed+1, pings)
+		assert.Equal(t, "2.6", client.RemoteAPIVersion())
+	})
+}
+
+func TestClient_noPingConcurrent(t *testing.T) {
+	var pings int32
+	pinging := make(chan struct{})
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			if atomic.AddInt32(&pings, 1) == 1 {
+				close(pinging)
+			}
+			<-release
+			w.Header().Set("TFP-API-Version", "2.6")
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/organizations/acme":
+			fmt.Fprint(w, `{"data":{"id":"acme","type":"organizations","attributes":{"name":"acme"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		NoPing:     true,
+	})
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 5)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.Organizations.Read(context.Background(), "acme")
+			errs <- err
+		}()
+	}
+
+	// The API version and rate limiter are available while the metadata is
+	// being read.
+	<-pinging
+	assert.Empty(t, client.RemoteAPIVersion())
+	assert.NotNil(t, client.requestLimiter())
+
+	close(release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&pings))
+	assert.Equal(t, "2.6", client.RemoteAPIVersion())
+}
+
+// sleepLimiter is a rate limiter which delays every request.
+type sleepLimiter time.Duration
+
+func (l sleepLimiter) Wait(ctx context.Context) error {
+	time.Sleep(time.Duration(l))
+	return nil
+}
+
+func TestClient_rateLimit(t *testing.T) {
+	remaining := 29
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.Header().Set("X-RateLimit-Limit", "30")
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+		w.Header().Set("X-RateLimit-Reset", "0.5")
+		remaining--
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/organizations/acme":
+			fmt.Fprint(w, `{"data":{"id":"acme","type":"organizations","attributes":{"name":"acme"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Clock:      NewFakeClock(now),
+	})
+	require.NoError(t, err)
+
+	t.Run("after the ping", func(t *testing.T) {
+		assert.Equal(t, &RateLimit{
+			Limit:      30,
+			Remaining:  29,
+			Reset:      500 * time.Millisecond,
+			ReceivedAt: now,
+		}, client.RateLimit())
+	})
+
+	t.Run("after a request", func(t *testing.T) {
+		_, err := client.Organizations.Read(context.Background(), "acme")
+		require.NoError(t, err)
+		assert.Equal(t, float64(28), client.RateLimit().Remaining)
+	})
+
+	t.Run("without rate limit headers", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer ts.Close()
+
+		client, err := NewClient(&Config{
+			Address:    ts.URL,
+			Token:      "dummy-token",
+			HTTPClient: ts.Client(),
+		})
+		require.NoError(t, err)
+		assert.Nil(t, client.RateLimit())
+	})
+
+	t.Run("when requests are delayed by the limiter", func(t *testing.T) {
+		var waits []time.Duration
+		client, err := NewClient(&Config{
+			Address:         ts.URL,
+			Token:           "dummy-token",
+			HTTPClient:      ts.Client(),
+			Limiter:         sleepLimiter(10 * time.Millisecond),
+			OnRateLimitWait: func(wait time.Duration) { waits = append(waits, wait) },
+		})
+		require.NoError(t, err)
+
+		_, err = client.Organizations.Read(context.Background(), "acme")
+		require.NoError(t, err)
+		require.Len(t, waits, 1)
+		assert.GreaterOrEqual(t, waits[0], 10*time.Millisecond)
+	})
+}
+
+func TestClient_statusErrors(t *testing.T) {
+	var retryAt time.Time
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/organizations/plan":
+			w.WriteHeader(http.StatusPaymentRequired)
+			fmt.Fprint(w, `{"errors":[{"status":"402","title":"SSO is not available on your current plan"}]}`)
+		case "/api/v2/organizations/maintenance":
+			w.Header().Set("Retry-After", "120")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"errors":[{"status":"503","title":"down for scheduled maintenance"}]}`)
+		case "/api/v2/organizations/maintenance-date":
+			now := time.Now().UTC()
+			w.Header().Set("Date", now.Format(http.TimeFormat))
+			w.Header().Set("Retry-After", now.Add(time.Hour).Format(http.TimeFormat))
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"errors":[{"status":"503","title":"Maintenance mode"}]}`)
+		case "/api/v2/organizations/maintenance-clock":
+			w.Header()["Date"] = nil
+			w.Header().Set("Retry-After", retryAt.Format(http.TimeFormat))
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"errors":[{"status":"503","title":"Maintenance mode"}]}`)
+		case "/api/v2/organizations/overloaded":
+			w.Header().Set("Retry-After", "120")
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer ts.Close()
+
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	retryAt = now.Add(30 * time.Minute)
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Clock:      NewFakeClock(now),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("when the feature is not in the plan", func(t *testing.T) {
+		_, err := client.Organizations.Read(ctx, "plan")
+		assert.True(t, errors.Is(err, ErrPaymentRequired))
+		assert.Contains(t, err.Error(), "SSO is not available")
+	})
+
+	t.Run("when down for maintenance", func(t *testing.T) {
+		_, err := client.Organizations.Read(ctx, "maintenance")
+
+		var maintenance *MaintenanceError
+		require.True(t, errors.As(err, &maintenance))
+		assert.True(t, errors.Is(err, ErrServiceUnavailableMaintenance))
+		assert.Equal(t, 2*time.Minute, maintenance.RetryAfter)
+		assert.Equal(t, "down for scheduled maintenance", maintenance.Message)
+	})
+
+	t.Run("when retrying after a date", func(t *testing.T) {
+		_, err := client.Organizations.Read(ctx, "maintenance-date")
+
+		var maintenance *MaintenanceError
+		require.True(t, errors.As(err, &maintenance))
+		assert.Equal(t, time.Hour, maintenance.RetryAfter)
+	})
+
+	t.Run("when retrying after a date without a Date header", func(t *testing.T) {
+		_, err := client.Organizations.Read(ctx, "maintenance-clock")
+
+		var maintenance *MaintenanceError
+		require.True(t, errors.As(err, &maintenance))
+		assert.Equal(t, 30*time.Minute, maintenance.RetryAfter)
+	})
+
+	t.Run("when overloaded", func(t *testing.T) {
+		_, err := client.Organizations.Read(ctx, "overloaded")
+		require.Error(t, err)
+		assert.False(t, errors.Is(err, ErrServiceUnavailableMaintenance))
+	})
+
+	t.Run("when unavailable for another reason", func(t *testing.T) {
+		_, err := client.Organizations.Read(ctx, "other")
+		require.Error(t, err)
+		assert.False(t, errors.Is(err, ErrServiceUnavailableMaintenance))
+		assert.EqualError(t, err, "503 Service Unavailable")
+	})
+}
+
+func TestFakeClock(t *testing.T) {
+	start := time.Now()
+	clock := NewFakeClock(start)
+
+	now := <-clock.After(time.Second)
+	assert.Equal(t, start.Add(time.Second), now)
+	assert.Equal(t, now, clock.Now())
+
+	for i := 1; i < 2*fakeClockMaxWaits; i++ {
+		clock.After(time.Second)
+	}
+	assert.Equal(t, start.Add(2*fakeClockMaxWaits*time.Second), clock.Now())
+	assert.Len(t, clock.Waits(), fakeClockMaxWaits)
+}
@@ -0,0 +1,234 @@
+package tfehelper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// defaultMigrateBatchSize is the number of workspaces moved to the new agent
+// pool per batch.
+const defaultMigrateBatchSize = 10
+
+// DrainAgentPoolOptions represents the options for draining an agent pool.
+type DrainAgentPoolOptions struct {
+	// Optional: The interval the runs of the workspaces are polled at. It
+	// defaults to 5 seconds.
+	PollInterval time.Duration
+
+	// Optional: A function called with the runs still in progress, every
+	// time the runs are polled.
+	OnWait func(runs []*tfe.Run)
+}
+
+// ReplaceAgentPoolOptions represents the options for replacing an agent
+// pool.
+type ReplaceAgentPoolOptions struct {
+	// Optional: The name of the agent pool to create. Either Name or
+	// AgentPoolID is required.
+	Name string
+
+	// Optional: The ID of an existing agent pool to move the workspaces to,
+	// instead of creating one. Use it to resume a replacement which failed
+	// after the new agent pool was created.
+	AgentPoolID string
+
+	// Optional: The number of workspaces moved to the new agent pool per
+	// batch. It defaults to 10.
+	BatchSize int
+
+	// Optional: The interval the runs of the workspaces are polled at. It
+	// defaults to 5 seconds.
+	PollInterval time.Duration
+
+	// Optional: A function called with the workspaces of every batch, after
+	// they were moved to the new agent pool.
+	OnMigrate func(workspaces []*tfe.Workspace)
+
+	// Optional: A function called with the runs still in progress on the
+	// old agent pool, every time the runs are polled.
+	OnWait func(runs []*tfe.Run)
+}
+
+// DrainAgentPool waits until the workspaces using the agent pool have no runs
+// in progress anymore, so its agents can be stopped without interrupting
+// runs. It does not prevent new runs from being queued in the meantime.
+func DrainAgentPool(ctx context.Context, client *tfe.Client, agentPoolID string, options *DrainAgentPoolOptions) error {
+	if options == nil {
+		options = &DrainAgentPoolOptions{}
+	}
+
+	pool, err := readAgentPool(ctx, client, agentPoolID)
+	if err != nil {
+		return err
+	}
+
+	for {
+		pending, err := listInFlightRuns(ctx, client, pool.Workspaces)
+		if err != nil {
+			return err
+		}
+
+		if len(pending) == 0 {
+			return nil
+		}
+		if options.OnWait != nil {
+			options.OnWait(pending)
+		}
+
+		if err := sleep(ctx, pollInterval(options.PollInterval)); err != nil {
+			return err
+		}
+	}
+}
+
+// ReplaceAgentPool moves the workspaces of the agent pool to a new agent pool
+// in batches, waits until the runs which were in progress on the old agent
+// pool have finished, and deletes the old agent pool. Runs queued after a
+// workspace was moved are executed by the agents of the new agent pool.
+//
+// The new agent pool is returned, also when the replacement failed after it
+// was created, so the replacement can be resumed by passing its ID as the
+// AgentPoolID option.
+func ReplaceAgentPool(ctx context.Context, client *tfe.Client, agentPoolID string, options ReplaceAgentPoolOptions) (*tfe.AgentPool, error) {
+	if options.Name == "" && options.AgentPoolID == "" {
+		return nil, tfe.ErrRequiredName
+	}
+	if options.AgentPoolID == agentPoolID {
+		return nil, fmt.Errorf("agent pool %s can not replace itself", agentPoolID)
+	}
+	batchSize := options.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultMigrateBatchSize
+	}
+	interval := pollInterval(options.PollInterval)
+
+	old, err := readAgentPool(ctx, client, agentPoolID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pool *tfe.AgentPool
+	if options.AgentPoolID != "" {
+		pool, err = client.AgentPools.Read(ctx, options.AgentPoolID)
+	} else {
+		pool, err = client.AgentPools.Create(ctx, old.Organization.Name, tfe.AgentPoolCreateOptions{
+			Name: tfe.String(options.Name),
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Workspaces may be assigned to the old agent pool while the workspaces
+	// are moved, so it is read again until no workspaces are left.
+	var inFlight []*tfe.Run
+	for len(old.Workspaces) > 0 {
+		for start := 0; start < len(old.Workspaces); start += batchSize {
+			end := start + batchSize
+			if end > len(old.Workspaces) {
+				end = len(old.Workspaces)
+			}
+
+			// The runs in progress when a workspace is moved are still
+			// executed by the agents of the old agent pool.
+			runs, err := listInFlightRuns(ctx, client, old.Workspaces[start:end])
+			if err != nil {
+				return pool, err
+			}
+			inFlight = append(inFlight, runs...)
+
+			batch := make([]*tfe.Workspace, 0, end-start)
+			for _, ws := range old.Workspaces[start:end] {
+				ws, err := client.Workspaces.UpdateByID(ctx, ws.ID, tfe.WorkspaceUpdateOptions{
+					ExecutionMode: tfe.ExecutionMode(tfe.ExecutionModeAgent),
+					AgentPoolID:   tfe.NewOptional(pool.ID),
+				})
+				if err != nil {
+					return pool, fmt.Errorf("moving workspace to agent pool %s: %w", pool.ID, err)
+				}
+				batch = append(batch, ws)
+			}
+
+			if options.OnMigrate != nil {
+				options.OnMigrate(batch)
+			}
+		}
+
+		if old, err = readAgentPool(ctx, client, agentPoolID); err != nil {
+			return pool, err
+		}
+	}
+
+	if err := waitForRuns(ctx, client, inFlight, interval, options.OnWait); err != nil {
+		return pool, err
+	}
+
+	if err := client.AgentPools.Delete(ctx, agentPoolID); err != nil {
+		return pool, fmt.Errorf("deleting agent pool %s: %w", agentPoolID, err)
+	}
+
+	return pool, nil
+}
+
+// readAgentPool reads the agent pool including its workspaces.
+func readAgentPool(ctx context.Context, client *tfe.Client, agentPoolID string) (*tfe.AgentPool, error) {
+	return client.AgentPools.ReadWithOptions(ctx, agentPoolID, &tfe.AgentPoolReadOptions{
+		Include: []tfe.AgentPoolIncludeOpt{tfe.AgentPoolWorkspaces},
+	})
+}
+
+// listInFlightRuns lists the runs of the workspaces which are in progress.
+func listInFlightRuns(ctx context.Context, client *tfe.Client, workspaces []*tfe.Workspace) ([]*tfe.Run, error) {
+	var runs []*tfe.Run
+	for _, ws := range workspaces {
+		rl, err := client.Runs.List(ctx, ws.ID, &tfe.RunListOptions{
+			StatusGroup: tfe.RunStatusGroupNonFinal,
+		})
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, rl.Items...)
+	}
+	return runs, nil
+}
+
+// waitForRuns waits until the runs have reached a final status.
+func waitForRuns(ctx context.Context, client *tfe.Client, runs []*tfe.Run, interval time.Duration, onWait func(runs []*tfe.Run)) error {
+	for {
+		var pending []*tfe.Run
+		for _, r := range runs {
+			r, err := client.Runs.Read(ctx, r.ID)
+			if err != nil {
+				return err
+			}
+			if !isFinalRunStatus(r.Status) {
+				pending = append(pending, r)
+			}
+		}
+
+		if len(pending) == 0 {
+			return nil
+		}
+		if onWait != nil {
+			onWait(pending)
+		}
+		runs = pending
+
+		if err := sleep(ctx, interval); err != nil {
+			return err
+		}
+	}
+}
+
+// isFinalRunStatus reports whether a run with the status has finished.
+func isFinalRunStatus(status tfe.RunStatus) bool {
+	switch status {
+	case tfe.RunApplied, tfe.RunCanceled, tfe.RunDiscarded, tfe.RunErrored, tfe.RunPlannedAndFinished:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,169 @@
+// Package tfehelper provides task-oriented operations built on the services
+// of go-tfe, like deploying a directory to a workspace and waiting for the
+// run, so small command-line tools do not each have to re-implement these
+// flows.
+package tfehelper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// defaultPollInterval is the interval the status of configuration versions,
+// runs and agent pools is polled at.
+const defaultPollInterval = 5 * time.Second
+
+var (
+	// ErrConfigurationVersionErrored is returned when the uploaded
+	// configuration version could not be processed.
+	ErrConfigurationVersionErrored = errors.New("configuration version errored")
+
+	// ErrRunNotApplied is returned when a run finished without being
+	// applied, as it errored, was canceled or was discarded.
+	ErrRunNotApplied = errors.New("run not applied")
+)
+
+// DeployOptions represents the options for deploying a directory.
+type DeployOptions struct {
+	// Optional: The message of the run.
+	Message string
+
+	// Optional: The interval the configuration version and the run are
+	// polled at. It defaults to 5 seconds.
+	PollInterval time.Duration
+
+	// Optional: A function called with every status change of the run.
+	OnStatus func(event *tfe.RunEvent)
+}
+
+// Deploy uploads the Terraform configuration in the directory to the
+// workspace, and creates a run which is applied automatically. It waits for
+// the run to finish, and returns an error wrapping ErrRunNotApplied when it
+// did not apply successfully, along with the finished run.
+func Deploy(ctx context.Context, client *tfe.Client, workspaceID, dir string, options *DeployOptions) (*tfe.Run, error) {
+	if options == nil {
+		options = &DeployOptions{}
+	}
+	interval := pollInterval(options.PollInterval)
+
+	cv, err := client.ConfigurationVersions.Create(ctx, workspaceID, tfe.ConfigurationVersionCreateOptions{
+		AutoQueueRuns: tfe.Bool(false),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := client.ConfigurationVersions.Upload(ctx, cv.UploadURL, dir); err != nil {
+		return nil, err
+	}
+
+	// Runs can only be created once the configuration version was processed.
+	for cv.Status != tfe.ConfigurationUploaded {
+		if cv.Status == tfe.ConfigurationErrored {
+			return nil, fmt.Errorf("%w: %s", ErrConfigurationVersionErrored, cv.ErrorMessage)
+		}
+		if err := sleep(ctx, interval); err != nil {
+			return nil, err
+		}
+		if cv, err = client.ConfigurationVersions.Read(ctx, cv.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	createOptions := tfe.RunCreateOptions{
+		Workspace:            &tfe.Workspace{ID: workspaceID},
+		ConfigurationVersion: cv,
+		AutoApply:            tfe.Bool(true),
+	}
+	if options.Message != "" {
+		createOptions.Message = tfe.String(options.Message)
+	}
+	r, err := client.Runs.Create(ctx, createOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return WaitForRun(ctx, client, r.ID, &WaitForRunOptions{
+		PollInterval: options.PollInterval,
+		OnStatus:     options.OnStatus,
+	})
+}
+
+// WaitForRunOptions represents the options for waiting for a run.
+type WaitForRunOptions struct {
+	// Optional: The interval the run is polled at. It defaults to 5 seconds.
+	PollInterval time.Duration
+
+	// Optional: A function called with every status change of the run.
+	OnStatus func(event *tfe.RunEvent)
+}
+
+// WaitForRun waits for the run to reach a final status and returns it. It
+// returns an error wrapping ErrRunNotApplied along with the run, when it did
+// not apply successfully. Runs which finished planning without changes to
+// apply are successful.
+func WaitForRun(ctx context.Context, client *tfe.Client, runID string, options *WaitForRunOptions) (*tfe.Run, error) {
+	if options == nil {
+		options = &WaitForRunOptions{}
+	}
+
+	watcher := tfe.NewRunWatcher(client, pollInterval(options.PollInterval))
+	defer watcher.Close()
+
+	events, err := watcher.Watch(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		_ = watcher.Run(ctx)
+	}()
+
+	var status tfe.RunStatus
+wait:
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case e, ok := <-events:
+			if !ok {
+				break wait
+			}
+			status = e.Status
+			if options.OnStatus != nil {
+				options.OnStatus(e)
+			}
+		}
+	}
+
+	r, err := client.Runs.Read(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch status {
+	case tfe.RunApplied, tfe.RunPlannedAndFinished:
+		return r, nil
+	default:
+		return r, fmt.Errorf("%w: run %s %s", ErrRunNotApplied, r.ID, status)
+	}
+}
+
+func pollInterval(interval time.Duration) time.Duration {
+	if interval <= 0 {
+		return defaultPollInterval
+	}
+	return interval
+}
+
+// sleep waits for the duration, unless the context is canceled first.
+func sleep(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
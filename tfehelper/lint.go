@@ -0,0 +1,407 @@
+package tfehelper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// ErrRequiredLintRules is returned when linting an organization without
+// rules.
+var ErrRequiredLintRules = errors.New("at least one lint rule is required")
+
+const (
+	// defaultLintConcurrency is the number of workspaces linted concurrently
+	// by default.
+	defaultLintConcurrency = 8
+
+	// defaultProviderHost is the host of provider source addresses which do
+	// not include one.
+	defaultProviderHost = "registry.terraform.io"
+)
+
+// LintSeverity represents the severity of a lint finding.
+type LintSeverity string
+
+// List of available lint severities.
+const (
+	LintSeverityWarning LintSeverity = "warning"
+	LintSeverityError   LintSeverity = "error"
+)
+
+// LintRule checks a workspace of an organization. Rules are called
+// concurrently for different workspaces, and read the data they need through
+// the LintTarget, which loads it once per workspace or organization.
+type LintRule interface {
+	// Name returns the name of the rule, which is set on its findings.
+	Name() string
+
+	// Check returns the findings of the rule for a workspace.
+	Check(ctx context.Context, target *LintTarget) ([]*LintFinding, error)
+}
+
+// LintFinding represents a problem found by a lint rule.
+type LintFinding struct {
+	Rule      string       `json:"rule"`
+	Severity  LintSeverity `json:"severity"`
+	Workspace string       `json:"workspace"`
+
+	// What the finding is about, like a provider source address or a module
+	// source and version.
+	Subject string `json:"subject"`
+	Message string `json:"message"`
+}
+
+// LintOptions represents the options for linting an organization.
+type LintOptions struct {
+	// Required: The rules to check.
+	Rules []LintRule
+
+	// Optional: Only the workspaces matching these options are linted.
+	Workspaces *tfe.WorkspaceListOptions
+
+	// Optional: Returns the registry modules used by a workspace, such as
+	// from its configuration. The API does not report the modules used by
+	// workspaces, so the module rules find nothing when nil.
+	ModuleUsage func(ctx context.Context, w *tfe.Workspace) ([]*ModuleUsage, error)
+
+	// Optional: The number of workspaces linted concurrently. Defaults to 8.
+	Concurrency int
+}
+
+// ModuleUsage represents a registry module used by a workspace.
+type ModuleUsage struct {
+	// The source of the module, like "app.terraform.io/acme/vpc/aws".
+	Source  string
+	Version string
+}
+
+// LintTarget is the workspace checked by a lint rule. It loads the data of
+// the workspace and its organization once, when first requested by a rule.
+type LintTarget struct {
+	Workspace *tfe.Workspace
+
+	linter *linter
+
+	providersOnce sync.Once
+	providers     []string
+	providersErr  error
+
+	modulesOnce sync.Once
+	modules     []*ModuleUsage
+	modulesErr  error
+}
+
+// linter holds the data shared by the targets of an organization.
+type linter struct {
+	client       *tfe.Client
+	organization string
+	options      LintOptions
+
+	registryOnce    sync.Once
+	registryModules []*tfe.RegistryModule
+	registryErr     error
+}
+
+// LintOrganization checks the workspaces of an organization against the
+// given rules, such as to flag unapproved providers or deprecated module
+// versions. The workspaces are checked concurrently, and the findings are
+// ordered by workspace name and rule.
+func LintOrganization(ctx context.Context, client *tfe.Client, organization string, options LintOptions) ([]*LintFinding, error) {
+	if len(options.Rules) == 0 {
+		return nil, ErrRequiredLintRules
+	}
+	if options.Concurrency <= 0 {
+		options.Concurrency = defaultLintConcurrency
+	}
+
+	workspaces, err := listAllWorkspaces(ctx, client, organization, options.Workspaces)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &linter{client: client, organization: organization, options: options}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		findings = []*LintFinding{}
+		firstErr error
+	)
+
+	sem := make(chan struct{}, options.Concurrency)
+	var wg sync.WaitGroup
+	for _, w := range workspaces {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(w *tfe.Workspace) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			found, err := l.lint(ctx, w)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err != nil && firstErr == nil:
+				// Stop the workspaces in progress.
+				firstErr = fmt.Errorf("workspace %s: %w", w.Name, err)
+				cancel()
+			case err == nil:
+				findings = append(findings, found...)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		a, b := findings[i], findings[j]
+		if a.Workspace != b.Workspace {
+			return a.Workspace < b.Workspace
+		}
+		return a.Rule < b.Rule
+	})
+
+	return findings, nil
+}
+
+// lint checks a workspace against all rules.
+func (l *linter) lint(ctx context.Context, w *tfe.Workspace) ([]*LintFinding, error) {
+	target := &LintTarget{Workspace: w, linter: l}
+
+	var findings []*LintFinding
+	for _, rule := range l.options.Rules {
+		found, err := rule.Check(ctx, target)
+		if err != nil {
+			return nil, fmt.Errorf("rule %s: %w", rule.Name(), err)
+		}
+		for _, f := range found {
+			f.Rule = rule.Name()
+			f.Workspace = w.Name
+		}
+		findings = append(findings, found...)
+	}
+
+	return findings, nil
+}
+
+// Providers returns the source addresses of the providers of the resources
+// in the current state of the workspace, like
+// "registry.terraform.io/hashicorp/aws". It is empty when the workspace has
+// no state, or its resources have not been processed yet.
+func (t *LintTarget) Providers(ctx context.Context) ([]string, error) {
+	t.providersOnce.Do(func() {
+		sv, err := t.linter.client.StateVersions.ReadCurrent(ctx, t.Workspace.ID)
+		switch {
+		case errors.Is(err, tfe.ErrResourceNotFound):
+			return
+		case err != nil:
+			t.providersErr = err
+			return
+		}
+
+		seen := make(map[string]bool)
+		for _, r := range sv.Resources {
+			p := normalizeProviderSource(r.Provider)
+			if p != "" && !seen[p] {
+				seen[p] = true
+				t.providers = append(t.providers, p)
+			}
+		}
+		sort.Strings(t.providers)
+	})
+
+	return t.providers, t.providersErr
+}
+
+// Modules returns the registry modules used by the workspace, as reported by
+// LintOptions.ModuleUsage.
+func (t *LintTarget) Modules(ctx context.Context) ([]*ModuleUsage, error) {
+	t.modulesOnce.Do(func() {
+		if t.linter.options.ModuleUsage != nil {
+			t.modules, t.modulesErr = t.linter.options.ModuleUsage(ctx, t.Workspace)
+		}
+	})
+
+	return t.modules, t.modulesErr
+}
+
+// RegistryModules returns the modules of the private registry of the
+// organization.
+func (t *LintTarget) RegistryModules(ctx context.Context) ([]*tfe.RegistryModule, error) {
+	l := t.linter
+	l.registryOnce.Do(func() {
+		l.registryModules, l.registryErr = listAllRegistryModules(ctx, l.client, l.organization)
+	})
+
+	return l.registryModules, l.registryErr
+}
+
+// ProviderAllowlistRule flags the workspaces managing resources with
+// providers which are not in the allowlist.
+type ProviderAllowlistRule struct {
+	// The source addresses of the allowed providers, like "hashicorp/aws"
+	// or "registry.terraform.io/hashicorp/aws".
+	Allowed []string
+}
+
+// Name implements LintRule.
+func (r *ProviderAllowlistRule) Name() string {
+	return "provider-allowlist"
+}
+
+// Check implements LintRule.
+func (r *ProviderAllowlistRule) Check(ctx context.Context, target *LintTarget) ([]*LintFinding, error) {
+	providers, err := target.Providers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := make(map[string]bool, len(r.Allowed))
+	for _, p := range r.Allowed {
+		allowed[normalizeProviderSource(p)] = true
+	}
+
+	var findings []*LintFinding
+	for _, p := range providers {
+		if !allowed[p] {
+			findings = append(findings, &LintFinding{
+				Severity: LintSeverityError,
+				Subject:  p,
+				Message:  fmt.Sprintf("provider %s is not approved", p),
+			})
+		}
+	}
+	return findings, nil
+}
+
+// DeprecatedModuleVersionRule flags the workspaces using deprecated versions
+// of registry modules. A version is deprecated when it is listed as such, or
+// when it is a version of a module of the private registry of the
+// organization which the registry does not have or failed to publish.
+type DeprecatedModuleVersionRule struct {
+	// The deprecated versions of modules, by module source without its host,
+	// like "acme/vpc/aws".
+	Deprecated map[string][]string
+}
+
+// Name implements LintRule.
+func (r *DeprecatedModuleVersionRule) Name() string {
+	return "deprecated-module-version"
+}
+
+// Check implements LintRule.
+func (r *DeprecatedModuleVersionRule) Check(ctx context.Context, target *LintTarget) ([]*LintFinding, error) {
+	modules, err := target.Modules(ctx)
+	if err != nil || len(modules) == 0 {
+		return nil, err
+	}
+
+	registryModules, err := target.RegistryModules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	published := make(map[string]map[string]tfe.RegistryModuleVersionStatus)
+	for _, rm := range registryModules {
+		versions := make(map[string]tfe.RegistryModuleVersionStatus)
+		for _, v := range rm.VersionStatuses {
+			versions[v.Version] = v.Status
+		}
+		published[fmt.Sprintf("%s/%s/%s", target.linter.organization, rm.Name, rm.Provider)] = versions
+	}
+
+	var findings []*LintFinding
+	for _, m := range modules {
+		source := moduleSourceWithoutHost(m.Source)
+		subject := fmt.Sprintf("%s@%s", m.Source, m.Version)
+
+		var reason string
+		switch versions, private := published[source]; {
+		case containsString(r.Deprecated[source], m.Version):
+			reason = "is deprecated"
+		case private && versions[m.Version] == "":
+			reason = "is not published in the private registry"
+		case private && versions[m.Version] != tfe.RegistryModuleVersionStatusOk:
+			reason = fmt.Sprintf("has status %q in the private registry", versions[m.Version])
+		default:
+			continue
+		}
+
+		findings = append(findings, &LintFinding{
+			Severity: LintSeverityWarning,
+			Subject:  subject,
+			Message:  fmt.Sprintf("module version %s %s", subject, reason),
+		})
+	}
+	return findings, nil
+}
+
+// normalizeProviderSource returns the source address of a provider, with
+// its host, from a provider address of a state like
+// `provider["registry.terraform.io/hashicorp/aws"].alias` or from a source
+// address like "hashicorp/aws".
+func normalizeProviderSource(p string) string {
+	if strings.HasPrefix(p, `provider["`) {
+		p = strings.TrimPrefix(p, `provider["`)
+		if i := strings.Index(p, `"]`); i >= 0 {
+			p = p[:i]
+		}
+	}
+	if p == "" {
+		return ""
+	}
+	if strings.Count(p, "/") == 1 {
+		p = defaultProviderHost + "/" + p
+	}
+	return strings.ToLower(p)
+}
+
+// moduleSourceWithoutHost returns the source of a registry module without
+// its host, like "acme/vpc/aws".
+func moduleSourceWithoutHost(source string) string {
+	if parts := strings.Split(source, "/"); len(parts) == 4 {
+		return strings.Join(parts[1:], "/")
+	}
+	return source
+}
+
+// containsString reports whether a slice contains a string.
+func containsString(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+// listAllRegistryModules lists the modules of the private registry of an
+// organization, following the pagination until all pages are read.
+func listAllRegistryModules(ctx context.Context, client *tfe.Client, organization string) ([]*tfe.RegistryModule, error) {
+	opts := tfe.RegistryModuleListOptions{}
+
+	var modules []*tfe.RegistryModule
+	for {
+		rml, err := client.RegistryModules.List(ctx, organization, &opts)
+		if err != nil {
+			return nil, err
+		}
+		modules = append(modules, rml.Items...)
+
+		if rml.Pagination == nil || rml.NextPage == 0 {
+			return modules, nil
+		}
+		opts.PageNumber = rml.NextPage
+	}
+}
@@ -0,0 +1,375 @@
+package tfehelper
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// ErrRequiredArchiveDestination is returned when archiving the logs of a run
+// without a destination.
+var ErrRequiredArchiveDestination = errors.New("archive destination is required")
+
+// RunLogsMetadataFile is the name of the metadata file written to a run logs
+// archive.
+const RunLogsMetadataFile = "metadata.json"
+
+// RunLogsArchiveMetadata describes the contents of a run logs archive.
+type RunLogsArchiveMetadata struct {
+	RunID       string                 `json:"run-id"`
+	RunStatus   tfe.RunStatus          `json:"run-status"`
+	WorkspaceID string                 `json:"workspace-id,omitempty"`
+	CreatedAt   time.Time              `json:"created-at"`
+	ArchivedAt  time.Time              `json:"archived-at"`
+	Logs        []*RunLogsArchiveEntry `json:"logs"`
+}
+
+// RunLogsArchiveEntry describes a single log file within a run logs archive.
+type RunLogsArchiveEntry struct {
+	// The kind of the log: "plan", "apply", "policy-check" or "cost-estimate".
+	Kind   string `json:"kind"`
+	ID     string `json:"id"`
+	Status string `json:"status"`
+
+	// The path of the log file, relative to the root of the archive.
+	Path string `json:"path"`
+}
+
+// runLogsWriter writes the files of a run logs archive.
+type runLogsWriter interface {
+	create(name string) (io.Writer, error)
+	close() error
+
+	// abort closes the writer and removes the files written so far.
+	abort() error
+}
+
+// ArchiveRunLogs downloads the plan, apply, policy check and cost estimate
+// logs of a run into a directory, or into a zip archive when dst ends with
+// ".zip", together with a metadata file describing them. Logs of unfinished
+// operations are streamed until the operation completes, while logs of
+// operations which did not and will not run are skipped. The files of the
+// archive only replace existing files once all logs were written, so a
+// failed archive leaves an earlier archive at the same destination intact.
+func ArchiveRunLogs(ctx context.Context, client *tfe.Client, runID, dst string) (err error) {
+	if dst == "" {
+		return ErrRequiredArchiveDestination
+	}
+
+	r, err := client.Runs.ReadWithOptions(ctx, runID, &tfe.RunReadOptions{
+		Include: []tfe.RunIncludeOpt{tfe.RunPlan, tfe.RunApply, tfe.RunCostEstimate},
+	})
+	if err != nil {
+		return err
+	}
+
+	policyChecks, err := listAllPolicyChecks(ctx, client, runID)
+	if err != nil {
+		return err
+	}
+
+	var w runLogsWriter
+	if strings.HasSuffix(dst, ".zip") {
+		w, err = newZipRunLogsWriter(dst)
+	} else {
+		w, err = newDirRunLogsWriter(dst)
+	}
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if aerr := w.abort(); aerr != nil {
+				err = fmt.Errorf("%w (failed to remove the partial archive: %v)", err, aerr)
+			}
+			return
+		}
+		err = w.close()
+	}()
+
+	meta := &RunLogsArchiveMetadata{
+		RunID:      r.ID,
+		RunStatus:  r.Status,
+		CreatedAt:  r.CreatedAt,
+		ArchivedAt: time.Now().UTC(),
+		Logs:       []*RunLogsArchiveEntry{},
+	}
+	if r.Workspace != nil {
+		meta.WorkspaceID = r.Workspace.ID
+	}
+
+	archive := func(kind, id, status, name string, logs func() (io.Reader, error)) error {
+		l, err := logs()
+		if err != nil {
+			return fmt.Errorf("failed to read %s logs: %w", kind, err)
+		}
+		f, err := w.create(name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(f, l); err != nil {
+			return fmt.Errorf("failed to write %s logs: %w", kind, err)
+		}
+		meta.Logs = append(meta.Logs, &RunLogsArchiveEntry{
+			Kind:   kind,
+			ID:     id,
+			Status: status,
+			Path:   name,
+		})
+		return nil
+	}
+
+	if p := r.Plan; p != nil && p.Status != tfe.PlanUnreachable && p.LogReadURL != "" {
+		err := archive("plan", p.ID, string(p.Status), "plan.log", func() (io.Reader, error) {
+			return client.Plans.Logs(ctx, p.ID)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if a := r.Apply; a != nil && a.Status != tfe.ApplyUnreachable && a.LogReadURL != "" {
+		err := archive("apply", a.ID, string(a.Status), "apply.log", func() (io.Reader, error) {
+			return client.Applies.Logs(ctx, a.ID)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, pc := range policyChecks {
+		if pc.Status == tfe.PolicyUnreachable {
+			continue
+		}
+		id := pc.ID
+		err := archive("policy-check", id, string(pc.Status), path.Join("policy-checks", id+".log"), func() (io.Reader, error) {
+			return client.PolicyChecks.Logs(ctx, id)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if ce := r.CostEstimate; ce != nil && ce.Status != tfe.CostEstimateSkippedDueToTargeting {
+		err := archive("cost-estimate", ce.ID, string(ce.Status), "cost-estimate.log", func() (io.Reader, error) {
+			return client.CostEstimates.Logs(ctx, ce.ID)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	f, err := w.create(RunLogsMetadataFile)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(meta)
+}
+
+// listAllPolicyChecks lists the policy checks of a run, following all pages.
+func listAllPolicyChecks(ctx context.Context, client *tfe.Client, runID string) ([]*tfe.PolicyCheck, error) {
+	var policyChecks []*tfe.PolicyCheck
+
+	options := &tfe.PolicyCheckListOptions{}
+	for {
+		pcl, err := client.PolicyChecks.List(ctx, runID, options)
+		if err != nil {
+			return nil, err
+		}
+		policyChecks = append(policyChecks, pcl.Items...)
+
+		if pcl.Pagination == nil || pcl.NextPage == 0 {
+			return policyChecks, nil
+		}
+		options.PageNumber = pcl.NextPage
+	}
+}
+
+// dirRunLogsWriter writes a run logs archive into a directory. The files are
+// written to temporary files, which replace the files of the archive once it
+// is complete, so a failed archive leaves existing files untouched.
+type dirRunLogsWriter struct {
+	root string
+	file *os.File
+
+	// The directories created by the writer, in order.
+	created []string
+
+	// The temporary files written so far, by the path they replace.
+	pending []pendingRunLogsFile
+}
+
+// pendingRunLogsFile is a temporary file of a run logs archive.
+type pendingRunLogsFile struct {
+	tmp, dst string
+}
+
+func newDirRunLogsWriter(root string) (*dirRunLogsWriter, error) {
+	w := &dirRunLogsWriter{root: root}
+	if err := w.mkdir(root); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// mkdir creates a directory and its parents, and records the directories
+// which did not exist yet.
+func (w *dirRunLogsWriter) mkdir(dir string) error {
+	var missing []string
+	for d := dir; ; d = filepath.Dir(d) {
+		if _, err := os.Stat(d); err == nil || filepath.Dir(d) == d {
+			break
+		}
+		missing = append(missing, d)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for i := len(missing) - 1; i >= 0; i-- {
+		w.created = append(w.created, missing[i])
+	}
+	return nil
+}
+
+func (w *dirRunLogsWriter) create(name string) (io.Writer, error) {
+	if err := w.closeFile(); err != nil {
+		return nil, err
+	}
+
+	p := filepath.Join(w.root, filepath.FromSlash(name))
+	if err := w.mkdir(filepath.Dir(p)); err != nil {
+		return nil, err
+	}
+
+	f, err := createTempRunLogsFile(p)
+	if err != nil {
+		return nil, err
+	}
+	w.file = f
+	w.pending = append(w.pending, pendingRunLogsFile{tmp: f.Name(), dst: p})
+
+	return f, nil
+}
+
+func (w *dirRunLogsWriter) closeFile() error {
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Close()
+	w.file = nil
+	return err
+}
+
+func (w *dirRunLogsWriter) close() error {
+	if err := w.closeFile(); err != nil {
+		return err
+	}
+
+	for _, f := range w.pending {
+		if err := os.Rename(f.tmp, f.dst); err != nil {
+			return err
+		}
+	}
+	w.pending = nil
+
+	return nil
+}
+
+func (w *dirRunLogsWriter) abort() error {
+	err := w.closeFile()
+
+	for _, f := range w.pending {
+		if rerr := os.Remove(f.tmp); rerr != nil && err == nil {
+			err = rerr
+		}
+	}
+	w.pending = nil
+
+	// Remove the created directories in reverse order, so the directories
+	// are empty when they are removed.
+	for i := len(w.created) - 1; i >= 0; i-- {
+		if rerr := os.Remove(w.created[i]); rerr != nil && err == nil {
+			err = rerr
+		}
+	}
+	w.created = nil
+
+	return err
+}
+
+// zipRunLogsWriter writes a run logs archive into a zip file. The archive is
+// written to a temporary file, which replaces the zip file once the archive
+// is complete.
+type zipRunLogsWriter struct {
+	name string
+	file *os.File
+	zip  *zip.Writer
+}
+
+func newZipRunLogsWriter(name string) (*zipRunLogsWriter, error) {
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return nil, err
+	}
+
+	f, err := createTempRunLogsFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &zipRunLogsWriter{name: name, file: f, zip: zip.NewWriter(f)}, nil
+}
+
+func (w *zipRunLogsWriter) create(name string) (io.Writer, error) {
+	return w.zip.Create(name)
+}
+
+func (w *zipRunLogsWriter) close() error {
+	err := w.zip.Close()
+	if cerr := w.file.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(w.file.Name(), w.name)
+}
+
+func (w *zipRunLogsWriter) abort() error {
+	err := w.zip.Close()
+	if cerr := w.file.Close(); err == nil {
+		err = cerr
+	}
+	if rerr := os.Remove(w.file.Name()); err == nil {
+		err = rerr
+	}
+	return err
+}
+
+// createTempRunLogsFile creates a temporary file next to the file it
+// replaces once the archive is complete.
+func createTempRunLogsFile(name string) (*os.File, error) {
+	f, err := os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+".*")
+	if err != nil {
+		return nil, err
+	}
+	if err := f.Chmod(0o644); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, err
+	}
+	return f, nil
+}
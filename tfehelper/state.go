@@ -0,0 +1,102 @@
+package tfehelper
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// PromoteStateOptions represents the options for promoting a state.
+type PromoteStateOptions struct {
+	// Optional: The reason the target workspace is locked for while the
+	// state is promoted.
+	LockReason string
+}
+
+// PromoteState copies the current state of the source workspace to the
+// target workspace, for example from a staging to a production workspace
+// managing the same infrastructure. The promoted state takes over the
+// lineage of the state of the target workspace, and a serial following its
+// serial, so it replaces the state of the target workspace. The target
+// workspace is locked while the state is promoted.
+func PromoteState(ctx context.Context, client *tfe.Client, sourceWorkspaceID, targetWorkspaceID string, options *PromoteStateOptions) (*tfe.StateVersion, error) {
+	if options == nil {
+		options = &PromoteStateOptions{}
+	}
+	reason := options.LockReason
+	if reason == "" {
+		reason = fmt.Sprintf("Promoting state from %s", sourceWorkspaceID)
+	}
+
+	source, err := client.StateVersions.ReadCurrent(ctx, sourceWorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := client.StateVersions.Download(ctx, source.DownloadURL)
+	if err != nil {
+		return nil, err
+	}
+
+	state := make(map[string]interface{})
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&state); err != nil {
+		return nil, fmt.Errorf("decoding state of %s: %w", sourceWorkspaceID, err)
+	}
+
+	if _, err := client.Workspaces.Lock(ctx, targetWorkspaceID, tfe.WorkspaceLockOptions{
+		Reason: tfe.String(reason),
+	}); err != nil {
+		return nil, err
+	}
+	defer func() {
+		_, _ = client.Workspaces.Unlock(ctx, targetWorkspaceID)
+	}()
+
+	serial := source.Serial
+	target, err := client.StateVersions.ReadCurrent(ctx, targetWorkspaceID)
+	switch {
+	case err == nil:
+		targetRaw, err := client.StateVersions.Download(ctx, target.DownloadURL)
+		if err != nil {
+			return nil, err
+		}
+		var meta struct {
+			Lineage string `json:"lineage"`
+		}
+		if err := json.Unmarshal(targetRaw, &meta); err != nil {
+			return nil, fmt.Errorf("decoding state of %s: %w", targetWorkspaceID, err)
+		}
+		if meta.Lineage != "" {
+			state["lineage"] = meta.Lineage
+		}
+		serial = target.Serial + 1
+	case errors.Is(err, tfe.ErrResourceNotFound):
+		// The target workspace has no state yet.
+	default:
+		return nil, err
+	}
+	state["serial"] = serial
+
+	promoted, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	createOptions := tfe.StateVersionCreateOptions{
+		MD5:    tfe.String(fmt.Sprintf("%x", md5.Sum(promoted))),
+		Serial: tfe.Int64(serial),
+		State:  tfe.String(base64.StdEncoding.EncodeToString(promoted)),
+	}
+	if lineage, ok := state["lineage"].(string); ok && lineage != "" {
+		createOptions.Lineage = tfe.String(lineage)
+	}
+
+	return client.StateVersions.Create(ctx, targetWorkspaceID, createOptions)
+}
@@ -0,0 +1,473 @@
+package tfehelper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+var (
+	// ErrInvalidTerraformUpgradeRisk is returned when advising Terraform
+	// upgrades with an unknown maximum risk.
+	ErrInvalidTerraformUpgradeRisk = errors.New(`invalid value for Terraform upgrade risk. It must be one of "none", "patch", "minor" or "major"`)
+
+	// ErrInvalidTerraformVersionSource is returned when advising Terraform
+	// upgrades with an unknown version source.
+	ErrInvalidTerraformVersionSource = errors.New("invalid value for Terraform version source")
+
+	// ErrRequiredTerraformVersions is returned when no Terraform release
+	// versions are available to upgrade to.
+	ErrRequiredTerraformVersions = errors.New("no Terraform release versions available")
+)
+
+// terraformReleasesURL is the public index of Terraform releases.
+var terraformReleasesURL = "https://releases.hashicorp.com/terraform/index.json"
+
+// TerraformUpgradeRisk classifies the risk of upgrading Terraform between two
+// versions.
+type TerraformUpgradeRisk string
+
+// List of available Terraform upgrade risks, from lowest to highest.
+const (
+	TerraformUpgradeNone  TerraformUpgradeRisk = "none"
+	TerraformUpgradePatch TerraformUpgradeRisk = "patch"
+	TerraformUpgradeMinor TerraformUpgradeRisk = "minor"
+	TerraformUpgradeMajor TerraformUpgradeRisk = "major"
+)
+
+// TerraformVersionSource represents where the available Terraform versions
+// are read from.
+type TerraformVersionSource string
+
+// List of available Terraform version sources.
+const (
+	// TerraformVersionSourceAdmin reads the enabled, non-beta and
+	// non-deprecated versions using the admin API, which is only available
+	// in Terraform Enterprise.
+	TerraformVersionSourceAdmin TerraformVersionSource = "admin"
+
+	// TerraformVersionSourceReleases reads the versions from the public
+	// Terraform releases index, which also lists versions not installed in
+	// Terraform Enterprise.
+	TerraformVersionSourceReleases TerraformVersionSource = "releases"
+)
+
+// TerraformUpgradeOptions represents the options for advising Terraform
+// version upgrades of the workspaces within an organization.
+type TerraformUpgradeOptions struct {
+	// Optional: The versions workspaces can be upgraded to. When empty, the
+	// versions are read from Source.
+	Versions []string
+
+	// Optional: Where the available versions are read from when Versions is
+	// empty. Defaults to TerraformVersionSourceAdmin.
+	Source TerraformVersionSource
+
+	// Optional: The HTTP client used to read the public Terraform releases
+	// index, which is never read with the client of the API.
+	HTTPClient *http.Client
+
+	// Optional: Whether workspaces should be upgraded to the recommended
+	// version. When false, upgrades are only advised.
+	Apply bool
+
+	// Optional: The highest risk of upgrades which are recommended and
+	// applied. Defaults to TerraformUpgradePatch.
+	MaxRisk TerraformUpgradeRisk
+}
+
+// TerraformUpgradeAdvice holds the upgrade advice of a single workspace.
+type TerraformUpgradeAdvice struct {
+	Workspace *tfe.Workspace
+
+	// Current is the Terraform version or version constraint of the
+	// workspace.
+	Current string
+
+	// Latest is the latest available version, and Risk the risk of
+	// upgrading to it from the current version, or from the latest version
+	// allowed by the constraint.
+	Latest string
+	Risk   TerraformUpgradeRisk
+
+	// Recommended is the latest available version which can be upgraded to
+	// within the maximum risk, or the constraint allowing it, or empty when
+	// there is none.
+	Recommended string
+
+	// Constraint is true when the workspace uses a version constraint
+	// instead of an exact version. Pessimistic constraints like "~> 1.5.0"
+	// are bumped keeping their precision, while other constraints are never
+	// upgraded.
+	Constraint bool
+
+	// Applied is true when the workspace was upgraded to the recommended
+	// version.
+	Applied bool
+
+	// Err holds the error returned while upgrading the workspace, if any.
+	Err error
+}
+
+// AdviseTerraformUpgrades compares the Terraform version of all workspaces
+// within an organization against the available versions, and classifies the
+// risk of upgrading them. If options.Apply is set, workspaces are upgraded to
+// the recommended version or constraint. Errors upgrading a single workspace
+// are reported in its advice and do not abort the upgrade of the other
+// workspaces.
+func AdviseTerraformUpgrades(ctx context.Context, client *tfe.Client, organization string, options TerraformUpgradeOptions) ([]*TerraformUpgradeAdvice, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	workspaces, err := listAllWorkspaces(ctx, client, organization, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := options.Versions
+	if len(versions) == 0 {
+		versions, err = listTerraformVersions(ctx, client, options)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	available := parseTerraformVersions(versions)
+	if len(available) == 0 {
+		return nil, ErrRequiredTerraformVersions
+	}
+
+	maxRisk := options.MaxRisk
+	if maxRisk == "" {
+		maxRisk = TerraformUpgradePatch
+	}
+
+	var advice []*TerraformUpgradeAdvice
+	for _, w := range workspaces {
+		a := adviseTerraformUpgrade(w, available, maxRisk)
+		advice = append(advice, a)
+
+		if !options.Apply || a.Recommended == "" {
+			continue
+		}
+
+		updated, err := client.Workspaces.UpdateByID(ctx, w.ID, tfe.WorkspaceUpdateOptions{
+			TerraformVersion: tfe.String(a.Recommended),
+		})
+		if err != nil {
+			a.Err = err
+			continue
+		}
+
+		a.Workspace = updated
+		a.Applied = true
+	}
+
+	return advice, nil
+}
+
+// adviseTerraformUpgrade returns the upgrade advice of a workspace, given the
+// available versions sorted from newest to oldest.
+func adviseTerraformUpgrade(w *tfe.Workspace, available []terraformVersion, maxRisk TerraformUpgradeRisk) *TerraformUpgradeAdvice {
+	a := &TerraformUpgradeAdvice{
+		Workspace: w,
+		Current:   w.TerraformVersion,
+		Latest:    available[0].String(),
+	}
+
+	if current, ok := parseTerraformVersion(w.TerraformVersion); ok {
+		a.Risk = current.upgradeRisk(available[0])
+		for _, v := range available {
+			risk := current.upgradeRisk(v)
+			if risk == TerraformUpgradeNone {
+				break
+			}
+			if risk.rank() <= maxRisk.rank() {
+				a.Recommended = v.String()
+				break
+			}
+		}
+		return a
+	}
+
+	a.Constraint = true
+	constraint, ok := parseTerraformConstraint(w.TerraformVersion)
+	if !ok {
+		return a
+	}
+
+	// Classify the risk from the latest version the constraint allows, which
+	// is the version used by the runs of the workspace.
+	current := constraint.base
+	for _, v := range available {
+		if constraint.allows(v) {
+			current = v
+			break
+		}
+	}
+
+	a.Risk = current.upgradeRisk(available[0])
+	for _, v := range available {
+		risk := current.upgradeRisk(v)
+		if risk == TerraformUpgradeNone || constraint.allows(v) {
+			break
+		}
+		if risk.rank() <= maxRisk.rank() {
+			a.Recommended = constraint.bump(v).String()
+			break
+		}
+	}
+
+	return a
+}
+
+// listAllWorkspaces lists the workspaces of an organization matching the
+// options, following all pages.
+func listAllWorkspaces(ctx context.Context, client *tfe.Client, organization string, options *tfe.WorkspaceListOptions) ([]*tfe.Workspace, error) {
+	opts := tfe.WorkspaceListOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	var workspaces []*tfe.Workspace
+	for {
+		wl, err := client.Workspaces.List(ctx, organization, &opts)
+		if err != nil {
+			return nil, err
+		}
+		workspaces = append(workspaces, wl.Items...)
+
+		if wl.Pagination == nil || wl.NextPage == 0 {
+			return workspaces, nil
+		}
+		opts.PageNumber = wl.NextPage
+	}
+}
+
+// listTerraformVersions reads the available Terraform versions from the
+// source of the options.
+func listTerraformVersions(ctx context.Context, client *tfe.Client, options TerraformUpgradeOptions) ([]string, error) {
+	if options.Source != TerraformVersionSourceReleases {
+		return listAdminTerraformVersions(ctx, client)
+	}
+
+	httpClient := options.HTTPClient
+	if httpClient == nil {
+		httpClient = cleanhttp.DefaultClient()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", terraformReleasesURL, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to read Terraform releases: %s", resp.Status)
+	}
+
+	var index struct {
+		Versions map[string]json.RawMessage `json:"versions"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&index); err != nil {
+		return nil, err
+	}
+
+	versions := make([]string, 0, len(index.Versions))
+	for v := range index.Versions {
+		versions = append(versions, v)
+	}
+
+	return versions, nil
+}
+
+// listAdminTerraformVersions reads the enabled, non-beta and non-deprecated
+// Terraform versions, following the pagination until all pages are read.
+func listAdminTerraformVersions(ctx context.Context, client *tfe.Client) ([]string, error) {
+	options := &tfe.AdminTerraformVersionsListOptions{}
+
+	var versions []string
+	for {
+		tvl, err := client.Admin.TerraformVersions.List(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		for _, tv := range tvl.Items {
+			if tv.Enabled && !tv.Beta && !tv.Deprecated {
+				versions = append(versions, tv.Version)
+			}
+		}
+
+		if tvl.Pagination == nil || tvl.NextPage == 0 {
+			return versions, nil
+		}
+		options.PageNumber = tvl.NextPage
+	}
+}
+
+// terraformVersion is a parsed Terraform release version.
+type terraformVersion struct {
+	major, minor, patch int
+}
+
+func (v terraformVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.major, v.minor, v.patch)
+}
+
+// less reports whether the version is older than the other version.
+func (v terraformVersion) less(other terraformVersion) bool {
+	if v.major != other.major {
+		return v.major < other.major
+	}
+	if v.minor != other.minor {
+		return v.minor < other.minor
+	}
+	return v.patch < other.patch
+}
+
+// upgradeRisk classifies the risk of upgrading from the version to the target
+// version. As Terraform releases before 1.0 introduced breaking changes in
+// minor releases, those are classified as major upgrades.
+func (v terraformVersion) upgradeRisk(target terraformVersion) TerraformUpgradeRisk {
+	switch {
+	case !v.less(target):
+		return TerraformUpgradeNone
+	case v.major != target.major:
+		return TerraformUpgradeMajor
+	case v.minor != target.minor && v.major == 0:
+		return TerraformUpgradeMajor
+	case v.minor != target.minor:
+		return TerraformUpgradeMinor
+	default:
+		return TerraformUpgradePatch
+	}
+}
+
+// terraformConstraint is a parsed pessimistic version constraint, like
+// "~> 1.5.0" allowing patch releases or "~> 1.5" allowing minor releases.
+type terraformConstraint struct {
+	base terraformVersion
+
+	// The number of version parts of the constraint, which is 2 or 3.
+	parts int
+}
+
+func (c terraformConstraint) String() string {
+	if c.parts == 2 {
+		return fmt.Sprintf("~> %d.%d", c.base.major, c.base.minor)
+	}
+	return "~> " + c.base.String()
+}
+
+// allows reports whether the constraint allows the version.
+func (c terraformConstraint) allows(v terraformVersion) bool {
+	if v.less(c.base) || v.major != c.base.major {
+		return false
+	}
+	return c.parts == 2 || v.minor == c.base.minor
+}
+
+// bump returns a constraint of the same precision allowing the version and
+// the later releases of its minor version, like "~> 1.6.0" for 1.6.2.
+func (c terraformConstraint) bump(v terraformVersion) terraformConstraint {
+	return terraformConstraint{base: terraformVersion{major: v.major, minor: v.minor}, parts: c.parts}
+}
+
+// parseTerraformConstraint parses a pessimistic version constraint. It
+// returns false for all other constraints.
+func parseTerraformConstraint(s string) (terraformConstraint, bool) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "~>") {
+		return terraformConstraint{}, false
+	}
+	s = strings.TrimSpace(strings.TrimPrefix(s, "~>"))
+
+	parts := strings.Split(s, ".")
+	switch len(parts) {
+	case 2:
+		v, ok := parseTerraformVersion(s + ".0")
+		return terraformConstraint{base: v, parts: 2}, ok
+	case 3:
+		v, ok := parseTerraformVersion(s)
+		return terraformConstraint{base: v, parts: 3}, ok
+	}
+	return terraformConstraint{}, false
+}
+
+// parseTerraformVersion parses an exact release version like "1.5.2". It
+// returns false for pre-releases and version constraints.
+func parseTerraformVersion(s string) (terraformVersion, bool) {
+	parts := strings.Split(strings.TrimPrefix(s, "v"), ".")
+	if len(parts) != 3 {
+		return terraformVersion{}, false
+	}
+
+	var numbers [3]int
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return terraformVersion{}, false
+		}
+		numbers[i] = n
+	}
+
+	return terraformVersion{major: numbers[0], minor: numbers[1], patch: numbers[2]}, true
+}
+
+// parseTerraformVersions parses the release versions, ignoring pre-releases,
+// and returns them sorted from newest to oldest.
+func parseTerraformVersions(versions []string) []terraformVersion {
+	var parsed []terraformVersion
+	for _, s := range versions {
+		if v, ok := parseTerraformVersion(s); ok {
+			parsed = append(parsed, v)
+		}
+	}
+
+	sort.Slice(parsed, func(i, j int) bool {
+		return parsed[j].less(parsed[i])
+	})
+
+	return parsed
+}
+
+func (r TerraformUpgradeRisk) rank() int {
+	switch r {
+	case TerraformUpgradeNone:
+		return 0
+	case TerraformUpgradePatch:
+		return 1
+	case TerraformUpgradeMinor:
+		return 2
+	case TerraformUpgradeMajor:
+		return 3
+	}
+	return -1
+}
+
+func (o TerraformUpgradeOptions) valid() error {
+	if o.MaxRisk != "" && o.MaxRisk.rank() < 0 {
+		return ErrInvalidTerraformUpgradeRisk
+	}
+	switch o.Source {
+	case "", TerraformVersionSourceAdmin, TerraformVersionSourceReleases:
+	default:
+		return ErrInvalidTerraformVersionSource
+	}
+
+	return nil
+}
@@ -0,0 +1,635 @@
+//go:build integration
+// +build integration
+
+package tfehelper
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/go-tfe/tfetest"
+)
+
+func TestPromoteState(t *testing.T) {
+	srv := tfetest.NewServer()
+	defer srv.Close()
+
+	client, err := srv.Client()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = client.Organizations.Create(ctx, tfe.OrganizationCreateOptions{
+		Name:  tfe.String("acme"),
+		Email: tfe.String("ops@example.com"),
+	})
+	require.NoError(t, err)
+
+	staging, err := client.Workspaces.Create(ctx, "acme", tfe.WorkspaceCreateOptions{Name: tfe.String("staging")})
+	require.NoError(t, err)
+	production, err := client.Workspaces.Create(ctx, "acme", tfe.WorkspaceCreateOptions{Name: tfe.String("production")})
+	require.NoError(t, err)
+
+	createState(t, client, staging.ID, "staging-lineage", 7, "10.0.0.0/16")
+
+	t.Run("to a workspace without state", func(t *testing.T) {
+		sv, err := PromoteState(ctx, client, staging.ID, production.ID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), sv.Serial)
+
+		ws, err := client.Workspaces.ReadByID(ctx, production.ID)
+		require.NoError(t, err)
+		assert.False(t, ws.Locked)
+	})
+
+	t.Run("to a workspace with state of another lineage", func(t *testing.T) {
+		other, err := client.Workspaces.Create(ctx, "acme", tfe.WorkspaceCreateOptions{Name: tfe.String("other")})
+		require.NoError(t, err)
+		createState(t, client, other.ID, "other-lineage", 12, "10.1.0.0/16")
+
+		sv, err := PromoteState(ctx, client, staging.ID, other.ID, &PromoteStateOptions{LockReason: "Release 1.2"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(13), sv.Serial)
+
+		raw, err := client.StateVersions.Download(ctx, sv.DownloadURL)
+		require.NoError(t, err)
+		var state map[string]interface{}
+		require.NoError(t, json.Unmarshal(raw, &state))
+		assert.Equal(t, "other-lineage", state["lineage"])
+		assert.Equal(t, float64(13), state["serial"])
+		assert.Equal(t, "10.0.0.0/16", state["outputs"].(map[string]interface{})["cidr"])
+	})
+
+	t.Run("from a workspace without state", func(t *testing.T) {
+		empty, err := client.Workspaces.Create(ctx, "acme", tfe.WorkspaceCreateOptions{Name: tfe.String("empty")})
+		require.NoError(t, err)
+		_, err = PromoteState(ctx, client, empty.ID, staging.ID, nil)
+		assert.ErrorIs(t, err, tfe.ErrResourceNotFound)
+	})
+}
+
+func TestWaitForRun(t *testing.T) {
+	srv := tfetest.NewServer()
+	defer srv.Close()
+
+	client, err := srv.Client()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = client.Organizations.Create(ctx, tfe.OrganizationCreateOptions{
+		Name:  tfe.String("acme"),
+		Email: tfe.String("ops@example.com"),
+	})
+	require.NoError(t, err)
+	ws, err := client.Workspaces.Create(ctx, "acme", tfe.WorkspaceCreateOptions{Name: tfe.String("network")})
+	require.NoError(t, err)
+
+	t.Run("with an applied run", func(t *testing.T) {
+		r, err := client.Runs.Create(ctx, tfe.RunCreateOptions{
+			Workspace: ws,
+			AutoApply: tfe.Bool(true),
+		})
+		require.NoError(t, err)
+
+		var statuses []tfe.RunStatus
+		r, err = WaitForRun(ctx, client, r.ID, &WaitForRunOptions{
+			PollInterval: time.Millisecond,
+			OnStatus: func(e *tfe.RunEvent) {
+				statuses = append(statuses, e.Status)
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, tfe.RunApplied, r.Status)
+		assert.Equal(t, []tfe.RunStatus{tfe.RunApplied}, statuses)
+	})
+
+	t.Run("with a discarded run", func(t *testing.T) {
+		r, err := client.Runs.Create(ctx, tfe.RunCreateOptions{Workspace: ws})
+		require.NoError(t, err)
+
+		done := make(chan error, 1)
+		go func() {
+			_, err := WaitForRun(ctx, client, r.ID, &WaitForRunOptions{PollInterval: time.Millisecond})
+			done <- err
+		}()
+
+		require.NoError(t, client.Runs.Discard(ctx, r.ID, tfe.RunDiscardOptions{}))
+		select {
+		case err := <-done:
+			assert.ErrorIs(t, err, ErrRunNotApplied)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the run")
+		}
+	})
+
+	t.Run("when the context is canceled", func(t *testing.T) {
+		r, err := client.Runs.Create(ctx, tfe.RunCreateOptions{Workspace: ws})
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		_, err = WaitForRun(ctx, client, r.ID, &WaitForRunOptions{PollInterval: time.Millisecond})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestReplaceAgentPool(t *testing.T) {
+	srv := tfetest.NewServer()
+	defer srv.Close()
+
+	client, err := srv.Client()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = client.Organizations.Create(ctx, tfe.OrganizationCreateOptions{
+		Name:  tfe.String("acme"),
+		Email: tfe.String("ops@example.com"),
+	})
+	require.NoError(t, err)
+	old, err := client.AgentPools.Create(ctx, "acme", tfe.AgentPoolCreateOptions{Name: tfe.String("agents-v1")})
+	require.NoError(t, err)
+
+	var workspaces []*tfe.Workspace
+	for i := 0; i < 3; i++ {
+		ws, err := client.Workspaces.Create(ctx, "acme", tfe.WorkspaceCreateOptions{
+			Name:          tfe.String(fmt.Sprintf("app-%d", i)),
+			ExecutionMode: tfe.ExecutionMode(tfe.ExecutionModeAgent),
+			AgentPoolID:   tfe.String(old.ID),
+		})
+		require.NoError(t, err)
+		workspaces = append(workspaces, ws)
+	}
+
+	t.Run("without a name", func(t *testing.T) {
+		_, err := ReplaceAgentPool(ctx, client, old.ID, ReplaceAgentPoolOptions{})
+		assert.Equal(t, tfe.ErrRequiredName, err)
+	})
+
+	t.Run("with a run in progress", func(t *testing.T) {
+		r, err := client.Runs.Create(ctx, tfe.RunCreateOptions{Workspace: workspaces[0]})
+		require.NoError(t, err)
+
+		var batches [][]string
+		var waited []string
+		pool, err := ReplaceAgentPool(ctx, client, old.ID, ReplaceAgentPoolOptions{
+			Name:         "agents-v2",
+			BatchSize:    2,
+			PollInterval: time.Millisecond,
+			OnMigrate: func(workspaces []*tfe.Workspace) {
+				var ids []string
+				for _, ws := range workspaces {
+					assert.NotEqual(t, old.ID, ws.AgentPoolID)
+					ids = append(ids, ws.ID)
+				}
+				batches = append(batches, ids)
+			},
+			OnWait: func(runs []*tfe.Run) {
+				for _, r := range runs {
+					waited = append(waited, r.ID)
+				}
+				// The old agents finish the run which was in progress.
+				require.NoError(t, client.Runs.Apply(ctx, r.ID, tfe.RunApplyOptions{}))
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "agents-v2", pool.Name)
+		assert.Equal(t, [][]string{{workspaces[0].ID, workspaces[1].ID}, {workspaces[2].ID}}, batches)
+		assert.Equal(t, []string{r.ID}, waited)
+
+		pool, err = client.AgentPools.ReadWithOptions(ctx, pool.ID, &tfe.AgentPoolReadOptions{
+			Include: []tfe.AgentPoolIncludeOpt{tfe.AgentPoolWorkspaces},
+		})
+		require.NoError(t, err)
+		assert.Len(t, pool.Workspaces, 3)
+
+		_, err = client.AgentPools.Read(ctx, old.ID)
+		assert.Equal(t, tfe.ErrResourceNotFound, err)
+	})
+}
+
+func TestArchiveRunLogs(t *testing.T) {
+	var failPolicyCheck bool
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/runs/run-1":
+			fmt.Fprint(w, `{"data":{"id":"run-1","type":"runs","attributes":{"status":"policy_checked"},"relationships":{"plan":{"data":{"id":"plan-1","type":"plans"}},"workspace":{"data":{"id":"ws-1","type":"workspaces"}}}},"included":[{"id":"plan-1","type":"plans","attributes":{"status":"finished","log-read-url":"`+ts.URL+`/logs/plan-1"}}]}`)
+		case "/api/v2/plans/plan-1":
+			fmt.Fprint(w, `{"data":{"id":"plan-1","type":"plans","attributes":{"status":"finished","log-read-url":"`+ts.URL+`/logs/plan-1"}}}`)
+		case "/logs/plan-1":
+			logs := "\x02Plan: 1 to add\x03"
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			if offset < len(logs) {
+				fmt.Fprint(w, logs[offset:])
+			}
+		case "/api/v2/runs/run-1/policy-checks":
+			// Each page holds a single policy check.
+			if r.URL.Query().Get("page[number]") == "2" {
+				fmt.Fprint(w, `{"data":[{"id":"polchk-2","type":"policy-checks","attributes":{"status":"passed"}}],"meta":{"pagination":{"current-page":2,"prev-page":1,"total-pages":2,"total-count":2}}}`)
+				return
+			}
+			fmt.Fprint(w, `{"data":[{"id":"polchk-1","type":"policy-checks","attributes":{"status":"passed"}}],"meta":{"pagination":{"current-page":1,"next-page":2,"total-pages":2,"total-count":2}}}`)
+		case "/api/v2/policy-checks/polchk-1", "/api/v2/policy-checks/polchk-2":
+			id := strings.TrimPrefix(r.URL.Path, "/api/v2/policy-checks/")
+			fmt.Fprintf(w, `{"data":{"id":%q,"type":"policy-checks","attributes":{"status":"passed"}}}`, id)
+		case "/api/v2/policy-checks/polchk-1/output":
+			fmt.Fprint(w, "policy 1 passed")
+		case "/api/v2/policy-checks/polchk-2/output":
+			if failPolicyCheck {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			fmt.Fprint(w, "policy 2 passed")
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := tfe.NewClient(&tfe.Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("into a directory", func(t *testing.T) {
+		dst := filepath.Join(t.TempDir(), "logs")
+
+		err := ArchiveRunLogs(ctx, client, "run-1", dst)
+		require.NoError(t, err)
+
+		data, err := os.ReadFile(filepath.Join(dst, RunLogsMetadataFile))
+		require.NoError(t, err)
+
+		meta := &RunLogsArchiveMetadata{}
+		require.NoError(t, json.Unmarshal(data, meta))
+		assert.Equal(t, "run-1", meta.RunID)
+		assert.Equal(t, "ws-1", meta.WorkspaceID)
+		require.Len(t, meta.Logs, 3)
+		assert.Equal(t, "plan", meta.Logs[0].Kind)
+		assert.Equal(t, "policy-checks/polchk-2.log", meta.Logs[2].Path)
+
+		plan, err := os.ReadFile(filepath.Join(dst, "plan.log"))
+		require.NoError(t, err)
+		assert.Equal(t, "Plan: 1 to add", string(plan))
+	})
+
+	t.Run("into a zip archive", func(t *testing.T) {
+		dst := filepath.Join(t.TempDir(), "archives", "logs.zip")
+
+		err := ArchiveRunLogs(ctx, client, "run-1", dst)
+		require.NoError(t, err)
+
+		zr, err := zip.OpenReader(dst)
+		require.NoError(t, err)
+		defer zr.Close()
+
+		var names []string
+		for _, f := range zr.File {
+			names = append(names, f.Name)
+		}
+		assert.Equal(t, []string{"plan.log", "policy-checks/polchk-1.log", "policy-checks/polchk-2.log", RunLogsMetadataFile}, names)
+	})
+
+	t.Run("removes the partial archive on failure", func(t *testing.T) {
+		failPolicyCheck = true
+		defer func() { failPolicyCheck = false }()
+
+		root := t.TempDir()
+		existing := filepath.Join(root, "existing")
+		require.NoError(t, os.Mkdir(existing, 0o755))
+
+		err := ArchiveRunLogs(ctx, client, "run-1", filepath.Join(root, "new", "logs"))
+		assert.ErrorIs(t, err, tfe.ErrResourceNotFound)
+		assert.NoDirExists(t, filepath.Join(root, "new"))
+
+		err = ArchiveRunLogs(ctx, client, "run-1", existing)
+		assert.ErrorIs(t, err, tfe.ErrResourceNotFound)
+		entries, err := os.ReadDir(existing)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+
+		err = ArchiveRunLogs(ctx, client, "run-1", filepath.Join(root, "logs.zip"))
+		assert.ErrorIs(t, err, tfe.ErrResourceNotFound)
+		assert.NoFileExists(t, filepath.Join(root, "logs.zip"))
+	})
+
+	t.Run("keeps an earlier archive on failure", func(t *testing.T) {
+		root := t.TempDir()
+		dir := filepath.Join(root, "logs")
+		zipped := filepath.Join(root, "logs.zip")
+		require.NoError(t, ArchiveRunLogs(ctx, client, "run-1", dir))
+		require.NoError(t, ArchiveRunLogs(ctx, client, "run-1", zipped))
+		before, err := os.ReadFile(zipped)
+		require.NoError(t, err)
+
+		failPolicyCheck = true
+		defer func() { failPolicyCheck = false }()
+
+		err = ArchiveRunLogs(ctx, client, "run-1", dir)
+		assert.ErrorIs(t, err, tfe.ErrResourceNotFound)
+		plan, err := os.ReadFile(filepath.Join(dir, "plan.log"))
+		require.NoError(t, err)
+		assert.Equal(t, "Plan: 1 to add", string(plan))
+		assert.FileExists(t, filepath.Join(dir, RunLogsMetadataFile))
+
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		for _, e := range entries {
+			assert.False(t, strings.HasPrefix(e.Name(), "."), "temporary file %s left behind", e.Name())
+		}
+
+		err = ArchiveRunLogs(ctx, client, "run-1", zipped)
+		assert.ErrorIs(t, err, tfe.ErrResourceNotFound)
+		after, err := os.ReadFile(zipped)
+		require.NoError(t, err)
+		assert.Equal(t, before, after)
+
+		entries, err = os.ReadDir(root)
+		require.NoError(t, err)
+		assert.Len(t, entries, 2)
+	})
+
+	t.Run("without a destination", func(t *testing.T) {
+		err := ArchiveRunLogs(ctx, client, "run-1", "")
+		assert.Equal(t, ErrRequiredArchiveDestination, err)
+	})
+}
+
+// createState creates a state version with a single output in the workspace.
+func TestAdviseTerraformUpgrades(t *testing.T) {
+	var updates []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch {
+		case r.URL.Path == "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/api/v2/admin/terraform-versions":
+			fmt.Fprint(w, `{"data":[`+
+				`{"id":"tool-1","type":"terraform-versions","attributes":{"version":"1.4.6","enabled":true}},`+
+				`{"id":"tool-2","type":"terraform-versions","attributes":{"version":"1.5.1","enabled":true}},`+
+				`{"id":"tool-3","type":"terraform-versions","attributes":{"version":"1.6.0","enabled":false}},`+
+				`{"id":"tool-4","type":"terraform-versions","attributes":{"version":"1.4.5","enabled":true,"deprecated":true}}`+
+				`],"meta":{"pagination":{"current-page":1,"total-pages":1}}}`)
+		case r.URL.Path == "/terraform/index.json":
+			assert.Empty(t, r.Header.Get("Authorization"))
+			fmt.Fprint(w, `{"name":"terraform","versions":{"1.4.6":{},"1.5.0-beta1":{},"1.5.1":{},"1.4.5":{}}}`)
+		case r.URL.Path == "/api/v2/organizations/acme/workspaces":
+			fmt.Fprint(w, `{"data":[`+
+				`{"id":"ws-patch","type":"workspaces","attributes":{"name":"patch","terraform-version":"1.4.5"}},`+
+				`{"id":"ws-current","type":"workspaces","attributes":{"name":"current","terraform-version":"1.5.1"}},`+
+				`{"id":"ws-legacy","type":"workspaces","attributes":{"name":"legacy","terraform-version":"0.15.5"}},`+
+				`{"id":"ws-constraint","type":"workspaces","attributes":{"name":"constraint","terraform-version":"~> 1.4.0"}},`+
+				`{"id":"ws-range","type":"workspaces","attributes":{"name":"range","terraform-version":">= 1.3.0, < 1.5.0"}}`+
+				`],"meta":{"pagination":{"current-page":1,"total-pages":1}}}`)
+		case r.Method == "PATCH" && strings.HasPrefix(r.URL.Path, "/api/v2/workspaces/"):
+			var payload struct {
+				Data struct {
+					Attributes map[string]interface{} `json:"attributes"`
+				} `json:"data"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+			version := payload.Data.Attributes["terraform-version"]
+
+			id := strings.TrimPrefix(r.URL.Path, "/api/v2/workspaces/")
+			updates = append(updates, fmt.Sprintf("%s=%s", id, version))
+			fmt.Fprintf(w, `{"data":{"id":%q,"type":"workspaces","attributes":{"terraform-version":%q}}}`, id, version)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	defer func(url string) { terraformReleasesURL = url }(terraformReleasesURL)
+	terraformReleasesURL = ts.URL + "/terraform/index.json"
+
+	client, err := tfe.NewClient(&tfe.Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("when only advising upgrades", func(t *testing.T) {
+		advice, err := AdviseTerraformUpgrades(ctx, client, "acme", TerraformUpgradeOptions{})
+		require.NoError(t, err)
+		require.Len(t, advice, 5)
+
+		assert.Equal(t, "1.5.1", advice[0].Latest)
+		assert.Equal(t, TerraformUpgradeMinor, advice[0].Risk)
+		assert.Equal(t, "1.4.6", advice[0].Recommended)
+
+		assert.Equal(t, TerraformUpgradeNone, advice[1].Risk)
+		assert.Empty(t, advice[1].Recommended)
+
+		assert.Equal(t, TerraformUpgradeMajor, advice[2].Risk)
+		assert.Empty(t, advice[2].Recommended)
+
+		assert.True(t, advice[3].Constraint)
+		assert.Equal(t, TerraformUpgradeMinor, advice[3].Risk)
+		assert.Empty(t, advice[3].Recommended)
+
+		assert.True(t, advice[4].Constraint)
+		assert.Empty(t, advice[4].Recommended)
+
+		assert.Empty(t, updates)
+	})
+
+	t.Run("with the releases source", func(t *testing.T) {
+		advice, err := AdviseTerraformUpgrades(ctx, client, "acme", TerraformUpgradeOptions{
+			Source:     TerraformVersionSourceReleases,
+			HTTPClient: ts.Client(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "1.5.1", advice[0].Latest)
+		assert.Equal(t, "1.4.6", advice[0].Recommended)
+	})
+
+	t.Run("with explicit versions and a higher maximum risk", func(t *testing.T) {
+		advice, err := AdviseTerraformUpgrades(ctx, client, "acme", TerraformUpgradeOptions{
+			Versions: []string{"1.4.6", "1.5.1"},
+			MaxRisk:  TerraformUpgradeMinor,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "1.5.1", advice[0].Recommended)
+		assert.Empty(t, advice[2].Recommended)
+		assert.Equal(t, "~> 1.5.0", advice[3].Recommended)
+		assert.Empty(t, advice[4].Recommended)
+	})
+
+	t.Run("when applying upgrades", func(t *testing.T) {
+		advice, err := AdviseTerraformUpgrades(ctx, client, "acme", TerraformUpgradeOptions{
+			Apply:   true,
+			MaxRisk: TerraformUpgradeMinor,
+		})
+		require.NoError(t, err)
+		assert.True(t, advice[0].Applied)
+		assert.Equal(t, "1.5.1", advice[0].Workspace.TerraformVersion)
+		assert.True(t, advice[3].Applied)
+		assert.Equal(t, "~> 1.5.0", advice[3].Workspace.TerraformVersion)
+		assert.False(t, advice[4].Applied)
+		assert.Equal(t, []string{"ws-patch=1.5.1", "ws-constraint=~> 1.5.0"}, updates)
+	})
+
+	t.Run("without available versions", func(t *testing.T) {
+		_, err := AdviseTerraformUpgrades(ctx, client, "acme", TerraformUpgradeOptions{
+			Versions: []string{"1.6.0-alpha"},
+		})
+		assert.Equal(t, ErrRequiredTerraformVersions, err)
+	})
+
+	t.Run("with an invalid maximum risk", func(t *testing.T) {
+		_, err := AdviseTerraformUpgrades(ctx, client, "acme", TerraformUpgradeOptions{MaxRisk: "huge"})
+		assert.Equal(t, ErrInvalidTerraformUpgradeRisk, err)
+	})
+
+	t.Run("with an invalid organization", func(t *testing.T) {
+		_, err := AdviseTerraformUpgrades(ctx, client, "", TerraformUpgradeOptions{})
+		assert.Equal(t, tfe.ErrInvalidOrg, err)
+	})
+}
+
+func TestLintOrganization(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/organizations/acme/workspaces":
+			fmt.Fprint(w, `{"data":[`+
+				`{"id":"ws-app","type":"workspaces","attributes":{"name":"app"}},`+
+				`{"id":"ws-new","type":"workspaces","attributes":{"name":"new"}}]}`)
+		case "/api/v2/workspaces/ws-app/current-state-version":
+			fmt.Fprint(w, `{"data":{"id":"sv-1","type":"state-versions","attributes":{"resources-processed":true,"resources":[`+
+				`{"name":"web","type":"aws_instance","module":"root","provider":"provider[\"registry.terraform.io/hashicorp/aws\"]"},`+
+				`{"name":"web","type":"aws_instance","module":"root","provider":"provider[\"registry.terraform.io/hashicorp/aws\"].east"},`+
+				`{"name":"db","type":"shady_database","module":"module.db","provider":"provider[\"registry.terraform.io/shady/shady\"]"}]}}}`)
+		case "/api/v2/workspaces/ws-new/current-state-version":
+			w.WriteHeader(http.StatusNotFound)
+		case "/api/v2/organizations/acme/registry-modules":
+			fmt.Fprint(w, `{"data":[{"id":"mod-1","type":"registry-modules","attributes":{"name":"vpc","provider":"aws","version-statuses":[`+
+				`{"version":"1.0.0","status":"ok"},`+
+				`{"version":"1.1.0","status":"reg_ingress_failed"},`+
+				`{"version":"2.0.0","status":"ok"}]}}]}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := tfe.NewClient(&tfe.Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	modules := map[string][]*ModuleUsage{
+		"app": {
+			{Source: "app.terraform.io/acme/vpc/aws", Version: "2.0.0"},
+			{Source: "terraform-aws-modules/s3-bucket/aws", Version: "3.0.0"},
+		},
+		"new": {
+			{Source: "app.terraform.io/acme/vpc/aws", Version: "1.1.0"},
+			{Source: "app.terraform.io/acme/vpc/aws", Version: "0.9.0"},
+			{Source: "app.terraform.io/acme/vpc/aws", Version: "1.0.0"},
+		},
+	}
+
+	t.Run("with the provider and module rules", func(t *testing.T) {
+		findings, err := LintOrganization(ctx, client, "acme", LintOptions{
+			Rules: []LintRule{
+				&ProviderAllowlistRule{Allowed: []string{"hashicorp/aws"}},
+				&DeprecatedModuleVersionRule{Deprecated: map[string][]string{
+					"acme/vpc/aws": {"1.0.0"},
+				}},
+			},
+			ModuleUsage: func(ctx context.Context, w *tfe.Workspace) ([]*ModuleUsage, error) {
+				return modules[w.Name], nil
+			},
+		})
+		require.NoError(t, err)
+
+		var got []string
+		for _, f := range findings {
+			got = append(got, fmt.Sprintf("%s %s %s %s", f.Workspace, f.Rule, f.Severity, f.Subject))
+		}
+		assert.Equal(t, []string{
+			"app provider-allowlist error registry.terraform.io/shady/shady",
+			"new deprecated-module-version warning app.terraform.io/acme/vpc/aws@1.1.0",
+			"new deprecated-module-version warning app.terraform.io/acme/vpc/aws@0.9.0",
+			"new deprecated-module-version warning app.terraform.io/acme/vpc/aws@1.0.0",
+		}, got)
+		assert.Equal(t, `module version app.terraform.io/acme/vpc/aws@1.1.0 has status "reg_ingress_failed" in the private registry`, findings[1].Message)
+	})
+
+	t.Run("without module usage", func(t *testing.T) {
+		findings, err := LintOrganization(ctx, client, "acme", LintOptions{
+			Rules: []LintRule{&DeprecatedModuleVersionRule{}},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, findings)
+	})
+
+	t.Run("without rules", func(t *testing.T) {
+		_, err := LintOrganization(ctx, client, "acme", LintOptions{})
+		assert.Equal(t, ErrRequiredLintRules, err)
+	})
+
+	t.Run("without a valid organization", func(t *testing.T) {
+		_, err := LintOrganization(ctx, client, "", LintOptions{
+			Rules: []LintRule{&ProviderAllowlistRule{}},
+		})
+		assert.Equal(t, tfe.ErrInvalidOrg, err)
+	})
+}
+
+func createState(t *testing.T, client *tfe.Client, workspaceID, lineage string, serial int64, cidr string) {
+	ctx := context.Background()
+	state := []byte(fmt.Sprintf(
+		`{"version": 4, "lineage": %q, "serial": %d, "outputs": {"cidr": %q}}`, lineage, serial, cidr))
+
+	_, err := client.Workspaces.Lock(ctx, workspaceID, tfe.WorkspaceLockOptions{})
+	require.NoError(t, err)
+	defer func() {
+		_, err := client.Workspaces.Unlock(ctx, workspaceID)
+		require.NoError(t, err)
+	}()
+
+	_, err = client.StateVersions.Create(ctx, workspaceID, tfe.StateVersionCreateOptions{
+		Lineage: tfe.String(lineage),
+		MD5:     tfe.String(fmt.Sprintf("%x", md5.Sum(state))),
+		Serial:  tfe.Int64(serial),
+		State:   tfe.String(base64.StdEncoding.EncodeToString(state)),
+	})
+	require.NoError(t, err)
+}
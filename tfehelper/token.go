@@ -0,0 +1,34 @@
+package tfehelper
+
+import (
+	"context"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// RotateTeamToken creates a new token for the team, which replaces and
+// revokes its current token. The new token expires after the given duration,
+// or never when it is zero.
+func RotateTeamToken(ctx context.Context, client *tfe.Client, teamID string, expiresIn time.Duration) (*tfe.TeamToken, error) {
+	return client.TeamTokens.CreateWithOptions(ctx, teamID, tfe.TeamTokenCreateOptions{
+		ExpiredAt: expiry(expiresIn),
+	})
+}
+
+// RotateOrganizationToken creates a new token for the organization, which
+// replaces and revokes its current token. The new token expires after the
+// given duration, or never when it is zero.
+func RotateOrganizationToken(ctx context.Context, client *tfe.Client, organization string, expiresIn time.Duration) (*tfe.OrganizationToken, error) {
+	return client.OrganizationTokens.CreateWithOptions(ctx, organization, tfe.OrganizationTokenCreateOptions{
+		ExpiredAt: expiry(expiresIn),
+	})
+}
+
+func expiry(expiresIn time.Duration) *time.Time {
+	if expiresIn <= 0 {
+		return nil
+	}
+	expiredAt := time.Now().Add(expiresIn).UTC()
+	return &expiredAt
+}
@@ -0,0 +1,156 @@
+package tfetest
+
+import (
+	"net/http"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// organizationAgentPoolsRoute routes the agent pool endpoints of an
+// organization.
+func (s *Server) organizationAgentPoolsRoute(r *http.Request, path []string) route {
+	switch {
+	case len(path) == 3 && r.Method == http.MethodGet:
+		return s.listAgentPools
+	case len(path) == 3 && r.Method == http.MethodPost:
+		return s.createAgentPool
+	}
+	return nil
+}
+
+func (s *Server) agentPoolsRoute(r *http.Request, path []string) route {
+	switch {
+	case len(path) == 2 && r.Method == http.MethodGet:
+		return s.readAgentPool
+	case len(path) == 2 && r.Method == http.MethodPatch:
+		return s.updateAgentPool
+	case len(path) == 2 && r.Method == http.MethodDelete:
+		return s.deleteAgentPool
+	}
+	return nil
+}
+
+func (s *Server) listAgentPools(w http.ResponseWriter, r *http.Request, path []string) {
+	if _, ok := s.organizations[path[1]]; !ok {
+		writeError(w, http.StatusNotFound, "organization not found")
+		return
+	}
+
+	pools := make(map[string]*tfe.AgentPool)
+	for id, pool := range s.agentPools {
+		if pool.Organization.Name == path[1] {
+			pools[id] = s.withAgentPoolWorkspaces(pool)
+		}
+	}
+
+	writeList(w, r, sortedModels(pools, func(a, b interface{}) bool {
+		return a.(*tfe.AgentPool).Name < b.(*tfe.AgentPool).Name
+	}))
+}
+
+func (s *Server) createAgentPool(w http.ResponseWriter, r *http.Request, path []string) {
+	if _, ok := s.organizations[path[1]]; !ok {
+		writeError(w, http.StatusNotFound, "organization not found")
+		return
+	}
+
+	doc, err := decodeDocument(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pool := &tfe.AgentPool{
+		ID:           s.newID("apool"),
+		Organization: &tfe.Organization{Name: path[1]},
+	}
+	if err := applyAttributes(pool, doc.Data.Attributes); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	if pool.Name == "" {
+		writeError(w, http.StatusUnprocessableEntity, "Name can't be blank")
+		return
+	}
+	s.agentPools[pool.ID] = pool
+
+	writeModel(w, http.StatusCreated, s.withAgentPoolWorkspaces(pool))
+}
+
+func (s *Server) readAgentPool(w http.ResponseWriter, _ *http.Request, path []string) {
+	pool, ok := s.agentPools[path[1]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "agent pool not found")
+		return
+	}
+	writeModel(w, http.StatusOK, s.withAgentPoolWorkspaces(pool))
+}
+
+func (s *Server) updateAgentPool(w http.ResponseWriter, r *http.Request, path []string) {
+	pool, ok := s.agentPools[path[1]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "agent pool not found")
+		return
+	}
+
+	doc, err := decodeDocument(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	updated := *pool
+	if err := applyAttributes(&updated, doc.Data.Attributes); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	s.agentPools[pool.ID] = &updated
+
+	writeModel(w, http.StatusOK, s.withAgentPoolWorkspaces(&updated))
+}
+
+func (s *Server) deleteAgentPool(w http.ResponseWriter, _ *http.Request, path []string) {
+	pool, ok := s.agentPools[path[1]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "agent pool not found")
+		return
+	}
+	if len(s.withAgentPoolWorkspaces(pool).Workspaces) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, "Agent pool is still in use by workspaces")
+		return
+	}
+	delete(s.agentPools, pool.ID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// withAgentPoolWorkspaces returns a copy of the agent pool referencing the
+// workspaces which are assigned to it.
+func (s *Server) withAgentPoolWorkspaces(pool *tfe.AgentPool) *tfe.AgentPool {
+	workspaces := make(map[string]*tfe.Workspace)
+	for id, ws := range s.workspaces {
+		if ws.AgentPoolID == pool.ID {
+			workspaces[id] = ws
+		}
+	}
+
+	result := *pool
+	result.Workspaces = nil
+	for _, ws := range sortedModels(workspaces, func(a, b interface{}) bool {
+		return a.(*tfe.Workspace).ID < b.(*tfe.Workspace).ID
+	}) {
+		result.Workspaces = append(result.Workspaces, &tfe.Workspace{ID: ws.(*tfe.Workspace).ID})
+	}
+
+	return &result
+}
+
+// validAgentPool reports whether the workspace may be assigned to the agent
+// pool, which must belong to the organization of the workspace.
+func (s *Server) validAgentPool(ws *tfe.Workspace) bool {
+	if ws.AgentPoolID == "" {
+		return true
+	}
+	pool, ok := s.agentPools[ws.AgentPoolID]
+	return ok && pool.Organization.Name == ws.Organization.Name
+}
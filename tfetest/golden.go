@@ -0,0 +1,221 @@
+package tfetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// UpdateGoldenEnv is the environment variable which makes AssertGolden write
+// the golden files instead of comparing against them, when it is set to a
+// non-empty value.
+const UpdateGoldenEnv = "TFETEST_UPDATE_GOLDEN"
+
+// LoadFixture returns the content of a fixture file, failing the test when
+// it can not be read.
+func LoadFixture(t testing.TB, path string) []byte {
+	t.Helper()
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("loading fixture: %v", err)
+	}
+
+	return b
+}
+
+// AssertGolden compares a JSON document with the golden file. The documents
+// are compared semantically, so formatting and the order of object keys do
+// not matter. When the UpdateGoldenEnv environment variable is set, the
+// golden file is written with the indented document instead.
+func AssertGolden(t testing.TB, path string, got []byte) {
+	t.Helper()
+
+	if os.Getenv(UpdateGoldenEnv) != "" {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, got, "", "  "); err != nil {
+			t.Fatalf("updating golden file %s: %v", path, err)
+		}
+		buf.WriteByte('\n')
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("updating golden file %s: %v", path, err)
+		}
+		if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+			t.Fatalf("updating golden file %s: %v", path, err)
+		}
+		return
+	}
+
+	want := LoadFixture(t, path)
+	if diff := diffJSON(want, got); diff != "" {
+		t.Errorf("document does not match the golden file %s:\n%s", path, diff)
+	}
+}
+
+// diffJSON compares two JSON documents semantically, and describes their
+// difference. It returns an empty string when they are equal.
+func diffJSON(want, got []byte) string {
+	var w, g interface{}
+	if err := json.Unmarshal(want, &w); err != nil {
+		return "invalid golden JSON: " + err.Error()
+	}
+	if err := json.Unmarshal(got, &g); err != nil {
+		return "invalid JSON: " + err.Error()
+	}
+	if reflect.DeepEqual(w, g) {
+		return ""
+	}
+
+	wb, _ := json.MarshalIndent(w, "", "  ")
+	gb, _ := json.MarshalIndent(g, "", "  ")
+	return "want:\n" + string(wb) + "\ngot:\n" + string(gb)
+}
+
+// RecordedRequest is a request received by a Recorder.
+type RecordedRequest struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// AssertRequest checks the method and path of a recorded request, and
+// compares its body with the golden file using AssertGolden. A request
+// without a body is expected when the golden file is empty.
+func AssertRequest(t testing.TB, req *RecordedRequest, method, path, golden string) {
+	t.Helper()
+
+	if req == nil {
+		t.Fatalf("expected a %s %s request, got none", method, path)
+	}
+	if req.Method != method || req.Path != path {
+		t.Errorf("expected a %s %s request, got %s %s", method, path, req.Method, req.Path)
+	}
+
+	switch {
+	case golden != "":
+		AssertGolden(t, golden, req.Body)
+	case len(req.Body) > 0:
+		t.Errorf("expected %s %s without a body, got:\n%s", method, path, req.Body)
+	}
+}
+
+// Recorder is a test server which serves fixtures as the responses of the
+// API, and records the requests it receives. Use it to verify the requests
+// made by code built on go-tfe, and how it handles the responses, against
+// golden payloads. Unlike Server, it has no state.
+type Recorder struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	responses map[string]*recordedResponse
+	requests  []*RecordedRequest
+}
+
+// recordedResponse is a response served by a Recorder.
+type recordedResponse struct {
+	status int
+	body   []byte
+}
+
+// NewRecorder starts and returns a new recorder. The caller should call
+// Close when finished, to shut it down.
+func NewRecorder() *Recorder {
+	r := &Recorder{
+		responses: make(map[string]*recordedResponse),
+	}
+	r.Server = httptest.NewServer(http.HandlerFunc(r.serveHTTP))
+
+	return r
+}
+
+// Client returns a go-tfe client configured to use the recorder.
+func (r *Recorder) Client() (*tfe.Client, error) {
+	return tfe.NewClient(&tfe.Config{
+		Address:    r.URL,
+		Token:      Token,
+		HTTPClient: r.Server.Client(),
+		RetryMax:   -1,
+	})
+}
+
+// Handle serves the body with the status code for the requests with the
+// method and path, which is relative to the API base path, e.g.
+// "organizations/acme/workspaces". Requests without a response are answered
+// with 404 Not Found.
+func (r *Recorder) Handle(method, path string, status int, body []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.responses[method+" "+strings.Trim(path, "/")] = &recordedResponse{
+		status: status,
+		body:   body,
+	}
+}
+
+// Requests returns the requests received so far, in the order they were
+// received. The requests made to the ping endpoint are not recorded.
+func (r *Recorder) Requests() []*RecordedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*RecordedRequest(nil), r.requests...)
+}
+
+// LastRequest returns the last request received, or nil if there was none.
+func (r *Recorder) LastRequest() *RecordedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.requests) == 0 {
+		return nil
+	}
+	return r.requests[len(r.requests)-1]
+}
+
+func (r *Recorder) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	path := strings.Trim(strings.TrimPrefix(req.URL.Path, basePath), "/")
+	if path == "ping" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.requests = append(r.requests, &RecordedRequest{
+		Method: req.Method,
+		Path:   path,
+		Query:  req.URL.Query(),
+		Header: req.Header.Clone(),
+		Body:   body,
+	})
+
+	resp, ok := r.responses[req.Method+" "+path]
+	if !ok {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/vnd.api+json")
+	w.WriteHeader(resp.status)
+	_, _ = w.Write(resp.body)
+}
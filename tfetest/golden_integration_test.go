@@ -0,0 +1,71 @@
+//go:build integration
+// +build integration
+
+package tfetest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// fakeTB records the failures of the assertions under test.
+type fakeTB struct {
+	testing.TB
+	errors []string
+}
+
+func (t *fakeTB) Helper() {}
+
+func (t *fakeTB) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func TestRecorder(t *testing.T) {
+	rec := NewRecorder()
+	defer rec.Close()
+
+	rec.Handle("POST", "organizations/acme/workspaces", http.StatusCreated, LoadFixture(t, "testdata/workspace.json"))
+
+	client, err := rec.Client()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	ws, err := client.Workspaces.Create(ctx, "acme", tfe.WorkspaceCreateOptions{
+		Name:      tfe.String("network"),
+		AutoApply: tfe.Bool(true),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ws-network", ws.ID)
+	assert.Equal(t, "acme", ws.Organization.Name)
+
+	AssertRequest(t, rec.LastRequest(), "POST", "organizations/acme/workspaces", "testdata/workspace_create_request.json")
+
+	t.Run("when the payload differs", func(t *testing.T) {
+		// Compare instead of updating the golden file.
+		t.Setenv(UpdateGoldenEnv, "")
+
+		fake := &fakeTB{TB: t}
+		AssertGolden(fake, "testdata/workspace_create_request.json", []byte(`{"data":{"type":"workspaces"}}`))
+		require.Len(t, fake.errors, 1)
+		assert.Contains(t, fake.errors[0], "does not match the golden file")
+
+		fake = &fakeTB{TB: t}
+		AssertRequest(fake, rec.LastRequest(), "PATCH", "organizations/acme/workspaces", "testdata/workspace_create_request.json")
+		assert.Len(t, fake.errors, 1)
+	})
+
+	t.Run("without a response", func(t *testing.T) {
+		_, err := client.Workspaces.Read(ctx, "acme", "network")
+		assert.Equal(t, tfe.ErrResourceNotFound, err)
+
+		AssertRequest(t, rec.LastRequest(), "GET", "organizations/acme/workspaces/network", "")
+		assert.Len(t, rec.Requests(), 2)
+	})
+}
@@ -0,0 +1,159 @@
+package tfetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+func (s *Server) organizationsRoute(r *http.Request, path []string) route {
+	switch {
+	case len(path) == 1 && r.Method == http.MethodGet:
+		return s.listOrganizations
+	case len(path) == 1 && r.Method == http.MethodPost:
+		return s.createOrganization
+	case len(path) == 2 && r.Method == http.MethodGet:
+		return s.readOrganization
+	case len(path) == 2 && r.Method == http.MethodPatch:
+		return s.updateOrganization
+	case len(path) == 2 && r.Method == http.MethodDelete:
+		return s.deleteOrganization
+	case len(path) >= 3 && path[2] == "workspaces":
+		return s.organizationWorkspacesRoute(r, path)
+	case len(path) >= 3 && path[2] == "agent-pools":
+		return s.organizationAgentPoolsRoute(r, path)
+	}
+	return nil
+}
+
+func (s *Server) listOrganizations(w http.ResponseWriter, r *http.Request, _ []string) {
+	writeList(w, r, sortedModels(s.organizations, func(a, b interface{}) bool {
+		return a.(*tfe.Organization).Name < b.(*tfe.Organization).Name
+	}))
+}
+
+func (s *Server) createOrganization(w http.ResponseWriter, r *http.Request, _ []string) {
+	doc, err := decodeDocument(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	org := &tfe.Organization{
+		CollaboratorAuthPolicy: tfe.AuthPolicyPassword,
+		CreatedAt:              time.Now().UTC(),
+		SessionRemember:        20160,
+		SessionTimeout:         20160,
+		Permissions: &tfe.OrganizationPermissions{
+			CanCreateTeam:      true,
+			CanCreateWorkspace: true,
+			CanDestroy:         true,
+			CanUpdate:          true,
+		},
+	}
+	if err := applyOrganizationAttributes(org, doc.Data.Attributes); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	if org.Name == "" || org.Email == "" {
+		writeError(w, http.StatusUnprocessableEntity, "name and email are required")
+		return
+	}
+	if _, ok := s.organizations[org.Name]; ok {
+		writeError(w, http.StatusUnprocessableEntity, "Name has already been taken")
+		return
+	}
+	s.organizations[org.Name] = org
+
+	writeModel(w, http.StatusCreated, org)
+}
+
+func (s *Server) readOrganization(w http.ResponseWriter, _ *http.Request, path []string) {
+	org, ok := s.organizations[path[1]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "organization not found")
+		return
+	}
+	writeModel(w, http.StatusOK, org)
+}
+
+func (s *Server) updateOrganization(w http.ResponseWriter, r *http.Request, path []string) {
+	org, ok := s.organizations[path[1]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "organization not found")
+		return
+	}
+
+	doc, err := decodeDocument(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	updated := *org
+	if err := applyOrganizationAttributes(&updated, doc.Data.Attributes); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	if updated.Name != org.Name {
+		if _, ok := s.organizations[updated.Name]; ok {
+			writeError(w, http.StatusUnprocessableEntity, "Name has already been taken")
+			return
+		}
+		s.renameOrganization(org.Name, updated.Name)
+	}
+	s.organizations[updated.Name] = &updated
+
+	writeModel(w, http.StatusOK, &updated)
+}
+
+func (s *Server) deleteOrganization(w http.ResponseWriter, _ *http.Request, path []string) {
+	if _, ok := s.organizations[path[1]]; !ok {
+		writeError(w, http.StatusNotFound, "organization not found")
+		return
+	}
+
+	for id, ws := range s.workspaces {
+		if ws.Organization.Name == path[1] {
+			s.removeWorkspace(id)
+		}
+	}
+	for id, pool := range s.agentPools {
+		if pool.Organization.Name == path[1] {
+			delete(s.agentPools, id)
+		}
+	}
+	delete(s.organizations, path[1])
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// applyOrganizationAttributes sets the attributes of the organization,
+// including its name which is not an attribute of the model but its ID.
+func applyOrganizationAttributes(org *tfe.Organization, attrs map[string]json.RawMessage) error {
+	if raw, ok := attrs["name"]; ok {
+		if err := json.Unmarshal(raw, &org.Name); err != nil {
+			return fmt.Errorf("invalid value for attribute %q: %w", "name", err)
+		}
+	}
+	return applyAttributes(org, attrs)
+}
+
+// renameOrganization moves the organization and its workspaces to the new
+// name.
+func (s *Server) renameOrganization(from, to string) {
+	delete(s.organizations, from)
+	for _, ws := range s.workspaces {
+		if ws.Organization.Name == from {
+			ws.Organization = &tfe.Organization{Name: to}
+		}
+	}
+	for _, pool := range s.agentPools {
+		if pool.Organization.Name == from {
+			pool.Organization = &tfe.Organization{Name: to}
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package tfetest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+func (s *Server) runsRoute(r *http.Request, path []string) route {
+	switch {
+	case len(path) == 1 && r.Method == http.MethodPost:
+		return s.createRun
+	case len(path) == 2 && r.Method == http.MethodGet:
+		return s.readRun
+	case len(path) == 4 && path[2] == "actions" && r.Method == http.MethodPost:
+		return s.runActionRoute(path[3])
+	}
+	return nil
+}
+
+func (s *Server) listRuns(w http.ResponseWriter, r *http.Request, path []string) {
+	if _, ok := s.workspaces[path[1]]; !ok {
+		writeError(w, http.StatusNotFound, "workspace not found")
+		return
+	}
+
+	status := r.URL.Query().Get("filter[status]")
+	group := r.URL.Query().Get("filter[status_group]")
+
+	runs := make(map[string]*tfe.Run)
+	for id, run := range s.runs {
+		if run.Workspace.ID != path[1] {
+			continue
+		}
+		if status != "" && !containsString(strings.Split(status, ","), string(run.Status)) {
+			continue
+		}
+		if group != "" && !inRunStatusGroup(run.Status, group) {
+			continue
+		}
+		runs[id] = run
+	}
+
+	// Runs are listed newest first.
+	writeList(w, r, sortedModels(runs, func(a, b interface{}) bool {
+		return a.(*tfe.Run).ID > b.(*tfe.Run).ID
+	}))
+}
+
+func (s *Server) createRun(w http.ResponseWriter, r *http.Request, _ []string) {
+	doc, err := decodeDocument(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ws, ok := s.workspaces[doc.relationshipID("workspace")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "workspace not found")
+		return
+	}
+
+	now := time.Now().UTC()
+	run := &tfe.Run{
+		ID:         s.newID("run"),
+		AutoApply:  ws.AutoApply,
+		CreatedAt:  now,
+		HasChanges: true,
+		Refresh:    true,
+		Source:     tfe.RunSourceAPI,
+		Permissions: &tfe.RunPermissions{
+			CanApply:        true,
+			CanCancel:       true,
+			CanDiscard:      true,
+			CanForceCancel:  true,
+			CanForceExecute: true,
+		},
+		StatusTimestamps: &tfe.RunStatusTimestamps{
+			PlanQueuedAt: now,
+			PlanningAt:   now,
+			PlannedAt:    now,
+		},
+		Workspace: &tfe.Workspace{ID: ws.ID},
+	}
+	if id := doc.relationshipID("configuration-version"); id != "" {
+		run.ConfigurationVersion = &tfe.ConfigurationVersion{ID: id}
+	}
+	if err := applyAttributes(run, doc.Data.Attributes); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+
+	setRunStatus(run, tfe.RunPlanned, now)
+	if run.AutoApply {
+		setRunStatus(run, tfe.RunApplied, now)
+	}
+
+	s.runs[run.ID] = run
+	ws.CurrentRun = &tfe.Run{ID: run.ID}
+
+	writeModel(w, http.StatusCreated, run)
+}
+
+func (s *Server) readRun(w http.ResponseWriter, _ *http.Request, path []string) {
+	run, ok := s.runs[path[1]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "run not found")
+		return
+	}
+	writeModel(w, http.StatusOK, run)
+}
+
+func (s *Server) runActionRoute(action string) route {
+	var status tfe.RunStatus
+	switch action {
+	case "apply":
+		status = tfe.RunApplied
+	case "discard":
+		status = tfe.RunDiscarded
+	case "cancel", "force-cancel":
+		status = tfe.RunCanceled
+	default:
+		return nil
+	}
+
+	return func(w http.ResponseWriter, _ *http.Request, path []string) {
+		run, ok := s.runs[path[1]]
+		if !ok {
+			writeError(w, http.StatusNotFound, "run not found")
+			return
+		}
+
+		// Only planned runs are waiting for an action, as runs are
+		// planned and applied as soon as they are created.
+		if run.Status != tfe.RunPlanned {
+			writeError(w, http.StatusConflict, "transition not allowed")
+			return
+		}
+		setRunStatus(run, status, time.Now().UTC())
+
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+// setRunStatus moves the run to the given status, updating its timestamps,
+// actions and permissions.
+func setRunStatus(run *tfe.Run, status tfe.RunStatus, at time.Time) {
+	run.Status = status
+
+	planned := status == tfe.RunPlanned
+	run.Actions = &tfe.RunActions{
+		IsConfirmable: planned,
+		IsDiscardable: planned,
+	}
+	run.Permissions.CanApply = planned
+	run.Permissions.CanDiscard = planned
+
+	ts := run.StatusTimestamps
+	switch status {
+	case tfe.RunApplied:
+		ts.ConfirmedAt = at
+		ts.ApplyQueuedAt = at
+		ts.ApplyingAt = at
+		ts.AppliedAt = at
+	case tfe.RunDiscarded:
+		ts.DiscardedAt = at
+	case tfe.RunCanceled:
+		ts.CanceledAt = at
+	}
+}
+
+// inRunStatusGroup reports whether a run with the status belongs to the
+// status group used to filter runs.
+func inRunStatusGroup(status tfe.RunStatus, group string) bool {
+	var final bool
+	switch status {
+	case tfe.RunApplied, tfe.RunCanceled, tfe.RunDiscarded, tfe.RunErrored, tfe.RunPlannedAndFinished:
+		final = true
+	}
+
+	switch tfe.RunStatusGroup(group) {
+	case tfe.RunStatusGroupFinal:
+		return final
+	case tfe.RunStatusGroupNonFinal:
+		return !final
+	}
+	return true
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
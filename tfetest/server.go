@@ -0,0 +1,411 @@
+// Package tfetest provides an in-memory fake of the Terraform Cloud and
+// Terraform Enterprise API, served by an httptest.Server, for unit testing
+// code built on go-tfe without a live instance.
+//
+// The fake implements the most commonly used endpoints of organizations,
+// workspaces, runs, variables, state versions and agent pools. Runs are not executed:
+// a new run is immediately planned, or applied when auto-apply is enabled.
+//
+// For verifying the exact payloads instead, Recorder serves fixtures and
+// records the requests it receives, which AssertRequest and AssertGolden
+// compare with golden files.
+package tfetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/jsonapi"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+const (
+	// Token is the API token accepted by the fake server.
+	Token = "tfetest-token"
+
+	basePath = "/api/v2/"
+
+	// signedPath is the path of the signed URLs, which embed their own
+	// authorization and do not require the API token.
+	signedPath = "/signed/"
+)
+
+// Server is an in-memory fake of the Terraform Cloud/Enterprise API. All
+// state is kept in memory and discarded when the server is closed.
+type Server struct {
+	*httptest.Server
+
+	mu            sync.Mutex
+	ids           int
+	organizations map[string]*tfe.Organization
+	workspaces    map[string]*tfe.Workspace
+	runs          map[string]*tfe.Run
+	variables     map[string]*tfe.Variable
+	stateVersions map[string]*stateVersion
+	agentPools    map[string]*tfe.AgentPool
+}
+
+// NewServer starts and returns a new fake server. The caller should call
+// Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		organizations: make(map[string]*tfe.Organization),
+		workspaces:    make(map[string]*tfe.Workspace),
+		runs:          make(map[string]*tfe.Run),
+		variables:     make(map[string]*tfe.Variable),
+		stateVersions: make(map[string]*stateVersion),
+		agentPools:    make(map[string]*tfe.AgentPool),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// Client returns a go-tfe client configured to use the fake server.
+func (s *Server) Client() (*tfe.Client, error) {
+	return tfe.NewClient(&tfe.Config{
+		Address:    s.URL,
+		Token:      Token,
+		HTTPClient: s.Server.Client(),
+		RetryMax:   -1,
+	})
+}
+
+// route is a handler of a single request. The path holds the segments of
+// the URL path below the API base path.
+type route func(w http.ResponseWriter, r *http.Request, path []string)
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.HasPrefix(r.URL.Path, signedPath) {
+		s.serveSignedURL(w, r)
+		return
+	}
+	if !strings.HasPrefix(r.URL.Path, basePath) {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer "+Token {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	path := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, basePath), "/"), "/")
+	if len(path) == 1 && path[0] == "ping" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var handler route
+	switch path[0] {
+	case "organizations":
+		handler = s.organizationsRoute(r, path)
+	case "workspaces":
+		handler = s.workspacesRoute(r, path)
+	case "runs":
+		handler = s.runsRoute(r, path)
+	case "state-versions":
+		handler = s.stateVersionsRoute(r, path)
+	case "agent-pools":
+		handler = s.agentPoolsRoute(r, path)
+	}
+	if handler == nil {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+
+	handler(w, r, path)
+}
+
+// serveSignedURL serves the signed URLs returned by the API.
+func (s *Server) serveSignedURL(w http.ResponseWriter, r *http.Request) {
+	path := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, signedPath), "/"), "/")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var handler route
+	if path[0] == "state-versions" {
+		handler = s.signedStateVersionsRoute(r, path)
+	}
+	if handler == nil {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+
+	handler(w, r, path)
+}
+
+// newID returns a new unique ID with the given prefix.
+func (s *Server) newID(prefix string) string {
+	s.ids++
+	return fmt.Sprintf("%s-tfetest%08d", prefix, s.ids)
+}
+
+// document is a decoded JSON:API request document.
+type document struct {
+	Data struct {
+		Type          string                     `json:"type"`
+		ID            string                     `json:"id"`
+		Attributes    map[string]json.RawMessage `json:"attributes"`
+		Relationships map[string]struct {
+			Data json.RawMessage `json:"data"`
+		} `json:"relationships"`
+	} `json:"data"`
+}
+
+// relationshipID returns the ID of a to-one relationship of the document.
+func (d *document) relationshipID(name string) string {
+	var ri struct {
+		ID string `json:"id"`
+	}
+	if rel, ok := d.Data.Relationships[name]; ok {
+		_ = json.Unmarshal(rel.Data, &ri)
+	}
+	return ri.ID
+}
+
+// decodeDocument decodes the JSON:API document of the request body.
+func decodeDocument(r *http.Request) (*document, error) {
+	doc := &document{}
+	if r.Body == nil {
+		return doc, nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(body) == 0 {
+		return doc, nil
+	}
+	if err := json.Unmarshal(body, doc); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// applyAttributes sets the attributes of the model from the document
+// attributes, matching them by their jsonapi attribute name. Timestamps are
+// managed by the fake and can not be set.
+func applyAttributes(model interface{}, attrs map[string]json.RawMessage) error {
+	v := reflect.Indirect(reflect.ValueOf(model))
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		tag := strings.Split(t.Field(i).Tag.Get("jsonapi"), ",")
+		if len(tag) < 2 || tag[0] != "attr" {
+			continue
+		}
+		raw, ok := attrs[tag[1]]
+		if !ok || t.Field(i).Type == reflect.TypeOf(time.Time{}) {
+			continue
+		}
+
+		field := v.Field(i)
+		if string(raw) == "null" {
+			field.Set(reflect.Zero(field.Type()))
+			continue
+		}
+		if isNested(field.Type()) {
+			var nested map[string]json.RawMessage
+			if err := json.Unmarshal(raw, &nested); err != nil {
+				return fmt.Errorf("invalid value for attribute %q: %w", tag[1], err)
+			}
+			if field.Kind() == reflect.Ptr {
+				if field.IsNil() {
+					field.Set(reflect.New(field.Type().Elem()))
+				}
+				field = field.Elem()
+			}
+			if err := applyAttributes(field.Addr().Interface(), nested); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := json.Unmarshal(raw, field.Addr().Interface()); err != nil {
+			return fmt.Errorf("invalid value for attribute %q: %w", tag[1], err)
+		}
+	}
+
+	return nil
+}
+
+// marshalNode marshals the model as a JSON:API resource object. Related
+// resources are only referenced, never included.
+func marshalNode(model interface{}) (*jsonapi.Node, error) {
+	payload, err := jsonapi.Marshal(model)
+	if err != nil {
+		return nil, err
+	}
+	node := payload.(*jsonapi.OnePayload).Data
+
+	// Nested attributes are decoded by the client using their jsonapi tags,
+	// so they must be encoded using those names as well.
+	for name, attr := range node.Attributes {
+		if nested, ok := nestedAttributes(reflect.ValueOf(attr)); ok {
+			node.Attributes[name] = nested
+		}
+	}
+
+	return node, nil
+}
+
+// nestedAttributes encodes a struct attribute as a map keyed by the jsonapi
+// attribute names of its fields.
+func nestedAttributes(v reflect.Value) (map[string]interface{}, bool) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, false
+		}
+		v = v.Elem()
+	}
+	if !isNested(v.Type()) {
+		return nil, false
+	}
+
+	attrs := make(map[string]interface{})
+	for i := 0; i < v.NumField(); i++ {
+		tag := strings.Split(v.Type().Field(i).Tag.Get("jsonapi"), ",")
+		if len(tag) < 2 || tag[0] != "attr" {
+			continue
+		}
+
+		switch value := v.Field(i).Interface().(type) {
+		case time.Time:
+			if !value.IsZero() {
+				attrs[tag[1]] = value.Format(time.RFC3339)
+			}
+		default:
+			if nested, ok := nestedAttributes(v.Field(i)); ok {
+				attrs[tag[1]] = nested
+			} else {
+				attrs[tag[1]] = value
+			}
+		}
+	}
+
+	return attrs, true
+}
+
+// isNested reports whether attributes of type t are nested attributes.
+func isNested(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct && t != reflect.TypeOf(time.Time{})
+}
+
+// writeModel writes a single model as a JSON:API document.
+func writeModel(w http.ResponseWriter, status int, model interface{}) {
+	node, err := marshalNode(model)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeJSON(w, status, &jsonapi.OnePayload{Data: node})
+}
+
+// writeList writes a page of the models as a JSON:API document, using the
+// page[number] and page[size] query parameters of the request.
+func writeList(w http.ResponseWriter, r *http.Request, models []interface{}) {
+	number, _ := strconv.Atoi(r.URL.Query().Get("page[number]"))
+	if number < 1 {
+		number = 1
+	}
+	size, _ := strconv.Atoi(r.URL.Query().Get("page[size]"))
+	if size < 1 {
+		size = 20
+	}
+
+	total := len(models)
+	pages := (total + size - 1) / size
+	if pages == 0 {
+		pages = 1
+	}
+
+	start := (number - 1) * size
+	if start > total {
+		start = total
+	}
+	end := start + size
+	if end > total {
+		end = total
+	}
+
+	var data []*jsonapi.Node
+	for _, m := range models[start:end] {
+		node, err := marshalNode(m)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		data = append(data, node)
+	}
+	if data == nil {
+		data = []*jsonapi.Node{}
+	}
+
+	pagination := map[string]interface{}{
+		"current-page": number,
+		"total-pages":  pages,
+		"total-count":  total,
+	}
+	if number > 1 {
+		pagination["prev-page"] = number - 1
+	}
+	if number < pages {
+		pagination["next-page"] = number + 1
+	}
+
+	writeJSON(w, http.StatusOK, &jsonapi.ManyPayload{
+		Data: data,
+		Meta: &jsonapi.Meta{"pagination": pagination},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", jsonapi.MediaType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes a JSON:API error document.
+func writeError(w http.ResponseWriter, status int, detail string) {
+	writeJSON(w, status, &jsonapi.ErrorsPayload{
+		Errors: []*jsonapi.ErrorObject{{
+			Status: strconv.Itoa(status),
+			Title:  strings.ToLower(http.StatusText(status)),
+			Detail: detail,
+		}},
+	})
+}
+
+// sortedModels returns the values of the map sorted using less.
+func sortedModels(models interface{}, less func(a, b interface{}) bool) []interface{} {
+	v := reflect.ValueOf(models)
+
+	var result []interface{}
+	for _, k := range v.MapKeys() {
+		result = append(result, v.MapIndex(k).Interface())
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return less(result[i], result[j])
+	})
+
+	return result
+}
@@ -0,0 +1,257 @@
+//go:build integration
+// +build integration
+
+package tfetest
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+func TestServer(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	client, err := srv.Client()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	org, err := client.Organizations.Create(ctx, tfe.OrganizationCreateOptions{
+		Name:  tfe.String("acme"),
+		Email: tfe.String("ops@example.com"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "acme", org.Name)
+	assert.True(t, org.Permissions.CanCreateWorkspace)
+
+	ws, err := client.Workspaces.Create(ctx, org.Name, tfe.WorkspaceCreateOptions{
+		Name:            tfe.String("network"),
+		TriggerPrefixes: []string{"modules/"},
+		VCSRepo: &tfe.VCSRepoOptions{
+			Identifier: tfe.String("acme/network"),
+			Branch:     tfe.String("main"),
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("workspaces", func(t *testing.T) {
+		_, err := client.Workspaces.Create(ctx, org.Name, tfe.WorkspaceCreateOptions{
+			Name: tfe.String("network"),
+		})
+		assert.Error(t, err)
+
+		read, err := client.Workspaces.Read(ctx, org.Name, "network")
+		require.NoError(t, err)
+		assert.Equal(t, ws.ID, read.ID)
+		assert.Equal(t, org.Name, read.Organization.Name)
+		assert.Equal(t, []string{"modules/"}, read.TriggerPrefixes)
+		assert.Equal(t, "acme/network", read.VCSRepo.Identifier)
+		assert.True(t, read.Permissions.CanQueueRun)
+
+		updated, err := client.Workspaces.UpdateByID(ctx, ws.ID, tfe.WorkspaceUpdateOptions{
+			Description: tfe.NewOptional("Core network"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Core network", updated.Description)
+		assert.Equal(t, "network", updated.Name)
+
+		wl, err := client.Workspaces.List(ctx, org.Name, &tfe.WorkspaceListOptions{
+			Search: "net",
+		})
+		require.NoError(t, err)
+		require.Len(t, wl.Items, 1)
+		assert.Equal(t, 1, wl.TotalCount)
+
+		_, err = client.Workspaces.ReadByID(ctx, "ws-doesnotexist")
+		assert.Equal(t, tfe.ErrResourceNotFound, err)
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			_, err := client.Workspaces.Create(ctx, org.Name, tfe.WorkspaceCreateOptions{
+				Name: tfe.String(fmt.Sprintf("app-%d", i)),
+			})
+			require.NoError(t, err)
+		}
+
+		wl, err := client.Workspaces.List(ctx, org.Name, &tfe.WorkspaceListOptions{
+			ListOptions: tfe.ListOptions{PageNumber: 2, PageSize: 3},
+		})
+		require.NoError(t, err)
+		require.Len(t, wl.Items, 1)
+		assert.Equal(t, "network", wl.Items[0].Name)
+		assert.Equal(t, 2, wl.CurrentPage)
+		assert.Equal(t, 1, wl.PreviousPage)
+		assert.Equal(t, 0, wl.NextPage)
+		assert.Equal(t, 4, wl.TotalCount)
+	})
+
+	t.Run("variables", func(t *testing.T) {
+		v, err := client.Variables.Create(ctx, ws.ID, tfe.VariableCreateOptions{
+			Key:       tfe.String("token"),
+			Value:     tfe.String("secret"),
+			Category:  tfe.Category(tfe.CategoryEnv),
+			Sensitive: tfe.Bool(true),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, v.Value)
+
+		_, err = client.Variables.Create(ctx, ws.ID, tfe.VariableCreateOptions{
+			Key:      tfe.String("token"),
+			Value:    tfe.String("other"),
+			Category: tfe.Category(tfe.CategoryEnv),
+		})
+		assert.Error(t, err)
+
+		vl, err := client.Variables.List(ctx, ws.ID, nil)
+		require.NoError(t, err)
+		require.Len(t, vl.Items, 1)
+		assert.Equal(t, "token", vl.Items[0].Key)
+
+		err = client.Variables.Delete(ctx, ws.ID, v.ID)
+		require.NoError(t, err)
+
+		_, err = client.Variables.Read(ctx, ws.ID, v.ID)
+		assert.Equal(t, tfe.ErrResourceNotFound, err)
+	})
+
+	t.Run("state versions", func(t *testing.T) {
+		state := []byte(`{"version":4,"serial":1,"lineage":"b2b4a1d0"}`)
+		options := tfe.StateVersionCreateOptions{
+			MD5:    tfe.String(fmt.Sprintf("%x", md5.Sum(state))),
+			Serial: tfe.Int64(1),
+			State:  tfe.String(base64.StdEncoding.EncodeToString(state)),
+		}
+
+		_, err := client.StateVersions.Create(ctx, ws.ID, options)
+		assert.Error(t, err)
+
+		_, err = client.Workspaces.Lock(ctx, ws.ID, tfe.WorkspaceLockOptions{})
+		require.NoError(t, err)
+		_, err = client.Workspaces.Lock(ctx, ws.ID, tfe.WorkspaceLockOptions{})
+		assert.ErrorIs(t, err, tfe.ErrWorkspaceLocked)
+
+		sv, err := client.StateVersions.Create(ctx, ws.ID, options)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), sv.Serial)
+
+		_, err = client.StateVersions.Create(ctx, ws.ID, options)
+		assert.True(t, errors.Is(err, tfe.ErrStateVersionSerialConflict))
+
+		other := []byte(`{"version":4,"serial":2,"lineage":"4d7b2f9e"}`)
+		_, err = client.StateVersions.Create(ctx, ws.ID, tfe.StateVersionCreateOptions{
+			MD5:    tfe.String(fmt.Sprintf("%x", md5.Sum(other))),
+			Serial: tfe.Int64(2),
+			State:  tfe.String(base64.StdEncoding.EncodeToString(other)),
+		})
+		assert.True(t, errors.Is(err, tfe.ErrStateVersionLineageMismatch))
+
+		current, err := client.StateVersions.ReadCurrent(ctx, ws.ID)
+		require.NoError(t, err)
+		assert.Equal(t, sv.ID, current.ID)
+
+		downloaded, err := client.StateVersions.Download(ctx, current.DownloadURL)
+		require.NoError(t, err)
+		assert.Equal(t, state, downloaded)
+
+		svl, err := client.StateVersions.List(ctx, &tfe.StateVersionListOptions{
+			Organization: org.Name,
+			Workspace:    ws.Name,
+		})
+		require.NoError(t, err)
+		assert.Len(t, svl.Items, 1)
+
+		_, err = client.Workspaces.Unlock(ctx, ws.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("runs", func(t *testing.T) {
+		run, err := client.Runs.Create(ctx, tfe.RunCreateOptions{
+			Message:   tfe.String("Triggered by a test"),
+			Workspace: ws,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, tfe.RunPlanned, run.Status)
+		assert.True(t, run.Actions.IsConfirmable)
+		assert.False(t, run.StatusTimestamps.PlannedAt.IsZero())
+
+		err = client.Runs.Apply(ctx, run.ID, tfe.RunApplyOptions{})
+		require.NoError(t, err)
+
+		run, err = client.Runs.Read(ctx, run.ID)
+		require.NoError(t, err)
+		assert.Equal(t, tfe.RunApplied, run.Status)
+		assert.False(t, run.Actions.IsConfirmable)
+
+		err = client.Runs.Discard(ctx, run.ID, tfe.RunDiscardOptions{})
+		assert.Error(t, err)
+
+		auto, err := client.Runs.Create(ctx, tfe.RunCreateOptions{
+			Workspace: ws,
+			AutoApply: tfe.Bool(true),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, tfe.RunApplied, auto.Status)
+
+		rl, err := client.Runs.List(ctx, ws.ID, nil)
+		require.NoError(t, err)
+		require.Len(t, rl.Items, 2)
+		assert.Equal(t, auto.ID, rl.Items[0].ID)
+
+		rl, err = client.Runs.List(ctx, ws.ID, &tfe.RunListOptions{
+			StatusGroup: tfe.RunStatusGroupNonFinal,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, rl.Items)
+
+		read, err := client.Workspaces.ReadByID(ctx, ws.ID)
+		require.NoError(t, err)
+		assert.Equal(t, auto.ID, read.CurrentRun.ID)
+	})
+
+	t.Run("agent pools", func(t *testing.T) {
+		pool, err := client.AgentPools.Create(ctx, org.Name, tfe.AgentPoolCreateOptions{
+			Name: tfe.String("agents"),
+		})
+		require.NoError(t, err)
+
+		_, err = client.Workspaces.UpdateByID(ctx, ws.ID, tfe.WorkspaceUpdateOptions{
+			ExecutionMode: tfe.ExecutionMode(tfe.ExecutionModeAgent),
+			AgentPoolID:   tfe.NewOptional("apool-doesnotexist"),
+		})
+		assert.Error(t, err)
+
+		_, err = client.Workspaces.UpdateByID(ctx, ws.ID, tfe.WorkspaceUpdateOptions{
+			ExecutionMode: tfe.ExecutionMode(tfe.ExecutionModeAgent),
+			AgentPoolID:   tfe.NewOptional(pool.ID),
+		})
+		require.NoError(t, err)
+
+		read, err := client.AgentPools.ReadWithOptions(ctx, pool.ID, &tfe.AgentPoolReadOptions{
+			Include: []tfe.AgentPoolIncludeOpt{tfe.AgentPoolWorkspaces},
+		})
+		require.NoError(t, err)
+		require.Len(t, read.Workspaces, 1)
+		assert.Equal(t, ws.ID, read.Workspaces[0].ID)
+
+		err = client.AgentPools.Delete(ctx, pool.ID)
+		assert.Error(t, err)
+	})
+
+	t.Run("deleting the organization", func(t *testing.T) {
+		err := client.Organizations.Delete(ctx, org.Name)
+		require.NoError(t, err)
+
+		_, err = client.Workspaces.ReadByID(ctx, ws.ID)
+		assert.Equal(t, tfe.ErrResourceNotFound, err)
+	})
+}
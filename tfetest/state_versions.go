@@ -0,0 +1,190 @@
+package tfetest
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// stateVersion is a stored state version with the state it holds.
+type stateVersion struct {
+	*tfe.StateVersion
+
+	workspaceID string
+	lineage     string
+	state       []byte
+}
+
+// stateVersionAttributes are the attributes of a state version create
+// request.
+type stateVersionAttributes struct {
+	Force   bool   `jsonapi:"attr,force"`
+	Lineage string `jsonapi:"attr,lineage"`
+	MD5     string `jsonapi:"attr,md5"`
+	Serial  int64  `jsonapi:"attr,serial"`
+	State   string `jsonapi:"attr,state"`
+}
+
+func (s *Server) stateVersionsRoute(r *http.Request, path []string) route {
+	switch {
+	case len(path) == 1 && r.Method == http.MethodGet:
+		return s.listStateVersions
+	case len(path) == 2 && r.Method == http.MethodGet:
+		return s.readStateVersion
+	}
+	return nil
+}
+
+func (s *Server) signedStateVersionsRoute(r *http.Request, path []string) route {
+	if len(path) == 3 && path[2] == "download" && r.Method == http.MethodGet {
+		return s.downloadStateVersion
+	}
+	return nil
+}
+
+func (s *Server) listStateVersions(w http.ResponseWriter, r *http.Request, _ []string) {
+	ws := s.workspaceByName(
+		r.URL.Query().Get("filter[organization][name]"),
+		r.URL.Query().Get("filter[workspace][name]"),
+	)
+	if ws == nil {
+		writeError(w, http.StatusNotFound, "workspace not found")
+		return
+	}
+
+	svs := make(map[string]*tfe.StateVersion)
+	for id, sv := range s.stateVersions {
+		if sv.workspaceID == ws.ID {
+			svs[id] = sv.StateVersion
+		}
+	}
+
+	// State versions are listed newest first.
+	writeList(w, r, sortedModels(svs, func(a, b interface{}) bool {
+		return a.(*tfe.StateVersion).ID > b.(*tfe.StateVersion).ID
+	}))
+}
+
+func (s *Server) createStateVersion(w http.ResponseWriter, r *http.Request, path []string) {
+	ws, ok := s.workspaces[path[1]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "workspace not found")
+		return
+	}
+
+	doc, err := decodeDocument(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	attrs := &stateVersionAttributes{}
+	if err := applyAttributes(attrs, doc.Data.Attributes); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+
+	state, err := base64.StdEncoding.DecodeString(attrs.State)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "State is not valid base64")
+		return
+	}
+	if fmt.Sprintf("%x", md5.Sum(state)) != attrs.MD5 {
+		writeError(w, http.StatusUnprocessableEntity, "MD5 does not match the state")
+		return
+	}
+	if attrs.Lineage == "" {
+		var meta struct {
+			Lineage string `json:"lineage"`
+		}
+		_ = json.Unmarshal(state, &meta)
+		attrs.Lineage = meta.Lineage
+	}
+
+	if !ws.Locked {
+		writeError(w, http.StatusConflict, "The workspace must be locked to create a state version")
+		return
+	}
+	if current := s.currentStateVersion(ws); current != nil && !attrs.Force {
+		if attrs.Lineage != "" && current.lineage != "" && attrs.Lineage != current.lineage {
+			writeError(w, http.StatusConflict, fmt.Sprintf(
+				"Lineage %q does not match the current lineage %q", attrs.Lineage, current.lineage))
+			return
+		}
+		if attrs.Serial <= current.Serial {
+			writeError(w, http.StatusConflict, fmt.Sprintf(
+				"Serial %d already exists, the current serial is %d", attrs.Serial, current.Serial))
+			return
+		}
+	}
+
+	id := s.newID("sv")
+	sv := &stateVersion{
+		StateVersion: &tfe.StateVersion{
+			ID:          id,
+			CreatedAt:   time.Now().UTC(),
+			DownloadURL: s.URL + signedPath + "state-versions/" + id + "/download",
+			Serial:      attrs.Serial,
+		},
+		workspaceID: ws.ID,
+		lineage:     attrs.Lineage,
+		state:       state,
+	}
+	if runID := doc.relationshipID("run"); runID != "" {
+		sv.Run = &tfe.Run{ID: runID}
+	}
+	s.stateVersions[id] = sv
+	ws.CurrentStateVersion = &tfe.StateVersion{ID: id}
+
+	writeModel(w, http.StatusCreated, sv.StateVersion)
+}
+
+func (s *Server) readStateVersion(w http.ResponseWriter, _ *http.Request, path []string) {
+	sv, ok := s.stateVersions[path[1]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "state version not found")
+		return
+	}
+	writeModel(w, http.StatusOK, sv.StateVersion)
+}
+
+func (s *Server) readCurrentStateVersion(w http.ResponseWriter, _ *http.Request, path []string) {
+	ws, ok := s.workspaces[path[1]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "workspace not found")
+		return
+	}
+
+	sv := s.currentStateVersion(ws)
+	if sv == nil {
+		writeError(w, http.StatusNotFound, "state version not found")
+		return
+	}
+	writeModel(w, http.StatusOK, sv.StateVersion)
+}
+
+func (s *Server) downloadStateVersion(w http.ResponseWriter, _ *http.Request, path []string) {
+	sv, ok := s.stateVersions[path[1]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "state version not found")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(sv.state)
+}
+
+// currentStateVersion returns the current state version of the workspace, or
+// nil if it has no state.
+func (s *Server) currentStateVersion(ws *tfe.Workspace) *stateVersion {
+	if ws.CurrentStateVersion == nil {
+		return nil
+	}
+	return s.stateVersions[ws.CurrentStateVersion.ID]
+}
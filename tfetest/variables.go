@@ -0,0 +1,152 @@
+package tfetest
+
+import (
+	"net/http"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+func (s *Server) variablesRoute(r *http.Request, path []string) route {
+	if _, ok := s.workspaces[path[1]]; !ok {
+		return func(w http.ResponseWriter, _ *http.Request, _ []string) {
+			writeError(w, http.StatusNotFound, "workspace not found")
+		}
+	}
+
+	switch {
+	case len(path) == 3 && r.Method == http.MethodGet:
+		return s.listVariables
+	case len(path) == 3 && r.Method == http.MethodPost:
+		return s.createVariable
+	case len(path) == 4 && r.Method == http.MethodGet:
+		return s.readVariable
+	case len(path) == 4 && r.Method == http.MethodPatch:
+		return s.updateVariable
+	case len(path) == 4 && r.Method == http.MethodDelete:
+		return s.deleteVariable
+	}
+	return nil
+}
+
+func (s *Server) listVariables(w http.ResponseWriter, r *http.Request, path []string) {
+	vars := make(map[string]*tfe.Variable)
+	for id, v := range s.variables {
+		if v.Workspace.ID == path[1] {
+			vars[id] = redactVariable(v)
+		}
+	}
+
+	writeList(w, r, sortedModels(vars, func(a, b interface{}) bool {
+		return a.(*tfe.Variable).ID < b.(*tfe.Variable).ID
+	}))
+}
+
+func (s *Server) createVariable(w http.ResponseWriter, r *http.Request, path []string) {
+	doc, err := decodeDocument(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	v := &tfe.Variable{
+		ID:        s.newID("var"),
+		Workspace: &tfe.Workspace{ID: path[1]},
+	}
+	if err := applyAttributes(v, doc.Data.Attributes); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	if v.Key == "" || v.Category == "" {
+		writeError(w, http.StatusUnprocessableEntity, "key and category are required")
+		return
+	}
+	if s.variableKeyTaken(v) {
+		writeError(w, http.StatusUnprocessableEntity, "Key has already been taken")
+		return
+	}
+	s.variables[v.ID] = v
+
+	writeModel(w, http.StatusCreated, redactVariable(v))
+}
+
+func (s *Server) readVariable(w http.ResponseWriter, _ *http.Request, path []string) {
+	v := s.variable(path[1], path[3])
+	if v == nil {
+		writeError(w, http.StatusNotFound, "variable not found")
+		return
+	}
+	writeModel(w, http.StatusOK, redactVariable(v))
+}
+
+func (s *Server) updateVariable(w http.ResponseWriter, r *http.Request, path []string) {
+	v := s.variable(path[1], path[3])
+	if v == nil {
+		writeError(w, http.StatusNotFound, "variable not found")
+		return
+	}
+
+	doc, err := decodeDocument(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	updated := *v
+	if err := applyAttributes(&updated, doc.Data.Attributes); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	if v.Sensitive && !updated.Sensitive {
+		writeError(w, http.StatusUnprocessableEntity, "Sensitive variables can not be made non-sensitive")
+		return
+	}
+	if s.variableKeyTaken(&updated) {
+		writeError(w, http.StatusUnprocessableEntity, "Key has already been taken")
+		return
+	}
+	s.variables[v.ID] = &updated
+
+	writeModel(w, http.StatusOK, redactVariable(&updated))
+}
+
+func (s *Server) deleteVariable(w http.ResponseWriter, _ *http.Request, path []string) {
+	if s.variable(path[1], path[3]) == nil {
+		writeError(w, http.StatusNotFound, "variable not found")
+		return
+	}
+	delete(s.variables, path[3])
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// variable returns the variable of the workspace, or nil if not found.
+func (s *Server) variable(workspaceID, variableID string) *tfe.Variable {
+	v, ok := s.variables[variableID]
+	if !ok || v.Workspace.ID != workspaceID {
+		return nil
+	}
+	return v
+}
+
+// variableKeyTaken reports whether another variable of the same workspace and
+// category uses the key of v.
+func (s *Server) variableKeyTaken(v *tfe.Variable) bool {
+	for _, other := range s.variables {
+		if other.ID != v.ID && other.Workspace.ID == v.Workspace.ID &&
+			other.Category == v.Category && other.Key == v.Key {
+			return true
+		}
+	}
+	return false
+}
+
+// redactVariable returns the variable as returned by the API, which never
+// includes the value of sensitive variables.
+func redactVariable(v *tfe.Variable) *tfe.Variable {
+	if !v.Sensitive {
+		return v
+	}
+	redacted := *v
+	redacted.Value = ""
+	return &redacted
+}
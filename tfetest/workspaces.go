@@ -0,0 +1,268 @@
+package tfetest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// organizationWorkspacesRoute routes the workspace endpoints addressed by
+// organization and workspace name.
+func (s *Server) organizationWorkspacesRoute(r *http.Request, path []string) route {
+	switch {
+	case len(path) == 3 && r.Method == http.MethodGet:
+		return s.listWorkspaces
+	case len(path) == 3 && r.Method == http.MethodPost:
+		return s.createWorkspace
+	case len(path) == 4:
+		return s.byWorkspaceName(s.workspaceRoute(r, nil))
+	}
+	return nil
+}
+
+func (s *Server) workspacesRoute(r *http.Request, path []string) route {
+	switch {
+	case len(path) == 2:
+		return s.workspaceRoute(r, nil)
+	case len(path) == 4 && path[2] == "actions" && r.Method == http.MethodPost:
+		return s.workspaceActionRoute(path[3])
+	case len(path) == 3 && path[2] == "runs" && r.Method == http.MethodGet:
+		return s.listRuns
+	case len(path) >= 3 && path[2] == "vars":
+		return s.variablesRoute(r, path)
+	case len(path) == 3 && path[2] == "state-versions" && r.Method == http.MethodPost:
+		return s.createStateVersion
+	case len(path) == 3 && path[2] == "current-state-version" && r.Method == http.MethodGet:
+		return s.readCurrentStateVersion
+	}
+	return nil
+}
+
+// workspaceRoute returns the handler of a single workspace. A non-nil route
+// is returned for all methods, so it can be wrapped by byWorkspaceName.
+func (s *Server) workspaceRoute(r *http.Request, _ []string) route {
+	switch r.Method {
+	case http.MethodGet:
+		return s.readWorkspace
+	case http.MethodPatch:
+		return s.updateWorkspace
+	case http.MethodDelete:
+		return s.deleteWorkspace
+	}
+	return func(w http.ResponseWriter, _ *http.Request, _ []string) {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// byWorkspaceName adapts a workspace handler, which expects the workspace ID
+// as the second path segment, to a path addressing the workspace by
+// organization and workspace name.
+func (s *Server) byWorkspaceName(next route) route {
+	return func(w http.ResponseWriter, r *http.Request, path []string) {
+		ws := s.workspaceByName(path[1], path[3])
+		if ws == nil {
+			writeError(w, http.StatusNotFound, "workspace not found")
+			return
+		}
+		next(w, r, []string{"workspaces", ws.ID})
+	}
+}
+
+func (s *Server) workspaceByName(organization, name string) *tfe.Workspace {
+	for _, ws := range s.workspaces {
+		if ws.Organization.Name == organization && ws.Name == name {
+			return ws
+		}
+	}
+	return nil
+}
+
+func (s *Server) listWorkspaces(w http.ResponseWriter, r *http.Request, path []string) {
+	if _, ok := s.organizations[path[1]]; !ok {
+		writeError(w, http.StatusNotFound, "organization not found")
+		return
+	}
+
+	search := r.URL.Query().Get("search[name]")
+	workspaces := make(map[string]*tfe.Workspace)
+	for id, ws := range s.workspaces {
+		if ws.Organization.Name == path[1] && strings.Contains(ws.Name, search) {
+			workspaces[id] = ws
+		}
+	}
+
+	writeList(w, r, sortedModels(workspaces, func(a, b interface{}) bool {
+		return a.(*tfe.Workspace).Name < b.(*tfe.Workspace).Name
+	}))
+}
+
+func (s *Server) createWorkspace(w http.ResponseWriter, r *http.Request, path []string) {
+	if _, ok := s.organizations[path[1]]; !ok {
+		writeError(w, http.StatusNotFound, "organization not found")
+		return
+	}
+
+	doc, err := decodeDocument(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	now := time.Now().UTC()
+	ws := &tfe.Workspace{
+		ID:                  s.newID("ws"),
+		Actions:             &tfe.WorkspaceActions{IsDestroyable: true},
+		AllowDestroyPlan:    true,
+		CreatedAt:           now,
+		ExecutionMode:       tfe.ExecutionModeRemote,
+		FileTriggersEnabled: true,
+		Operations:          true,
+		SpeculativeEnabled:  true,
+		UpdatedAt:           now,
+		Permissions: &tfe.WorkspacePermissions{
+			CanDestroy:        true,
+			CanForceUnlock:    true,
+			CanLock:           true,
+			CanQueueApply:     true,
+			CanQueueDestroy:   true,
+			CanQueueRun:       true,
+			CanReadSettings:   true,
+			CanUnlock:         true,
+			CanUpdate:         true,
+			CanUpdateVariable: true,
+		},
+		Organization: &tfe.Organization{Name: path[1]},
+	}
+	if err := applyAttributes(ws, doc.Data.Attributes); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	if ws.Name == "" {
+		writeError(w, http.StatusUnprocessableEntity, "Name can't be blank")
+		return
+	}
+	if s.workspaceByName(path[1], ws.Name) != nil {
+		writeError(w, http.StatusUnprocessableEntity, "Name has already been taken")
+		return
+	}
+	if !s.validAgentPool(ws) {
+		writeError(w, http.StatusUnprocessableEntity, "Agent pool not found")
+		return
+	}
+	s.workspaces[ws.ID] = ws
+
+	writeModel(w, http.StatusCreated, ws)
+}
+
+func (s *Server) readWorkspace(w http.ResponseWriter, _ *http.Request, path []string) {
+	ws, ok := s.workspaces[path[1]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "workspace not found")
+		return
+	}
+	writeModel(w, http.StatusOK, ws)
+}
+
+func (s *Server) updateWorkspace(w http.ResponseWriter, r *http.Request, path []string) {
+	ws, ok := s.workspaces[path[1]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "workspace not found")
+		return
+	}
+
+	doc, err := decodeDocument(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	updated := *ws
+	if err := applyAttributes(&updated, doc.Data.Attributes); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	if updated.Name != ws.Name && s.workspaceByName(ws.Organization.Name, updated.Name) != nil {
+		writeError(w, http.StatusUnprocessableEntity, "Name has already been taken")
+		return
+	}
+	if !s.validAgentPool(&updated) {
+		writeError(w, http.StatusUnprocessableEntity, "Agent pool not found")
+		return
+	}
+	updated.UpdatedAt = time.Now().UTC()
+	s.workspaces[ws.ID] = &updated
+
+	writeModel(w, http.StatusOK, &updated)
+}
+
+func (s *Server) deleteWorkspace(w http.ResponseWriter, _ *http.Request, path []string) {
+	if _, ok := s.workspaces[path[1]]; !ok {
+		writeError(w, http.StatusNotFound, "workspace not found")
+		return
+	}
+	s.removeWorkspace(path[1])
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// removeWorkspace deletes the workspace and all of its resources.
+func (s *Server) removeWorkspace(id string) {
+	for runID, run := range s.runs {
+		if run.Workspace.ID == id {
+			delete(s.runs, runID)
+		}
+	}
+	for varID, v := range s.variables {
+		if v.Workspace.ID == id {
+			delete(s.variables, varID)
+		}
+	}
+	for svID, sv := range s.stateVersions {
+		if sv.workspaceID == id {
+			delete(s.stateVersions, svID)
+		}
+	}
+	delete(s.workspaces, id)
+}
+
+func (s *Server) workspaceActionRoute(action string) route {
+	switch action {
+	case "lock":
+		return s.lockWorkspace
+	case "unlock", "force-unlock":
+		return s.unlockWorkspace
+	}
+	return nil
+}
+
+func (s *Server) lockWorkspace(w http.ResponseWriter, _ *http.Request, path []string) {
+	ws, ok := s.workspaces[path[1]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "workspace not found")
+		return
+	}
+	if ws.Locked {
+		writeError(w, http.StatusConflict, "Unable to lock workspace. The workspace is already locked.")
+		return
+	}
+	ws.Locked = true
+
+	writeModel(w, http.StatusOK, ws)
+}
+
+func (s *Server) unlockWorkspace(w http.ResponseWriter, _ *http.Request, path []string) {
+	ws, ok := s.workspaces[path[1]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "workspace not found")
+		return
+	}
+	if !ws.Locked {
+		writeError(w, http.StatusConflict, "Unable to unlock workspace. The workspace is not locked.")
+		return
+	}
+	ws.Locked = false
+
+	writeModel(w, http.StatusOK, ws)
+}
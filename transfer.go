@@ -0,0 +1,344 @@
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+const (
+	// defaultTransferChunkSize is the size of the chunks large artifacts are
+	// downloaded in by default.
+	defaultTransferChunkSize = 16 << 20
+
+	// defaultTransferConcurrency is the number of chunks downloaded at the
+	// same time by default.
+	defaultTransferConcurrency = 4
+
+	// defaultTransferMaxResumes is how often an interrupted chunk is resumed
+	// by default.
+	defaultTransferMaxResumes = 3
+)
+
+// TransferOptions configures how artifacts, like state versions and plan
+// exports, are downloaded. Artifacts larger than the chunk size are
+// downloaded in chunks with HTTP range requests, several at a time, while
+// they are written to the writer in order. A chunk which is interrupted is
+// resumed from the last byte received. Servers which do not support range
+// requests are read from in one piece.
+type TransferOptions struct {
+	// The size of the chunks. Defaults to 16 MiB.
+	ChunkSize int64
+
+	// The maximum number of chunks downloaded at the same time, and so held
+	// in memory. Defaults to 4.
+	Concurrency int
+
+	// How often a single chunk is resumed after being interrupted. Defaults
+	// to 3, while a negative value disables resuming.
+	MaxResumes int
+}
+
+// withDefaults returns the options with the defaults filled in.
+func (o TransferOptions) withDefaults() TransferOptions {
+	if o.ChunkSize <= 0 {
+		o.ChunkSize = defaultTransferChunkSize
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = defaultTransferConcurrency
+	}
+	switch {
+	case o.MaxResumes == 0:
+		o.MaxResumes = defaultTransferMaxResumes
+	case o.MaxResumes < 0:
+		o.MaxResumes = 0
+	}
+	return o
+}
+
+// firstChunkRange returns the value of the Range header requesting the first
+// chunk of an artifact.
+func (c *Client) firstChunkRange() string {
+	return fmt.Sprintf("bytes=0-%d", c.transfer.ChunkSize-1)
+}
+
+// download writes the artifact of the response to the request made with
+// the firstChunkRange into the writer. A partial response is completed with
+// range requests to the URL the response was finally served from, which
+// is the signed URL when the request was redirected. The API token is only
+// sent along when it was sent to that URL. The range requests are reported
+// as the API call op. The response body is closed.
+func (c *Client) download(ctx context.Context, op string, resp *http.Response, w io.Writer, options *SignedURLDownloadOptions) error {
+	defer resp.Body.Close()
+
+	if options == nil {
+		options = &SignedURLDownloadOptions{}
+	}
+
+	d := &downloader{
+		client:  c,
+		op:      op,
+		url:     resp.Request.URL.String(),
+		auth:    resp.Request.Header.Get("Authorization"),
+		options: c.transfer,
+	}
+
+	var total int64
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		start, end, size, err := parseContentRange(resp.Header.Get("Content-Range"))
+		if err != nil {
+			return err
+		}
+		if start != 0 {
+			return fmt.Errorf("unexpected content range starting at %d", start)
+		}
+		d.first = end
+		total = size
+	case http.StatusRequestedRangeNotSatisfiable:
+		// The range of the first chunk is only unsatisfiable when the
+		// artifact is empty.
+		d.first = -1
+	default:
+		if err := checkResponseCode(resp, c.clock); err != nil {
+			return err
+		}
+		// The server ignored the range and responds with the whole
+		// artifact, whose size may be unknown.
+		d.first = -1
+		if resp.ContentLength > 0 {
+			d.first = resp.ContentLength - 1
+		}
+		d.unranged = true
+		total = resp.ContentLength
+	}
+
+	var h hash.Hash
+	if options.SHA256 != "" {
+		h = sha256.New()
+		w = io.MultiWriter(w, h)
+	}
+	if options.Progress != nil {
+		w = &progressWriter{w: w, total: total, progress: options.Progress}
+	}
+
+	if err := d.run(ctx, resp.Body, w, total); err != nil {
+		return err
+	}
+
+	if h != nil {
+		return verifySHA256(options.SHA256, h)
+	}
+
+	return nil
+}
+
+// downloader downloads the chunks of an artifact.
+type downloader struct {
+	client  *Client
+	op      string
+	url     string
+	auth    string
+	options TransferOptions
+
+	// The last byte of the first chunk, which is -1 for an empty artifact,
+	// and when the size of an unranged response is unknown.
+	first int64
+
+	// Whether the server responded with the whole artifact.
+	unranged bool
+}
+
+// chunk is a byte range of an artifact, downloaded into a buffer.
+type chunk struct {
+	start, end int64
+	buf        bytes.Buffer
+	err        error
+	done       chan struct{}
+}
+
+// run writes the first chunk from the body, then downloads the remaining
+// chunks concurrently and writes them in order.
+func (d *downloader) run(ctx context.Context, body io.ReadCloser, w io.Writer, total int64) error {
+	if d.unranged {
+		return d.copy(ctx, body, w, 0, d.first)
+	}
+	if d.first >= 0 {
+		if err := d.copy(ctx, body, w, 0, d.first); err != nil {
+			return err
+		}
+	}
+
+	var chunks []*chunk
+	for start := d.first + 1; start < total; start += d.options.ChunkSize {
+		end := start + d.options.ChunkSize - 1
+		if end >= total {
+			end = total - 1
+		}
+		chunks = append(chunks, &chunk{start: start, end: end, done: make(chan struct{})})
+	}
+	if len(chunks) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	started := 0
+	for i, c := range chunks {
+		// Keep at most the configured number of chunks in flight ahead of
+		// the one written next.
+		for ; started < len(chunks) && started < i+d.options.Concurrency; started++ {
+			wg.Add(1)
+			go func(c *chunk) {
+				defer wg.Done()
+				defer close(c.done)
+				c.err = d.fetch(ctx, &c.buf, c.start, c.end)
+			}(chunks[started])
+		}
+
+		<-c.done
+		if c.err != nil {
+			return c.err
+		}
+		if _, err := c.buf.WriteTo(w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fetch downloads the byte range into the writer.
+func (d *downloader) fetch(ctx context.Context, w io.Writer, start, end int64) error {
+	body, err := d.get(ctx, start, end)
+	if err != nil {
+		return err
+	}
+	return d.copy(ctx, body, w, start, end)
+}
+
+// copy writes the byte range from the body into the writer. When reading the
+// body fails, the rest of the range is requested again, up to the maximum
+// number of resumes. An end of -1 copies until the end of the body. The body
+// is closed.
+func (d *downloader) copy(ctx context.Context, body io.ReadCloser, w io.Writer, start, end int64) error {
+	resumes := 0
+	for {
+		n, err := io.Copy(w, body)
+		body.Close()
+		start += n
+
+		if err == nil && end >= 0 && start <= end {
+			err = io.ErrUnexpectedEOF
+		}
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if resumes >= d.options.MaxResumes || end < 0 {
+			return err
+		}
+		resumes++
+
+		d.client.logger.Warn("resuming an interrupted download", "offset", start, "error", err)
+
+		body, err = d.get(ctx, start, end)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// get requests a byte range of the artifact, and returns the body of the
+// response.
+func (d *downloader) get(ctx context.Context, start, end int64) (io.ReadCloser, error) {
+	req, err := retryablehttp.NewRequest("GET", d.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", start, end))
+	if d.auth != "" {
+		req.Header.Set("Authorization", d.auth)
+	}
+
+	s := &signedURLClient{client: d.client}
+	resp, err := s.do(ctx, d.op, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusPartialContent {
+		resp.Body.Close()
+		return nil, ErrRangeNotSupported
+	}
+	if first, _, _, err := parseContentRange(resp.Header.Get("Content-Range")); err != nil || first != start {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected content range %q for the range starting at %d", resp.Header.Get("Content-Range"), start)
+	}
+
+	return resp.Body, nil
+}
+
+// parseContentRange parses the value of a Content-Range header, like
+// "bytes 0-1023/4096", into the first and last byte of the range, and the
+// size of the artifact.
+func parseContentRange(v string) (start, end, size int64, err error) {
+	invalid := fmt.Errorf("invalid content range %q", v)
+
+	if !strings.HasPrefix(v, "bytes ") {
+		return 0, 0, 0, invalid
+	}
+	rng, total, ok := strings.Cut(strings.TrimPrefix(v, "bytes "), "/")
+	if !ok {
+		return 0, 0, 0, invalid
+	}
+	first, last, ok := strings.Cut(rng, "-")
+	if !ok {
+		return 0, 0, 0, invalid
+	}
+
+	if start, err = strconv.ParseInt(first, 10, 64); err != nil {
+		return 0, 0, 0, invalid
+	}
+	if end, err = strconv.ParseInt(last, 10, 64); err != nil || end < start {
+		return 0, 0, 0, invalid
+	}
+	if size, err = strconv.ParseInt(total, 10, 64); err != nil || size <= end {
+		return 0, 0, 0, invalid
+	}
+
+	return start, end, size, nil
+}
+
+// progressWriter reports the number of bytes written to the writer.
+type progressWriter struct {
+	w           io.Writer
+	transferred int64
+	total       int64
+	progress    SignedURLProgressFunc
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	if n > 0 {
+		p.transferred += int64(n)
+		p.progress(p.transferred, p.total)
+	}
+	return n, err
+}
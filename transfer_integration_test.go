@@ -0,0 +1,170 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferDownload(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 105)
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+
+	var mu sync.Mutex
+	var ranges []string
+	interrupt := map[string]bool{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/plan-exports/pe-1/download":
+			assert.Equal(t, "Bearer dummy-token", r.Header.Get("Authorization"))
+			http.Redirect(w, r, "/archivist/export", http.StatusFound)
+		case "/archivist/export", "/signed/object":
+			mu.Lock()
+			ranges = append(ranges, r.Header.Get("Range"))
+			interrupted := interrupt[r.Header.Get("Range")]
+			delete(interrupt, r.Header.Get("Range"))
+			mu.Unlock()
+
+			if interrupted {
+				// Announce the whole range, but send only a part of it.
+				w.Header().Set("Content-Range", "bytes 100-199/1050")
+				w.Header().Set("Content-Length", "100")
+				w.WriteHeader(http.StatusPartialContent)
+				_, _ = w.Write(content[100:150])
+				return
+			}
+			http.ServeContent(w, r, "object", time.Time{}, bytes.NewReader(content))
+		case "/signed/empty":
+			http.ServeContent(w, r, "empty", time.Time{}, strings.NewReader(""))
+		case "/signed/unranged":
+			_, _ = w.Write(content)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Transfer: TransferOptions{
+			ChunkSize:   100,
+			Concurrency: 3,
+		},
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	reset := func() {
+		mu.Lock()
+		defer mu.Unlock()
+		ranges = nil
+	}
+
+	t.Run("when downloading in chunks", func(t *testing.T) {
+		reset()
+
+		var buf bytes.Buffer
+		var transferred, total int64
+		err := client.SignedURLs.DownloadWithOptions(ctx, ts.URL+"/signed/object", &buf, &SignedURLDownloadOptions{
+			SHA256: checksum,
+			Progress: func(n, size int64) {
+				assert.Greater(t, n, transferred)
+				transferred, total = n, size
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, content, buf.Bytes())
+		assert.Equal(t, int64(len(content)), transferred)
+		assert.Equal(t, int64(len(content)), total)
+
+		require.Len(t, ranges, 11)
+		assert.Equal(t, "bytes=0-99", ranges[0])
+		assert.Contains(t, ranges, "bytes=1000-1049")
+	})
+
+	t.Run("when a chunk is interrupted", func(t *testing.T) {
+		reset()
+		mu.Lock()
+		interrupt["bytes=100-199"] = true
+		mu.Unlock()
+
+		var buf bytes.Buffer
+		err := client.SignedURLs.DownloadWithOptions(ctx, ts.URL+"/signed/object", &buf, &SignedURLDownloadOptions{
+			SHA256: checksum,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, content, buf.Bytes())
+		assert.Contains(t, ranges, "bytes=150-199")
+	})
+
+	t.Run("when the server does not support ranges", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := client.SignedURLs.DownloadWithOptions(ctx, ts.URL+"/signed/unranged", &buf, &SignedURLDownloadOptions{
+			SHA256: checksum,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, content, buf.Bytes())
+	})
+
+	t.Run("when the artifact is empty", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := client.SignedURLs.Download(ctx, ts.URL+"/signed/empty", &buf)
+		require.NoError(t, err)
+		assert.Empty(t, buf.Bytes())
+	})
+
+	t.Run("when the checksum does not match", func(t *testing.T) {
+		err := client.SignedURLs.DownloadWithOptions(ctx, ts.URL+"/signed/object", io.Discard, &SignedURLDownloadOptions{
+			SHA256: "invalid",
+		})
+		assert.True(t, errors.Is(err, ErrChecksumMismatch))
+	})
+
+	t.Run("when downloading a plan export", func(t *testing.T) {
+		reset()
+
+		data, err := client.PlanExports.Download(ctx, "pe-1")
+		require.NoError(t, err)
+		assert.Equal(t, content, data)
+		assert.Len(t, ranges, 11)
+	})
+
+	t.Run("when the plan export does not exist", func(t *testing.T) {
+		_, err := client.PlanExports.Download(ctx, "pe-unknown")
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+}
+
+func TestParseContentRange(t *testing.T) {
+	start, end, size, err := parseContentRange("bytes 100-199/1050")
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), start)
+	assert.Equal(t, int64(199), end)
+	assert.Equal(t, int64(1050), size)
+
+	for _, v := range []string{"", "bytes */1050", "bytes 100-199/*", "bytes 199-100/1050", "bytes 0-99/99", "items 0-99/100"} {
+		_, _, _, err := parseContentRange(v)
+		assert.Error(t, err, v)
+	}
+}
@@ -45,6 +45,11 @@ func EnforcementMode(v EnforcementLevel) *EnforcementLevel {
 	return &v
 }
 
+// ExecutionMode returns a pointer to the given execution mode.
+func ExecutionMode(v ExecutionModeType) *ExecutionModeType {
+	return &v
+}
+
 // Int returns a pointer to the given int.
 func Int(v int) *int {
 	return &v
@@ -68,7 +68,7 @@ func (s *users) ReadCurrent(ctx context.Context) (*User, error) {
 	}
 
 	u := &User{}
-	err = s.client.do(ctx, req, u)
+	err = s.client.do(ctx, "users.ReadCurrent", req, u)
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +84,7 @@ func (s *users) UpdateCurrent(ctx context.Context, options UserUpdateOptions) (*
 	}
 
 	u := &User{}
-	err = s.client.do(ctx, req, u)
+	err = s.client.do(ctx, "users.UpdateCurrent", req, u)
 	if err != nil {
 		return nil, err
 	}
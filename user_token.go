@@ -45,6 +45,7 @@ type UserToken struct {
 	ID          string    `jsonapi:"primary,authentication-tokens"`
 	CreatedAt   time.Time `jsonapi:"attr,created-at,iso8601"`
 	Description string    `jsonapi:"attr,description"`
+	ExpiredAt   time.Time `jsonapi:"attr,expired-at,iso8601"`
 	LastUsedAt  time.Time `jsonapi:"attr,last-used-at,iso8601"`
 	Token       string    `jsonapi:"attr,token"`
 }
@@ -53,6 +54,10 @@ type UserToken struct {
 type UserTokenCreateOptions struct {
 	// Optional: Description of the token
 	Description string `jsonapi:"attr,description,omitempty"`
+
+	// Optional: The time the token expires at. Tokens without an expiry
+	// do not expire.
+	ExpiredAt *time.Time `jsonapi:"attr,expired-at,iso8601,omitempty"`
 }
 
 // Create a new user token
@@ -68,7 +73,7 @@ func (s *userTokens) Create(ctx context.Context, userID string, options UserToke
 	}
 
 	ut := &UserToken{}
-	err = s.client.do(ctx, req, ut)
+	err = s.client.do(ctx, "userTokens.Create", req, ut)
 	if err != nil {
 		return nil, err
 	}
@@ -89,7 +94,7 @@ func (s *userTokens) List(ctx context.Context, userID string) (*UserTokenList, e
 	}
 
 	tl := &UserTokenList{}
-	err = s.client.do(ctx, req, tl)
+	err = s.client.do(ctx, "userTokens.List", req, tl)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +115,7 @@ func (s *userTokens) Read(ctx context.Context, tokenID string) (*UserToken, erro
 	}
 
 	tt := &UserToken{}
-	err = s.client.do(ctx, req, tt)
+	err = s.client.do(ctx, "userTokens.Read", req, tt)
 	if err != nil {
 		return nil, err
 	}
@@ -130,5 +135,5 @@ func (s *userTokens) Delete(ctx context.Context, tokenID string) error {
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "userTokens.Delete", req, nil)
 }
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -78,6 +79,16 @@ func TestUserTokens_Create(t *testing.T) {
 			t.Fatal(err)
 		}
 	})
+
+	t.Run("create token with an expiry", func(t *testing.T) {
+		expiredAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+		token, err := client.UserTokens.Create(ctx, user.ID, UserTokenCreateOptions{
+			ExpiredAt: &expiredAt,
+		})
+		require.NoError(t, err)
+		tokens = append(tokens, token.ID)
+		assert.True(t, expiredAt.Equal(token.ExpiredAt))
+	})
 }
 
 // TestUserTokens_Read tests basic creation of user tokens
@@ -3,6 +3,7 @@ package tfe
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/url"
 )
 
@@ -28,6 +29,13 @@ type Variables interface {
 
 	// Delete a variable by its ID.
 	Delete(ctx context.Context, workspaceID string, variableID string) error
+
+	// Replace the value of the variable with the given key, preserving its
+	// other attributes.
+	Replace(ctx context.Context, workspaceID string, key string, value string) (*Variable, error)
+
+	// Export the non-sensitive variables of a workspace in the given format.
+	Export(ctx context.Context, workspaceID string, format VariableExportFormat, w io.Writer) error
 }
 
 // variables implements Variables.
@@ -134,7 +142,7 @@ func (s *variables) List(ctx context.Context, workspaceID string, options *Varia
 	}
 
 	vl := &VariableList{}
-	err = s.client.do(ctx, req, vl)
+	err = s.client.do(ctx, "variables.List", req, vl)
 	if err != nil {
 		return nil, err
 	}
@@ -158,7 +166,7 @@ func (s *variables) Create(ctx context.Context, workspaceID string, options Vari
 	}
 
 	v := &Variable{}
-	err = s.client.do(ctx, req, v)
+	err = s.client.do(ctx, "variables.Create", req, v)
 	if err != nil {
 		return nil, err
 	}
@@ -182,7 +190,7 @@ func (s *variables) Read(ctx context.Context, workspaceID, variableID string) (*
 	}
 
 	v := &Variable{}
-	err = s.client.do(ctx, req, v)
+	err = s.client.do(ctx, "variables.Read", req, v)
 	if err != nil {
 		return nil, err
 	}
@@ -206,7 +214,7 @@ func (s *variables) Update(ctx context.Context, workspaceID, variableID string,
 	}
 
 	v := &Variable{}
-	err = s.client.do(ctx, req, v)
+	err = s.client.do(ctx, "variables.Update", req, v)
 	if err != nil {
 		return nil, err
 	}
@@ -229,7 +237,62 @@ func (s *variables) Delete(ctx context.Context, workspaceID, variableID string)
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "variables.Delete", req, nil)
+}
+
+// Replace the value of the variable with the given key, preserving its
+// other attributes, which simplifies rotating secrets. The variable is
+// updated in place, which the API also supports for sensitive variables, so
+// a failed replacement leaves the variable unchanged.
+func (s *variables) Replace(ctx context.Context, workspaceID, key, value string) (*Variable, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+	if !validString(&key) {
+		return nil, ErrRequiredKey
+	}
+
+	v, err := s.readByKey(ctx, workspaceID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Update(ctx, workspaceID, v.ID, VariableUpdateOptions{
+		Value: String(value),
+	})
+}
+
+// readByKey returns the variable of the workspace with the given key.
+func (s *variables) readByKey(ctx context.Context, workspaceID, key string) (*Variable, error) {
+	var found *Variable
+	options := &VariableListOptions{}
+	for {
+		vl, err := s.List(ctx, workspaceID, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range vl.Items {
+			if v.Key != key {
+				continue
+			}
+			if found != nil {
+				return nil, ErrAmbiguousVariableKey
+			}
+			found = v
+		}
+
+		if vl.Pagination == nil || vl.NextPage == 0 {
+			break
+		}
+		options.PageNumber = vl.NextPage
+	}
+
+	if found == nil {
+		return nil, ErrResourceNotFound
+	}
+
+	return found, nil
 }
 
 func (o VariableCreateOptions) valid() error {
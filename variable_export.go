@@ -0,0 +1,169 @@
+package tfe
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// VariableExportFormat represents the format variables are exported to.
+type VariableExportFormat string
+
+// List of available variable export formats.
+const (
+	// VariableExportTFVars renders Terraform variables as a .tfvars file.
+	VariableExportTFVars VariableExportFormat = "tfvars"
+
+	// VariableExportTFVarsJSON renders Terraform variables as a
+	// .auto.tfvars.json file.
+	VariableExportTFVarsJSON VariableExportFormat = "tfvars-json"
+
+	// VariableExportDotenv renders environment variables, and Terraform
+	// variables prefixed with TF_VAR_, as a dotenv file.
+	VariableExportDotenv VariableExportFormat = "dotenv"
+)
+
+// Export renders the non-sensitive variables of a workspace in the given
+// format to w, so the inputs of a remote run can be reproduced locally.
+func (s *variables) Export(ctx context.Context, workspaceID string, format VariableExportFormat, w io.Writer) error {
+	if !validStringID(&workspaceID) {
+		return ErrInvalidWorkspaceID
+	}
+	if err := format.valid(); err != nil {
+		return err
+	}
+
+	var vars []*Variable
+	options := &VariableListOptions{}
+	for {
+		vl, err := s.List(ctx, workspaceID, options)
+		if err != nil {
+			return err
+		}
+		vars = append(vars, vl.Items...)
+
+		if vl.Pagination == nil || vl.NextPage == 0 {
+			break
+		}
+		options.PageNumber = vl.NextPage
+	}
+
+	return RenderVariables(vars, format, w)
+}
+
+// RenderVariables renders the non-sensitive variables in the given format to
+// w. The variables are sorted by key, and variables of a category which the
+// format can not hold are skipped.
+func RenderVariables(vars []*Variable, format VariableExportFormat, w io.Writer) error {
+	if err := format.valid(); err != nil {
+		return err
+	}
+
+	var exported []*Variable
+	for _, v := range vars {
+		if v.Sensitive {
+			continue
+		}
+		if v.Category == CategoryTerraform || (v.Category == CategoryEnv && format == VariableExportDotenv) {
+			exported = append(exported, v)
+		}
+	}
+	sort.SliceStable(exported, func(i, j int) bool {
+		return exported[i].Key < exported[j].Key
+	})
+
+	switch format {
+	case VariableExportTFVars:
+		return renderTFVars(exported, w)
+	case VariableExportTFVarsJSON:
+		return renderTFVarsJSON(exported, w)
+	default:
+		return renderDotenv(exported, w)
+	}
+}
+
+func renderTFVars(vars []*Variable, w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for _, v := range vars {
+		value := v.Value
+		if !v.HCL {
+			value = quoteHCLString(value)
+		}
+		fmt.Fprintf(bw, "%s = %s\n", v.Key, value)
+	}
+	return bw.Flush()
+}
+
+func renderTFVarsJSON(vars []*Variable, w io.Writer) error {
+	values := make(map[string]json.RawMessage, len(vars))
+	for _, v := range vars {
+		if v.HCL {
+			// Only HCL values which are valid JSON can be represented.
+			if !json.Valid([]byte(v.Value)) {
+				return fmt.Errorf("HCL value of variable %q can not be exported as JSON", v.Key)
+			}
+			values[v.Key] = json.RawMessage(v.Value)
+			continue
+		}
+
+		value, err := json.Marshal(v.Value)
+		if err != nil {
+			return err
+		}
+		values[v.Key] = value
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(values)
+}
+
+func renderDotenv(vars []*Variable, w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for _, v := range vars {
+		key := v.Key
+		if v.Category == CategoryTerraform {
+			key = "TF_VAR_" + key
+		}
+		fmt.Fprintf(bw, "%s=%s\n", key, quoteDotenvValue(v.Value))
+	}
+	return bw.Flush()
+}
+
+// quoteHCLString quotes s as an HCL string literal, escaping template
+// sequences so the value is used verbatim.
+func quoteHCLString(s string) string {
+	r := strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"\t", `\t`,
+		"${", "$${",
+		"%{", "%%{",
+	)
+	return `"` + r.Replace(s) + `"`
+}
+
+// quoteDotenvValue quotes s as a double quoted dotenv value.
+func quoteDotenvValue(s string) string {
+	r := strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\n`,
+		"$", `\$`,
+	)
+	return `"` + r.Replace(s) + `"`
+}
+
+func (f VariableExportFormat) valid() error {
+	switch f {
+	case VariableExportTFVars, VariableExportTFVarsJSON, VariableExportDotenv:
+		return nil
+	}
+	return ErrInvalidVariableExportFormat
+}
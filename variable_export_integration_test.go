@@ -0,0 +1,97 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderVariables(t *testing.T) {
+	vars := []*Variable{
+		{Key: "region", Value: "eu-west-1", Category: CategoryTerraform},
+		{Key: "tags", Value: `{"team" = "infra"}`, Category: CategoryTerraform, HCL: true},
+		{Key: "zones", Value: `["a", "b"]`, Category: CategoryTerraform, HCL: true},
+		{Key: "greeting", Value: "say \"hi\" to ${name}\n", Category: CategoryTerraform},
+		{Key: "password", Value: "", Category: CategoryTerraform, Sensitive: true},
+		{Key: "AWS_REGION", Value: "eu-west-1", Category: CategoryEnv},
+		{Key: "AWS_SECRET_ACCESS_KEY", Value: "", Category: CategoryEnv, Sensitive: true},
+	}
+
+	t.Run("as tfvars", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		err := RenderVariables(vars, VariableExportTFVars, buf)
+		require.NoError(t, err)
+
+		assert.Equal(t, `greeting = "say \"hi\" to $${name}\n"
+region = "eu-west-1"
+tags = {"team" = "infra"}
+zones = ["a", "b"]
+`, buf.String())
+	})
+
+	t.Run("as tfvars JSON", func(t *testing.T) {
+		jsonVars := []*Variable{vars[0], vars[2], vars[3], vars[4], vars[5]}
+
+		buf := &bytes.Buffer{}
+		err := RenderVariables(jsonVars, VariableExportTFVarsJSON, buf)
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{
+			"greeting": "say \"hi\" to ${name}\n",
+			"region": "eu-west-1",
+			"zones": ["a", "b"]
+		}`, buf.String())
+	})
+
+	t.Run("as tfvars JSON with an HCL only value", func(t *testing.T) {
+		err := RenderVariables(vars, VariableExportTFVarsJSON, &bytes.Buffer{})
+		assert.EqualError(t, err, `HCL value of variable "tags" can not be exported as JSON`)
+	})
+
+	t.Run("as dotenv", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		err := RenderVariables(vars, VariableExportDotenv, buf)
+		require.NoError(t, err)
+
+		assert.Equal(t, `AWS_REGION="eu-west-1"
+TF_VAR_greeting="say \"hi\" to \${name}\n"
+TF_VAR_region="eu-west-1"
+TF_VAR_tags="{\"team\" = \"infra\"}"
+TF_VAR_zones="[\"a\", \"b\"]"
+`, buf.String())
+	})
+
+	t.Run("with an invalid format", func(t *testing.T) {
+		err := RenderVariables(vars, VariableExportFormat("yaml"), &bytes.Buffer{})
+		assert.Equal(t, ErrInvalidVariableExportFormat, err)
+	})
+}
+
+func TestVariablesExport(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	wTest, wTestCleanup := createWorkspace(t, client, nil)
+	defer wTestCleanup()
+
+	vTest, vTestCleanup := createVariable(t, client, wTest)
+	defer vTestCleanup()
+
+	t.Run("with a valid workspace ID", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		err := client.Variables.Export(ctx, wTest.ID, VariableExportTFVars, buf)
+		require.NoError(t, err)
+		assert.Contains(t, buf.String(), vTest.Key)
+	})
+
+	t.Run("with an invalid workspace ID", func(t *testing.T) {
+		err := client.Variables.Export(ctx, badIdentifier, VariableExportTFVars, &bytes.Buffer{})
+		assert.Equal(t, ErrInvalidWorkspaceID, err)
+	})
+}
@@ -328,3 +328,74 @@ func TestVariablesDelete(t *testing.T) {
 		assert.Equal(t, err, ErrInvalidVariableID)
 	})
 }
+
+func TestVariablesReplace(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	wTest, wTestCleanup := createWorkspace(t, client, nil)
+	defer wTestCleanup()
+
+	vTest, vTestCleanup := createVariable(t, client, wTest)
+	defer vTestCleanup()
+
+	t.Run("with a non-sensitive variable", func(t *testing.T) {
+		v, err := client.Variables.Replace(ctx, wTest.ID, vTest.Key, "newvalue")
+		require.NoError(t, err)
+
+		assert.Equal(t, vTest.ID, v.ID)
+		assert.Equal(t, "newvalue", v.Value)
+		assert.Equal(t, vTest.Description, v.Description)
+	})
+
+	t.Run("with a sensitive variable", func(t *testing.T) {
+		sTest, err := client.Variables.Create(ctx, wTest.ID, VariableCreateOptions{
+			Key:         String(randomString(t)),
+			Value:       String(randomString(t)),
+			Description: String("a secret"),
+			Category:    Category(CategoryEnv),
+			Sensitive:   Bool(true),
+		})
+		require.NoError(t, err)
+
+		defer client.Variables.Delete(ctx, wTest.ID, sTest.ID)
+
+		v, err := client.Variables.Replace(ctx, wTest.ID, sTest.Key, "rotated")
+		require.NoError(t, err)
+
+		assert.Equal(t, sTest.ID, v.ID)
+		assert.Equal(t, sTest.Key, v.Key)
+		assert.Equal(t, sTest.Description, v.Description)
+		assert.Equal(t, sTest.Category, v.Category)
+		assert.True(t, v.Sensitive)
+		assert.Empty(t, v.Value)
+	})
+
+	t.Run("with a key used by several categories", func(t *testing.T) {
+		eTest, err := client.Variables.Create(ctx, wTest.ID, VariableCreateOptions{
+			Key:      String(vTest.Key),
+			Value:    String(randomString(t)),
+			Category: Category(CategoryEnv),
+		})
+		require.NoError(t, err)
+		defer client.Variables.Delete(ctx, wTest.ID, eTest.ID)
+
+		_, err = client.Variables.Replace(ctx, wTest.ID, vTest.Key, "newvalue")
+		assert.Equal(t, ErrAmbiguousVariableKey, err)
+	})
+
+	t.Run("with a non existing key", func(t *testing.T) {
+		_, err := client.Variables.Replace(ctx, wTest.ID, "nonexisting", "newvalue")
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("with invalid workspace ID", func(t *testing.T) {
+		_, err := client.Variables.Replace(ctx, badIdentifier, vTest.Key, "newvalue")
+		assert.Equal(t, ErrInvalidWorkspaceID, err)
+	})
+
+	t.Run("without a key", func(t *testing.T) {
+		_, err := client.Variables.Replace(ctx, wTest.ID, "", "newvalue")
+		assert.Equal(t, ErrRequiredKey, err)
+	})
+}
@@ -20,6 +20,11 @@ type VariableSets interface {
 	// Create is used to create a new variable set.
 	Create(ctx context.Context, organization string, options *VariableSetCreateOptions) (*VariableSet, error)
 
+	// GetOrCreate creates a variable set, or reads it when a variable set
+	// with the same name already exists. It reports whether the variable set
+	// was created.
+	GetOrCreate(ctx context.Context, organization string, options *VariableSetCreateOptions) (*VariableSet, bool, error)
+
 	// Read a variable set by its ID.
 	Read(ctx context.Context, variableSetID string, options *VariableSetReadOptions) (*VariableSet, error)
 
@@ -171,7 +176,7 @@ func (s *variableSets) List(ctx context.Context, organization string, options *V
 	}
 
 	vl := &VariableSetList{}
-	err = s.client.do(ctx, req, vl)
+	err = s.client.do(ctx, "variableSets.List", req, vl)
 	if err != nil {
 		return nil, err
 	}
@@ -195,7 +200,7 @@ func (s *variableSets) Create(ctx context.Context, organization string, options
 	}
 
 	vl := &VariableSet{}
-	err = s.client.do(ctx, req, vl)
+	err = s.client.do(ctx, "variableSets.Create", req, vl)
 	if err != nil {
 		return nil, err
 	}
@@ -203,6 +208,44 @@ func (s *variableSets) Create(ctx context.Context, organization string, options
 	return vl, nil
 }
 
+// GetOrCreate creates a variable set, or reads it when creating it failed
+// because a variable set with the same name already exists. Creating first
+// makes it safe against concurrent callers creating the same variable set. As
+// variable sets can not be filtered by name, all variable sets of the
+// organization are listed to find an existing one. The settings of an
+// existing variable set are not compared to the options.
+func (s *variableSets) GetOrCreate(ctx context.Context, organization string, options *VariableSetCreateOptions) (*VariableSet, bool, error) {
+	if options == nil {
+		return nil, false, ErrRequiredName
+	}
+
+	vs, err := s.Create(ctx, organization, options)
+	if err == nil {
+		return vs, true, nil
+	}
+	if !isCreateConflict(err) {
+		return nil, false, err
+	}
+
+	opts := &VariableSetListOptions{}
+	for {
+		vl, lerr := s.List(ctx, organization, opts)
+		if lerr != nil {
+			return nil, false, lerr
+		}
+		for _, vs := range vl.Items {
+			if vs.Name == *options.Name {
+				return vs, false, nil
+			}
+		}
+
+		if vl.Pagination == nil || vl.NextPage == 0 {
+			return nil, false, err
+		}
+		opts.PageNumber = vl.NextPage
+	}
+}
+
 // Read is used to inspect a given variable set based on ID
 func (s *variableSets) Read(ctx context.Context, variableSetID string, options *VariableSetReadOptions) (*VariableSet, error) {
 	if !validStringID(&variableSetID) {
@@ -216,7 +259,7 @@ func (s *variableSets) Read(ctx context.Context, variableSetID string, options *
 	}
 
 	vs := &VariableSet{}
-	err = s.client.do(ctx, req, vs)
+	err = s.client.do(ctx, "variableSets.Read", req, vs)
 	if err != nil {
 		return nil, err
 	}
@@ -237,7 +280,7 @@ func (s *variableSets) Update(ctx context.Context, variableSetID string, options
 	}
 
 	v := &VariableSet{}
-	err = s.client.do(ctx, req, v)
+	err = s.client.do(ctx, "variableSets.Update", req, v)
 	if err != nil {
 		return nil, err
 	}
@@ -257,7 +300,7 @@ func (s *variableSets) Delete(ctx context.Context, variableSetID string) error {
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "variableSets.Delete", req, nil)
 }
 
 // Apply variable set to workspaces in the supplied list.
@@ -276,7 +319,7 @@ func (s *variableSets) ApplyToWorkspaces(ctx context.Context, variableSetID stri
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "variableSets.ApplyToWorkspaces", req, nil)
 }
 
 // Remove variable set from workspaces in the supplied list.
@@ -295,7 +338,7 @@ func (s *variableSets) RemoveFromWorkspaces(ctx context.Context, variableSetID s
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "variableSets.RemoveFromWorkspaces", req, nil)
 }
 
 // Update variable set to be applied to only the workspaces in the supplied list.
@@ -318,7 +361,7 @@ func (s *variableSets) UpdateWorkspaces(ctx context.Context, variableSetID strin
 	}
 
 	v := &VariableSet{}
-	err = s.client.do(ctx, req, v)
+	err = s.client.do(ctx, "variableSets.UpdateWorkspaces", req, v)
 	if err != nil {
 		return nil, err
 	}
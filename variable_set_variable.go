@@ -78,7 +78,7 @@ func (s *variableSetVariables) List(ctx context.Context, variableSetID string, o
 	}
 
 	vl := &VariableSetVariableList{}
-	err = s.client.do(ctx, req, vl)
+	err = s.client.do(ctx, "variableSetVariables.List", req, vl)
 	if err != nil {
 		return nil, err
 	}
@@ -141,7 +141,7 @@ func (s *variableSetVariables) Create(ctx context.Context, variableSetID string,
 	}
 
 	v := &VariableSetVariable{}
-	err = s.client.do(ctx, req, v)
+	err = s.client.do(ctx, "variableSetVariables.Create", req, v)
 	if err != nil {
 		return nil, err
 	}
@@ -166,7 +166,7 @@ func (s *variableSetVariables) Read(ctx context.Context, variableSetID, variable
 	}
 
 	v := &VariableSetVariable{}
-	err = s.client.do(ctx, req, v)
+	err = s.client.do(ctx, "variableSetVariables.Read", req, v)
 	if err != nil {
 		return nil, err
 	}
@@ -214,7 +214,7 @@ func (s *variableSetVariables) Update(ctx context.Context, variableSetID, variab
 	}
 
 	v := &VariableSetVariable{}
-	err = s.client.do(ctx, req, v)
+	err = s.client.do(ctx, "variableSetVariables.Update", req, v)
 	if err != nil {
 		return nil, err
 	}
@@ -237,5 +237,5 @@ func (s *variableSetVariables) Delete(ctx context.Context, variableSetID, variab
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "variableSetVariables.Delete", req, nil)
 }
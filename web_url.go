@@ -0,0 +1,77 @@
+package tfe
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// WebURL returns the URL of a resource in the web UI of Terraform Cloud or
+// Enterprise, for linking to it from notifications, chat messages or pull
+// request comments. The supported resources are organizations, workspaces,
+// runs and policy sets.
+//
+// The URL is built from the names of the resource and its relations, so a
+// workspace or policy set needs its organization, and a run needs its
+// workspace with its organization. A run read with the "workspace" include
+// has them.
+func (c *Client) WebURL(resource interface{}) (string, error) {
+	var p string
+
+	switch r := resource.(type) {
+	case *Organization:
+		if r == nil || !validStringID(&r.Name) {
+			return "", ErrRequiredOrg
+		}
+		p = r.Name
+	case *Workspace:
+		if r == nil || !validStringID(&r.Name) {
+			return "", ErrRequiredName
+		}
+		if r.Organization == nil || !validStringID(&r.Organization.Name) {
+			return "", ErrRequiredOrg
+		}
+		p = fmt.Sprintf("%s/workspaces/%s", r.Organization.Name, r.Name)
+	case *Run:
+		if r == nil || !validStringID(&r.ID) {
+			return "", ErrInvalidRunID
+		}
+		if r.Workspace == nil {
+			return "", ErrRequiredWorkspace
+		}
+		ws, err := c.WebURL(r.Workspace)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s/runs/%s", ws, r.ID), nil
+	case *PolicySet:
+		if r == nil || !validStringID(&r.ID) {
+			return "", ErrInvalidPolicySetID
+		}
+		if r.Organization == nil || !validStringID(&r.Organization.Name) {
+			return "", ErrRequiredOrg
+		}
+		p = fmt.Sprintf("%s/settings/policy-sets/%s/edit", r.Organization.Name, r.ID)
+	default:
+		return "", ErrUnsupportedWebURLResource
+	}
+
+	return c.webURL(p), nil
+}
+
+// webURL returns the URL of a path of the web UI, relative to "/app/". The
+// web UI is assumed to be served next to the API, so the base path of the
+// API is kept if it ends with the default base path.
+func (c *Client) webURL(p string) string {
+	prefix := ""
+	if strings.HasSuffix(c.baseURL.Path, DefaultBasePath) {
+		prefix = strings.TrimSuffix(c.baseURL.Path, DefaultBasePath)
+	}
+
+	u := &url.URL{
+		Scheme: c.baseURL.Scheme,
+		Host:   c.baseURL.Host,
+		Path:   prefix + "/app/" + p,
+	}
+	return u.String()
+}
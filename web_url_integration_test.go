@@ -0,0 +1,82 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientWebURL(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	org := &Organization{Name: "acme"}
+	ws := &Workspace{ID: "ws-1", Name: "app", Organization: org}
+
+	t.Run("with supported resources", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			resource interface{}
+			url      string
+		}{
+			"organization": {org, ts.URL + "/app/acme"},
+			"workspace":    {ws, ts.URL + "/app/acme/workspaces/app"},
+			"run":          {&Run{ID: "run-1", Workspace: ws}, ts.URL + "/app/acme/workspaces/app/runs/run-1"},
+			"policy set":   {&PolicySet{ID: "polset-1", Organization: org}, ts.URL + "/app/acme/settings/policy-sets/polset-1/edit"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				u, err := client.WebURL(tc.resource)
+				require.NoError(t, err)
+				assert.Equal(t, tc.url, u)
+			})
+		}
+	})
+
+	t.Run("with missing relations", func(t *testing.T) {
+		_, err := client.WebURL(&Workspace{ID: "ws-1", Name: "app"})
+		assert.Equal(t, ErrRequiredOrg, err)
+
+		_, err = client.WebURL(&Run{ID: "run-1"})
+		assert.Equal(t, ErrRequiredWorkspace, err)
+
+		_, err = client.WebURL(&Run{ID: "run-1", Workspace: &Workspace{ID: "ws-1"}})
+		assert.Equal(t, ErrRequiredName, err)
+
+		_, err = client.WebURL(&PolicySet{Organization: org})
+		assert.Equal(t, ErrInvalidPolicySetID, err)
+	})
+
+	t.Run("with an unsupported resource", func(t *testing.T) {
+		_, err := client.WebURL(&Team{ID: "team-1"})
+		assert.Equal(t, ErrUnsupportedWebURLResource, err)
+	})
+
+	t.Run("with the API served under a path", func(t *testing.T) {
+		client, err := NewClient(&Config{
+			Address:    ts.URL,
+			BasePath:   "/tfe/api/v2/",
+			Token:      "dummy-token",
+			HTTPClient: ts.Client(),
+			NoPing:     true,
+		})
+		require.NoError(t, err)
+
+		u, err := client.WebURL(ws)
+		require.NoError(t, err)
+		assert.Equal(t, ts.URL+"/tfe/app/acme/workspaces/app", u)
+	})
+}
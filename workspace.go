@@ -23,9 +23,21 @@ type Workspaces interface {
 	// Create is used to create a new workspace.
 	Create(ctx context.Context, organization string, options WorkspaceCreateOptions) (*Workspace, error)
 
+	// GetOrCreate creates a workspace, or reads it when a workspace with the
+	// same name already exists. It reports whether the workspace was created.
+	GetOrCreate(ctx context.Context, organization string, options WorkspaceCreateOptions) (*Workspace, bool, error)
+
 	// Read a workspace by its name and organization name.
 	Read(ctx context.Context, organization string, workspace string) (*Workspace, error)
 
+	// ReconcileSettings compares the settings of the workspaces carrying a
+	// tag against a policy, and optionally fixes the drift.
+	ReconcileSettings(ctx context.Context, organization string, options WorkspaceReconcileOptions) ([]*WorkspaceReconcileResult, error)
+
+	// ReadMany reads the workspaces of an organization by their names, and
+	// returns them keyed by name.
+	ReadMany(ctx context.Context, organization string, names []string) (map[string]*Workspace, error)
+
 	// ReadWithOptions reads a workspace by name and organization name with given options.
 	ReadWithOptions(ctx context.Context, organization string, workspace string, options *WorkspaceReadOptions) (*Workspace, error)
 
@@ -44,6 +56,10 @@ type Workspaces interface {
 	// UpdateByID updates the settings of an existing workspace.
 	UpdateByID(ctx context.Context, workspaceID string, options WorkspaceUpdateOptions) (*Workspace, error)
 
+	// UpdateRelationshipsByID updates only the relationships of an existing
+	// workspace, leaving all of its attributes unchanged.
+	UpdateRelationshipsByID(ctx context.Context, workspaceID string, options RelationshipsUpdateOptions) (*Workspace, error)
+
 	// Delete a workspace by its name.
 	Delete(ctx context.Context, organization string, workspace string) error
 
@@ -65,6 +81,12 @@ type Workspaces interface {
 	// ForceUnlock a workspace by its ID.
 	ForceUnlock(ctx context.Context, workspaceID string) (*Workspace, error)
 
+	// LockStatus reads the lock of a workspace, including who holds it.
+	LockStatus(ctx context.Context, workspaceID string) (*WorkspaceLockStatus, error)
+
+	// WaitForUnlock waits until the lock of a workspace is released.
+	WaitForUnlock(ctx context.Context, workspaceID string, options *WorkspaceUnlockWaitOptions) (*WorkspaceLockStatus, error)
+
 	// AssignSSHKey to a workspace.
 	AssignSSHKey(ctx context.Context, workspaceID string, options WorkspaceAssignSSHKeyOptions) (*Workspace, error)
 
@@ -92,6 +114,14 @@ type Workspaces interface {
 
 	// RemoveTags removes tags from a workspace
 	RemoveTags(ctx context.Context, workspaceID string, options WorkspaceRemoveTagsOptions) error
+
+	// AddTagsByFilter adds tags to all the workspaces of an organization
+	// matching the filter.
+	AddTagsByFilter(ctx context.Context, organization string, options WorkspaceTagsByFilterOptions) ([]*WorkspaceTagsResult, error)
+
+	// RemoveTagsByFilter removes tags from all the workspaces of an
+	// organization matching the filter.
+	RemoveTagsByFilter(ctx context.Context, organization string, options WorkspaceTagsByFilterOptions) ([]*WorkspaceTagsResult, error)
 }
 
 // workspaces implements Workspaces.
@@ -99,6 +129,16 @@ type workspaces struct {
 	client *Client
 }
 
+// ExecutionModeType represents where the runs of a workspace are executed.
+type ExecutionModeType string
+
+// List all available execution modes.
+const (
+	ExecutionModeRemote ExecutionModeType = "remote"
+	ExecutionModeLocal  ExecutionModeType = "local"
+	ExecutionModeAgent  ExecutionModeType = "agent"
+)
+
 // WorkspaceList represents a list of workspaces.
 type WorkspaceList struct {
 	*Pagination
@@ -107,49 +147,58 @@ type WorkspaceList struct {
 
 // Workspace represents a Terraform Enterprise workspace.
 type Workspace struct {
-	ID                         string                `jsonapi:"primary,workspaces"`
-	Actions                    *WorkspaceActions     `jsonapi:"attr,actions"`
-	AgentPoolID                string                `jsonapi:"attr,agent-pool-id"`
-	AllowDestroyPlan           bool                  `jsonapi:"attr,allow-destroy-plan"`
-	AutoApply                  bool                  `jsonapi:"attr,auto-apply"`
-	CanQueueDestroyPlan        bool                  `jsonapi:"attr,can-queue-destroy-plan"`
-	CreatedAt                  time.Time             `jsonapi:"attr,created-at,iso8601"`
-	Description                string                `jsonapi:"attr,description"`
-	Environment                string                `jsonapi:"attr,environment"`
-	ExecutionMode              string                `jsonapi:"attr,execution-mode"`
-	FileTriggersEnabled        bool                  `jsonapi:"attr,file-triggers-enabled"`
-	GlobalRemoteState          bool                  `jsonapi:"attr,global-remote-state"`
-	Locked                     bool                  `jsonapi:"attr,locked"`
-	MigrationEnvironment       string                `jsonapi:"attr,migration-environment"`
-	Name                       string                `jsonapi:"attr,name"`
-	Operations                 bool                  `jsonapi:"attr,operations"`
-	Permissions                *WorkspacePermissions `jsonapi:"attr,permissions"`
-	QueueAllRuns               bool                  `jsonapi:"attr,queue-all-runs"`
-	SpeculativeEnabled         bool                  `jsonapi:"attr,speculative-enabled"`
-	SourceName                 string                `jsonapi:"attr,source-name"`
-	SourceURL                  string                `jsonapi:"attr,source-url"`
-	StructuredRunOutputEnabled bool                  `jsonapi:"attr,structured-run-output-enabled"`
-	TerraformVersion           string                `jsonapi:"attr,terraform-version"`
-	TriggerPrefixes            []string              `jsonapi:"attr,trigger-prefixes"`
-	VCSRepo                    *VCSRepo              `jsonapi:"attr,vcs-repo"`
-	WorkingDirectory           string                `jsonapi:"attr,working-directory"`
-	UpdatedAt                  time.Time             `jsonapi:"attr,updated-at,iso8601"`
-	ResourceCount              int                   `jsonapi:"attr,resource-count"`
-	ApplyDurationAverage       time.Duration         `jsonapi:"attr,apply-duration-average"`
-	PlanDurationAverage        time.Duration         `jsonapi:"attr,plan-duration-average"`
-	PolicyCheckFailures        int                   `jsonapi:"attr,policy-check-failures"`
-	RunFailures                int                   `jsonapi:"attr,run-failures"`
-	RunsCount                  int                   `jsonapi:"attr,workspace-kpis-runs-count"`
-	TagNames                   []string              `jsonapi:"attr,tag-names"`
+	ID                         string                      `jsonapi:"primary,workspaces"`
+	Actions                    *WorkspaceActions           `jsonapi:"attr,actions"`
+	AgentPoolID                string                      `jsonapi:"attr,agent-pool-id"`
+	AllowDestroyPlan           bool                        `jsonapi:"attr,allow-destroy-plan"`
+	AssessmentsEnabled         bool                        `jsonapi:"attr,assessments-enabled"`
+	AutoApply                  bool                        `jsonapi:"attr,auto-apply"`
+	CanQueueDestroyPlan        bool                        `jsonapi:"attr,can-queue-destroy-plan"`
+	CreatedAt                  time.Time                   `jsonapi:"attr,created-at,iso8601"`
+	Description                string                      `jsonapi:"attr,description"`
+	Environment                string                      `jsonapi:"attr,environment"`
+	ExecutionMode              ExecutionModeType           `jsonapi:"attr,execution-mode"`
+	FileTriggersEnabled        bool                        `jsonapi:"attr,file-triggers-enabled"`
+	GlobalRemoteState          bool                        `jsonapi:"attr,global-remote-state"`
+	Locked                     bool                        `jsonapi:"attr,locked"`
+	MigrationEnvironment       string                      `jsonapi:"attr,migration-environment"`
+	Name                       string                      `jsonapi:"attr,name"`
+	Operations                 bool                        `jsonapi:"attr,operations"`
+	Permissions                *WorkspacePermissions       `jsonapi:"attr,permissions"`
+	QueueAllRuns               bool                        `jsonapi:"attr,queue-all-runs"`
+	SpeculativeEnabled         bool                        `jsonapi:"attr,speculative-enabled"`
+	SourceName                 string                      `jsonapi:"attr,source-name"`
+	SourceURL                  string                      `jsonapi:"attr,source-url"`
+	StructuredRunOutputEnabled bool                        `jsonapi:"attr,structured-run-output-enabled"`
+	TerraformVersion           string                      `jsonapi:"attr,terraform-version"`
+	TriggerPrefixes            []string                    `jsonapi:"attr,trigger-prefixes"`
+	TriggerPatterns            []string                    `jsonapi:"attr,trigger-patterns"`
+	VCSRepo                    *VCSRepo                    `jsonapi:"attr,vcs-repo"`
+	WorkingDirectory           string                      `jsonapi:"attr,working-directory"`
+	UpdatedAt                  time.Time                   `jsonapi:"attr,updated-at,iso8601"`
+	ResourceCount              int                         `jsonapi:"attr,resource-count"`
+	ApplyDurationAverage       time.Duration               `jsonapi:"attr,apply-duration-average"`
+	PlanDurationAverage        time.Duration               `jsonapi:"attr,plan-duration-average"`
+	PolicyCheckFailures        int                         `jsonapi:"attr,policy-check-failures"`
+	RunFailures                int                         `jsonapi:"attr,run-failures"`
+	RunsCount                  int                         `jsonapi:"attr,workspace-kpis-runs-count"`
+	TagNames                   []string                    `jsonapi:"attr,tag-names"`
+	SettingOverwrites          *WorkspaceSettingOverwrites `jsonapi:"attr,setting-overwrites"`
 
 	// Relations
-	AgentPool           *AgentPool          `jsonapi:"relation,agent-pool"`
-	CurrentRun          *Run                `jsonapi:"relation,current-run"`
-	CurrentStateVersion *StateVersion       `jsonapi:"relation,current-state-version"`
-	Organization        *Organization       `jsonapi:"relation,organization"`
-	SSHKey              *SSHKey             `jsonapi:"relation,ssh-key"`
-	Outputs             []*WorkspaceOutputs `jsonapi:"relation,outputs"`
-	Tags                []*Tag              `jsonapi:"relation,tags"`
+	AgentPool                   *AgentPool            `jsonapi:"relation,agent-pool"`
+	CurrentConfigurationVersion *ConfigurationVersion `jsonapi:"relation,current-configuration-version"`
+	CurrentRun                  *Run                  `jsonapi:"relation,current-run"`
+	CurrentStateVersion         *StateVersion         `jsonapi:"relation,current-state-version"`
+	Organization                *Organization         `jsonapi:"relation,organization"`
+	Project                     *Project              `jsonapi:"relation,project"`
+	SSHKey                      *SSHKey               `jsonapi:"relation,ssh-key"`
+	Outputs                     []*WorkspaceOutputs   `jsonapi:"relation,outputs"`
+	Tags                        []*Tag                `jsonapi:"relation,tags"`
+
+	// Who holds the lock of the workspace. It is decoded separately, as the
+	// relation refers to a run, a user or a team.
+	LockedBy *LockedByChoice
 }
 
 type WorkspaceOutputs struct {
@@ -181,6 +230,26 @@ type VCSRepo struct {
 	OAuthTokenID      string `jsonapi:"attr,oauth-token-id"`
 	RepositoryHTTPURL string `jsonapi:"attr,repository-http-url"`
 	ServiceProvider   string `jsonapi:"attr,service-provider"`
+	TagsRegex         string `jsonapi:"attr,tags-regex"`
+}
+
+// WorkspaceSettingOverwrites represents which settings of a workspace are set
+// explicitly on the workspace. A false value means the setting is inherited
+// from the defaults of the organization.
+type WorkspaceSettingOverwrites struct {
+	ExecutionMode *bool `jsonapi:"attr,execution-mode"`
+	AgentPool     *bool `jsonapi:"attr,agent-pool"`
+}
+
+// WorkspaceSettingOverwritesOptions represents which settings of a workspace
+// are set explicitly on the workspace. Set a setting to false to have the
+// workspace inherit it from the defaults of the organization.
+type WorkspaceSettingOverwritesOptions struct {
+	// Optional: Whether the execution mode of the workspace is set explicitly.
+	ExecutionMode *bool `json:"execution-mode,omitempty"`
+
+	// Optional: Whether the agent pool of the workspace is set explicitly.
+	AgentPool *bool `json:"agent-pool,omitempty"`
 }
 
 // WorkspaceActions represents the workspace actions.
@@ -238,10 +307,69 @@ type WorkspaceListOptions struct {
 	// Optional: A search string (comma-separated tag names) used to filter the results.
 	Tags string `url:"search[tags],omitempty"`
 
+	// Optional: A search string (comma-separated tag names) used to exclude the
+	// workspaces carrying any of these tags from the results.
+	ExcludeTags string `url:"search[exclude-tags],omitempty"`
+
+	// Optional: Only list the workspaces of the project with this ID. The
+	// TotalCount of the pagination is the number of workspaces in the project.
+	ProjectID string `url:"filter[project][id],omitempty"`
+
+	// Optional: A name pattern used to filter the results, in which "*"
+	// matches any characters, like "app-*-prod".
+	WildcardName string `url:"search[wildcard-name],omitempty"`
+
+	// Optional: Only list the workspaces whose current run has one of these
+	// statuses.
+	CurrentRunStatus []RunStatus `url:"filter[current-run][status],comma,omitempty"`
+
+	// Optional: Only list the workspaces carrying all of these tags. A tag
+	// without a value matches any value of its key.
+	TagBindings WorkspaceTagBindings `url:"filter[tagged],omitempty"`
+
+	// Optional: The attribute to sort the results by. Prefix the attribute
+	// with a hyphen to sort in descending order, like "-name".
+	Sort WorkspaceSortKey `url:"sort,omitempty"`
+
 	// Optional: A list of relations to include. See available resources https://www.terraform.io/docs/cloud/api/workspaces.html#available-related-resources
 	Include []WSIncludeOpt `url:"include,omitempty"`
 }
 
+// WorkspaceSortKey represents an attribute the workspaces can be sorted by.
+type WorkspaceSortKey string
+
+// List all available workspace sort keys.
+const (
+	WorkspaceSortName                WorkspaceSortKey = "name"
+	WorkspaceSortCurrentRunCreatedAt WorkspaceSortKey = "current-run.created-at"
+)
+
+// Desc returns the sort key sorting in descending order.
+func (k WorkspaceSortKey) Desc() WorkspaceSortKey {
+	return "-" + WorkspaceSortKey(strings.TrimPrefix(string(k), "-"))
+}
+
+// WorkspaceTagBinding represents a tag a workspace is filtered by.
+type WorkspaceTagBinding struct {
+	Key   string
+	Value string
+}
+
+// WorkspaceTagBindings represents the tags the workspaces are filtered by.
+type WorkspaceTagBindings []*WorkspaceTagBinding
+
+// EncodeValues encodes the tags as indexed query parameters, like
+// filter[tagged][0][key]=env&filter[tagged][0][value]=prod.
+func (b WorkspaceTagBindings) EncodeValues(key string, v *url.Values) error {
+	for i, t := range b {
+		v.Add(fmt.Sprintf("%s[%d][key]", key, i), t.Key)
+		if t.Value != "" {
+			v.Add(fmt.Sprintf("%s[%d][value]", key, i), t.Value)
+		}
+	}
+	return nil
+}
+
 // WorkspaceCreateOptions represents the options for creating a new workspace.
 type WorkspaceCreateOptions struct {
 	// Type is a public field utilized by JSON:API to
@@ -258,6 +386,10 @@ type WorkspaceCreateOptions struct {
 	// Optional: Whether destroy plans can be queued on the workspace.
 	AllowDestroyPlan *bool `jsonapi:"attr,allow-destroy-plan,omitempty"`
 
+	// Optional: Whether health assessments, which detect drift, are enabled
+	// for the workspace.
+	AssessmentsEnabled *bool `jsonapi:"attr,assessments-enabled,omitempty"`
+
 	// Optional: Whether to automatically apply changes when a Terraform plan is successful.
 	AutoApply *bool `jsonapi:"attr,auto-apply,omitempty"`
 
@@ -268,7 +400,7 @@ type WorkspaceCreateOptions struct {
 	// When set to local, the workspace will be used for state storage only.
 	// This value must not be specified if operations is specified.
 	// 'agent' execution mode is not available in Terraform Enterprise.
-	ExecutionMode *string `jsonapi:"attr,execution-mode,omitempty"`
+	ExecutionMode *ExecutionModeType `jsonapi:"attr,execution-mode,omitempty"`
 
 	// Optional: Whether to filter runs based on the changed files in a VCS push. If
 	// enabled, the working directory and trigger prefixes describe a set of
@@ -296,6 +428,10 @@ type WorkspaceCreateOptions struct {
 	// a webhook will not be queued until at least one run is manually queued.
 	QueueAllRuns *bool `jsonapi:"attr,queue-all-runs,omitempty"`
 
+	// Optional: Which settings are set explicitly on the workspace, instead of
+	// being inherited from the defaults of the organization.
+	SettingOverwrites *WorkspaceSettingOverwritesOptions `jsonapi:"attr,setting-overwrites,omitempty"`
+
 	// Whether this workspace allows speculative plans. Setting this to false
 	// prevents Terraform Cloud or the Terraform Enterprise instance from
 	// running plans on pull requests, which can improve security if the VCS
@@ -326,6 +462,11 @@ type WorkspaceCreateOptions struct {
 	// tracked for changes. See FileTriggersEnabled above for more details.
 	TriggerPrefixes []string `jsonapi:"attr,trigger-prefixes,omitempty"`
 
+	// Optional: List of glob patterns, relative to the repository root, which
+	// describe the files tracked for changes. This value must not be specified
+	// if trigger prefixes or a tags regex are specified.
+	TriggerPatterns []string `jsonapi:"attr,trigger-patterns,omitempty"`
+
 	// Settings for the workspace's VCS repository. If omitted, the workspace is
 	// created without a VCS repo. If included, you must specify at least the
 	// oauth-token-id and identifier keys below.
@@ -348,6 +489,7 @@ type VCSRepoOptions struct {
 	Identifier        *string `json:"identifier,omitempty"`
 	IngressSubmodules *bool   `json:"ingress-submodules,omitempty"`
 	OAuthTokenID      *string `json:"oauth-token-id,omitempty"`
+	TagsRegex         *string `json:"tags-regex,omitempty"`
 }
 
 // WorkspaceUpdateOptions represents the options for updating a workspace.
@@ -361,11 +503,16 @@ type WorkspaceUpdateOptions struct {
 	// Required when: execution-mode is set to agent. The ID of the agent pool
 	// belonging to the workspace's organization. This value must not be specified
 	// if execution-mode is set to remote or local or if operations is set to true.
-	AgentPoolID *string `jsonapi:"attr,agent-pool-id,omitempty"`
+	// Use Null to remove the agent pool from the workspace.
+	AgentPoolID Optional[string] `jsonapi:"attr,agent-pool-id,omitempty"`
 
 	// Optional: Whether destroy plans can be queued on the workspace.
 	AllowDestroyPlan *bool `jsonapi:"attr,allow-destroy-plan,omitempty"`
 
+	// Optional: Whether health assessments, which detect drift, are enabled
+	// for the workspace.
+	AssessmentsEnabled *bool `jsonapi:"attr,assessments-enabled,omitempty"`
+
 	// Optional: Whether to automatically apply changes when a Terraform plan is successful.
 	AutoApply *bool `jsonapi:"attr,auto-apply,omitempty"`
 
@@ -375,14 +522,15 @@ type WorkspaceUpdateOptions struct {
 	// API and UI.
 	Name *string `jsonapi:"attr,name,omitempty"`
 
-	// Optional: A description for the workspace.
-	Description *string `jsonapi:"attr,description,omitempty"`
+	// Optional: A description for the workspace. Use Null to remove the
+	// description from the workspace.
+	Description Optional[string] `jsonapi:"attr,description,omitempty"`
 
 	// Optional: Which execution mode to use. Valid values are remote, local, and agent.
 	// When set to local, the workspace will be used for state storage only.
 	// This value must not be specified if operations is specified.
 	// 'agent' execution mode is not available in Terraform Enterprise.
-	ExecutionMode *string `jsonapi:"attr,execution-mode,omitempty"`
+	ExecutionMode *ExecutionModeType `jsonapi:"attr,execution-mode,omitempty"`
 
 	// Optional: Whether to filter runs based on the changed files in a VCS push. If
 	// enabled, the working directory and trigger prefixes describe a set of
@@ -401,6 +549,10 @@ type WorkspaceUpdateOptions struct {
 	// a webhook will not be queued until at least one run is manually queued.
 	QueueAllRuns *bool `jsonapi:"attr,queue-all-runs,omitempty"`
 
+	// Optional: Which settings are set explicitly on the workspace, instead of
+	// being inherited from the defaults of the organization.
+	SettingOverwrites *WorkspaceSettingOverwritesOptions `jsonapi:"attr,setting-overwrites,omitempty"`
+
 	// Optional: Whether this workspace allows speculative plans. Setting this to false
 	// prevents Terraform Cloud or the Terraform Enterprise instance from
 	// running plans on pull requests, which can improve security if the VCS
@@ -420,12 +572,17 @@ type WorkspaceUpdateOptions struct {
 	// tracked for changes. See FileTriggersEnabled above for more details.
 	TriggerPrefixes []string `jsonapi:"attr,trigger-prefixes,omitempty"`
 
-	// Optional: To delete a workspace's existing VCS repo, specify null instead of an
+	// Optional: List of glob patterns, relative to the repository root, which
+	// describe the files tracked for changes. This value must not be specified
+	// if trigger prefixes or a tags regex are specified.
+	TriggerPatterns []string `jsonapi:"attr,trigger-patterns,omitempty"`
+
+	// Optional: To delete a workspace's existing VCS repo, use Null instead of an
 	// object. To modify a workspace's existing VCS repo, include whichever of
 	// the keys below you wish to modify. To add a new VCS repo to a workspace
 	// that didn't previously have one, include at least the oauth-token-id and
 	// identifier keys.
-	VCSRepo *VCSRepoOptions `jsonapi:"attr,vcs-repo,omitempty"`
+	VCSRepo Optional[VCSRepoOptions] `jsonapi:"attr,vcs-repo,omitempty"`
 
 	// Optional: A relative path that Terraform will execute within. This defaults to the
 	// root of your repository and is typically set to a subdirectory matching
@@ -529,7 +686,7 @@ func (s *workspaces) List(ctx context.Context, organization string, options *Wor
 	}
 
 	wl := &WorkspaceList{}
-	err = s.client.do(ctx, req, wl)
+	err = s.client.do(ctx, "workspaces.List", req, wl)
 	if err != nil {
 		return nil, err
 	}
@@ -553,7 +710,7 @@ func (s *workspaces) Create(ctx context.Context, organization string, options Wo
 	}
 
 	w := &Workspace{}
-	err = s.client.do(ctx, req, w)
+	err = s.client.do(ctx, "workspaces.Create", req, w)
 	if err != nil {
 		return nil, err
 	}
@@ -561,13 +718,39 @@ func (s *workspaces) Create(ctx context.Context, organization string, options Wo
 	return w, nil
 }
 
+// GetOrCreate creates a workspace, or reads it when creating it failed because
+// a workspace with the same name already exists. Creating first makes it safe
+// against concurrent callers creating the same workspace. The settings of an
+// existing workspace are not compared to the options.
+func (s *workspaces) GetOrCreate(ctx context.Context, organization string, options WorkspaceCreateOptions) (*Workspace, bool, error) {
+	w, err := s.Create(ctx, organization, options)
+	if err == nil {
+		return w, true, nil
+	}
+	if !isCreateConflict(err) {
+		return nil, false, err
+	}
+
+	w, err = s.Read(ctx, organization, *options.Name)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return w, false, nil
+}
+
 // Read a workspace by its name and organization name.
 func (s *workspaces) Read(ctx context.Context, organization, workspace string) (*Workspace, error) {
-	return s.ReadWithOptions(ctx, organization, workspace, nil)
+	return s.readWithOptions(ctx, "workspaces.Read", organization, workspace, nil)
 }
 
 // ReadWithOptions reads a workspace by name and organization name with given options.
 func (s *workspaces) ReadWithOptions(ctx context.Context, organization, workspace string, options *WorkspaceReadOptions) (*Workspace, error) {
+	return s.readWithOptions(ctx, "workspaces.ReadWithOptions", organization, workspace, options)
+}
+
+// readWithOptions implements Read and ReadWithOptions, naming the API call op.
+func (s *workspaces) readWithOptions(ctx context.Context, op, organization, workspace string, options *WorkspaceReadOptions) (*Workspace, error) {
 	if !validStringID(&organization) {
 		return nil, ErrInvalidOrg
 	}
@@ -589,7 +772,7 @@ func (s *workspaces) ReadWithOptions(ctx context.Context, organization, workspac
 	}
 
 	w := &Workspace{}
-	err = s.client.do(ctx, req, w)
+	err = s.client.do(ctx, op, req, w)
 	if err != nil {
 		return nil, err
 	}
@@ -603,11 +786,17 @@ func (s *workspaces) ReadWithOptions(ctx context.Context, organization, workspac
 
 // ReadByID reads a workspace by its ID.
 func (s *workspaces) ReadByID(ctx context.Context, workspaceID string) (*Workspace, error) {
-	return s.ReadByIDWithOptions(ctx, workspaceID, nil)
+	return s.readByIDWithOptions(ctx, "workspaces.ReadByID", workspaceID, nil)
 }
 
 // ReadByIDWithOptions reads a workspace by its ID with the given options.
 func (s *workspaces) ReadByIDWithOptions(ctx context.Context, workspaceID string, options *WorkspaceReadOptions) (*Workspace, error) {
+	return s.readByIDWithOptions(ctx, "workspaces.ReadByIDWithOptions", workspaceID, options)
+}
+
+// readByIDWithOptions implements ReadByID and ReadByIDWithOptions, naming the
+// API call op.
+func (s *workspaces) readByIDWithOptions(ctx context.Context, op, workspaceID string, options *WorkspaceReadOptions) (*Workspace, error) {
 	if !validStringID(&workspaceID) {
 		return nil, ErrInvalidWorkspaceID
 	}
@@ -619,7 +808,7 @@ func (s *workspaces) ReadByIDWithOptions(ctx context.Context, workspaceID string
 	}
 
 	w := &Workspace{}
-	err = s.client.do(ctx, req, w)
+	err = s.client.do(ctx, op, req, w)
 	if err != nil {
 		return nil, err
 	}
@@ -644,7 +833,7 @@ func (s *workspaces) Readme(ctx context.Context, workspaceID string) (io.Reader,
 	}
 
 	r := &workspaceWithReadme{}
-	err = s.client.do(ctx, req, r)
+	err = s.client.do(ctx, "workspaces.Readme", req, r)
 	if err != nil {
 		return nil, err
 	}
@@ -678,7 +867,7 @@ func (s *workspaces) Update(ctx context.Context, organization, workspace string,
 	}
 
 	w := &Workspace{}
-	err = s.client.do(ctx, req, w)
+	err = s.client.do(ctx, "workspaces.Update", req, w)
 	if err != nil {
 		return nil, err
 	}
@@ -699,7 +888,24 @@ func (s *workspaces) UpdateByID(ctx context.Context, workspaceID string, options
 	}
 
 	w := &Workspace{}
-	err = s.client.do(ctx, req, w)
+	err = s.client.do(ctx, "workspaces.UpdateByID", req, w)
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// UpdateRelationshipsByID updates only the relationships of an existing
+// workspace, leaving all of its attributes unchanged.
+func (s *workspaces) UpdateRelationshipsByID(ctx context.Context, workspaceID string, options RelationshipsUpdateOptions) (*Workspace, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+
+	u := fmt.Sprintf("workspaces/%s", url.QueryEscape(workspaceID))
+	w := &Workspace{}
+	err := s.client.updateRelationships(ctx, "workspaces.UpdateRelationshipsByID", u, "workspaces", workspaceID, options, w)
 	if err != nil {
 		return nil, err
 	}
@@ -726,7 +932,7 @@ func (s *workspaces) Delete(ctx context.Context, organization, workspace string)
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "workspaces.Delete", req, nil)
 }
 
 // DeleteByID deletes a workspace by its ID.
@@ -741,7 +947,7 @@ func (s *workspaces) DeleteByID(ctx context.Context, workspaceID string) error {
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "workspaces.DeleteByID", req, nil)
 }
 
 // RemoveVCSConnection from a workspace.
@@ -765,7 +971,7 @@ func (s *workspaces) RemoveVCSConnection(ctx context.Context, organization, work
 	}
 
 	w := &Workspace{}
-	err = s.client.do(ctx, req, w)
+	err = s.client.do(ctx, "workspaces.RemoveVCSConnection", req, w)
 	if err != nil {
 		return nil, err
 	}
@@ -787,7 +993,7 @@ func (s *workspaces) RemoveVCSConnectionByID(ctx context.Context, workspaceID st
 	}
 
 	w := &Workspace{}
-	err = s.client.do(ctx, req, w)
+	err = s.client.do(ctx, "workspaces.RemoveVCSConnectionByID", req, w)
 	if err != nil {
 		return nil, err
 	}
@@ -808,7 +1014,10 @@ func (s *workspaces) Lock(ctx context.Context, workspaceID string, options Works
 	}
 
 	w := &Workspace{}
-	err = s.client.do(ctx, req, w)
+	err = s.client.do(ctx, "workspaces.Lock", req, w)
+	if err == ErrWorkspaceLocked {
+		return nil, s.workspaceLockedError(ctx, workspaceID)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -829,7 +1038,7 @@ func (s *workspaces) Unlock(ctx context.Context, workspaceID string) (*Workspace
 	}
 
 	w := &Workspace{}
-	err = s.client.do(ctx, req, w)
+	err = s.client.do(ctx, "workspaces.Unlock", req, w)
 	if err != nil {
 		return nil, err
 	}
@@ -850,7 +1059,7 @@ func (s *workspaces) ForceUnlock(ctx context.Context, workspaceID string) (*Work
 	}
 
 	w := &Workspace{}
-	err = s.client.do(ctx, req, w)
+	err = s.client.do(ctx, "workspaces.ForceUnlock", req, w)
 	if err != nil {
 		return nil, err
 	}
@@ -874,7 +1083,7 @@ func (s *workspaces) AssignSSHKey(ctx context.Context, workspaceID string, optio
 	}
 
 	w := &Workspace{}
-	err = s.client.do(ctx, req, w)
+	err = s.client.do(ctx, "workspaces.AssignSSHKey", req, w)
 	if err != nil {
 		return nil, err
 	}
@@ -895,7 +1104,7 @@ func (s *workspaces) UnassignSSHKey(ctx context.Context, workspaceID string) (*W
 	}
 
 	w := &Workspace{}
-	err = s.client.do(ctx, req, w)
+	err = s.client.do(ctx, "workspaces.UnassignSSHKey", req, w)
 	if err != nil {
 		return nil, err
 	}
@@ -917,7 +1126,7 @@ func (s *workspaces) ListRemoteStateConsumers(ctx context.Context, workspaceID s
 	}
 
 	wl := &WorkspaceList{}
-	err = s.client.do(ctx, req, wl)
+	err = s.client.do(ctx, "workspaces.ListRemoteStateConsumers", req, wl)
 	if err != nil {
 		return nil, err
 	}
@@ -940,7 +1149,7 @@ func (s *workspaces) AddRemoteStateConsumers(ctx context.Context, workspaceID st
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "workspaces.AddRemoteStateConsumers", req, nil)
 }
 
 // RemoveRemoteStateConsumers removes the remote state consumers for a given workspace.
@@ -958,7 +1167,7 @@ func (s *workspaces) RemoveRemoteStateConsumers(ctx context.Context, workspaceID
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "workspaces.RemoveRemoteStateConsumers", req, nil)
 }
 
 // UpdateRemoteStateConsumers removes the remote state consumers for a given workspace.
@@ -976,7 +1185,7 @@ func (s *workspaces) UpdateRemoteStateConsumers(ctx context.Context, workspaceID
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "workspaces.UpdateRemoteStateConsumers", req, nil)
 }
 
 // ListTags returns the tags for a given workspace.
@@ -993,7 +1202,7 @@ func (s *workspaces) ListTags(ctx context.Context, workspaceID string, options *
 	}
 
 	tl := &TagList{}
-	err = s.client.do(ctx, req, tl)
+	err = s.client.do(ctx, "workspaces.ListTags", req, tl)
 	if err != nil {
 		return nil, err
 	}
@@ -1016,7 +1225,7 @@ func (s *workspaces) AddTags(ctx context.Context, workspaceID string, options Wo
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "workspaces.AddTags", req, nil)
 }
 
 // RemoveTags removes a list of tags from a workspace.
@@ -1034,7 +1243,7 @@ func (s *workspaces) RemoveTags(ctx context.Context, workspaceID string, options
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "workspaces.RemoveTags", req, nil)
 }
 
 func (o WorkspaceCreateOptions) valid() error {
@@ -1047,14 +1256,17 @@ func (o WorkspaceCreateOptions) valid() error {
 	if o.Operations != nil && o.ExecutionMode != nil {
 		return ErrUnsupportedOperations
 	}
-	if o.AgentPoolID != nil && (o.ExecutionMode == nil || *o.ExecutionMode != "agent") {
+	if o.ExecutionMode != nil && !o.ExecutionMode.valid() {
+		return ErrInvalidExecutionMode
+	}
+	if o.AgentPoolID != nil && (o.ExecutionMode == nil || *o.ExecutionMode != ExecutionModeAgent) {
 		return ErrRequiredAgentMode
 	}
-	if o.AgentPoolID == nil && (o.ExecutionMode != nil && *o.ExecutionMode == "agent") {
+	if o.AgentPoolID == nil && (o.ExecutionMode != nil && *o.ExecutionMode == ExecutionModeAgent) {
 		return ErrRequiredAgentPoolID
 	}
 
-	return nil
+	return validateVCSTriggers(o.FileTriggersEnabled, o.TriggerPrefixes, o.TriggerPatterns, o.VCSRepo)
 }
 
 func (o WorkspaceUpdateOptions) valid() error {
@@ -1064,11 +1276,27 @@ func (o WorkspaceUpdateOptions) valid() error {
 	if o.Operations != nil && o.ExecutionMode != nil {
 		return ErrUnsupportedOperations
 	}
-	if o.AgentPoolID == nil && (o.ExecutionMode != nil && *o.ExecutionMode == "agent") {
+	if o.ExecutionMode != nil && !o.ExecutionMode.valid() {
+		return ErrInvalidExecutionMode
+	}
+	if _, ok := o.AgentPoolID.Get(); !ok && (o.ExecutionMode != nil && *o.ExecutionMode == ExecutionModeAgent) {
 		return ErrRequiredAgentPoolID
 	}
 
-	return nil
+	var vcsRepo *VCSRepoOptions
+	if v, ok := o.VCSRepo.Get(); ok {
+		vcsRepo = &v
+	}
+
+	return validateVCSTriggers(o.FileTriggersEnabled, o.TriggerPrefixes, o.TriggerPatterns, vcsRepo)
+}
+
+func (m ExecutionModeType) valid() bool {
+	switch m {
+	case ExecutionModeRemote, ExecutionModeLocal, ExecutionModeAgent:
+		return true
+	}
+	return false
 }
 
 func (o WorkspaceAssignSSHKeyOptions) valid() error {
@@ -1142,6 +1370,22 @@ func (o *WorkspaceListOptions) valid() error {
 		return nil // nothing to validate
 	}
 
+	if o.ProjectID != "" && !validStringID(&o.ProjectID) {
+		return ErrInvalidProjectID
+	}
+
+	switch WorkspaceSortKey(strings.TrimPrefix(string(o.Sort), "-")) {
+	case "", WorkspaceSortName, WorkspaceSortCurrentRunCreatedAt:
+	default:
+		return ErrInvalidWorkspaceSort
+	}
+
+	for _, t := range o.TagBindings {
+		if t == nil || !validString(&t.Key) {
+			return ErrRequiredTagBindingKey
+		}
+	}
+
 	if err := validateWorkspaceIncludeParams(o.Include); err != nil {
 		return err
 	}
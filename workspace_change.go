@@ -0,0 +1,126 @@
+package tfe
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WorkspaceChangeKind represents the kind of a workspace change.
+type WorkspaceChangeKind string
+
+// List of available workspace change kinds.
+const (
+	WorkspaceChangeProject WorkspaceChangeKind = "project"
+	WorkspaceChangeSetting WorkspaceChangeKind = "setting"
+)
+
+// WorkspaceChange describes a changed attribute of a workspace, such as a
+// move to another project or a change of its settings.
+//
+// Neither notification payloads nor audit trail events hold the changed
+// attributes, so changes are found by comparing two reads of a workspace
+// with DiffWorkspaces, and attributed to who made them with
+// AttributeWorkspaceChanges.
+type WorkspaceChange struct {
+	WorkspaceID string
+	Kind        WorkspaceChangeKind
+
+	// The name of the changed attribute, e.g. "project" or "auto-apply",
+	// and its values before and after the change. The project is identified
+	// by its ID.
+	Attribute string
+	From      string
+	To        string
+
+	// Who made the change and when, as reported by the audit trail. They
+	// are empty until attributed by AttributeWorkspaceChanges.
+	ChangedBy  string
+	AccessorID string
+	ChangedAt  time.Time
+}
+
+// workspaceSettings are the compared settings of a workspace, by attribute
+// name.
+var workspaceSettings = []struct {
+	attribute string
+	value     func(w *Workspace) string
+}{
+	{"name", func(w *Workspace) string { return w.Name }},
+	{"description", func(w *Workspace) string { return w.Description }},
+	{"agent-pool-id", func(w *Workspace) string { return w.AgentPoolID }},
+	{"allow-destroy-plan", func(w *Workspace) string { return strconv.FormatBool(w.AllowDestroyPlan) }},
+	{"assessments-enabled", func(w *Workspace) string { return strconv.FormatBool(w.AssessmentsEnabled) }},
+	{"auto-apply", func(w *Workspace) string { return strconv.FormatBool(w.AutoApply) }},
+	{"execution-mode", func(w *Workspace) string { return string(w.ExecutionMode) }},
+	{"file-triggers-enabled", func(w *Workspace) string { return strconv.FormatBool(w.FileTriggersEnabled) }},
+	{"global-remote-state", func(w *Workspace) string { return strconv.FormatBool(w.GlobalRemoteState) }},
+	{"queue-all-runs", func(w *Workspace) string { return strconv.FormatBool(w.QueueAllRuns) }},
+	{"speculative-enabled", func(w *Workspace) string { return strconv.FormatBool(w.SpeculativeEnabled) }},
+	{"terraform-version", func(w *Workspace) string { return w.TerraformVersion }},
+	{"trigger-patterns", func(w *Workspace) string { return strings.Join(w.TriggerPatterns, ",") }},
+	{"trigger-prefixes", func(w *Workspace) string { return strings.Join(w.TriggerPrefixes, ",") }},
+	{"working-directory", func(w *Workspace) string { return w.WorkingDirectory }},
+}
+
+// DiffWorkspaces returns the changes between two reads of the same
+// workspace: a move to another project first, followed by the changed
+// settings.
+func DiffWorkspaces(before, after *Workspace) []*WorkspaceChange {
+	var changes []*WorkspaceChange
+
+	if from, to := workspaceProjectID(before), workspaceProjectID(after); from != to {
+		changes = append(changes, &WorkspaceChange{
+			WorkspaceID: after.ID,
+			Kind:        WorkspaceChangeProject,
+			Attribute:   "project",
+			From:        from,
+			To:          to,
+		})
+	}
+
+	for _, s := range workspaceSettings {
+		if from, to := s.value(before), s.value(after); from != to {
+			changes = append(changes, &WorkspaceChange{
+				WorkspaceID: after.ID,
+				Kind:        WorkspaceChangeSetting,
+				Attribute:   s.attribute,
+				From:        from,
+				To:          to,
+			})
+		}
+	}
+
+	return changes
+}
+
+// AttributeWorkspaceChanges sets who made the changes and when, from the
+// latest workspace update event of the audit trail of each workspace.
+// Changes of workspaces without such an event are left unattributed.
+func AttributeWorkspaceChanges(changes []*WorkspaceChange, events []*AuditTrail) {
+	latest := make(map[string]*AuditTrail)
+	for _, e := range events {
+		if e.Resource.Type != "workspace" || e.Resource.Action != "update" {
+			continue
+		}
+		if l, ok := latest[e.Resource.ID]; !ok || e.Timestamp.After(l.Timestamp) {
+			latest[e.Resource.ID] = e
+		}
+	}
+
+	for _, c := range changes {
+		if e, ok := latest[c.WorkspaceID]; ok {
+			c.ChangedBy = e.Auth.Description
+			c.AccessorID = e.Auth.AccessorID
+			c.ChangedAt = e.Timestamp
+		}
+	}
+}
+
+// workspaceProjectID returns the ID of the project of a workspace.
+func workspaceProjectID(w *Workspace) string {
+	if w.Project == nil {
+		return ""
+	}
+	return w.Project.ID
+}
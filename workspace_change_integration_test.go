@@ -0,0 +1,72 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffWorkspaces(t *testing.T) {
+	before := &Workspace{
+		ID:               "ws-1",
+		Name:             "app",
+		TerraformVersion: "1.5.0",
+		Project:          &Project{ID: "prj-1"},
+	}
+	after := &Workspace{
+		ID:               "ws-1",
+		Name:             "app",
+		AutoApply:        true,
+		TerraformVersion: "1.5.0",
+		Project:          &Project{ID: "prj-2"},
+	}
+
+	changes := DiffWorkspaces(before, after)
+	require.Len(t, changes, 2)
+	assert.Equal(t, &WorkspaceChange{
+		WorkspaceID: "ws-1",
+		Kind:        WorkspaceChangeProject,
+		Attribute:   "project",
+		From:        "prj-1",
+		To:          "prj-2",
+	}, changes[0])
+	assert.Equal(t, &WorkspaceChange{
+		WorkspaceID: "ws-1",
+		Kind:        WorkspaceChangeSetting,
+		Attribute:   "auto-apply",
+		From:        "false",
+		To:          "true",
+	}, changes[1])
+
+	t.Run("without changes", func(t *testing.T) {
+		assert.Empty(t, DiffWorkspaces(before, before))
+	})
+
+	t.Run("when attributing the changes", func(t *testing.T) {
+		at := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+		event := func(id, action string, at time.Time, user string) *AuditTrail {
+			return &AuditTrail{
+				Timestamp: at,
+				Auth:      AuditTrailAuth{AccessorID: "user-" + user, Description: user},
+				Resource:  AuditTrailResource{ID: id, Type: "workspace", Action: action},
+			}
+		}
+
+		AttributeWorkspaceChanges(changes, []*AuditTrail{
+			event("ws-1", "update", at, "alice"),
+			event("ws-1", "update", at.Add(time.Minute), "bob"),
+			event("ws-1", "read", at.Add(time.Hour), "carol"),
+			event("ws-2", "update", at.Add(time.Hour), "dave"),
+		})
+		for _, c := range changes {
+			assert.Equal(t, "bob", c.ChangedBy)
+			assert.Equal(t, "user-bob", c.AccessorID)
+			assert.Equal(t, at.Add(time.Minute), c.ChangedAt)
+		}
+	})
+}
@@ -7,7 +7,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"sort"
 	"strings"
 	"testing"
@@ -93,6 +98,39 @@ func TestWorkspacesList(t *testing.T) {
 		assert.Equal(t, 1, wl.TotalCount)
 	})
 
+	t.Run("when excluding a tag", func(t *testing.T) {
+		// The first workspace was tagged by the previous test.
+		wl, err := client.Workspaces.List(ctx, orgTest.Name, &WorkspaceListOptions{
+			ExcludeTags: "tagtest",
+		})
+		require.NoError(t, err)
+		require.Len(t, wl.Items, 1)
+		assert.Equal(t, wTest2.ID, wl.Items[0].ID)
+		assert.Equal(t, 1, wl.TotalCount)
+	})
+
+	t.Run("when filtering by project", func(t *testing.T) {
+		skipIfEnterprise(t)
+		require.NotNil(t, wTest1.Project)
+
+		wl, err := client.Workspaces.List(ctx, orgTest.Name, &WorkspaceListOptions{
+			ProjectID: wTest1.Project.ID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, wl.TotalCount)
+		for _, w := range wl.Items {
+			assert.Equal(t, wTest1.Project.ID, w.Project.ID)
+		}
+	})
+
+	t.Run("with an invalid project ID", func(t *testing.T) {
+		wl, err := client.Workspaces.List(ctx, orgTest.Name, &WorkspaceListOptions{
+			ProjectID: badIdentifier,
+		})
+		assert.Nil(t, wl)
+		assert.Equal(t, ErrInvalidProjectID, err)
+	})
+
 	t.Run("when searching an unknown workspace", func(t *testing.T) {
 		// Use a nonexisting workspace name as search attribute. The result
 		// should be successful, but return no results.
@@ -238,7 +276,7 @@ func TestWorkspacesCreate(t *testing.T) {
 	t.Run("when options includes both an operations value and an enforcement mode value", func(t *testing.T) {
 		options := WorkspaceCreateOptions{
 			Name:          String("foo"),
-			ExecutionMode: String("remote"),
+			ExecutionMode: ExecutionMode(ExecutionModeRemote),
 			Operations:    Bool(true),
 		}
 
@@ -261,7 +299,7 @@ func TestWorkspacesCreate(t *testing.T) {
 	t.Run("when 'agent' execution mode is specified without an an agent pool ID", func(t *testing.T) {
 		options := WorkspaceCreateOptions{
 			Name:          String("foo"),
-			ExecutionMode: String("agent"),
+			ExecutionMode: ExecutionMode(ExecutionModeAgent),
 		}
 
 		w, err := client.Workspaces.Create(ctx, orgTest.Name, options)
@@ -269,6 +307,15 @@ func TestWorkspacesCreate(t *testing.T) {
 		assert.Equal(t, err, ErrRequiredAgentPoolID)
 	})
 
+	t.Run("when an invalid execution mode is specified", func(t *testing.T) {
+		w, err := client.Workspaces.Create(ctx, orgTest.Name, WorkspaceCreateOptions{
+			Name:          String("foo"),
+			ExecutionMode: ExecutionMode("cloud"),
+		})
+		assert.Nil(t, w)
+		assert.Equal(t, err, ErrInvalidExecutionMode)
+	})
+
 	t.Run("when an error is returned from the API", func(t *testing.T) {
 		w, err := client.Workspaces.Create(ctx, "bar", WorkspaceCreateOptions{
 			Name:             String("bar"),
@@ -279,6 +326,71 @@ func TestWorkspacesCreate(t *testing.T) {
 	})
 }
 
+func TestWorkspacesGetOrCreate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	options := WorkspaceCreateOptions{Name: String(randomString(t))}
+
+	t.Run("when the workspace does not exist", func(t *testing.T) {
+		w, created, err := client.Workspaces.GetOrCreate(ctx, orgTest.Name, options)
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, *options.Name, w.Name)
+	})
+
+	t.Run("when the workspace already exists", func(t *testing.T) {
+		w, created, err := client.Workspaces.GetOrCreate(ctx, orgTest.Name, options)
+		require.NoError(t, err)
+		assert.False(t, created)
+		assert.Equal(t, *options.Name, w.Name)
+	})
+
+	t.Run("when creating a workspace with a taken name", func(t *testing.T) {
+		_, err := client.Workspaces.Create(ctx, orgTest.Name, options)
+		assert.True(t, errors.Is(err, ErrWorkspaceNameTaken))
+	})
+
+	t.Run("without a name", func(t *testing.T) {
+		_, _, err := client.Workspaces.GetOrCreate(ctx, orgTest.Name, WorkspaceCreateOptions{})
+		assert.Equal(t, ErrRequiredName, err)
+	})
+}
+
+func TestWorkspacesGetOrCreateRace(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch {
+		case r.URL.Path == "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == "POST" && r.URL.Path == "/api/v2/organizations/acme/workspaces":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"errors":[{"status":"422","title":"invalid attribute","detail":"Name has already been taken"}]}`)
+		case r.Method == "GET" && r.URL.Path == "/api/v2/organizations/acme/workspaces/app":
+			fmt.Fprint(w, `{"data":{"id":"ws-app","type":"workspaces","attributes":{"name":"app"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	w, created, err := client.Workspaces.GetOrCreate(context.Background(), "acme", WorkspaceCreateOptions{Name: String("app")})
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, "ws-app", w.ID)
+}
+
 func TestWorkspacesRead(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -506,7 +618,7 @@ func TestWorkspacesUpdate(t *testing.T) {
 			Operations:                 Bool(false),
 			QueueAllRuns:               Bool(false),
 			SpeculativeEnabled:         Bool(true),
-			Description:                String("updated description"),
+			Description:                NewOptional("updated description"),
 			StructuredRunOutputEnabled: Bool(true),
 			TerraformVersion:           String("0.11.1"),
 			TriggerPrefixes:            []string{"/modules", "/shared"},
@@ -528,7 +640,8 @@ func TestWorkspacesUpdate(t *testing.T) {
 			assert.Equal(t, *options.AllowDestroyPlan, item.AllowDestroyPlan)
 			assert.Equal(t, *options.AutoApply, item.AutoApply)
 			assert.Equal(t, *options.FileTriggersEnabled, item.FileTriggersEnabled)
-			assert.Equal(t, *options.Description, item.Description)
+			description, _ := options.Description.Get()
+			assert.Equal(t, description, item.Description)
 			assert.Equal(t, *options.Operations, item.Operations)
 			assert.Equal(t, *options.QueueAllRuns, item.QueueAllRuns)
 			assert.Equal(t, *options.SpeculativeEnabled, item.SpeculativeEnabled)
@@ -539,9 +652,17 @@ func TestWorkspacesUpdate(t *testing.T) {
 		}
 	})
 
+	t.Run("when clearing the description", func(t *testing.T) {
+		w, err := client.Workspaces.UpdateByID(ctx, wTest.ID, WorkspaceUpdateOptions{
+			Description: Null[string](),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, w.Description)
+	})
+
 	t.Run("when options includes both an operations value and an enforcement mode value", func(t *testing.T) {
 		options := WorkspaceUpdateOptions{
-			ExecutionMode: String("remote"),
+			ExecutionMode: ExecutionMode(ExecutionModeRemote),
 			Operations:    Bool(true),
 		}
 
@@ -552,7 +673,7 @@ func TestWorkspacesUpdate(t *testing.T) {
 
 	t.Run("when 'agent' execution mode is specified without an an agent pool ID", func(t *testing.T) {
 		options := WorkspaceUpdateOptions{
-			ExecutionMode: String("agent"),
+			ExecutionMode: ExecutionMode(ExecutionModeAgent),
 		}
 
 		wAfter, err := client.Workspaces.Update(ctx, orgTest.Name, wTest.Name, options)
@@ -560,6 +681,14 @@ func TestWorkspacesUpdate(t *testing.T) {
 		assert.Equal(t, err, ErrRequiredAgentPoolID)
 	})
 
+	t.Run("when an invalid execution mode is specified", func(t *testing.T) {
+		wAfter, err := client.Workspaces.Update(ctx, orgTest.Name, wTest.Name, WorkspaceUpdateOptions{
+			ExecutionMode: ExecutionMode("cloud"),
+		})
+		assert.Nil(t, wAfter)
+		assert.Equal(t, err, ErrInvalidExecutionMode)
+	})
+
 	t.Run("when an error is returned from the api", func(t *testing.T) {
 		w, err := client.Workspaces.Update(ctx, orgTest.Name, wTest.Name, WorkspaceUpdateOptions{
 			TerraformVersion: String("nonexisting"),
@@ -771,7 +900,12 @@ func TestWorkspacesLock(t *testing.T) {
 
 	t.Run("when workspace is already locked", func(t *testing.T) {
 		_, err := client.Workspaces.Lock(ctx, wTest.ID, WorkspaceLockOptions{})
-		assert.Equal(t, ErrWorkspaceLocked, err)
+		assert.ErrorIs(t, err, ErrWorkspaceLocked)
+
+		var lockErr *WorkspaceLockError
+		require.ErrorAs(t, err, &lockErr)
+		require.NotNil(t, lockErr.LockedBy)
+		assert.NotNil(t, lockErr.LockedBy.User)
 	})
 
 	t.Run("without a valid workspace ID", func(t *testing.T) {
@@ -820,7 +954,11 @@ func TestWorkspacesUnlock(t *testing.T) {
 
 	t.Run("when a workspace is locked by a run", func(t *testing.T) {
 		_, err = client.Workspaces.Unlock(ctx, wTest2.ID)
-		assert.Equal(t, ErrWorkspaceLockedByRun, err)
+		assert.ErrorIs(t, err, ErrWorkspaceLockedByRun)
+
+		var lockErr *WorkspaceLockError
+		require.ErrorAs(t, err, &lockErr)
+		assert.NotEmpty(t, lockErr.RunID)
 	})
 
 	t.Run("without a valid workspace ID", func(t *testing.T) {
@@ -1359,6 +1497,10 @@ func TestWorkspace_Unmarshal(t *testing.T) {
 					"is-destroyable": true,
 				},
 				"trigger-prefixes": []string{"prefix-"},
+				"setting-overwrites": map[string]interface{}{
+					"execution-mode": false,
+					"agent-pool":     true,
+				},
 			},
 		},
 	}
@@ -1390,6 +1532,191 @@ func TestWorkspace_Unmarshal(t *testing.T) {
 	assert.Equal(t, ws.VCSRepo.ServiceProvider, "github")
 	assert.Equal(t, ws.Actions.IsDestroyable, true)
 	assert.Equal(t, ws.TriggerPrefixes, []string{"prefix-"})
+	require.NotNil(t, ws.SettingOverwrites)
+	assert.Equal(t, Bool(false), ws.SettingOverwrites.ExecutionMode)
+	assert.Equal(t, Bool(true), ws.SettingOverwrites.AgentPool)
+	assert.Nil(t, ws.LockedBy)
+}
+
+func TestWorkspace_UnmarshalLockedBy(t *testing.T) {
+	t.Run("when locked by an included user", func(t *testing.T) {
+		body := `{
+			"data": {"id": "ws-1", "type": "workspaces", "attributes": {"locked": true},
+				"relationships": {"locked-by": {"data": {"id": "user-1", "type": "users"}}}},
+			"included": [{"id": "user-1", "type": "users", "attributes": {"username": "admin"}}]
+		}`
+
+		ws := &Workspace{}
+		require.NoError(t, unmarshalResponse(strings.NewReader(body), ws))
+		require.NotNil(t, ws.LockedBy)
+		require.NotNil(t, ws.LockedBy.User)
+		assert.Equal(t, "user-1", ws.LockedBy.User.ID)
+		assert.Equal(t, "admin", ws.LockedBy.User.Username)
+		assert.Nil(t, ws.LockedBy.Run)
+		assert.Nil(t, ws.LockedBy.Team)
+	})
+
+	t.Run("when listing workspaces locked by runs and teams", func(t *testing.T) {
+		body := `{
+			"data": [
+				{"id": "ws-1", "type": "workspaces", "relationships": {"locked-by": {"data": {"id": "run-1", "type": "runs"}}}},
+				{"id": "ws-2", "type": "workspaces", "relationships": {"locked-by": {"data": null}}},
+				{"id": "ws-3", "type": "workspaces", "relationships": {"locked-by": {"data": {"id": "team-1", "type": "teams"}}}}
+			],
+			"meta": {"pagination": {"current-page": 1, "total-count": 3}}
+		}`
+
+		wl := &WorkspaceList{}
+		require.NoError(t, unmarshalResponse(strings.NewReader(body), wl))
+		require.Len(t, wl.Items, 3)
+		require.NotNil(t, wl.Items[0].LockedBy)
+		assert.Equal(t, "run-1", wl.Items[0].LockedBy.Run.ID)
+		assert.Nil(t, wl.Items[1].LockedBy)
+		require.NotNil(t, wl.Items[2].LockedBy)
+		assert.Equal(t, "team-1", wl.Items[2].LockedBy.Team.ID)
+		assert.Equal(t, 3, wl.TotalCount)
+	})
+}
+
+func TestWorkspacesLockErrors(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "POST /api/v2/workspaces/ws-1/actions/lock":
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"errors":[{"status":"409","title":"conflict","detail":"Unable to lock workspace. The workspace is already locked."}]}`)
+		case "GET /api/v2/workspaces/ws-1":
+			assert.Equal(t, "locked_by", r.URL.Query().Get("include"))
+			fmt.Fprint(w, `{"data":{"id":"ws-1","type":"workspaces","relationships":{"locked-by":{"data":{"id":"run-abc123","type":"runs"}}}},
+				"included":[{"id":"run-abc123","type":"runs","attributes":{"status":"applying"}}]}`)
+		case "POST /api/v2/workspaces/ws-1/actions/unlock":
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"errors":[{"status":"409","title":"conflict","detail":"Unable to unlock workspace. The workspace is locked by Run run-abc123."}]}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("when locking a locked workspace", func(t *testing.T) {
+		_, err := client.Workspaces.Lock(ctx, "ws-1", WorkspaceLockOptions{})
+		assert.ErrorIs(t, err, ErrWorkspaceLocked)
+
+		var lockErr *WorkspaceLockError
+		require.ErrorAs(t, err, &lockErr)
+		assert.Equal(t, "run-abc123", lockErr.RunID)
+		require.NotNil(t, lockErr.LockedBy)
+		assert.Equal(t, RunApplying, lockErr.LockedBy.Run.Status)
+		assert.EqualError(t, err, "workspace already locked: locked by run-abc123")
+	})
+
+	t.Run("when unlocking a workspace locked by a run", func(t *testing.T) {
+		_, err := client.Workspaces.Unlock(ctx, "ws-1")
+		assert.ErrorIs(t, err, ErrWorkspaceLockedByRun)
+
+		var lockErr *WorkspaceLockError
+		require.ErrorAs(t, err, &lockErr)
+		assert.Equal(t, "run-abc123", lockErr.RunID)
+	})
+}
+
+func TestWorkspacesWaitForUnlock(t *testing.T) {
+	holders := []string{
+		`"relationships":{"organization":{"data":{"id":"acme","type":"organizations"}},"locked-by":{"data":{"id":"run-abc123","type":"runs"}}}},
+			"included":[{"id":"run-abc123","type":"runs","attributes":{"status":"applying"}}]`,
+		`"relationships":{"locked-by":{"data":{"id":"user-1","type":"users"}}}},
+			"included":[{"id":"user-1","type":"users","attributes":{"username":"admin"}}]`,
+	}
+
+	reads := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "GET /api/v2/workspaces/ws-1":
+			assert.Equal(t, "locked_by", r.URL.Query().Get("include"))
+			if reads < len(holders) {
+				fmt.Fprintf(w, `{"data":{"id":"ws-1","type":"workspaces","attributes":{"name":"app","locked":true},%s}`, holders[reads])
+			} else {
+				fmt.Fprint(w, `{"data":{"id":"ws-1","type":"workspaces","attributes":{"name":"app","locked":false}}}`)
+			}
+			reads++
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	clock := NewFakeClock(time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC))
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Clock:      clock,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("when reading the lock status", func(t *testing.T) {
+		reads = 0
+
+		status, err := client.Workspaces.LockStatus(ctx, "ws-1")
+		require.NoError(t, err)
+		assert.True(t, status.Locked)
+		assert.Equal(t, "run-abc123", status.Holder)
+		assert.Equal(t, ts.URL+"/app/acme/workspaces/app/runs/run-abc123", status.RunURL)
+		assert.Equal(t, RunApplying, status.LockedBy.Run.Status)
+	})
+
+	t.Run("when waiting for the lock to be released", func(t *testing.T) {
+		reads = 0
+
+		var holders []string
+		var waited []time.Duration
+		status, err := client.Workspaces.WaitForUnlock(ctx, "ws-1", &WorkspaceUnlockWaitOptions{
+			PollInterval: 2 * time.Second,
+			Progress: func(status *WorkspaceLockStatus, wait time.Duration) {
+				holders = append(holders, status.Holder)
+				waited = append(waited, wait)
+			},
+		})
+		require.NoError(t, err)
+		assert.False(t, status.Locked)
+		assert.Empty(t, status.Holder)
+		assert.Equal(t, []string{"run-abc123", "admin"}, holders)
+		assert.Equal(t, []time.Duration{0, 2 * time.Second}, waited)
+		assert.Equal(t, 3, reads)
+	})
+
+	t.Run("when the context is canceled", func(t *testing.T) {
+		reads = 0
+
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, err := client.Workspaces.WaitForUnlock(ctx, "ws-1", nil)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		_, err := client.Workspaces.WaitForUnlock(ctx, badIdentifier, nil)
+		assert.Equal(t, ErrInvalidWorkspaceID, err)
+	})
 }
 
 func TestWorkspaceCreateOptions_Marshal(t *testing.T) {
@@ -1415,6 +1742,137 @@ func TestWorkspaceCreateOptions_Marshal(t *testing.T) {
 	assert.Equal(t, expectedBody, string(bodyBytes))
 }
 
+func TestWorkspaceUpdateOptions_Marshal(t *testing.T) {
+	t.Run("with unspecified optional attributes", func(t *testing.T) {
+		opts := WorkspaceUpdateOptions{
+			AutoApply: Bool(true),
+		}
+
+		reqBody, err := serializeRequestBody(&opts)
+		require.NoError(t, err)
+		req, err := retryablehttp.NewRequest("PATCH", "url", reqBody)
+		require.NoError(t, err)
+		bodyBytes, err := req.BodyBytes()
+		require.NoError(t, err)
+
+		expectedBody := `{"data":{"type":"workspaces","attributes":{"auto-apply":true}}}
+`
+		assert.Equal(t, expectedBody, string(bodyBytes))
+	})
+
+	t.Run("with cleared and set optional attributes", func(t *testing.T) {
+		opts := WorkspaceUpdateOptions{
+			AgentPoolID: Null[string](),
+			Description: NewOptional("new description"),
+		}
+
+		reqBody, err := serializeRequestBody(&opts)
+		require.NoError(t, err)
+		req, err := retryablehttp.NewRequest("PATCH", "url", reqBody)
+		require.NoError(t, err)
+		bodyBytes, err := req.BodyBytes()
+		require.NoError(t, err)
+
+		expectedBody := `{"data":{"type":"workspaces","attributes":{"agent-pool-id":null,"description":"new description"}}}
+`
+		assert.Equal(t, expectedBody, string(bodyBytes))
+	})
+
+	t.Run("with a cleared VCS repo", func(t *testing.T) {
+		opts := WorkspaceUpdateOptions{
+			VCSRepo: Null[VCSRepoOptions](),
+		}
+
+		reqBody, err := serializeRequestBody(&opts)
+		require.NoError(t, err)
+		req, err := retryablehttp.NewRequest("PATCH", "url", reqBody)
+		require.NoError(t, err)
+		bodyBytes, err := req.BodyBytes()
+		require.NoError(t, err)
+
+		expectedBody := `{"data":{"type":"workspaces","attributes":{"vcs-repo":null}}}
+`
+		assert.Equal(t, expectedBody, string(bodyBytes))
+	})
+
+	t.Run("with a set VCS repo", func(t *testing.T) {
+		opts := WorkspaceUpdateOptions{
+			VCSRepo: NewOptional(VCSRepoOptions{
+				Branch: String("main"),
+			}),
+		}
+
+		reqBody, err := serializeRequestBody(&opts)
+		require.NoError(t, err)
+		req, err := retryablehttp.NewRequest("PATCH", "url", reqBody)
+		require.NoError(t, err)
+		bodyBytes, err := req.BodyBytes()
+		require.NoError(t, err)
+
+		expectedBody := `{"data":{"type":"workspaces","attributes":{"vcs-repo":{"branch":"main"}}}}
+`
+		assert.Equal(t, expectedBody, string(bodyBytes))
+	})
+}
+
+func TestWorkspaceRelationshipsUpdate_Marshal(t *testing.T) {
+	body := &relationshipsPatch{
+		Data: relationshipsPatchData{
+			Type: "workspaces",
+			ID:   "ws-123",
+			Relationships: map[string]relationshipsPatchLinkage{
+				"agent-pool": {Data: &ResourceIdentifier{Type: "agent-pools", ID: "apool-123"}},
+				"ssh-key":    {Data: nil},
+			},
+		},
+	}
+
+	reqBody, err := serializeRequestBody(body)
+	require.NoError(t, err)
+	req, err := retryablehttp.NewRequest("PATCH", "url", reqBody)
+	require.NoError(t, err)
+	bodyBytes, err := req.BodyBytes()
+	require.NoError(t, err)
+
+	expectedBody := `{"data":{"type":"workspaces","id":"ws-123","relationships":{"agent-pool":{"data":{"type":"agent-pools","id":"apool-123"}},"ssh-key":{"data":null}}}}`
+	assert.Equal(t, expectedBody, string(bodyBytes))
+}
+
+func TestWorkspacesUpdateRelationshipsByID(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	wTest, wTestCleanup := createWorkspace(t, client, orgTest)
+	defer wTestCleanup()
+
+	t.Run("without relationships", func(t *testing.T) {
+		w, err := client.Workspaces.UpdateRelationshipsByID(ctx, wTest.ID, RelationshipsUpdateOptions{})
+		assert.Nil(t, w)
+		assert.Equal(t, err, ErrRequiredRelationships)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		w, err := client.Workspaces.UpdateRelationshipsByID(ctx, badIdentifier, RelationshipsUpdateOptions{
+			ToOne: map[string]*ResourceIdentifier{"agent-pool": nil},
+		})
+		assert.Nil(t, w)
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
+
+	t.Run("when clearing a relationship", func(t *testing.T) {
+		w, err := client.Workspaces.UpdateRelationshipsByID(ctx, wTest.ID, RelationshipsUpdateOptions{
+			ToOne: map[string]*ResourceIdentifier{"agent-pool": nil},
+		})
+		require.NoError(t, err)
+		assert.Nil(t, w.AgentPool)
+		assert.Equal(t, wTest.Name, w.Name)
+		assert.Equal(t, wTest.AutoApply, w.AutoApply)
+	})
+}
+
 func TestWorkspacesRunTasksPermission(t *testing.T) {
 	skipIfFreeOnly(t)
 	skipIfBeta(t)
@@ -1435,3 +1893,69 @@ func TestWorkspacesRunTasksPermission(t *testing.T) {
 		assert.True(t, w.Permissions.CanManageRunTasks)
 	})
 }
+
+func TestWorkspacesListSortAndFilters(t *testing.T) {
+	var query url.Values
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/organizations/acme/workspaces":
+			query = r.URL.Query()
+			w.Write([]byte(`{"data":[]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("with sort and filters", func(t *testing.T) {
+		_, err := client.Workspaces.List(ctx, "acme", &WorkspaceListOptions{
+			WildcardName:     "app-*-prod",
+			CurrentRunStatus: []RunStatus{RunErrored, RunPlannedAndFinished},
+			TagBindings: WorkspaceTagBindings{
+				{Key: "env", Value: "prod"},
+				{Key: "team"},
+			},
+			Sort: WorkspaceSortCurrentRunCreatedAt.Desc(),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "app-*-prod", query.Get("search[wildcard-name]"))
+		assert.Equal(t, "errored,planned_and_finished", query.Get("filter[current-run][status]"))
+		assert.Equal(t, "env", query.Get("filter[tagged][0][key]"))
+		assert.Equal(t, "prod", query.Get("filter[tagged][0][value]"))
+		assert.Equal(t, "team", query.Get("filter[tagged][1][key]"))
+		assert.NotContains(t, query, "filter[tagged][1][value]")
+		assert.Equal(t, "-current-run.created-at", query.Get("sort"))
+	})
+
+	t.Run("without sort and filters", func(t *testing.T) {
+		_, err := client.Workspaces.List(ctx, "acme", nil)
+		require.NoError(t, err)
+		assert.NotContains(t, query, "sort")
+		assert.NotContains(t, query, "filter[tagged][0][key]")
+	})
+
+	t.Run("with an invalid sort key", func(t *testing.T) {
+		_, err := client.Workspaces.List(ctx, "acme", &WorkspaceListOptions{Sort: "-created-at"})
+		assert.Equal(t, ErrInvalidWorkspaceSort, err)
+	})
+
+	t.Run("with a tag binding without a key", func(t *testing.T) {
+		_, err := client.Workspaces.List(ctx, "acme", &WorkspaceListOptions{
+			TagBindings: WorkspaceTagBindings{{Value: "prod"}},
+		})
+		assert.Equal(t, ErrRequiredTagBindingKey, err)
+	})
+}
@@ -0,0 +1,294 @@
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/jsonapi"
+)
+
+// lockedByRunPattern matches the ID of the run holding the lock of a
+// workspace in the error returned when unlocking it fails.
+var lockedByRunPattern = regexp.MustCompile(`\brun-[a-zA-Z0-9]+\b`)
+
+// LockedByChoice represents who holds the lock of a workspace, which is
+// either a run, a user or a team. Only the field of the type of the holder
+// is set. Unless the workspace is read with the "locked_by" include, only
+// the ID of the holder is known.
+type LockedByChoice struct {
+	Run  *Run
+	User *User
+	Team *Team
+}
+
+// WorkspaceLockError is returned when a workspace can not be locked or
+// unlocked because of the lock held on it. It wraps ErrWorkspaceLocked or
+// ErrWorkspaceLockedByRun, so it matches them when compared using errors.Is.
+type WorkspaceLockError struct {
+	// The error wrapped, either ErrWorkspaceLocked or
+	// ErrWorkspaceLockedByRun.
+	Err error
+
+	// The ID of the run holding the lock, if the lock is held by a run.
+	RunID string
+
+	// Who holds the lock, if known.
+	LockedBy *LockedByChoice
+}
+
+// Error implements the error interface.
+func (e *WorkspaceLockError) Error() string {
+	if e.RunID != "" {
+		return fmt.Sprintf("%s: locked by %s", e.Err, e.RunID)
+	}
+	return e.Err.Error()
+}
+
+// Unwrap returns the wrapped ErrWorkspaceLocked or ErrWorkspaceLockedByRun.
+func (e *WorkspaceLockError) Unwrap() error {
+	return e.Err
+}
+
+// workspaceLockedByRunError returns the error of unlocking a workspace which
+// is locked by a run, including the ID of the run when the API reports it.
+func workspaceLockedByRunError(errs []string) error {
+	for _, e := range errs {
+		if runID := lockedByRunPattern.FindString(e); runID != "" {
+			return &WorkspaceLockError{
+				Err:      ErrWorkspaceLockedByRun,
+				RunID:    runID,
+				LockedBy: &LockedByChoice{Run: &Run{ID: runID}},
+			}
+		}
+	}
+
+	return &WorkspaceLockError{Err: ErrWorkspaceLockedByRun}
+}
+
+// workspaceLockedError returns the error of locking a workspace which is
+// already locked, including the holder of the lock when it can be read.
+func (s *workspaces) workspaceLockedError(ctx context.Context, workspaceID string) error {
+	lockErr := &WorkspaceLockError{Err: ErrWorkspaceLocked}
+
+	w, err := s.ReadByIDWithOptions(ctx, workspaceID, &WorkspaceReadOptions{
+		Include: []WSIncludeOpt{WSLockedBy},
+	})
+	if err == nil && w.LockedBy != nil {
+		lockErr.LockedBy = w.LockedBy
+		if w.LockedBy.Run != nil {
+			lockErr.RunID = w.LockedBy.Run.ID
+		}
+	}
+
+	return lockErr
+}
+
+// defaultUnlockPollInterval is the interval WaitForUnlock polls at by
+// default.
+const defaultUnlockPollInterval = 5 * time.Second
+
+// WorkspaceLockStatus describes the lock of a workspace, for tools which show
+// who holds it.
+type WorkspaceLockStatus struct {
+	// Whether the workspace is locked.
+	Locked bool
+
+	// Who holds the lock, if known.
+	LockedBy *LockedByChoice
+
+	// The name of the user or team, or the ID of the run holding the lock.
+	Holder string
+
+	// The URL of the run holding the lock in the web UI.
+	RunURL string
+}
+
+// WorkspaceUnlockWaitOptions represents the options for waiting until the
+// lock of a workspace is released.
+type WorkspaceUnlockWaitOptions struct {
+	// Optional: The interval the workspace is polled at. It defaults to 5
+	// seconds.
+	PollInterval time.Duration
+
+	// Optional: Called with the status of the lock and the time waited so
+	// far, every time the workspace was found to be still locked.
+	Progress func(status *WorkspaceLockStatus, waited time.Duration)
+}
+
+// LockStatus reads the lock of a workspace, including who holds it.
+func (s *workspaces) LockStatus(ctx context.Context, workspaceID string) (*WorkspaceLockStatus, error) {
+	w, err := s.ReadByIDWithOptions(ctx, workspaceID, &WorkspaceReadOptions{
+		Include: []WSIncludeOpt{WSLockedBy},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.lockStatus(w), nil
+}
+
+// WaitForUnlock waits until the lock of a workspace is released, reporting
+// who holds it in the meantime, and returns the status of the released lock.
+func (s *workspaces) WaitForUnlock(ctx context.Context, workspaceID string, options *WorkspaceUnlockWaitOptions) (*WorkspaceLockStatus, error) {
+	interval := defaultUnlockPollInterval
+	var progress func(*WorkspaceLockStatus, time.Duration)
+	if options != nil {
+		if options.PollInterval > 0 {
+			interval = options.PollInterval
+		}
+		progress = options.Progress
+	}
+
+	start := s.client.clock.Now()
+	for {
+		status, err := s.LockStatus(ctx, workspaceID)
+		if err != nil {
+			return nil, err
+		}
+		if !status.Locked {
+			return status, nil
+		}
+		if progress != nil {
+			progress(status, s.client.clock.Now().Sub(start))
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, ctx.Err()
+		case <-s.client.clock.After(interval):
+		}
+	}
+}
+
+// lockStatus returns the status of the lock of the workspace.
+func (s *workspaces) lockStatus(w *Workspace) *WorkspaceLockStatus {
+	status := &WorkspaceLockStatus{
+		Locked:   w.Locked,
+		LockedBy: w.LockedBy,
+	}
+	if !w.Locked || w.LockedBy == nil {
+		return status
+	}
+
+	switch {
+	case w.LockedBy.Run != nil:
+		status.Holder = w.LockedBy.Run.ID
+		if u, err := s.client.WebURL(&Run{ID: w.LockedBy.Run.ID, Workspace: w}); err == nil {
+			status.RunURL = u
+		}
+	case w.LockedBy.User != nil:
+		status.Holder = w.LockedBy.User.Username
+	case w.LockedBy.Team != nil:
+		status.Holder = w.LockedBy.Team.Name
+	}
+
+	return status
+}
+
+// nodeUnmarshaler is implemented by models with attributes of named types,
+// relations to resources of different types, or relations of their
+// relations, which the jsonapi package can not unmarshal. They are decoded
+// from the resource node after unmarshaling the model.
+type nodeUnmarshaler interface {
+	unmarshalNode(node *jsonapi.Node, included []*jsonapi.Node) error
+}
+
+// unmarshalNode decodes the locked-by relation of the workspace.
+func (w *Workspace) unmarshalNode(node *jsonapi.Node, included []*jsonapi.Node) error {
+	raw, ok := node.Relationships["locked-by"]
+	if !ok {
+		return nil
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	rel := &jsonapi.RelationshipOneNode{}
+	if err := json.Unmarshal(data, rel); err != nil {
+		return err
+	}
+	if rel.Data == nil {
+		return nil
+	}
+
+	holder := rel.Data
+	for _, n := range included {
+		if n.Type == holder.Type && n.ID == holder.ID {
+			holder = n
+			break
+		}
+	}
+
+	lockedBy := &LockedByChoice{}
+	var model interface{}
+	switch holder.Type {
+	case "runs":
+		lockedBy.Run = &Run{}
+		model = lockedBy.Run
+	case "users":
+		lockedBy.User = &User{}
+		model = lockedBy.User
+	case "teams":
+		lockedBy.Team = &Team{}
+		model = lockedBy.Team
+	default:
+		return nil
+	}
+
+	// Only the attributes of the holder are decoded, its own relations are
+	// not included.
+	payload, err := json.Marshal(&jsonapi.OnePayload{Data: &jsonapi.Node{
+		Type:       holder.Type,
+		ID:         holder.ID,
+		Attributes: holder.Attributes,
+	}})
+	if err != nil {
+		return err
+	}
+	if err := jsonapi.UnmarshalPayload(bytes.NewReader(payload), model); err != nil {
+		return err
+	}
+
+	w.LockedBy = lockedBy
+	return nil
+}
+
+// unmarshalOneNode decodes what the jsonapi package can not unmarshal of a
+// single resource document.
+func unmarshalOneNode(body []byte, model nodeUnmarshaler) error {
+	payload := &jsonapi.OnePayload{}
+	if err := json.Unmarshal(body, payload); err != nil {
+		return err
+	}
+	if payload.Data == nil {
+		return nil
+	}
+
+	return model.unmarshalNode(payload.Data, payload.Included)
+}
+
+// unmarshalManyNodes decodes what the jsonapi package can not unmarshal of
+// the resources of a list document, in the order they are listed.
+func unmarshalManyNodes(body []byte, models []nodeUnmarshaler) error {
+	payload := &jsonapi.ManyPayload{}
+	if err := json.Unmarshal(body, payload); err != nil {
+		return err
+	}
+	if len(payload.Data) != len(models) {
+		return errors.New("unexpected number of resources")
+	}
+
+	for i, node := range payload.Data {
+		if err := models[i].unmarshalNode(node, payload.Included); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package tfe
+
+import (
+	"context"
+	"sync"
+)
+
+// readManyConcurrency is the number of workspace names searched concurrently
+// by ReadMany.
+const readManyConcurrency = 10
+
+// ReadMany reads the workspaces of an organization by their names. The names
+// are searched concurrently, using the name search of the workspace list, so
+// hundreds of workspaces are resolved without listing all the workspaces of
+// the organization. The workspaces are returned keyed by name, and names
+// without a workspace are missing from the result.
+func (s *workspaces) ReadMany(ctx context.Context, organization string, names []string) (map[string]*Workspace, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+
+	unique := make(map[string]bool, len(names))
+	for _, name := range names {
+		name := name
+		if !validStringID(&name) {
+			return nil, ErrInvalidWorkspaceValue
+		}
+		unique[name] = true
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu         sync.Mutex
+		firstErr   error
+		workspaces = make(map[string]*Workspace, len(unique))
+	)
+
+	sem := make(chan struct{}, readManyConcurrency)
+	var wg sync.WaitGroup
+	for name := range unique {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(name string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			w, err := s.searchByName(ctx, organization, name)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err != nil && firstErr == nil:
+				// Stop the searches in progress.
+				firstErr = err
+				cancel()
+			case w != nil:
+				workspaces[name] = w
+			}
+		}(name)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return workspaces, nil
+}
+
+// searchByName returns the workspace with exactly the given name, or nil if
+// there is none. The name search also matches the workspaces whose names
+// contain the name, so the matches are paged through until it is found.
+func (s *workspaces) searchByName(ctx context.Context, organization, name string) (*Workspace, error) {
+	opts := &WorkspaceListOptions{
+		ListOptions: ListOptions{PageSize: MaxPageSize},
+		Search:      name,
+	}
+	for {
+		wl, err := s.List(ctx, organization, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range wl.Items {
+			if w.Name == name {
+				return w, nil
+			}
+		}
+
+		if wl.Pagination == nil || wl.NextPage == 0 {
+			return nil, nil
+		}
+		opts.PageNumber = wl.NextPage
+	}
+}
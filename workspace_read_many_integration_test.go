@@ -0,0 +1,106 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspacesReadMany(t *testing.T) {
+	names := []string{"app", "app-dev", "app-prod", "db-prod"}
+
+	var mu sync.Mutex
+	var searches []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/organizations/acme/workspaces":
+			search := r.URL.Query().Get("search[name]")
+			if search == "fail" {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			assert.Equal(t, "100", r.URL.Query().Get("page[size]"))
+
+			mu.Lock()
+			searches = append(searches, search)
+			mu.Unlock()
+
+			// The matches are served one per page, to page through them.
+			var matches []string
+			for _, name := range names {
+				if strings.Contains(name, search) {
+					matches = append(matches, name)
+				}
+			}
+			page := 1
+			fmt.Sscan(r.URL.Query().Get("page[number]"), &page)
+			next := "null"
+			if page < len(matches) {
+				next = fmt.Sprint(page + 1)
+			}
+			fmt.Fprintf(w, `{"data":[{"id":"ws-%s","type":"workspaces","attributes":{"name":"%s"}}],`+
+				`"meta":{"pagination":{"current-page":%d,"next-page":%s,"total-pages":%d,"total-count":%d}}}`,
+				matches[page-1], matches[page-1], page, next, len(matches), len(matches))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("when all the workspaces exist", func(t *testing.T) {
+		ws, err := client.Workspaces.ReadMany(ctx, "acme", []string{"app-prod", "db-prod", "app-prod", "app"})
+		require.NoError(t, err)
+		require.Len(t, ws, 3)
+		assert.Equal(t, "ws-app", ws["app"].ID)
+		assert.Equal(t, "ws-app-prod", ws["app-prod"].ID)
+		assert.Equal(t, "ws-db-prod", ws["db-prod"].ID)
+	})
+
+	t.Run("when a workspace does not exist", func(t *testing.T) {
+		mu.Lock()
+		searches = nil
+		mu.Unlock()
+
+		ws, err := client.Workspaces.ReadMany(ctx, "acme", []string{"db", "db-prod"})
+		require.NoError(t, err)
+		require.Len(t, ws, 1)
+		assert.Equal(t, "ws-db-prod", ws["db-prod"].ID)
+		assert.ElementsMatch(t, []string{"db", "db-prod"}, searches)
+	})
+
+	t.Run("when a search fails", func(t *testing.T) {
+		_, err := client.Workspaces.ReadMany(ctx, "acme", []string{"app", "fail"})
+		assert.Error(t, err)
+	})
+
+	t.Run("with invalid values", func(t *testing.T) {
+		_, err := client.Workspaces.ReadMany(ctx, badIdentifier, []string{"app"})
+		assert.Equal(t, ErrInvalidOrg, err)
+
+		_, err = client.Workspaces.ReadMany(ctx, "acme", []string{"app", badIdentifier})
+		assert.Equal(t, ErrInvalidWorkspaceValue, err)
+	})
+}
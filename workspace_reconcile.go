@@ -0,0 +1,196 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// WorkspaceSettingsPolicy describes the workspace settings which should be
+// enforced. Only the non-nil fields are compared and enforced.
+type WorkspaceSettingsPolicy struct {
+	// Optional: Whether workspaces should automatically apply changes.
+	AutoApply *bool
+
+	// Optional: The version of Terraform workspaces should use.
+	TerraformVersion *string
+
+	// Optional: The execution mode workspaces should use. Agent execution
+	// mode can not be enforced, as it requires a workspace specific agent pool.
+	ExecutionMode *ExecutionModeType
+}
+
+// WorkspaceReconcileOptions represents the options for reconciling the
+// settings of all workspaces carrying a given tag.
+type WorkspaceReconcileOptions struct {
+	// Required: The name of the tag used to select the workspaces. It can
+	// not contain commas, which separate the tags of the filter.
+	Tag string
+
+	// Required: The settings to enforce on the selected workspaces.
+	Policy WorkspaceSettingsPolicy
+
+	// Optional: Whether drifted workspaces should be updated to match the
+	// policy. When false, drift is only reported.
+	Fix bool
+}
+
+// WorkspaceSettingDrift describes a single setting of a workspace which does
+// not match the policy.
+type WorkspaceSettingDrift struct {
+	Setting string
+	Current string
+	Desired string
+}
+
+// WorkspaceReconcileResult holds the reconciliation result of a single
+// workspace.
+type WorkspaceReconcileResult struct {
+	Workspace *Workspace
+	Drift     []*WorkspaceSettingDrift
+
+	// Fixed is true when the workspace was updated to match the policy.
+	Fixed bool
+
+	// Err holds the error returned while fixing the workspace, if any.
+	Err error
+}
+
+// ReconcileSettings compares the settings of all workspaces within an
+// organization carrying the given tag against the policy, and reports any
+// drift. If options.Fix is set, only the drifted settings of the workspaces
+// are updated to match the policy. Errors updating a single workspace are
+// reported in its result and do not abort the reconciliation of the other
+// workspaces.
+func (s *workspaces) ReconcileSettings(ctx context.Context, organization string, options WorkspaceReconcileOptions) ([]*WorkspaceReconcileResult, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	workspaces, err := listAllWorkspaces(ctx, s.client, organization, &WorkspaceListOptions{
+		Tags: options.Tag,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*WorkspaceReconcileResult
+	for _, w := range workspaces {
+		result := &WorkspaceReconcileResult{
+			Workspace: w,
+			Drift:     options.Policy.drift(w),
+		}
+		results = append(results, result)
+
+		if !options.Fix || len(result.Drift) == 0 {
+			continue
+		}
+
+		updated, err := s.UpdateByID(ctx, w.ID, options.Policy.fix(result.Drift))
+		if err != nil {
+			result.Err = err
+			continue
+		}
+
+		result.Workspace = updated
+		result.Fixed = true
+	}
+
+	return results, nil
+}
+
+// drift returns the settings of the given workspace which do not match the
+// policy.
+func (p WorkspaceSettingsPolicy) drift(w *Workspace) []*WorkspaceSettingDrift {
+	var drift []*WorkspaceSettingDrift
+
+	if p.AutoApply != nil && *p.AutoApply != w.AutoApply {
+		drift = append(drift, &WorkspaceSettingDrift{
+			Setting: "auto-apply",
+			Current: strconv.FormatBool(w.AutoApply),
+			Desired: strconv.FormatBool(*p.AutoApply),
+		})
+	}
+	if p.TerraformVersion != nil && *p.TerraformVersion != w.TerraformVersion {
+		drift = append(drift, &WorkspaceSettingDrift{
+			Setting: "terraform-version",
+			Current: w.TerraformVersion,
+			Desired: *p.TerraformVersion,
+		})
+	}
+	if p.ExecutionMode != nil && *p.ExecutionMode != w.ExecutionMode {
+		drift = append(drift, &WorkspaceSettingDrift{
+			Setting: "execution-mode",
+			Current: string(w.ExecutionMode),
+			Desired: string(*p.ExecutionMode),
+		})
+	}
+
+	return drift
+}
+
+// fix returns the options updating the drifted settings of a workspace to
+// match the policy, leaving the other settings out.
+func (p WorkspaceSettingsPolicy) fix(drift []*WorkspaceSettingDrift) WorkspaceUpdateOptions {
+	var options WorkspaceUpdateOptions
+	for _, d := range drift {
+		switch d.Setting {
+		case "auto-apply":
+			options.AutoApply = p.AutoApply
+		case "terraform-version":
+			options.TerraformVersion = p.TerraformVersion
+		case "execution-mode":
+			options.ExecutionMode = p.ExecutionMode
+		}
+	}
+	return options
+}
+
+// String returns a human readable description of the drift.
+func (d *WorkspaceSettingDrift) String() string {
+	return fmt.Sprintf("%s is %q, expected %q", d.Setting, d.Current, d.Desired)
+}
+
+// listAllWorkspaces lists the workspaces within an organization matching the
+// given options, following the pagination until all pages are read.
+func listAllWorkspaces(ctx context.Context, client *Client, organization string, options *WorkspaceListOptions) ([]*Workspace, error) {
+	opts := WorkspaceListOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	var workspaces []*Workspace
+	for {
+		wl, err := client.Workspaces.List(ctx, organization, &opts)
+		if err != nil {
+			return nil, err
+		}
+		workspaces = append(workspaces, wl.Items...)
+
+		if wl.Pagination == nil || wl.NextPage == 0 {
+			return workspaces, nil
+		}
+		opts.PageNumber = wl.NextPage
+	}
+}
+
+func (o WorkspaceReconcileOptions) valid() error {
+	if !validString(&o.Tag) {
+		return ErrRequiredTagName
+	}
+	if strings.Contains(o.Tag, ",") {
+		return ErrInvalidTagName
+	}
+	if o.Policy.ExecutionMode != nil && !o.Policy.ExecutionMode.valid() {
+		return ErrInvalidExecutionMode
+	}
+	if o.Policy.ExecutionMode != nil && *o.Policy.ExecutionMode == ExecutionModeAgent {
+		return ErrUnsupportedAgentExecutionMode
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspacesReconcileSettings(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	wTest1, wTest1Cleanup := createWorkspace(t, client, orgTest)
+	defer wTest1Cleanup()
+	wTest2, wTest2Cleanup := createWorkspace(t, client, orgTest)
+	defer wTest2Cleanup()
+
+	tagName := "reconciletest"
+	err := client.Workspaces.AddTags(ctx, wTest1.ID, WorkspaceAddTagsOptions{
+		Tags: []*Tag{{Name: tagName}},
+	})
+	require.NoError(t, err)
+
+	options := WorkspaceReconcileOptions{
+		Tag: tagName,
+		Policy: WorkspaceSettingsPolicy{
+			AutoApply: Bool(!wTest1.AutoApply),
+		},
+	}
+
+	t.Run("when only reporting drift", func(t *testing.T) {
+		results, err := client.Workspaces.ReconcileSettings(ctx, orgTest.Name, options)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, wTest1.ID, results[0].Workspace.ID)
+		require.Len(t, results[0].Drift, 1)
+		assert.Equal(t, "auto-apply", results[0].Drift[0].Setting)
+		assert.False(t, results[0].Fixed)
+
+		w, err := client.Workspaces.ReadByID(ctx, wTest1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, wTest1.AutoApply, w.AutoApply)
+	})
+
+	t.Run("when fixing drift", func(t *testing.T) {
+		fixOptions := options
+		fixOptions.Fix = true
+
+		results, err := client.Workspaces.ReconcileSettings(ctx, orgTest.Name, fixOptions)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.True(t, results[0].Fixed)
+		assert.NoError(t, results[0].Err)
+		assert.Equal(t, !wTest1.AutoApply, results[0].Workspace.AutoApply)
+
+		// Workspaces without the tag are left untouched.
+		w, err := client.Workspaces.ReadByID(ctx, wTest2.ID)
+		require.NoError(t, err)
+		assert.Equal(t, wTest2.AutoApply, w.AutoApply)
+
+		// Reconciling again reports no drift.
+		results, err = client.Workspaces.ReconcileSettings(ctx, orgTest.Name, options)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Empty(t, results[0].Drift)
+	})
+
+	t.Run("without a tag", func(t *testing.T) {
+		results, err := client.Workspaces.ReconcileSettings(ctx, orgTest.Name, WorkspaceReconcileOptions{})
+		assert.Nil(t, results)
+		assert.Equal(t, err, ErrRequiredTagName)
+	})
+
+	t.Run("with agent execution mode", func(t *testing.T) {
+		results, err := client.Workspaces.ReconcileSettings(ctx, orgTest.Name, WorkspaceReconcileOptions{
+			Tag: tagName,
+			Policy: WorkspaceSettingsPolicy{
+				ExecutionMode: ExecutionMode(ExecutionModeAgent),
+			},
+		})
+		assert.Nil(t, results)
+		assert.Equal(t, err, ErrUnsupportedAgentExecutionMode)
+	})
+
+	t.Run("without a valid organization", func(t *testing.T) {
+		results, err := client.Workspaces.ReconcileSettings(ctx, badIdentifier, options)
+		assert.Nil(t, results)
+		assert.EqualError(t, err, ErrInvalidOrg.Error())
+	})
+}
+
+func TestWorkspacesReconcileSettingsFix(t *testing.T) {
+	var updates []map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "GET /api/v2/organizations/acme/workspaces":
+			assert.Equal(t, "infra", r.URL.Query().Get("search[tags]"))
+			fmt.Fprint(w, `{"data":[{"id":"ws-1","type":"workspaces","attributes":{"name":"app","auto-apply":true,"terraform-version":"1.5.0","execution-mode":"remote"}}]}`)
+		case "PATCH /api/v2/workspaces/ws-1":
+			var body struct {
+				Data struct {
+					Attributes map[string]interface{} `json:"attributes"`
+				} `json:"data"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			updates = append(updates, body.Data.Attributes)
+			fmt.Fprint(w, `{"data":{"id":"ws-1","type":"workspaces","attributes":{"name":"app","auto-apply":true,"terraform-version":"1.6.0","execution-mode":"remote"}}}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("only updates the drifted settings", func(t *testing.T) {
+		results, err := client.Workspaces.ReconcileSettings(ctx, "acme", WorkspaceReconcileOptions{
+			Tag: "infra",
+			Policy: WorkspaceSettingsPolicy{
+				AutoApply:        Bool(true),
+				TerraformVersion: String("1.6.0"),
+				ExecutionMode:    ExecutionMode(ExecutionModeRemote),
+			},
+			Fix: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.True(t, results[0].Fixed)
+
+		require.Len(t, updates, 1)
+		assert.Equal(t, map[string]interface{}{"terraform-version": "1.6.0"}, updates[0])
+	})
+
+	t.Run("with a comma in the tag", func(t *testing.T) {
+		_, err := client.Workspaces.ReconcileSettings(ctx, "acme", WorkspaceReconcileOptions{
+			Tag:    "infra,prod",
+			Policy: WorkspaceSettingsPolicy{AutoApply: Bool(true)},
+		})
+		assert.Equal(t, ErrInvalidTagName, err)
+	})
+}
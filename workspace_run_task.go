@@ -2,8 +2,11 @@ package tfe
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
+
+	"github.com/hashicorp/jsonapi"
 )
 
 // Compile-time proof of interface implementation
@@ -37,6 +40,11 @@ type workspaceRunTasks struct {
 type WorkspaceRunTask struct {
 	ID               string               `jsonapi:"primary,workspace-tasks"`
 	EnforcementLevel TaskEnforcementLevel `jsonapi:"attr,enforcement-level"`
+	Enabled          bool                 `jsonapi:"attr,enabled"`
+
+	// The stages the run task runs in, which are only decoded when the
+	// workspace run task is read, created, updated or listed.
+	Stages []Stage
 
 	RunTask   *RunTask   `jsonapi:"relation,task"`
 	Workspace *Workspace `jsonapi:"relation,workspace"`
@@ -58,6 +66,10 @@ type WorkspaceRunTaskCreateOptions struct {
 	Type string `jsonapi:"primary,workspace-tasks"`
 	// Required: The enforcement level for a run task
 	EnforcementLevel TaskEnforcementLevel `jsonapi:"attr,enforcement-level"`
+	// Optional: Whether the run task is enabled for the workspace
+	Enabled *bool `jsonapi:"attr,enabled,omitempty"`
+	// Optional: The run stages the run task runs in, defaults to post_plan
+	Stages []Stage `jsonapi:"attr,stages,omitempty"`
 	// Required: The run task to attach to the workspace
 	RunTask *RunTask `jsonapi:"relation,task"`
 }
@@ -66,6 +78,8 @@ type WorkspaceRunTaskCreateOptions struct {
 type WorkspaceRunTaskUpdateOptions struct {
 	Type             string               `jsonapi:"primary,workspace-tasks"`
 	EnforcementLevel TaskEnforcementLevel `jsonapi:"attr,enforcement-level,omitempty"`
+	Enabled          *bool                `jsonapi:"attr,enabled,omitempty"`
+	Stages           []Stage              `jsonapi:"attr,stages,omitempty"`
 }
 
 // List all run tasks attached to a workspace
@@ -81,7 +95,7 @@ func (s *workspaceRunTasks) List(ctx context.Context, workspaceID string, option
 	}
 
 	rl := &WorkspaceRunTaskList{}
-	err = s.client.do(ctx, req, rl)
+	err = s.client.do(ctx, "workspaceRunTasks.List", req, rl)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +124,7 @@ func (s *workspaceRunTasks) Read(ctx context.Context, workspaceID, workspaceTask
 	}
 
 	wr := &WorkspaceRunTask{}
-	err = s.client.do(ctx, req, wr)
+	err = s.client.do(ctx, "workspaceRunTasks.Read", req, wr)
 	if err != nil {
 		return nil, err
 	}
@@ -135,7 +149,7 @@ func (s *workspaceRunTasks) Create(ctx context.Context, workspaceID string, opti
 	}
 
 	wr := &WorkspaceRunTask{}
-	err = s.client.do(ctx, req, wr)
+	err = s.client.do(ctx, "workspaceRunTasks.Create", req, wr)
 	if err != nil {
 		return nil, err
 	}
@@ -153,6 +167,14 @@ func (s *workspaceRunTasks) Update(ctx context.Context, workspaceID, workspaceTa
 		return nil, ErrInvalidWorkspaceRunTaskID
 	}
 
+	if options.EnforcementLevel != "" && !options.EnforcementLevel.valid() {
+		return nil, ErrInvalidTaskEnforcementLevel
+	}
+
+	if err := validateTaskStages(options.Stages); err != nil {
+		return nil, err
+	}
+
 	u := fmt.Sprintf(
 		"workspaces/%s/tasks/%s",
 		url.QueryEscape(workspaceID),
@@ -164,7 +186,7 @@ func (s *workspaceRunTasks) Update(ctx context.Context, workspaceID, workspaceTa
 	}
 
 	wr := &WorkspaceRunTask{}
-	err = s.client.do(ctx, req, wr)
+	err = s.client.do(ctx, "workspaceRunTasks.Update", req, wr)
 	if err != nil {
 		return nil, err
 	}
@@ -192,13 +214,42 @@ func (s *workspaceRunTasks) Delete(ctx context.Context, workspaceID, workspaceTa
 		return err
 	}
 
-	return s.client.do(ctx, req, nil)
+	return s.client.do(ctx, "workspaceRunTasks.Delete", req, nil)
 }
 
 func (o *WorkspaceRunTaskCreateOptions) valid() error {
 	if o.RunTask.ID == "" {
 		return ErrInvalidRunTaskID
 	}
+	if !o.EnforcementLevel.valid() {
+		return ErrInvalidTaskEnforcementLevel
+	}
+
+	return validateTaskStages(o.Stages)
+}
+
+func validateTaskStages(stages []Stage) error {
+	for _, stage := range stages {
+		switch stage {
+		case PrePlan, PostPlan, PreApply:
+		default:
+			return ErrInvalidTaskStage
+		}
+	}
 
 	return nil
 }
+
+// unmarshalNode decodes the stages of the workspace run task.
+func (wr *WorkspaceRunTask) unmarshalNode(node *jsonapi.Node, included []*jsonapi.Node) error {
+	raw, ok := node.Attributes["stages"]
+	if !ok || raw == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &wr.Stages)
+}
@@ -5,6 +5,9 @@ package tfe
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -40,6 +43,29 @@ func TestWorkspaceRunTasksCreate(t *testing.T) {
 			assert.NotEmpty(t, wr.RunTask.ID)
 		})
 	})
+
+	t.Run("with stages", func(t *testing.T) {
+		wsTest, wsTestCleanup := createWorkspace(t, client, orgTest)
+		defer wsTestCleanup()
+
+		wr, err := client.WorkspaceRunTasks.Create(ctx, wsTest.ID, WorkspaceRunTaskCreateOptions{
+			EnforcementLevel: Advisory,
+			Stages:           []Stage{PrePlan, PostPlan},
+			RunTask:          runTaskTest,
+		})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []Stage{PrePlan, PostPlan}, wr.Stages)
+	})
+
+	t.Run("with an invalid stage", func(t *testing.T) {
+		wr, err := client.WorkspaceRunTasks.Create(ctx, wkspaceTest.ID, WorkspaceRunTaskCreateOptions{
+			EnforcementLevel: Mandatory,
+			Stages:           []Stage{"post_apply"},
+			RunTask:          runTaskTest,
+		})
+		assert.Nil(t, wr)
+		assert.Equal(t, ErrInvalidTaskStage, err)
+	})
 }
 
 func TestWorkspaceRunTasksList(t *testing.T) {
@@ -178,3 +204,63 @@ func TestWorkspaceRunTasksDelete(t *testing.T) {
 		assert.EqualError(t, err, ErrResourceNotFound.Error())
 	})
 }
+
+func TestWorkspaceRunTaskCreateOptions_valid(t *testing.T) {
+	runTask := &RunTask{ID: "task-1"}
+
+	t.Run("with a valid enforcement level", func(t *testing.T) {
+		options := WorkspaceRunTaskCreateOptions{EnforcementLevel: Mandatory, RunTask: runTask}
+		assert.NoError(t, options.valid())
+	})
+
+	t.Run("without an enforcement level", func(t *testing.T) {
+		options := WorkspaceRunTaskCreateOptions{RunTask: runTask}
+		assert.Equal(t, ErrInvalidTaskEnforcementLevel, options.valid())
+	})
+}
+
+func TestWorkspaceRunTasks_stages(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/workspaces/ws-1/tasks":
+			fmt.Fprint(w, `{"data":[{"id":"wstask-1","type":"workspace-tasks","attributes":{"enforcement-level":"advisory","stages":["pre_plan","post_plan"]}},{"id":"wstask-2","type":"workspace-tasks","attributes":{"enforcement-level":"mandatory"}}],"meta":{"pagination":{"current-page":1,"total-pages":1,"total-count":2}}}`)
+		case "/api/v2/workspaces/ws-1/tasks/wstask-1":
+			fmt.Fprint(w, `{"data":{"id":"wstask-1","type":"workspace-tasks","attributes":{"enforcement-level":"advisory","stages":["pre_apply"]}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("when reading", func(t *testing.T) {
+		wr, err := client.WorkspaceRunTasks.Read(ctx, "ws-1", "wstask-1")
+		require.NoError(t, err)
+		assert.Equal(t, []Stage{PreApply}, wr.Stages)
+
+		// The stages read can be passed back when updating.
+		options := WorkspaceRunTaskUpdateOptions{Stages: wr.Stages}
+		assert.NoError(t, validateTaskStages(options.Stages))
+	})
+
+	t.Run("when listing", func(t *testing.T) {
+		wrl, err := client.WorkspaceRunTasks.List(ctx, "ws-1", nil)
+		require.NoError(t, err)
+		require.Len(t, wrl.Items, 2)
+		assert.Equal(t, []Stage{PrePlan, PostPlan}, wrl.Items[0].Stages)
+		assert.Equal(t, Advisory, wrl.Items[0].EnforcementLevel)
+		assert.Nil(t, wrl.Items[1].Stages)
+	})
+}
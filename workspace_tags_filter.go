@@ -0,0 +1,115 @@
+package tfe
+
+import (
+	"context"
+	"path"
+	"sync"
+)
+
+// defaultTagsByFilterConcurrency is the number of workspaces tagged
+// concurrently by default.
+const defaultTagsByFilterConcurrency = 5
+
+// WorkspaceTagsByFilterOptions represents the options for adding or removing
+// tags across all the workspaces of an organization matching a filter. At
+// least one of Name and Tag is required, so all workspaces are never tagged
+// by accident.
+type WorkspaceTagsByFilterOptions struct {
+	// Optional: A wildcard pattern the workspace names have to match, using
+	// the syntax of path.Match, e.g. "*-prod".
+	Name string
+
+	// Optional: The name of a tag the workspaces have to carry.
+	Tag string
+
+	// Required: The tags to add or remove.
+	Tags []*Tag
+
+	// Optional: The number of workspaces updated concurrently. Defaults to 5.
+	Concurrency int
+}
+
+// WorkspaceTagsResult holds the result of adding or removing the tags of a
+// single workspace.
+type WorkspaceTagsResult struct {
+	Workspace *Workspace
+
+	// Err holds the error returned while updating the tags of the workspace,
+	// if any.
+	Err error
+}
+
+// AddTagsByFilter adds tags to all the workspaces of an organization matching
+// the filter. Errors updating a single workspace are reported in its result
+// and do not abort the update of the other workspaces.
+func (s *workspaces) AddTagsByFilter(ctx context.Context, organization string, options WorkspaceTagsByFilterOptions) ([]*WorkspaceTagsResult, error) {
+	return s.updateTagsByFilter(ctx, organization, options, func(workspaceID string) error {
+		return s.AddTags(ctx, workspaceID, WorkspaceAddTagsOptions{Tags: options.Tags})
+	})
+}
+
+// RemoveTagsByFilter removes tags from all the workspaces of an organization
+// matching the filter. Errors updating a single workspace are reported in its
+// result and do not abort the update of the other workspaces.
+func (s *workspaces) RemoveTagsByFilter(ctx context.Context, organization string, options WorkspaceTagsByFilterOptions) ([]*WorkspaceTagsResult, error) {
+	return s.updateTagsByFilter(ctx, organization, options, func(workspaceID string) error {
+		return s.RemoveTags(ctx, workspaceID, WorkspaceRemoveTagsOptions{Tags: options.Tags})
+	})
+}
+
+// updateTagsByFilter calls update concurrently for all the workspaces matching
+// the filter, and returns their results in the order they are listed.
+func (s *workspaces) updateTagsByFilter(ctx context.Context, organization string, options WorkspaceTagsByFilterOptions, update func(workspaceID string) error) ([]*WorkspaceTagsResult, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	workspaces, err := listAllWorkspaces(ctx, s.client, organization, &WorkspaceListOptions{
+		Tags: options.Tag,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*WorkspaceTagsResult
+	for _, w := range workspaces {
+		// The pattern was validated, so matching can not fail.
+		if ok, _ := path.Match(options.Name, w.Name); ok || options.Name == "" {
+			results = append(results, &WorkspaceTagsResult{Workspace: w})
+		}
+	}
+
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultTagsByFilterConcurrency
+	}
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for _, result := range results {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(result *WorkspaceTagsResult) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			result.Err = update(result.Workspace.ID)
+		}(result)
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+func (o WorkspaceTagsByFilterOptions) valid() error {
+	if o.Name == "" && o.Tag == "" {
+		return ErrRequiredWorkspaceTagsFilter
+	}
+	if _, err := path.Match(o.Name, ""); err != nil {
+		return ErrInvalidWorkspaceNamePattern
+	}
+
+	return WorkspaceAddTagsOptions{Tags: o.Tags}.valid()
+}
@@ -0,0 +1,121 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspacesTagsByFilter(t *testing.T) {
+	var mu sync.Mutex
+	var updated []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+
+		switch {
+		case r.URL.Path == "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/api/v2/organizations/acme/workspaces":
+			if r.URL.Query().Get("search[tags]") == "legacy" {
+				fmt.Fprint(w, `{"data":[{"id":"ws-app-dev","type":"workspaces","attributes":{"name":"app-dev"}}],"meta":{"pagination":{"current-page":1,"total-pages":1}}}`)
+				return
+			}
+			fmt.Fprint(w, `{"data":[`+
+				`{"id":"ws-app-prod","type":"workspaces","attributes":{"name":"app-prod"}},`+
+				`{"id":"ws-app-dev","type":"workspaces","attributes":{"name":"app-dev"}},`+
+				`{"id":"ws-db-prod","type":"workspaces","attributes":{"name":"db-prod"}}`+
+				`],"meta":{"pagination":{"current-page":1,"total-pages":1}}}`)
+		case strings.HasSuffix(r.URL.Path, "/relationships/tags"):
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.Contains(t, string(body), `"name":"prod"`)
+
+			id := strings.Split(r.URL.Path, "/")[4]
+			if id == "ws-db-prod" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			mu.Lock()
+			updated = append(updated, r.Method+" "+id)
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	tags := []*Tag{{Name: "prod"}}
+
+	t.Run("when adding tags by name pattern", func(t *testing.T) {
+		updated = nil
+
+		results, err := client.Workspaces.AddTagsByFilter(ctx, "acme", WorkspaceTagsByFilterOptions{
+			Name:        "*-prod",
+			Tags:        tags,
+			Concurrency: 2,
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+
+		assert.Equal(t, "ws-app-prod", results[0].Workspace.ID)
+		assert.NoError(t, results[0].Err)
+		assert.Equal(t, "ws-db-prod", results[1].Workspace.ID)
+		assert.Equal(t, ErrResourceNotFound, results[1].Err)
+
+		assert.Equal(t, []string{"POST ws-app-prod"}, updated)
+	})
+
+	t.Run("when removing tags by tag", func(t *testing.T) {
+		updated = nil
+
+		results, err := client.Workspaces.RemoveTagsByFilter(ctx, "acme", WorkspaceTagsByFilterOptions{
+			Tag:  "legacy",
+			Tags: tags,
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.NoError(t, results[0].Err)
+		assert.Equal(t, []string{"DELETE ws-app-dev"}, updated)
+	})
+
+	t.Run("without a filter", func(t *testing.T) {
+		_, err := client.Workspaces.AddTagsByFilter(ctx, "acme", WorkspaceTagsByFilterOptions{Tags: tags})
+		assert.Equal(t, ErrRequiredWorkspaceTagsFilter, err)
+	})
+
+	t.Run("with an invalid name pattern", func(t *testing.T) {
+		_, err := client.Workspaces.AddTagsByFilter(ctx, "acme", WorkspaceTagsByFilterOptions{Name: "[app", Tags: tags})
+		assert.Equal(t, ErrInvalidWorkspaceNamePattern, err)
+	})
+
+	t.Run("without tags", func(t *testing.T) {
+		_, err := client.Workspaces.RemoveTagsByFilter(ctx, "acme", WorkspaceTagsByFilterOptions{Name: "*"})
+		assert.Equal(t, ErrMissingTagIdentifier, err)
+	})
+
+	t.Run("with an invalid organization", func(t *testing.T) {
+		_, err := client.Workspaces.AddTagsByFilter(ctx, badIdentifier, WorkspaceTagsByFilterOptions{Name: "*", Tags: tags})
+		assert.Equal(t, ErrInvalidOrg, err)
+	})
+}
@@ -0,0 +1,156 @@
+package tfe
+
+import (
+	"regexp"
+	"strings"
+)
+
+// TriggersRun previews whether a VCS push changing the given files, relative
+// to the repository root, triggers a run of the workspace. Workspaces with a
+// tags regex are only triggered by tags, see TriggersRunForTag.
+func (w *Workspace) TriggersRun(changedFiles []string) (bool, error) {
+	if w.VCSRepo != nil && w.VCSRepo.TagsRegex != "" {
+		return false, nil
+	}
+	if !w.FileTriggersEnabled {
+		return true, nil
+	}
+
+	if len(w.TriggerPatterns) > 0 {
+		patterns := make([]*regexp.Regexp, 0, len(w.TriggerPatterns))
+		for _, pattern := range w.TriggerPatterns {
+			re, err := compileTriggerPattern(pattern)
+			if err != nil {
+				return false, err
+			}
+			patterns = append(patterns, re)
+		}
+
+		for _, file := range changedFiles {
+			for _, re := range patterns {
+				if re.MatchString(strings.TrimPrefix(file, "/")) {
+					return true, nil
+				}
+			}
+		}
+		return false, nil
+	}
+
+	prefixes := append([]string{w.WorkingDirectory}, w.TriggerPrefixes...)
+	for _, file := range changedFiles {
+		file = strings.TrimPrefix(file, "/")
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(file, strings.TrimPrefix(prefix, "/")) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// TriggersRunForTag previews whether pushing the given Git tag triggers a run
+// of the workspace, which is only the case when the tag matches the tags regex
+// of its VCS repository.
+func (w *Workspace) TriggersRunForTag(tag string) (bool, error) {
+	if w.VCSRepo == nil || w.VCSRepo.TagsRegex == "" {
+		return false, nil
+	}
+
+	re, err := regexp.Compile(w.VCSRepo.TagsRegex)
+	if err != nil {
+		return false, ErrInvalidTagsRegex
+	}
+
+	return re.MatchString(tag), nil
+}
+
+// validateVCSTriggers checks the VCS trigger settings of the workspace create
+// and update options are not mutually exclusive, and have a valid syntax.
+// Tags regexes are checked using the Go regular expression syntax, which
+// covers the common subset of the syntaxes supported by the API.
+func validateVCSTriggers(fileTriggersEnabled *bool, prefixes, patterns []string, vcsRepo *VCSRepoOptions) error {
+	if len(prefixes) > 0 && len(patterns) > 0 {
+		return ErrUnsupportedBothTriggerPatternsAndPrefixes
+	}
+
+	if vcsRepo != nil && vcsRepo.TagsRegex != nil && *vcsRepo.TagsRegex != "" {
+		if len(patterns) > 0 {
+			return ErrUnsupportedBothTagsRegexAndTriggerPatterns
+		}
+		if len(prefixes) > 0 {
+			return ErrUnsupportedBothTagsRegexAndTriggerPrefixes
+		}
+		if fileTriggersEnabled != nil && *fileTriggersEnabled {
+			return ErrUnsupportedBothTagsRegexAndFileTriggersEnabled
+		}
+		if _, err := regexp.Compile(*vcsRepo.TagsRegex); err != nil {
+			return ErrInvalidTagsRegex
+		}
+	}
+
+	for _, pattern := range patterns {
+		if _, err := compileTriggerPattern(pattern); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// compileTriggerPattern converts a trigger glob pattern into a regular
+// expression. A "*" matches any sequence of characters except "/", a "**"
+// matches any sequence of directories, a "?" matches a single character except
+// "/", and "[...]" matches a character class.
+func compileTriggerPattern(pattern string) (*regexp.Regexp, error) {
+	glob := strings.TrimPrefix(pattern, "/")
+	if glob == "" {
+		return nil, ErrInvalidTriggerPattern
+	}
+
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; c {
+		case '*':
+			if strings.HasPrefix(glob[i:], "**/") {
+				b.WriteString("(?:.*/)?")
+				i += 2
+			} else if strings.HasPrefix(glob[i:], "**") {
+				b.WriteString(".*")
+				i++
+			} else {
+				b.WriteString("[^/]*")
+			}
+		case '?':
+			b.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(glob[i+1:], ']')
+			if end < 0 {
+				return nil, ErrInvalidTriggerPattern
+			}
+			class := glob[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			b.WriteString("[" + class + "]")
+			i += end + 1
+		case '\\':
+			if i+1 == len(glob) {
+				return nil, ErrInvalidTriggerPattern
+			}
+			i++
+			b.WriteString(regexp.QuoteMeta(string(glob[i])))
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return nil, ErrInvalidTriggerPattern
+	}
+
+	return re, nil
+}
@@ -0,0 +1,142 @@
+//go:build integration
+// +build integration
+
+package tfe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspaceTriggersRun(t *testing.T) {
+	t.Run("with trigger patterns", func(t *testing.T) {
+		w := &Workspace{
+			FileTriggersEnabled: true,
+			TriggerPatterns:     []string{"/modules/**/*.tf", "*.tfvars"},
+		}
+
+		for files, expected := range map[string]bool{
+			"modules/vpc/main.tf":        true,
+			"modules/vpc/subnets/sub.tf": true,
+			"modules/main.tf":            true,
+			"prod.tfvars":                true,
+			"env/prod.tfvars":            false,
+			"modules/vpc/README.md":      false,
+		} {
+			triggered, err := w.TriggersRun([]string{files})
+			require.NoError(t, err)
+			assert.Equal(t, expected, triggered, files)
+		}
+	})
+
+	t.Run("with a working directory and trigger prefixes", func(t *testing.T) {
+		w := &Workspace{
+			FileTriggersEnabled: true,
+			WorkingDirectory:    "envs/prod",
+			TriggerPrefixes:     []string{"/modules"},
+		}
+
+		triggered, err := w.TriggersRun([]string{"README.md", "modules/vpc/main.tf"})
+		require.NoError(t, err)
+		assert.True(t, triggered)
+
+		triggered, err = w.TriggersRun([]string{"envs/dev/main.tf"})
+		require.NoError(t, err)
+		assert.False(t, triggered)
+	})
+
+	t.Run("without file triggers", func(t *testing.T) {
+		w := &Workspace{TriggerPrefixes: []string{"modules"}}
+
+		triggered, err := w.TriggersRun([]string{"README.md"})
+		require.NoError(t, err)
+		assert.True(t, triggered)
+	})
+
+	t.Run("with a tags regex", func(t *testing.T) {
+		w := &Workspace{VCSRepo: &VCSRepo{TagsRegex: `^v\d+\.\d+\.\d+$`}}
+
+		triggered, err := w.TriggersRun([]string{"main.tf"})
+		require.NoError(t, err)
+		assert.False(t, triggered)
+
+		triggered, err = w.TriggersRunForTag("v1.2.3")
+		require.NoError(t, err)
+		assert.True(t, triggered)
+
+		triggered, err = w.TriggersRunForTag("release-1")
+		require.NoError(t, err)
+		assert.False(t, triggered)
+	})
+
+	t.Run("with an invalid trigger pattern", func(t *testing.T) {
+		w := &Workspace{FileTriggersEnabled: true, TriggerPatterns: []string{"modules/[a-z"}}
+
+		_, err := w.TriggersRun([]string{"main.tf"})
+		assert.Equal(t, ErrInvalidTriggerPattern, err)
+	})
+}
+
+func TestWorkspaceTriggersValidation(t *testing.T) {
+	for name, tc := range map[string]struct {
+		options WorkspaceUpdateOptions
+		err     error
+	}{
+		"with trigger patterns and prefixes": {
+			options: WorkspaceUpdateOptions{
+				TriggerPatterns: []string{"*.tf"},
+				TriggerPrefixes: []string{"modules"},
+			},
+			err: ErrUnsupportedBothTriggerPatternsAndPrefixes,
+		},
+		"with a tags regex and trigger patterns": {
+			options: WorkspaceUpdateOptions{
+				TriggerPatterns: []string{"*.tf"},
+				VCSRepo:         NewOptional(VCSRepoOptions{TagsRegex: String(`\d+`)}),
+			},
+			err: ErrUnsupportedBothTagsRegexAndTriggerPatterns,
+		},
+		"with a tags regex and trigger prefixes": {
+			options: WorkspaceUpdateOptions{
+				TriggerPrefixes: []string{"modules"},
+				VCSRepo:         NewOptional(VCSRepoOptions{TagsRegex: String(`\d+`)}),
+			},
+			err: ErrUnsupportedBothTagsRegexAndTriggerPrefixes,
+		},
+		"with a tags regex and file triggers enabled": {
+			options: WorkspaceUpdateOptions{
+				FileTriggersEnabled: Bool(true),
+				VCSRepo:             NewOptional(VCSRepoOptions{TagsRegex: String(`\d+`)}),
+			},
+			err: ErrUnsupportedBothTagsRegexAndFileTriggersEnabled,
+		},
+		"with an invalid tags regex": {
+			options: WorkspaceUpdateOptions{
+				VCSRepo: NewOptional(VCSRepoOptions{TagsRegex: String(`v(\d+`)}),
+			},
+			err: ErrInvalidTagsRegex,
+		},
+		"with an invalid trigger pattern": {
+			options: WorkspaceUpdateOptions{
+				TriggerPatterns: []string{`modules\`},
+			},
+			err: ErrInvalidTriggerPattern,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.err, tc.options.valid())
+
+			vcsRepo, _ := tc.options.VCSRepo.Get()
+			createOptions := WorkspaceCreateOptions{
+				Name:                String("triggers"),
+				FileTriggersEnabled: tc.options.FileTriggersEnabled,
+				TriggerPatterns:     tc.options.TriggerPatterns,
+				TriggerPrefixes:     tc.options.TriggerPrefixes,
+				VCSRepo:             &vcsRepo,
+			}
+			assert.Equal(t, tc.err, createOptions.valid())
+		})
+	}
+}